@@ -0,0 +1,171 @@
+// api/attachments.go
+package api
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"mime"
+	"path/filepath"
+	"strings"
+	"time"
+
+	gen "github.com/kolaente/meet-mesh/api/gen"
+)
+
+const (
+	defaultAttachmentSizeMB = 10
+	maxAttachmentSizeMB     = 25
+)
+
+// pendingAttachmentTTL is how long an uploaded file waits for its booking
+// before it is removed.
+const pendingAttachmentTTL = 24 * time.Hour
+
+// maxPendingAttachmentsPerField limits how many files can wait for their
+// booking per file custom field of a booking link.
+const maxPendingAttachmentsPerField = 20
+
+// checkFileField validates the upload limits of a file custom field. It
+// returns why they are rejected, or an empty string.
+func checkFileField(field CustomField) string {
+	if field.MaxFileSizeMB < 0 || field.MaxFileSizeMB > maxAttachmentSizeMB {
+		return fmt.Sprintf("Files of custom field %q can be at most %dMB", field.Name, maxAttachmentSizeMB)
+	}
+	for _, t := range field.FileTypes {
+		if !strings.HasPrefix(t, ".") && !strings.Contains(t, "/") {
+			return fmt.Sprintf("Invalid file type %q for custom field %q, use an extension like .pdf or a MIME type", t, field.Name)
+		}
+	}
+	return ""
+}
+
+// attachmentSizeLimit returns the largest upload field accepts in bytes.
+func attachmentSizeLimit(field CustomField) int64 {
+	mb := field.MaxFileSizeMB
+	if mb <= 0 {
+		mb = defaultAttachmentSizeMB
+	}
+	return int64(mb) << 20
+}
+
+// attachmentContentType returns the MIME type of an uploaded file, from its
+// extension if that is known and sniffed from its content otherwise.
+func attachmentContentType(filename string, sniffed string) string {
+	if byExt := mime.TypeByExtension(strings.ToLower(filepath.Ext(filename))); byExt != "" {
+		sniffed = byExt
+	}
+	if mediaType, _, err := mime.ParseMediaType(sniffed); err == nil {
+		return mediaType
+	}
+	return "application/octet-stream"
+}
+
+// acceptsFileType reports whether field accepts a file named filename with
+// contentType. Types are matched by extension, MIME type or MIME wildcard.
+func acceptsFileType(field CustomField, filename, contentType string) bool {
+	if len(field.FileTypes) == 0 {
+		return true
+	}
+	ext := strings.ToLower(filepath.Ext(filename))
+	for _, t := range field.FileTypes {
+		t = strings.ToLower(strings.TrimSpace(t))
+		switch {
+		case strings.HasPrefix(t, "."):
+			if ext == t {
+				return true
+			}
+		case strings.HasSuffix(t, "/*"):
+			if strings.HasPrefix(contentType, strings.TrimSuffix(t, "*")) {
+				return true
+			}
+		case contentType == t:
+			return true
+		}
+	}
+	return false
+}
+
+// claimAttachments resolves the upload tokens given as answers to the file
+// fields of link. The answers are replaced by the file names. It returns the
+// attachments to link to the booking, or the problems with every answer
+// that doesn't refer to a pending upload.
+func (h *Handler) claimAttachments(link *BookingLink, answers map[string]string) ([]Attachment, []gen.FieldError) {
+	var attachments []Attachment
+	var problems []gen.FieldError
+	for _, f := range link.CustomFields {
+		token := answers[f.Name]
+		if f.Type != CustomFieldTypeFile || token == "" {
+			continue
+		}
+
+		var attachment Attachment
+		if err := h.db.Where("token = ? AND booking_link_id = ? AND field_name = ? AND booking_id IS NULL", token, link.ID, f.Name).
+			First(&attachment).Error; err != nil {
+			problems = append(problems, gen.FieldError{Field: f.Name, Message: "The file was not found, please upload it again"})
+			continue
+		}
+		answers[f.Name] = attachment.Filename
+		attachments = append(attachments, attachment)
+	}
+	return attachments, problems
+}
+
+// attachToBooking links claimed attachments to their booking.
+func (h *Handler) attachToBooking(attachments []Attachment, bookingID uint) {
+	for _, a := range attachments {
+		if err := h.db.Model(&Attachment{}).Where("id = ? AND booking_id IS NULL", a.ID).Update("booking_id", bookingID).Error; err != nil {
+			log.Printf("[WARN] Failed to attach file %d to booking %d: %v", a.ID, bookingID, err)
+		}
+	}
+}
+
+// attachmentNotes lists download links to the attachments of a booking for
+// the organizer's calendar event. Downloading requires the organizer's
+// session.
+func (h *Handler) attachmentNotes(bookingID uint) string {
+	var attachments []Attachment
+	if err := h.db.Where("booking_id = ?", bookingID).Order("id").Find(&attachments).Error; err != nil || len(attachments) == 0 {
+		return ""
+	}
+
+	var b strings.Builder
+	b.WriteString("Attachments:")
+	for _, a := range attachments {
+		fmt.Fprintf(&b, "\n%s: %s", a.Filename, attachmentURL(h.config.Server.BaseURL, a.ID))
+	}
+	return b.String()
+}
+
+func attachmentURL(baseURL string, id uint) string {
+	return fmt.Sprintf("%s/api/attachments/%d", baseURL, id)
+}
+
+// deleteAttachments removes attachments and their files.
+func (h *Handler) deleteAttachments(ctx context.Context, attachments []Attachment) error {
+	for _, a := range attachments {
+		if h.attachments != nil {
+			if err := h.attachments.Delete(ctx, a.StorageKey); err != nil {
+				log.Printf("[WARN] Failed to delete attachment file %s: %v", a.StorageKey, err)
+			}
+		}
+		if err := h.db.WithContext(ctx).Delete(&a).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// CleanupAttachments removes uploads that were never used for a booking and
+// the attachments of bookings and booking links that no longer exist.
+func (h *Handler) CleanupAttachments(ctx context.Context) error {
+	var attachments []Attachment
+	if err := h.db.WithContext(ctx).
+		Where("booking_id IS NULL AND created_at < ?", time.Now().Add(-pendingAttachmentTTL)).
+		Or("booking_id IS NOT NULL AND booking_id NOT IN (SELECT id FROM bookings)").
+		Or("booking_link_id NOT IN (SELECT id FROM booking_links)").
+		Find(&attachments).Error; err != nil {
+		return err
+	}
+	return h.deleteAttachments(ctx, attachments)
+}
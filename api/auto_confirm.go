@@ -0,0 +1,83 @@
+// api/auto_confirm.go
+package api
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// normalizeAutoConfirmRules cleans up the allow-listed email domains and
+// validates the rules. It returns why they are rejected, or an empty string.
+// Rules that can never match are dropped.
+func normalizeAutoConfirmRules(rules *AutoConfirmRules) (*AutoConfirmRules, string) {
+	if rules == nil {
+		return nil, ""
+	}
+
+	domains := make([]string, 0, len(rules.EmailDomains))
+	for _, d := range rules.EmailDomains {
+		d = strings.ToLower(strings.TrimPrefix(strings.TrimSpace(d), "@"))
+		if d == "" || strings.ContainsAny(d, "@ \t") || !strings.Contains(d, ".") {
+			return nil, fmt.Sprintf("Invalid email domain %q for auto-confirm", d)
+		}
+		domains = append(domains, d)
+	}
+	rules.EmailDomains = domains
+
+	for _, h := range rules.Hours {
+		if h.StartTime >= h.EndTime {
+			return nil, fmt.Sprintf("Auto-confirm hours must start before they end, %s-%s doesn't", h.StartTime, h.EndTime)
+		}
+	}
+
+	if !rules.KnownGuests && len(rules.EmailDomains) == 0 && len(rules.Hours) == 0 {
+		return nil, ""
+	}
+	return rules, ""
+}
+
+// autoConfirmBooking reports whether a booking of link by guestEmail for the
+// given slot is confirmed right away. Links with AutoConfirm confirm every
+// booking, otherwise a booking has to match one of the link's rules.
+// Guests type in their email address, so bookings matching an email based
+// rule are only confirmed once the guest verified the address; verify
+// reports that.
+func (h *Handler) autoConfirmBooking(link *BookingLink, guestEmail string, start, end time.Time) (confirm, verify bool) {
+	if link.AutoConfirm {
+		return true, false
+	}
+	rules := link.AutoConfirmRules
+	if rules == nil {
+		return false, false
+	}
+
+	if len(rules.Hours) > 0 && h.isSlotWithinAvailability(start, end, rules.Hours) {
+		return true, false
+	}
+
+	guestEmail = strings.ToLower(strings.TrimSpace(guestEmail))
+	if at := strings.LastIndex(guestEmail, "@"); at >= 0 {
+		domain := guestEmail[at+1:]
+		for _, d := range rules.EmailDomains {
+			if domain == d {
+				return false, true
+			}
+		}
+	}
+
+	return false, rules.KnownGuests && guestEmail != "" && h.isKnownGuest(link.UserID, guestEmail)
+}
+
+// isKnownGuest reports whether guestEmail has a confirmed booking on any of
+// the organizer's booking links.
+func (h *Handler) isKnownGuest(userID uint, guestEmail string) bool {
+	var count int64
+	if err := h.db.Model(&Booking{}).
+		Joins("JOIN booking_links ON booking_links.id = bookings.booking_link_id").
+		Where("booking_links.user_id = ? AND LOWER(bookings.guest_email) = ? AND bookings.status = ?", userID, guestEmail, BookingStatusConfirmed).
+		Count(&count).Error; err != nil {
+		return false
+	}
+	return count > 0
+}
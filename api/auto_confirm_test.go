@@ -0,0 +1,116 @@
+package api
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	gen "github.com/kolaente/meet-mesh/api/gen"
+)
+
+func TestNormalizeAutoConfirmRules(t *testing.T) {
+	rules, problem := normalizeAutoConfirmRules(&AutoConfirmRules{EmailDomains: []string{" @Example.COM "}})
+	if problem != "" || rules == nil || rules.EmailDomains[0] != "example.com" {
+		t.Fatalf("expected the domain to be normalized, got %+v %q", rules, problem)
+	}
+
+	if _, problem := normalizeAutoConfirmRules(&AutoConfirmRules{EmailDomains: []string{"alice@example.com"}}); problem == "" {
+		t.Errorf("expected an email address to be rejected as domain")
+	}
+	if _, problem := normalizeAutoConfirmRules(&AutoConfirmRules{Hours: []AvailabilityRule{{DaysOfWeek: []int{1}, StartTime: "17:00", EndTime: "09:00"}}}); problem == "" {
+		t.Errorf("expected hours ending before they start to be rejected")
+	}
+	if rules, _ := normalizeAutoConfirmRules(&AutoConfirmRules{}); rules != nil {
+		t.Errorf("expected empty rules to be dropped, got %+v", rules)
+	}
+}
+
+func TestCreateBookingAutoConfirmRules(t *testing.T) {
+	h := newTestHandler(t)
+	ctx := context.Background()
+
+	// Next Monday, so the slot is in the future and on a known weekday
+	start := time.Now().UTC().Truncate(24*time.Hour).AddDate(0, 0, 7)
+	for start.Weekday() != time.Monday {
+		start = start.AddDate(0, 0, 1)
+	}
+
+	allDay := []AvailabilityRule{{DaysOfWeek: []int{1}, StartTime: "00:00", EndTime: "23:59"}}
+	link := BookingLink{UserID: 1, Slug: "intro", Name: "Intro", Status: LinkStatusActive, SlotDurationMinutes: 30, AvailabilityRules: allDay,
+		AutoConfirmRules: &AutoConfirmRules{
+			KnownGuests:  true,
+			EmailDomains: []string{"partner.com"},
+			Hours:        []AvailabilityRule{{DaysOfWeek: []int{1}, StartTime: "09:00", EndTime: "12:00"}},
+		}}
+	h.db.Create(&User{ID: 1, OIDCSub: "organizer", Email: "organizer@example.com"})
+	h.db.Create(&link)
+	other := BookingLink{UserID: 1, Slug: "other", Name: "Other", Status: LinkStatusActive}
+	h.db.Create(&other)
+	h.db.Create(&Booking{BookingLinkID: other.ID, GuestEmail: "Regular@example.com", Status: BookingStatusConfirmed, ActionToken: "known"})
+
+	book := func(email string, hour int) gen.BookingStatus {
+		t.Helper()
+		slotStart := start.Add(time.Duration(hour) * time.Hour)
+		res, err := h.CreateBooking(ctx, &gen.CreateBookingReq{
+			GuestEmail: email,
+			StartTime:  slotStart,
+			EndTime:    slotStart.Add(30 * time.Minute),
+		}, gen.CreateBookingParams{Slug: link.Slug})
+		if err != nil {
+			t.Fatalf("CreateBooking failed: %v", err)
+		}
+		created, ok := res.(*gen.CreateBookingCreated)
+		if !ok {
+			t.Fatalf("expected booking, got %#v", res)
+		}
+		return created.Status
+	}
+
+	tests := []struct {
+		name   string
+		email  string
+		hour   int
+		want   BookingStatus
+		verify bool
+	}{
+		{"unknown guest", "stranger@example.com", 14, BookingStatusPending, false},
+		{"known guest", "regular@example.com", 15, BookingStatusPending, true},
+		{"allowed domain", "bob@Partner.com", 16, BookingStatusPending, true},
+		{"subdomain of allowed domain", "bob@mail.partner.com", 17, BookingStatusPending, false},
+		{"within hours", "newcomer@example.com", 10, BookingStatusConfirmed, false},
+	}
+	for _, tt := range tests {
+		if got := book(tt.email, tt.hour); got != gen.BookingStatus(tt.want) {
+			t.Errorf("%s: expected status %d, got %d", tt.name, tt.want, got)
+		}
+		var booking Booking
+		h.db.Order("id DESC").First(&booking)
+		if (booking.VerifyToken != "") != tt.verify {
+			t.Errorf("%s: expected verification %v, got token %q", tt.name, tt.verify, booking.VerifyToken)
+		}
+	}
+
+	// A pending booking doesn't make a guest known
+	if got := book("stranger@example.com", 18); got != gen.BookingStatus(BookingStatusPending) {
+		t.Errorf("expected a guest with only pending bookings to wait for approval, got %d", got)
+	}
+
+	// Verifying the email address confirms the booking, once
+	var pending Booking
+	h.db.Where("guest_email = ?", "bob@Partner.com").First(&pending)
+	res, err := h.VerifyBookingEmail(ctx, gen.VerifyBookingEmailParams{Token: pending.VerifyToken})
+	if err != nil {
+		t.Fatalf("VerifyBookingEmail failed: %v", err)
+	}
+	if _, ok := res.(*gen.VerifyBookingEmailOK); !ok {
+		t.Fatalf("expected the booking to be confirmed, got %#v", res)
+	}
+	var verified Booking
+	h.db.First(&verified, pending.ID)
+	if verified.Status != BookingStatusConfirmed || verified.VerifyToken != "" || verified.ActionToken != "" {
+		t.Errorf("expected a confirmed booking without tokens, got %+v", verified)
+	}
+	if res, _ := h.VerifyBookingEmail(ctx, gen.VerifyBookingEmailParams{Token: pending.VerifyToken}); !isError(res) {
+		t.Errorf("expected the link to work only once, got %#v", res)
+	}
+}
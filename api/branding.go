@@ -0,0 +1,295 @@
+package api
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"gorm.io/gorm"
+
+	gen "github.com/kolaente/meet-mesh/api/gen"
+)
+
+const (
+	maxBrandingImageSize = 5 << 20 // 5MB
+	logoMaxDim           = 512
+	coverMaxDim          = 1600
+	coverQuality         = 85
+)
+
+// BrandingHandler handles logo and cover image upload, delete, and serving
+// for users, booking links and polls. Like AvatarHandler these are plain HTTP
+// handlers because ogen does not support multipart uploads.
+type BrandingHandler struct {
+	db      *gorm.DB
+	auth    *AuthService
+	storage FileStorage
+}
+
+func NewBrandingHandler(db *gorm.DB, auth *AuthService, storage FileStorage) *BrandingHandler {
+	return &BrandingHandler{
+		db:      db,
+		auth:    auth,
+		storage: storage,
+	}
+}
+
+// HandleBranding is the main handler for /api/branding/ that dispatches by method.
+//
+//	POST   /api/branding/{logo|cover}[?booking_link_id=|poll_id=]  upload
+//	DELETE /api/branding/{logo|cover}[?booking_link_id=|poll_id=]  remove
+//	GET    /api/branding/{filename}                                 serve
+func (h *BrandingHandler) HandleBranding(w http.ResponseWriter, r *http.Request) {
+	name := strings.Trim(strings.TrimPrefix(r.URL.Path, "/api/branding"), "/")
+
+	switch {
+	case r.Method == http.MethodPost && isBrandingKind(name):
+		h.Upload(w, r, name)
+	case r.Method == http.MethodDelete && isBrandingKind(name):
+		h.Delete(w, r, name)
+	case r.Method == http.MethodGet && name != "":
+		h.Serve(w, r, name)
+	default:
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+	}
+}
+
+func isBrandingKind(kind string) bool {
+	return kind == "logo" || kind == "cover"
+}
+
+// Upload handles POST /api/branding/{kind}
+func (h *BrandingHandler) Upload(w http.ResponseWriter, r *http.Request, kind string) {
+	userID, ok := authenticateSession(h.auth, r)
+	if !ok {
+		http.Error(w, "Not authenticated", http.StatusUnauthorized)
+		return
+	}
+
+	record, branding, ok := h.loadTarget(w, r, userID)
+	if !ok {
+		return
+	}
+
+	img, ok := readImageUpload(w, r, "image", maxBrandingImageSize)
+	if !ok {
+		return
+	}
+
+	// Logos keep transparency as PNG, covers are photos and stored as JPEG
+	var data []byte
+	var err error
+	var ext, contentType string
+	if kind == "logo" {
+		data, err = encodePNG(resizeImage(img, logoMaxDim))
+		ext, contentType = ".png", "image/png"
+	} else {
+		data, err = encodeJPEG(resizeImage(img, coverMaxDim), coverQuality)
+		ext, contentType = ".jpg", "image/jpeg"
+	}
+	if err != nil {
+		http.Error(w, "Failed to process image", http.StatusInternalServerError)
+		return
+	}
+
+	// Generate filename from content hash for cache busting
+	hash := sha256.Sum256(data)
+	filename := kind + "-" + hex.EncodeToString(hash[:8]) + ext
+
+	if err := h.storage.Put(r.Context(), filename, data, contentType); err != nil {
+		http.Error(w, "Failed to save image", http.StatusInternalServerError)
+		return
+	}
+
+	if *branding == nil {
+		*branding = &Branding{}
+	}
+	oldFilename := setBrandingFilename(*branding, kind, filename)
+
+	if err := h.db.Model(record).Select("Branding").Updates(record).Error; err != nil {
+		http.Error(w, "Failed to update branding", http.StatusInternalServerError)
+		return
+	}
+
+	h.cleanupFile(r, oldFilename, filename)
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_, _ = fmt.Fprintf(w, `{"%s_url":"%s"}`, kind, brandingFileURL(filename))
+}
+
+// Delete handles DELETE /api/branding/{kind}
+func (h *BrandingHandler) Delete(w http.ResponseWriter, r *http.Request, kind string) {
+	userID, ok := authenticateSession(h.auth, r)
+	if !ok {
+		http.Error(w, "Not authenticated", http.StatusUnauthorized)
+		return
+	}
+
+	record, branding, ok := h.loadTarget(w, r, userID)
+	if !ok {
+		return
+	}
+
+	if *branding != nil {
+		oldFilename := setBrandingFilename(*branding, kind, "")
+		if err := h.db.Model(record).Select("Branding").Updates(record).Error; err != nil {
+			http.Error(w, "Failed to update branding", http.StatusInternalServerError)
+			return
+		}
+		h.cleanupFile(r, oldFilename, "")
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_, _ = fmt.Fprintf(w, `{"%s_url":""}`, kind)
+}
+
+// Serve handles GET /api/branding/{filename}
+// This endpoint is public (no auth) since branding appears on public pages and in emails.
+func (h *BrandingHandler) Serve(w http.ResponseWriter, r *http.Request, filename string) {
+	if filepath.Base(filename) != filename {
+		http.NotFound(w, r)
+		return
+	}
+
+	contentType := "image/jpeg"
+	if strings.HasSuffix(filename, ".png") {
+		contentType = "image/png"
+	}
+
+	serveStoredFile(w, r, h.storage, filename, contentType)
+}
+
+// loadTarget loads the record whose branding is changed: the booking link or
+// poll given in the query, or the user themselves. It returns the record and
+// a pointer to its branding field.
+func (h *BrandingHandler) loadTarget(w http.ResponseWriter, r *http.Request, userID uint) (any, **Branding, bool) {
+	query := r.URL.Query()
+
+	if idStr := query.Get("booking_link_id"); idStr != "" {
+		id, err := strconv.Atoi(idStr)
+		var link BookingLink
+		if err != nil || h.db.Where("id = ? AND user_id = ?", id, userID).First(&link).Error != nil {
+			http.Error(w, "Booking link not found", http.StatusNotFound)
+			return nil, nil, false
+		}
+		return &link, &link.Branding, true
+	}
+
+	if idStr := query.Get("poll_id"); idStr != "" {
+		id, err := strconv.Atoi(idStr)
+		var poll Poll
+		if err != nil || h.db.Where("id = ? AND user_id = ?", id, userID).First(&poll).Error != nil {
+			http.Error(w, "Poll not found", http.StatusNotFound)
+			return nil, nil, false
+		}
+		return &poll, &poll.Branding, true
+	}
+
+	var user User
+	if err := h.db.First(&user, userID).Error; err != nil {
+		http.Error(w, "User not found", http.StatusInternalServerError)
+		return nil, nil, false
+	}
+	return &user, &user.Branding, true
+}
+
+// cleanupFile removes a replaced branding file unless it is still in use.
+// Filenames are content hashes, so the same image may be shared by the user
+// and several links.
+func (h *BrandingHandler) cleanupFile(r *http.Request, oldFilename, newFilename string) {
+	if oldFilename == "" || oldFilename == newFilename {
+		return
+	}
+
+	pattern := "%\"" + oldFilename + "\"%"
+	for _, model := range []any{&User{}, &BookingLink{}, &Poll{}} {
+		var count int64
+		h.db.Model(model).Where("branding LIKE ?", pattern).Count(&count)
+		if count > 0 {
+			return
+		}
+	}
+
+	_ = h.storage.Delete(r.Context(), oldFilename)
+}
+
+// setBrandingFilename sets the logo or cover filename and returns the old one.
+func setBrandingFilename(b *Branding, kind, filename string) string {
+	var old string
+	if kind == "logo" {
+		old, b.LogoFilename = b.LogoFilename, filename
+	} else {
+		old, b.CoverFilename = b.CoverFilename, filename
+	}
+	return old
+}
+
+// brandingFileURL constructs the URL for a branding image.
+// Returns empty string if no image is set.
+func brandingFileURL(filename string) string {
+	if filename == "" {
+		return ""
+	}
+	return "/api/branding/" + filename
+}
+
+// effectiveBranding merges the branding of a booking link or poll over the
+// organizer's default branding. Each value falls back individually.
+func effectiveBranding(organizer *User, own *Branding) *Branding {
+	result := &Branding{}
+	for _, b := range []*Branding{organizer.Branding, own} {
+		if b == nil {
+			continue
+		}
+		if b.LogoFilename != "" {
+			result.LogoFilename = b.LogoFilename
+		}
+		if b.CoverFilename != "" {
+			result.CoverFilename = b.CoverFilename
+		}
+		if b.PrimaryColor != "" {
+			result.PrimaryColor = b.PrimaryColor
+		}
+		if b.AccentColor != "" {
+			result.AccentColor = b.AccentColor
+		}
+	}
+	return result
+}
+
+// applyBrandingColorsFromGen updates the colors of current from an API request.
+// Images are only changed through the upload endpoints.
+func applyBrandingColorsFromGen(current *Branding, opt gen.OptBranding) *Branding {
+	if !opt.Set {
+		return current
+	}
+	result := &Branding{}
+	if current != nil {
+		*result = *current
+	}
+	if opt.Value.PrimaryColor.Set {
+		result.PrimaryColor = opt.Value.PrimaryColor.Value
+	}
+	if opt.Value.AccentColor.Set {
+		result.AccentColor = opt.Value.AccentColor.Value
+	}
+	return result
+}
+
+func mapBrandingToGen(b *Branding) gen.OptBranding {
+	if b == nil {
+		return gen.OptBranding{}
+	}
+	return gen.NewOptBranding(gen.Branding{
+		PrimaryColor: gen.NewOptString(b.PrimaryColor),
+		AccentColor:  gen.NewOptString(b.AccentColor),
+		LogoURL:      gen.NewOptString(brandingFileURL(b.LogoFilename)),
+		CoverURL:     gen.NewOptString(brandingFileURL(b.CoverFilename)),
+	})
+}
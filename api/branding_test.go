@@ -0,0 +1,53 @@
+package api
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestEffectiveBranding(t *testing.T) {
+	organizer := &User{Branding: &Branding{
+		LogoFilename: "logo-user.png",
+		PrimaryColor: "#111111",
+		AccentColor:  "#222222",
+	}}
+	own := &Branding{
+		CoverFilename: "cover-link.jpg",
+		PrimaryColor:  "#333333",
+	}
+
+	got := effectiveBranding(organizer, own)
+	want := Branding{
+		LogoFilename:  "logo-user.png",
+		CoverFilename: "cover-link.jpg",
+		PrimaryColor:  "#333333",
+		AccentColor:   "#222222",
+	}
+	if *got != want {
+		t.Errorf("got %+v, want %+v", *got, want)
+	}
+
+	if got := effectiveBranding(&User{}, nil); *got != (Branding{}) {
+		t.Errorf("expected empty branding, got %+v", *got)
+	}
+}
+
+func TestBrandedEmail(t *testing.T) {
+	mailer, err := NewMailer(&SMTPConfig{}, "https://meet.example.com")
+	if err != nil {
+		t.Fatalf("NewMailer failed: %v", err)
+	}
+
+	organizer := &User{Name: "Jane", Branding: &Branding{LogoFilename: "logo-abc.png"}}
+	body := mailer.renderTemplate("booking_declined", mailer.withBranding(map[string]any{
+		"LinkName":      "Intro Call",
+		"OrganizerName": organizer.Name,
+	}, organizer, &Branding{PrimaryColor: "#ff0000"}))
+
+	if !strings.Contains(body, `src="https://meet.example.com/api/branding/logo-abc.png"`) {
+		t.Errorf("expected logo in email, got:\n%s", body)
+	}
+	if !strings.Contains(body, "color: #ff0000;") {
+		t.Errorf("expected primary color in email, got:\n%s", body)
+	}
+}
@@ -3,10 +3,11 @@ package api
 
 import (
 	"context"
+	"crypto/rand"
+	"encoding/hex"
 	"fmt"
 	"net/http"
 	"sort"
-	"strings"
 	"time"
 
 	"github.com/emersion/go-ical"
@@ -208,8 +209,20 @@ func (c *CalDAVClient) TestCalendarConnection(ctx context.Context, connID uint,
 	return events, nil
 }
 
-// CreateBookingEvent creates a calendar event for a confirmed booking
-func (c *CalDAVClient) CreateBookingEvent(ctx context.Context, userID uint, booking *Booking, slot *Slot, template *EventTemplate, meetingLink string) (string, error) {
+// CreateBookingEvent creates a calendar event for a confirmed booking. notes
+// are added to the end of the description. Overwriting the hold of a pending
+// booking confirms it.
+func (c *CalDAVClient) CreateBookingEvent(ctx context.Context, userID uint, booking *Booking, slot *Slot, template *EventTemplate, meetingLink, notes string) (string, error) {
+	return c.putBookingEvent(ctx, userID, booking, slot, template, meetingLink, notes, ical.EventConfirmed)
+}
+
+// CreateBookingHold creates a tentative event reserving the slot of a booking
+// that waits for the organizer's approval.
+func (c *CalDAVClient) CreateBookingHold(ctx context.Context, userID uint, booking *Booking, slot *Slot, template *EventTemplate, notes string) (string, error) {
+	return c.putBookingEvent(ctx, userID, booking, slot, template, "", notes, ical.EventTentative)
+}
+
+func (c *CalDAVClient) putBookingEvent(ctx context.Context, userID uint, booking *Booking, slot *Slot, template *EventTemplate, meetingLink, notes string, status ical.EventStatus) (string, error) {
 	var conn CalendarConnection
 	if err := c.db.Where("user_id = ? AND write_url != ''", userID).First(&conn).Error; err != nil {
 		return "", err
@@ -220,50 +233,97 @@ func (c *CalDAVClient) CreateBookingEvent(ctx context.Context, userID uint, book
 		return "", err
 	}
 
-	// Build iCal event
-	cal := ical.NewCalendar()
-	cal.Props.SetText(ical.PropProductID, "-//Meet Mesh//EN")
-	cal.Props.SetText(ical.PropVersion, "2.0")
+	uid := booking.CalendarUID
+	if uid == "" {
+		uid = generateUID()
+	}
 
-	event := ical.NewEvent()
-	uid := generateUID()
-	event.Props.SetText(ical.PropUID, uid)
-	event.Props.SetDateTime(ical.PropDateTimeStart, slot.StartTime)
-	event.Props.SetDateTime(ical.PropDateTimeEnd, slot.EndTime)
+	var organizer User
+	if err := c.db.First(&organizer, userID).Error; err != nil {
+		return "", err
+	}
 
-	title := "Meeting"
-	if template != nil && template.TitleTemplate != "" {
-		title = expandTemplate(template.TitleTemplate, booking, meetingLink)
+	cal := newBookingEventCalendar(uid, booking, slot, template, &organizer, meetingLink, notes, status)
+
+	// Put the event
+	path := conn.WriteURL + "/" + uid + ".ics"
+	_, err = client.PutCalendarObject(ctx, path, cal)
+	if err != nil {
+		return "", err
 	}
-	event.Props.SetText(ical.PropSummary, title)
 
-	if template != nil && template.DescriptionTemplate != "" {
-		event.Props.SetText(ical.PropDescription, expandTemplate(template.DescriptionTemplate, booking, meetingLink))
+	return uid, nil
+}
+
+// CreatePollEvent creates a calendar event for the winning option of a poll
+// with the voters as attendees.
+func (c *CalDAVClient) CreatePollEvent(ctx context.Context, userID uint, poll *Poll, option *PollOption, attendees []Vote) (string, error) {
+	var conn CalendarConnection
+	if err := c.db.Where("user_id = ? AND write_url != ''", userID).First(&conn).Error; err != nil {
+		return "", err
 	}
 
-	// Use meeting link as location if provided and no location set in template
-	if meetingLink != "" {
-		if template == nil || template.Location == "" {
-			event.Props.SetText(ical.PropLocation, meetingLink)
-		} else {
-			event.Props.SetText(ical.PropLocation, template.Location)
-		}
-	} else if template != nil && template.Location != "" {
-		event.Props.SetText(ical.PropLocation, template.Location)
+	client, err := c.createClient(&conn)
+	if err != nil {
+		return "", err
 	}
 
-	cal.Children = append(cal.Children, event.Component)
+	var organizer User
+	if err := c.db.First(&organizer, userID).Error; err != nil {
+		return "", err
+	}
+
+	// Overwriting the hold of the option confirms it
+	uid := option.HoldUID
+	if uid == "" {
+		uid = generateUID()
+	}
+	cal := newPollEventCalendar(uid, poll, option, attendees, &organizer)
 
-	// Put the event
 	path := conn.WriteURL + "/" + uid + ".ics"
-	_, err = client.PutCalendarObject(ctx, path, cal)
+	if _, err := client.PutCalendarObject(ctx, path, cal); err != nil {
+		return "", err
+	}
+
+	return uid, nil
+}
+
+// CreatePollHold creates a tentative event reserving an option of an open poll
+func (c *CalDAVClient) CreatePollHold(ctx context.Context, userID uint, poll *Poll, option *PollOption) (string, error) {
+	var conn CalendarConnection
+	if err := c.db.Where("user_id = ? AND write_url != ''", userID).First(&conn).Error; err != nil {
+		return "", err
+	}
+
+	client, err := c.createClient(&conn)
 	if err != nil {
 		return "", err
 	}
 
+	uid := generateUID()
+	path := conn.WriteURL + "/" + uid + ".ics"
+	if _, err := client.PutCalendarObject(ctx, path, newPollHoldCalendar(uid, poll, option)); err != nil {
+		return "", err
+	}
+
 	return uid, nil
 }
 
+// DeleteEvent removes an event created by meet-mesh from the write calendar
+func (c *CalDAVClient) DeleteEvent(ctx context.Context, userID uint, uid string) error {
+	var conn CalendarConnection
+	if err := c.db.Where("user_id = ? AND write_url != ''", userID).First(&conn).Error; err != nil {
+		return err
+	}
+
+	client, err := c.createClient(&conn)
+	if err != nil {
+		return err
+	}
+
+	return client.RemoveAll(ctx, conn.WriteURL+"/"+uid+".ics")
+}
+
 // Helper functions
 func mergePeriods(periods []TimePeriod) []TimePeriod {
 	if len(periods) == 0 {
@@ -292,21 +352,9 @@ func mergePeriods(periods []TimePeriod) []TimePeriod {
 }
 
 func generateUID() string {
-	// Generate unique ID for calendar event
-	return time.Now().Format("20060102T150405") + "@meet-mesh"
-}
-
-func expandTemplate(template string, booking *Booking, meetingLink string) string {
-	// Simple template expansion
-	result := template
-	if booking != nil {
-		// Replace {{guest_name}} with booking.GuestName
-		// Replace {{guest_email}} with booking.GuestEmail
-		// Basic string replacement - in production use text/template
-		result = strings.ReplaceAll(result, "{{guest_name}}", booking.GuestName)
-		result = strings.ReplaceAll(result, "{{guest_email}}", booking.GuestEmail)
-	}
-	// Replace {{meeting_link}} with the meeting link
-	result = strings.ReplaceAll(result, "{{meeting_link}}", meetingLink)
-	return result
+	// Generate unique ID for calendar event. The random part keeps events
+	// created within the same second apart.
+	b := make([]byte, 4)
+	_, _ = rand.Read(b)
+	return time.Now().Format("20060102T150405") + "-" + hex.EncodeToString(b) + "@meet-mesh"
 }
@@ -7,7 +7,6 @@ import (
 	"fmt"
 	"log"
 	"net/http"
-	"os"
 
 	"github.com/kolaente/meet-mesh/api"
 	gen "github.com/kolaente/meet-mesh/api/gen"
@@ -23,15 +22,33 @@ func main() {
 		log.Fatalf("Failed to load config: %v", err)
 	}
 
+	if flag.Arg(0) == "migrate" {
+		runMigrate(cfg, flag.Args()[1:])
+		return
+	}
+
 	// Initialize database
 	db, err := api.InitDatabase(&cfg.Database)
 	if err != nil {
 		log.Fatalf("Failed to init database: %v", err)
 	}
 
-	// Ensure avatar storage directory exists
-	if err := os.MkdirAll(cfg.Storage.AvatarsPath, 0755); err != nil {
-		log.Fatalf("Failed to create avatar storage directory: %v", err)
+	// Initialize avatar storage
+	avatarStorage, err := api.NewFileStorage(&cfg.Storage, cfg.Storage.AvatarsPath, "avatars")
+	if err != nil {
+		log.Fatalf("Failed to init avatar storage: %v", err)
+	}
+
+	// Initialize branding image storage
+	brandingStorage, err := api.NewFileStorage(&cfg.Storage, cfg.Storage.BrandingPath, "branding")
+	if err != nil {
+		log.Fatalf("Failed to init branding storage: %v", err)
+	}
+
+	// Initialize booking attachment storage
+	attachmentStorage, err := api.NewFileStorage(&cfg.Storage, cfg.Storage.AttachmentsPath, "attachments")
+	if err != nil {
+		log.Fatalf("Failed to init attachment storage: %v", err)
 	}
 
 	// Initialize auth service
@@ -50,14 +67,37 @@ func main() {
 		log.Fatalf("Failed to init mailer: %v", err)
 	}
 
+	// Initialize meeting link providers
+	meetings := api.NewMeetingService(&cfg.Meetings)
+
+	// Initialize live update broker
+	events := api.NewEventBroker(db)
+
 	// Create handler
-	handler := api.NewHandler(db, auth, caldav, mailer, cfg)
+	handler := api.NewHandler(db, auth, caldav, mailer, meetings, events, attachmentStorage, cfg)
+
+	// Start background jobs
+	scheduler := api.NewScheduler()
+	handler.RegisterJobs(scheduler)
+	scheduler.Start(ctx)
 
 	// Create security handler
 	security := api.NewSecurityHandler(db, auth)
 
 	// Create avatar handler (plain HTTP, not ogen)
-	avatarHandler := api.NewAvatarHandler(db, auth, cfg.Storage.AvatarsPath)
+	avatarHandler := api.NewAvatarHandler(db, auth, avatarStorage)
+
+	// Create branding handler (plain HTTP, not ogen)
+	brandingHandler := api.NewBrandingHandler(db, auth, brandingStorage)
+
+	// Create export handler (plain HTTP, not ogen)
+	exportHandler := api.NewExportHandler(db, auth)
+
+	// Create booking attachment handler (plain HTTP, not ogen)
+	attachmentHandler := api.NewAttachmentHandler(db, auth, attachmentStorage, int64(cfg.Storage.MaxPendingAttachmentsMB)<<20)
+
+	// Create live update handler (plain HTTP, not ogen)
+	eventsHandler := api.NewEventsHandler(db, auth, events)
 
 	// Create server with /api prefix so ogen strips it before routing
 	server, err := gen.NewServer(handler, security, gen.WithPathPrefix("/api"))
@@ -71,6 +111,11 @@ func main() {
 
 	// Avatar routes (plain HTTP, not ogen - must be registered before /api/)
 	mux.HandleFunc("/api/avatars/", avatarHandler.HandleAvatars)
+	mux.HandleFunc("/api/branding/", brandingHandler.HandleBranding)
+	mux.HandleFunc("/api/events", eventsHandler.HandleEvents)
+	mux.HandleFunc("/api/exports/", exportHandler.HandleExports)
+	mux.HandleFunc("/api/attachments", attachmentHandler.HandleAttachments)
+	mux.HandleFunc("/api/attachments/", attachmentHandler.HandleAttachments)
 
 	// API routes - the ogen server handles /api/*
 	mux.Handle("/api/", server)
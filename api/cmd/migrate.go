@@ -0,0 +1,80 @@
+// api/cmd/migrate.go
+package main
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"strconv"
+
+	"github.com/kolaente/meet-mesh/api"
+)
+
+const migrateUsage = `Usage: meet-mesh [-config path] migrate <command>
+
+Commands:
+  up           apply all pending migrations
+  down [n]     roll back the last n migrations (default 1)
+  status       list migrations and whether they are applied`
+
+// runMigrate implements the migrate subcommand.
+func runMigrate(cfg *api.Config, args []string) {
+	if len(args) == 0 {
+		fmt.Println(migrateUsage)
+		os.Exit(1)
+	}
+
+	db, err := api.OpenDatabase(&cfg.Database)
+	if err != nil {
+		log.Fatalf("Failed to open database: %v", err)
+	}
+
+	migrator, err := api.NewMigrator(db)
+	if err != nil {
+		log.Fatalf("Failed to load migrations: %v", err)
+	}
+
+	switch args[0] {
+	case "up":
+		done, err := migrator.Up()
+		for _, m := range done {
+			fmt.Printf("Applied %04d_%s\n", m.Version, m.Name)
+		}
+		if err != nil {
+			log.Fatal(err)
+		}
+		if len(done) == 0 {
+			fmt.Println("Database is up to date")
+		}
+	case "down":
+		steps := 1
+		if len(args) > 1 {
+			steps, err = strconv.Atoi(args[1])
+			if err != nil || steps < 1 {
+				log.Fatalf("Invalid number of steps %q", args[1])
+			}
+		}
+		done, err := migrator.Down(steps)
+		for _, m := range done {
+			fmt.Printf("Rolled back %04d_%s\n", m.Version, m.Name)
+		}
+		if err != nil {
+			log.Fatal(err)
+		}
+	case "status":
+		status, err := migrator.Status()
+		if err != nil {
+			log.Fatal(err)
+		}
+		for _, s := range status {
+			applied := "pending"
+			if s.AppliedAt != nil {
+				applied = "applied " + s.AppliedAt.Format("2006-01-02 15:04:05")
+			}
+			fmt.Printf("%04d_%-40s %s\n", s.Version, s.Name, applied)
+		}
+	default:
+		fmt.Println(migrateUsage)
+		os.Exit(1)
+	}
+}
@@ -13,6 +13,7 @@ type Config struct {
 	OIDC     OIDCConfig     `yaml:"oidc"`
 	SMTP     SMTPConfig     `yaml:"smtp"`
 	Storage  StorageConfig  `yaml:"storage"`
+	Meetings MeetingsConfig `yaml:"meetings"`
 }
 
 type ServerConfig struct {
@@ -40,13 +41,55 @@ type SMTPConfig struct {
 }
 
 type StorageConfig struct {
-	AvatarsPath string `yaml:"avatars_path"`
+	// Backend is either "local" (default) or "s3"
+	Backend         string `yaml:"backend"`
+	AvatarsPath     string `yaml:"avatars_path"`
+	BrandingPath    string `yaml:"branding_path"`
+	AttachmentsPath string `yaml:"attachments_path"`
+	// MaxPendingAttachmentsMB limits the files guests uploaded that no
+	// booking claimed yet, in total
+	MaxPendingAttachmentsMB int      `yaml:"max_pending_attachments_mb"`
+	S3                      S3Config `yaml:"s3"`
+}
+
+type S3Config struct {
+	Endpoint        string `yaml:"endpoint"`
+	Bucket          string `yaml:"bucket"`
+	Region          string `yaml:"region"`
+	AccessKeyID     string `yaml:"access_key_id"`
+	SecretAccessKey string `yaml:"secret_access_key"`
+	UseSSL          bool   `yaml:"use_ssl"`
+	PathStyle       bool   `yaml:"path_style"`
+	Prefix          string `yaml:"prefix"`
+}
+
+type MeetingsConfig struct {
+	Jitsi         JitsiConfig         `yaml:"jitsi"`
+	BigBlueButton BigBlueButtonConfig `yaml:"bigbluebutton"`
+}
+
+type JitsiConfig struct {
+	BaseURL string `yaml:"base_url"`
+}
+
+type BigBlueButtonConfig struct {
+	URL    string `yaml:"url"`
+	Secret string `yaml:"secret"`
 }
 
 func (c *Config) SetDefaults() {
 	if c.Storage.AvatarsPath == "" {
 		c.Storage.AvatarsPath = "./data/avatars"
 	}
+	if c.Storage.BrandingPath == "" {
+		c.Storage.BrandingPath = "./data/branding"
+	}
+	if c.Storage.AttachmentsPath == "" {
+		c.Storage.AttachmentsPath = "./data/attachments"
+	}
+	if c.Storage.MaxPendingAttachmentsMB <= 0 {
+		c.Storage.MaxPendingAttachmentsMB = 1024
+	}
 }
 
 func LoadConfig(path string) (*Config, error) {
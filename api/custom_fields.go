@@ -0,0 +1,212 @@
+// api/custom_fields.go
+package api
+
+import (
+	"fmt"
+	"math"
+	"net/mail"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	gen "github.com/kolaente/meet-mesh/api/gen"
+)
+
+// maxCustomFieldLength limits the length of a single custom field answer.
+const maxCustomFieldLength = 5000
+
+// customFieldDateLayout is the format of date answers.
+const customFieldDateLayout = "2006-01-02"
+
+// checkCustomFields validates the custom field definitions of a booking link
+// or poll. It returns why they are rejected, or an empty string.
+func checkCustomFields(fields []CustomField) string {
+	names := make(map[string]bool, len(fields))
+	for _, f := range fields {
+		if strings.TrimSpace(f.Name) == "" {
+			return "Custom fields need a name"
+		}
+		if names[f.Name] {
+			return fmt.Sprintf("Custom field %q is defined twice", f.Name)
+		}
+		names[f.Name] = true
+
+		switch f.Type {
+		case CustomFieldTypeSelect, CustomFieldTypeMultiSelect:
+			if len(f.Options) == 0 {
+				return fmt.Sprintf("Custom field %q needs options", f.Name)
+			}
+			if f.Type == CustomFieldTypeMultiSelect {
+				for _, opt := range f.Options {
+					if strings.Contains(opt, ",") {
+						return fmt.Sprintf("Options of custom field %q can't contain commas", f.Name)
+					}
+				}
+			}
+		}
+
+		if f.Type == CustomFieldTypeFile {
+			if problem := checkFileField(f); problem != "" {
+				return problem
+			}
+		}
+
+		if f.Pattern != "" {
+			if f.Type != CustomFieldTypeText && f.Type != CustomFieldTypeTextarea {
+				return fmt.Sprintf("Only text fields can have a pattern, %q can't", f.Name)
+			}
+			if _, err := compileFieldPattern(f.Pattern); err != nil {
+				return fmt.Sprintf("Invalid pattern for custom field %q", f.Name)
+			}
+		}
+	}
+	return ""
+}
+
+// checkPollCustomFields validates the custom field definitions of a poll.
+// Polls have no upload flow, so they can't have file fields.
+func checkPollCustomFields(fields []CustomField) string {
+	for _, f := range fields {
+		if f.Type == CustomFieldTypeFile {
+			return "File fields are only available on booking links"
+		}
+	}
+	return checkCustomFields(fields)
+}
+
+// compileFieldPattern compiles pattern so that it has to match an answer
+// completely.
+func compileFieldPattern(pattern string) (*regexp.Regexp, error) {
+	return regexp.Compile(`^(?:` + pattern + `)$`)
+}
+
+// validateCustomFieldAnswers checks answers against the field definitions.
+// It returns the answers with whitespace trimmed and empty answers removed,
+// or the problems with every invalid answer.
+func validateCustomFieldAnswers(fields []CustomField, answers map[string]string) (map[string]string, []gen.FieldError) {
+	var problems []gen.FieldError
+	cleaned := make(map[string]string, len(answers))
+
+	defined := make(map[string]bool, len(fields))
+	for _, f := range fields {
+		defined[f.Name] = true
+
+		value := strings.TrimSpace(answers[f.Name])
+		if value == "" || (f.Type == CustomFieldTypeCheckbox && value == "false") {
+			if f.Required {
+				problems = append(problems, gen.FieldError{Field: f.Name, Message: "This field is required"})
+			} else if value != "" {
+				cleaned[f.Name] = value
+			}
+			continue
+		}
+
+		normalized, problem := checkCustomFieldAnswer(f, value)
+		if problem != "" {
+			problems = append(problems, gen.FieldError{Field: f.Name, Message: problem})
+			continue
+		}
+		cleaned[f.Name] = normalized
+	}
+
+	var unknown []string
+	for name := range answers {
+		if !defined[name] {
+			unknown = append(unknown, name)
+		}
+	}
+	slices.Sort(unknown)
+	for _, name := range unknown {
+		problems = append(problems, gen.FieldError{Field: name, Message: "Unknown field"})
+	}
+
+	if len(problems) > 0 {
+		return nil, problems
+	}
+	if len(cleaned) == 0 {
+		return nil, nil
+	}
+	return cleaned, nil
+}
+
+// checkCustomFieldAnswer validates a non-empty answer to field. It returns
+// the answer in its canonical form, or why it is rejected.
+func checkCustomFieldAnswer(field CustomField, value string) (string, string) {
+	if utf8.RuneCountInString(value) > maxCustomFieldLength {
+		return "", fmt.Sprintf("Must be at most %d characters", maxCustomFieldLength)
+	}
+
+	switch field.Type {
+	case CustomFieldTypeText, CustomFieldTypeTextarea:
+		if field.Pattern != "" {
+			re, err := compileFieldPattern(field.Pattern)
+			if err != nil || !re.MatchString(value) {
+				return "", "Doesn't have the expected format"
+			}
+		}
+	case CustomFieldTypeEmail:
+		addr, err := mail.ParseAddress(value)
+		if err != nil || addr.Name != "" || addr.Address != value {
+			return "", "Must be a valid email address"
+		}
+	case CustomFieldTypePhone:
+		if !isPhoneNumber(value) {
+			return "", "Must be a valid phone number"
+		}
+	case CustomFieldTypeSelect:
+		if !slices.Contains(field.Options, value) {
+			return "", "Must be one of the options"
+		}
+	case CustomFieldTypeMultiSelect:
+		var chosen []string
+		for _, opt := range strings.Split(value, ",") {
+			opt = strings.TrimSpace(opt)
+			if !slices.Contains(field.Options, opt) {
+				return "", fmt.Sprintf("%q is not one of the options", opt)
+			}
+			if !slices.Contains(chosen, opt) {
+				chosen = append(chosen, opt)
+			}
+		}
+		return strings.Join(chosen, ", "), ""
+	case CustomFieldTypeCheckbox:
+		if value != "true" {
+			return "", `Must be "true" or "false"`
+		}
+	case CustomFieldTypeNumber:
+		n, err := strconv.ParseFloat(value, 64)
+		if err != nil || math.IsInf(n, 0) || math.IsNaN(n) {
+			return "", "Must be a number"
+		}
+	case CustomFieldTypeDate:
+		if _, err := time.Parse(customFieldDateLayout, value); err != nil {
+			return "", "Must be a date like 2006-01-02"
+		}
+	}
+	return value, ""
+}
+
+// isPhoneNumber reports whether value looks like a phone number: 5 to 15
+// digits with an optional leading + and the usual separators.
+func isPhoneNumber(value string) bool {
+	digits := 0
+	for i, r := range value {
+		switch {
+		case r >= '0' && r <= '9':
+			digits++
+		case r == '+' && i == 0:
+		case strings.ContainsRune(" ()-./", r):
+		default:
+			return false
+		}
+	}
+	return digits >= 5 && digits <= 15
+}
+
+// customFieldsError is the response for invalid custom field answers.
+func customFieldsError(problems []gen.FieldError) *gen.Error {
+	return &gen.Error{Message: "Please check your answers", FieldErrors: problems}
+}
@@ -0,0 +1,131 @@
+package api
+
+import (
+	"context"
+	"testing"
+
+	gen "github.com/kolaente/meet-mesh/api/gen"
+)
+
+func TestValidateCustomFieldAnswers(t *testing.T) {
+	fields := []CustomField{
+		{Name: "company", Type: CustomFieldTypeText, Required: true},
+		{Name: "email", Type: CustomFieldTypeEmail},
+		{Name: "phone", Type: CustomFieldTypePhone},
+		{Name: "size", Type: CustomFieldTypeSelect, Options: []string{"S", "M"}},
+		{Name: "topics", Type: CustomFieldTypeMultiSelect, Options: []string{"Go", "Rust", "Zig"}},
+		{Name: "terms", Type: CustomFieldTypeCheckbox, Required: true},
+		{Name: "guests", Type: CustomFieldTypeNumber},
+		{Name: "birthday", Type: CustomFieldTypeDate},
+		{Name: "ticket", Type: CustomFieldTypeText, Pattern: `[A-Z]{3}-\d+`},
+	}
+
+	cleaned, problems := validateCustomFieldAnswers(fields, map[string]string{
+		"company":  " ACME ",
+		"email":    "alice@example.com",
+		"phone":    "+49 (30) 123-456",
+		"size":     "M",
+		"topics":   "Zig, Go,Zig",
+		"terms":    "true",
+		"guests":   "2.5",
+		"birthday": "1990-02-28",
+		"ticket":   "ABC-42",
+	})
+	if len(problems) > 0 {
+		t.Fatalf("expected valid answers, got %+v", problems)
+	}
+	if cleaned["company"] != "ACME" || cleaned["topics"] != "Zig, Go" {
+		t.Fatalf("unexpected cleaned answers: %v", cleaned)
+	}
+
+	_, problems = validateCustomFieldAnswers(fields, map[string]string{
+		"terms":    "false",
+		"email":    "Alice <alice@example.com>",
+		"phone":    "call me",
+		"size":     "XL",
+		"topics":   "Go, Java",
+		"guests":   "two",
+		"birthday": "28.02.1990",
+		"ticket":   "ABC-42x",
+		"extra":    "?",
+	})
+	got := map[string]bool{}
+	for _, p := range problems {
+		got[p.Field] = true
+	}
+	for _, name := range []string{"company", "terms", "email", "phone", "size", "topics", "guests", "birthday", "ticket", "extra"} {
+		if !got[name] {
+			t.Errorf("expected a problem with %q, got %+v", name, problems)
+		}
+	}
+	if len(problems) != 10 {
+		t.Errorf("expected 10 problems, got %+v", problems)
+	}
+}
+
+func TestCheckCustomFields(t *testing.T) {
+	tests := []struct {
+		name   string
+		fields []CustomField
+		valid  bool
+	}{
+		{"valid", []CustomField{{Name: "a", Type: CustomFieldTypeText, Pattern: `\d+`}, {Name: "b", Type: CustomFieldTypeSelect, Options: []string{"x"}}}, true},
+		{"duplicate name", []CustomField{{Name: "a", Type: CustomFieldTypeText}, {Name: "a", Type: CustomFieldTypeEmail}}, false},
+		{"empty name", []CustomField{{Name: " ", Type: CustomFieldTypeText}}, false},
+		{"select without options", []CustomField{{Name: "a", Type: CustomFieldTypeSelect}}, false},
+		{"multi-select option with comma", []CustomField{{Name: "a", Type: CustomFieldTypeMultiSelect, Options: []string{"x, y"}}}, false},
+		{"invalid pattern", []CustomField{{Name: "a", Type: CustomFieldTypeText, Pattern: `(`}}, false},
+		{"pattern on number", []CustomField{{Name: "a", Type: CustomFieldTypeNumber, Pattern: `\d`}}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			problem := checkCustomFields(tt.fields)
+			if (problem == "") != tt.valid {
+				t.Fatalf("expected valid=%v, got %q", tt.valid, problem)
+			}
+		})
+	}
+}
+
+func TestSubmitVoteValidatesCustomFields(t *testing.T) {
+	h := newTestHandler(t)
+	ctx := context.Background()
+
+	poll := Poll{UserID: 1, Slug: "team", Name: "Team", Status: LinkStatusActive, CustomFields: []CustomField{
+		{Name: "team", Type: CustomFieldTypeSelect, Required: true, Options: []string{"Red", "Blue"}},
+	}}
+	h.db.Create(&poll)
+	option := PollOption{PollID: poll.ID, Type: SlotTypeTime}
+	h.db.Create(&option)
+
+	res, err := h.SubmitVote(ctx, &gen.SubmitVoteReq{
+		GuestName:    gen.NewOptString("Alice"),
+		Responses:    gen.SubmitVoteReqResponses{"1": gen.VoteResponse(VoteResponseYes)},
+		CustomFields: gen.NewOptSubmitVoteReqCustomFields(gen.SubmitVoteReqCustomFields{"team": "Green"}),
+	}, gen.SubmitVoteParams{Slug: poll.Slug})
+	if err != nil {
+		t.Fatalf("SubmitVote failed: %v", err)
+	}
+	e, ok := res.(*gen.Error)
+	if !ok || len(e.FieldErrors) != 1 || e.FieldErrors[0].Field != "team" {
+		t.Fatalf("expected a field error for team, got %#v", res)
+	}
+
+	res, _ = h.SubmitVote(ctx, &gen.SubmitVoteReq{
+		GuestName:    gen.NewOptString("Alice"),
+		Responses:    gen.SubmitVoteReqResponses{"1": gen.VoteResponse(VoteResponseYes)},
+		CustomFields: gen.NewOptSubmitVoteReqCustomFields(gen.SubmitVoteReqCustomFields{"team": "Blue"}),
+	}, gen.SubmitVoteParams{Slug: poll.Slug})
+	vote, ok := res.(*gen.Vote)
+	if !ok {
+		t.Fatalf("expected vote, got %#v", res)
+	}
+
+	updated, _ := h.UpdateOwnVote(ctx, &gen.UpdateOwnVoteReq{
+		Responses:    gen.UpdateOwnVoteReqResponses{"1": gen.VoteResponse(VoteResponseNo)},
+		CustomFields: gen.NewOptUpdateOwnVoteReqCustomFields(gen.UpdateOwnVoteReqCustomFields{}),
+	}, gen.UpdateOwnVoteParams{Slug: poll.Slug, Token: vote.EditToken.Value})
+	if e, ok := updated.(*gen.Error); !ok || len(e.FieldErrors) != 1 {
+		t.Fatalf("expected the required field to be enforced on update, got %#v", updated)
+	}
+}
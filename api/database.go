@@ -9,29 +9,25 @@ import (
 	"gorm.io/gorm"
 )
 
-func InitDatabase(cfg *DatabaseConfig) (*gorm.DB, error) {
+// OpenDatabase opens the database without touching the schema.
+func OpenDatabase(cfg *DatabaseConfig) (*gorm.DB, error) {
 	// Ensure directory exists
 	dir := filepath.Dir(cfg.Path)
 	if err := os.MkdirAll(dir, 0755); err != nil {
 		return nil, err
 	}
 
-	db, err := gorm.Open(sqlite.Open(cfg.Path), &gorm.Config{})
+	return gorm.Open(sqlite.Open(cfg.Path), &gorm.Config{})
+}
+
+// InitDatabase opens the database and applies all pending migrations.
+func InitDatabase(cfg *DatabaseConfig) (*gorm.DB, error) {
+	db, err := OpenDatabase(cfg)
 	if err != nil {
 		return nil, err
 	}
 
-	// Auto-migrate all models
-	if err := db.AutoMigrate(
-		&User{},
-		&CalendarConnection{},
-		&BookingLink{},
-		&Poll{},
-		&PollOption{},
-		&Slot{},
-		&Booking{},
-		&Vote{},
-	); err != nil {
+	if err := MigrateUp(db); err != nil {
 		return nil, err
 	}
 
@@ -0,0 +1,253 @@
+// api/events.go
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"gorm.io/gorm"
+
+	gen "github.com/kolaente/meet-mesh/api/gen"
+)
+
+// Event types pushed to live update streams.
+const (
+	EventVoteSubmitted  = "vote-submitted"
+	EventBookingCreated = "booking-created"
+	EventSlotTaken      = "slot-taken"
+)
+
+// eventBufferSize is how many events a subscriber may fall behind before
+// further events are dropped for it.
+const eventBufferSize = 16
+
+// eventRelayInterval is how often the broker checks the database for events
+// published by any instance, eventRetention how long they are kept there.
+const (
+	eventRelayInterval = time.Second
+	eventRetention     = 10 * time.Minute
+)
+
+// Event is a live update. Data is sent as JSON.
+type Event struct {
+	Type string
+	Data any
+}
+
+// EventBroker fans out events to the subscribers of a topic. Topics scope
+// events to a poll, a booking link or an organizer.
+//
+// With a database, published events are stored in it and Relay delivers
+// them to the subscribers of this instance, so subscribers get the events
+// of every instance sharing the database. Without one, events only reach
+// subscribers of the same instance.
+type EventBroker struct {
+	mu          sync.Mutex
+	subscribers map[string]map[chan Event]struct{}
+
+	db     *gorm.DB
+	lastID uint
+}
+
+func NewEventBroker(db *gorm.DB) *EventBroker {
+	b := &EventBroker{
+		subscribers: make(map[string]map[chan Event]struct{}),
+		db:          db,
+	}
+	// Only events published from now on are relayed
+	if db != nil {
+		db.Model(&LiveEvent{}).Select("COALESCE(MAX(id), 0)").Scan(&b.lastID)
+	}
+	return b
+}
+
+// Subscribe returns a channel receiving the events of topic. cancel must be
+// called once the subscriber is done.
+func (b *EventBroker) Subscribe(topic string) (events <-chan Event, cancel func()) {
+	ch := make(chan Event, eventBufferSize)
+
+	b.mu.Lock()
+	if b.subscribers[topic] == nil {
+		b.subscribers[topic] = make(map[chan Event]struct{})
+	}
+	b.subscribers[topic][ch] = struct{}{}
+	b.mu.Unlock()
+
+	return ch, func() {
+		b.mu.Lock()
+		defer b.mu.Unlock()
+		delete(b.subscribers[topic], ch)
+		if len(b.subscribers[topic]) == 0 {
+			delete(b.subscribers, topic)
+		}
+	}
+}
+
+// Publish sends event to every subscriber of topic. Slow subscribers miss
+// the event instead of blocking the publisher. With a database the event is
+// delivered by Relay.
+func (b *EventBroker) Publish(topic string, event Event) {
+	if b.db != nil {
+		data, err := json.Marshal(event.Data)
+		if err == nil {
+			err = b.db.Create(&LiveEvent{Topic: topic, Type: event.Type, Data: string(data)}).Error
+		}
+		if err == nil {
+			return
+		}
+		log.Printf("[WARN] Failed to store %s event for other instances: %v", event.Type, err)
+	}
+	b.deliver(topic, event)
+}
+
+// Relay delivers the events stored since the last call to the subscribers
+// of this instance.
+func (b *EventBroker) Relay(ctx context.Context) error {
+	if b.db == nil {
+		return nil
+	}
+
+	var events []LiveEvent
+	if err := b.db.WithContext(ctx).Where("id > ?", b.lastID).Order("id").Find(&events).Error; err != nil {
+		return err
+	}
+	for _, e := range events {
+		b.deliver(e.Topic, Event{Type: e.Type, Data: json.RawMessage(e.Data)})
+		b.lastID = e.ID
+	}
+	return nil
+}
+
+// CleanupEvents removes stored events every instance has relayed already.
+func (b *EventBroker) CleanupEvents(ctx context.Context) error {
+	if b.db == nil {
+		return nil
+	}
+	return b.db.WithContext(ctx).Where("created_at < ?", time.Now().Add(-eventRetention)).Delete(&LiveEvent{}).Error
+}
+
+// deliver sends event to the subscribers of topic on this instance.
+func (b *EventBroker) deliver(topic string, event Event) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for ch := range b.subscribers[topic] {
+		select {
+		case ch <- event:
+		default:
+		}
+	}
+}
+
+func pollTopic(slug string) string {
+	return "poll:" + slug
+}
+
+func bookingLinkTopic(slug string) string {
+	return "booking-link:" + slug
+}
+
+func userTopic(userID uint) string {
+	return fmt.Sprintf("user:%d", userID)
+}
+
+// voteEvent is the data of a vote-submitted event.
+type voteEvent struct {
+	PollID    uint            `json:"poll_id,omitempty"`
+	PollSlug  string          `json:"poll_slug"`
+	VoteID    uint            `json:"vote_id,omitempty"`
+	GuestName string          `json:"guest_name,omitempty"`
+	Tally     []gen.VoteTally `json:"tally,omitempty"`
+}
+
+// bookingEvent is the data of a booking-created event.
+type bookingEvent struct {
+	BookingID     uint      `json:"booking_id"`
+	BookingLinkID uint      `json:"booking_link_id"`
+	GuestName     string    `json:"guest_name,omitempty"`
+	GuestEmail    string    `json:"guest_email"`
+	Status        int       `json:"status"`
+	StartTime     time.Time `json:"start_time"`
+	EndTime       time.Time `json:"end_time"`
+}
+
+// slotEvent is the data of a slot-taken event.
+type slotEvent struct {
+	StartTime time.Time `json:"start_time"`
+	EndTime   time.Time `json:"end_time"`
+}
+
+// publishVote tells the organizer and the participants of poll about vote.
+// Participants only see what the results visibility of the poll allows
+// everyone to see right now.
+func (h *Handler) publishVote(poll *Poll, vote *Vote) {
+	if h.events == nil {
+		return
+	}
+
+	h.events.Publish(userTopic(poll.UserID), Event{Type: EventVoteSubmitted, Data: voteEvent{
+		PollID:    poll.ID,
+		PollSlug:  poll.Slug,
+		VoteID:    vote.ID,
+		GuestName: vote.GuestName,
+	}})
+
+	public := voteEvent{PollSlug: poll.Slug}
+	if poll.ShowResults && poll.ResultsTiming == ResultsTimingAlways {
+		ranked := *poll
+		if err := h.db.Where("poll_id = ?", poll.ID).Find(&ranked.PollOptions).Error; err == nil {
+			if ranks, _, err := h.rankPoll(&ranked); err == nil {
+				public.Tally = mapOptionRanksToGen(ranks)
+			}
+		}
+		if poll.ResultsVisibility != ResultsVisibilityAggregate {
+			public.GuestName = vote.GuestName
+		}
+	}
+	h.events.Publish(pollTopic(poll.Slug), Event{Type: EventVoteSubmitted, Data: public})
+}
+
+// publishBooking tells the organizer about a new booking. Confirmed
+// bookings also take their slot.
+func (h *Handler) publishBooking(link *BookingLink, booking *Booking, slot *Slot) {
+	if h.events == nil {
+		return
+	}
+
+	h.events.Publish(userTopic(link.UserID), Event{Type: EventBookingCreated, Data: bookingEvent{
+		BookingID:     booking.ID,
+		BookingLinkID: link.ID,
+		GuestName:     booking.GuestName,
+		GuestEmail:    booking.GuestEmail,
+		Status:        int(booking.Status),
+		StartTime:     slot.StartTime,
+		EndTime:       slot.EndTime,
+	}})
+
+	if booking.Status == BookingStatusConfirmed {
+		h.publishSlotTaken(link.UserID, slot)
+	}
+}
+
+// publishSlotTaken tells the visitors of every active booking link of the
+// organizer that slot is no longer available. All links share the
+// organizer's calendar.
+func (h *Handler) publishSlotTaken(userID uint, slot *Slot) {
+	if h.events == nil {
+		return
+	}
+
+	var links []BookingLink
+	if err := h.db.Where("user_id = ? AND status = ?", userID, LinkStatusActive).Find(&links).Error; err != nil {
+		log.Printf("[WARN] Failed to load booking links of user %d for live updates: %v", userID, err)
+		return
+	}
+
+	event := Event{Type: EventSlotTaken, Data: slotEvent{StartTime: slot.StartTime, EndTime: slot.EndTime}}
+	for _, link := range links {
+		h.events.Publish(bookingLinkTopic(link.Slug), event)
+	}
+}
@@ -17,6 +17,7 @@ import (
 )
 
 var regexMap = map[string]ogenregex.Regexp{
+	"^(#[0-9a-fA-F]{6})?$":    ogenregex.MustCompile("^(#[0-9a-fA-F]{6})?$"),
 	"^[0-2][0-9]:[0-5][0-9]$": ogenregex.MustCompile("^[0-2][0-9]:[0-5][0-9]$"),
 }
 var (
@@ -34,6 +34,18 @@ type Invoker interface {
 	//
 	// POST /calendars
 	AddCalendar(ctx context.Context, request *AddCalendarReq) (*CalendarConnection, error)
+	// AddPollComment invokes addPollComment operation.
+	//
+	// Comment on a poll or one of its options.
+	//
+	// POST /p/poll/{slug}/comments
+	AddPollComment(ctx context.Context, request *AddPollCommentReq, params AddPollCommentParams) (AddPollCommentRes, error)
+	// AddPollInvites invokes addPollInvites operation.
+	//
+	// Invite participants and email them a personal link.
+	//
+	// POST /polls/{id}/invites
+	AddPollInvites(ctx context.Context, request *AddPollInvitesReq, params AddPollInvitesParams) (AddPollInvitesRes, error)
 	// AddPollOption invokes addPollOption operation.
 	//
 	// Add an option to a poll.
@@ -46,6 +58,12 @@ type Invoker interface {
 	//
 	// POST /bookings/{id}/approve
 	ApproveBooking(ctx context.Context, params ApproveBookingParams) (*Booking, error)
+	// ApprovePollProposal invokes approvePollProposal operation.
+	//
+	// Add a proposal to the options of the poll.
+	//
+	// POST /polls/{id}/proposals/{proposalId}/approve
+	ApprovePollProposal(ctx context.Context, params ApprovePollProposalParams) (ApprovePollProposalRes, error)
 	// ApproveViaEmail invokes approveViaEmail operation.
 	//
 	// Approve booking via email link.
@@ -58,6 +76,15 @@ type Invoker interface {
 	//
 	// GET /auth/callback
 	AuthCallback(ctx context.Context, params AuthCallbackParams) (AuthCallbackRes, error)
+	// AutofillVote invokes autofillVote operation.
+	//
+	// Reads the busy times from a private ICS or CalDAV free-busy URL, or from the contents of an
+	// uploaded .ics file, and suggests an answer per option: no if the voter is busy, yes otherwise.
+	// Full-day and multi-day options the voter is partly busy on get maybe. The calendar is only used to
+	// compute the answers and is not stored.
+	//
+	// POST /p/poll/{slug}/autofill
+	AutofillVote(ctx context.Context, request *AutofillVoteReq, params AutofillVoteParams) (AutofillVoteRes, error)
 	// CreateBooking invokes createBooking operation.
 	//
 	// Create a booking.
@@ -69,19 +96,25 @@ type Invoker interface {
 	// Create a booking link.
 	//
 	// POST /booking-links
-	CreateBookingLink(ctx context.Context, request *CreateBookingLinkReq) (*BookingLink, error)
+	CreateBookingLink(ctx context.Context, request *CreateBookingLinkReq) (CreateBookingLinkRes, error)
 	// CreatePoll invokes createPoll operation.
 	//
 	// Create a poll.
 	//
 	// POST /polls
-	CreatePoll(ctx context.Context, request *CreatePollReq) (*Poll, error)
+	CreatePoll(ctx context.Context, request *CreatePollReq) (CreatePollRes, error)
 	// DeclineBooking invokes declineBooking operation.
 	//
 	// Decline a booking.
 	//
 	// POST /bookings/{id}/decline
 	DeclineBooking(ctx context.Context, params DeclineBookingParams) (*Booking, error)
+	// DeclinePollProposal invokes declinePollProposal operation.
+	//
+	// Decline an option proposal.
+	//
+	// DELETE /polls/{id}/proposals/{proposalId}
+	DeclinePollProposal(ctx context.Context, params DeclinePollProposalParams) error
 	// DeclineViaEmail invokes declineViaEmail operation.
 	//
 	// Decline booking via email link.
@@ -94,12 +127,30 @@ type Invoker interface {
 	//
 	// DELETE /booking-links/{id}
 	DeleteBookingLink(ctx context.Context, params DeleteBookingLinkParams) error
+	// DeleteOwnVote invokes deleteOwnVote operation.
+	//
+	// Delete own vote by edit token.
+	//
+	// DELETE /p/poll/{slug}/vote/{token}
+	DeleteOwnVote(ctx context.Context, params DeleteOwnVoteParams) (DeleteOwnVoteRes, error)
 	// DeletePoll invokes deletePoll operation.
 	//
 	// Delete a poll.
 	//
 	// DELETE /polls/{id}
 	DeletePoll(ctx context.Context, params DeletePollParams) error
+	// DeletePollComment invokes deletePollComment operation.
+	//
+	// Delete a comment.
+	//
+	// DELETE /polls/{id}/comments/{commentId}
+	DeletePollComment(ctx context.Context, params DeletePollCommentParams) error
+	// DeletePollInvite invokes deletePollInvite operation.
+	//
+	// Remove an invitee from a poll.
+	//
+	// DELETE /polls/{id}/invites/{inviteId}
+	DeletePollInvite(ctx context.Context, params DeletePollInviteParams) error
 	// DeletePollOption invokes deletePollOption operation.
 	//
 	// Delete an option from a poll.
@@ -112,6 +163,15 @@ type Invoker interface {
 	//
 	// POST /calendars/discover
 	DiscoverCalendars(ctx context.Context, request *DiscoverCalendarsReq) (*CalendarDiscoveryResult, error)
+	// GeneratePollOptions invokes generatePollOptions operation.
+	//
+	// Creates one option per matching day between start_date and end_date, for each of the given times.
+	// Full-day options cover the whole day, multi-day options span span_days days. Times are wall-clock
+	// times in time_zone, so they stay the same across daylight saving changes. Options the poll already
+	// offers are skipped. Without add, the options are only previewed.
+	//
+	// POST /polls/{id}/options/generate
+	GeneratePollOptions(ctx context.Context, request *GeneratePollOptionsReq, params GeneratePollOptionsParams) (GeneratePollOptionsRes, error)
 	// GetBookingAvailability invokes getBookingAvailability operation.
 	//
 	// Get real-time availability for booking link.
@@ -136,6 +196,12 @@ type Invoker interface {
 	//
 	// GET /auth/me
 	GetCurrentUser(ctx context.Context) (GetCurrentUserRes, error)
+	// GetOwnVote invokes getOwnVote operation.
+	//
+	// Get own vote by edit token.
+	//
+	// GET /p/poll/{slug}/vote/{token}
+	GetOwnVote(ctx context.Context, params GetOwnVoteParams) (GetOwnVoteRes, error)
 	// GetPoll invokes getPoll operation.
 	//
 	// Get poll details.
@@ -148,9 +214,16 @@ type Invoker interface {
 	//
 	// GET /polls/{id}/options
 	GetPollOptions(ctx context.Context, params GetPollOptionsParams) ([]PollOption, error)
+	// GetPollRanking invokes getPollRanking operation.
+	//
+	// Get ranked results of a poll.
+	//
+	// GET /polls/{id}/results
+	GetPollRanking(ctx context.Context, params GetPollRankingParams) (*PollResults, error)
 	// GetPollResults invokes getPollResults operation.
 	//
-	// Get poll results.
+	// Results are only returned if the poll shows results and its timing allows it. Voter details are
+	// reduced to what the poll's visibility permits.
 	//
 	// GET /p/poll/{slug}/results
 	GetPollResults(ctx context.Context, params GetPollResultsParams) (GetPollResultsRes, error)
@@ -172,6 +245,12 @@ type Invoker interface {
 	//
 	// GET /p/poll/{slug}
 	GetPublicPoll(ctx context.Context, params GetPublicPollParams) (GetPublicPollRes, error)
+	// GetPublicPollComments invokes getPublicPollComments operation.
+	//
+	// List the visible comments of a poll.
+	//
+	// GET /p/poll/{slug}/comments
+	GetPublicPollComments(ctx context.Context, params GetPublicPollCommentsParams) (GetPublicPollCommentsRes, error)
 	// InitiateLogin invokes initiateLogin operation.
 	//
 	// Redirect to OIDC provider.
@@ -190,6 +269,30 @@ type Invoker interface {
 	//
 	// GET /calendars
 	ListCalendars(ctx context.Context) ([]CalendarConnection, error)
+	// ListMeetingProviders invokes listMeetingProviders operation.
+	//
+	// List enabled meeting link providers.
+	//
+	// GET /meeting-providers
+	ListMeetingProviders(ctx context.Context) ([]string, error)
+	// ListPollComments invokes listPollComments operation.
+	//
+	// List all comments of a poll, including hidden ones.
+	//
+	// GET /polls/{id}/comments
+	ListPollComments(ctx context.Context, params ListPollCommentsParams) ([]PollComment, error)
+	// ListPollInvites invokes listPollInvites operation.
+	//
+	// List invitees of a poll and whether they voted.
+	//
+	// GET /polls/{id}/invites
+	ListPollInvites(ctx context.Context, params ListPollInvitesParams) ([]PollInvite, error)
+	// ListPollProposals invokes listPollProposals operation.
+	//
+	// List the option proposals waiting for approval.
+	//
+	// GET /polls/{id}/proposals
+	ListPollProposals(ctx context.Context, params ListPollProposalsParams) ([]PollProposal, error)
 	// ListPolls invokes listPolls operation.
 	//
 	// List all polls.
@@ -202,12 +305,31 @@ type Invoker interface {
 	//
 	// POST /auth/logout
 	Logout(ctx context.Context) error
+	// ModeratePollComment invokes moderatePollComment operation.
+	//
+	// Hide or show a comment.
+	//
+	// PUT /polls/{id}/comments/{commentId}
+	ModeratePollComment(ctx context.Context, request *ModeratePollCommentReq, params ModeratePollCommentParams) (*PollComment, error)
 	// PickPollWinner invokes pickPollWinner operation.
 	//
 	// Pick winning option for poll.
 	//
 	// POST /polls/{id}/pick-winner
-	PickPollWinner(ctx context.Context, request *PickPollWinnerReq, params PickPollWinnerParams) error
+	PickPollWinner(ctx context.Context, request *PickPollWinnerReq, params PickPollWinnerParams) (PickPollWinnerRes, error)
+	// ProposePollOption invokes proposePollOption operation.
+	//
+	// Only accepted if the poll allows proposals. Depending on the poll, the option is added right away
+	// or waits for the organizer's approval.
+	//
+	// POST /p/poll/{slug}/proposals
+	ProposePollOption(ctx context.Context, request *ProposePollOptionReq, params ProposePollOptionParams) (ProposePollOptionRes, error)
+	// RemindPollInvites invokes remindPollInvites operation.
+	//
+	// Send a reminder to all invitees who haven't voted yet.
+	//
+	// POST /polls/{id}/invites/remind
+	RemindPollInvites(ctx context.Context, params RemindPollInvitesParams) (*RemindPollInvitesOK, error)
 	// RemoveCalendar invokes removeCalendar operation.
 	//
 	// Remove calendar connection.
@@ -219,7 +341,14 @@ type Invoker interface {
 	// Submit poll vote.
 	//
 	// POST /p/poll/{slug}/vote
-	SubmitVote(ctx context.Context, request *SubmitVoteReq, params SubmitVoteParams) (*Vote, error)
+	SubmitVote(ctx context.Context, request *SubmitVoteReq, params SubmitVoteParams) (SubmitVoteRes, error)
+	// SuggestPollOptions invokes suggestPollOptions operation.
+	//
+	// Runs the booking availability logic for the given range: slots within the availability rules that
+	// don't overlap busy times in the connected calendars. Times the poll already offers are skipped.
+	//
+	// POST /polls/{id}/options/suggest
+	SuggestPollOptions(ctx context.Context, request *SuggestPollOptionsReq, params SuggestPollOptionsParams) (SuggestPollOptionsRes, error)
 	// TestCalendar invokes testCalendar operation.
 	//
 	// Test calendar connection by fetching events.
@@ -231,19 +360,32 @@ type Invoker interface {
 	// Update a booking link.
 	//
 	// PUT /booking-links/{id}
-	UpdateBookingLink(ctx context.Context, request *UpdateBookingLinkReq, params UpdateBookingLinkParams) (*BookingLink, error)
+	UpdateBookingLink(ctx context.Context, request *UpdateBookingLinkReq, params UpdateBookingLinkParams) (UpdateBookingLinkRes, error)
 	// UpdateCurrentUser invokes updateCurrentUser operation.
 	//
 	// Update current user profile.
 	//
 	// PUT /auth/me
 	UpdateCurrentUser(ctx context.Context, request *UpdateCurrentUserReq) (UpdateCurrentUserRes, error)
+	// UpdateOwnVote invokes updateOwnVote operation.
+	//
+	// Update own vote by edit token.
+	//
+	// PUT /p/poll/{slug}/vote/{token}
+	UpdateOwnVote(ctx context.Context, request *UpdateOwnVoteReq, params UpdateOwnVoteParams) (UpdateOwnVoteRes, error)
 	// UpdatePoll invokes updatePoll operation.
 	//
 	// Update a poll.
 	//
 	// PUT /polls/{id}
-	UpdatePoll(ctx context.Context, request *UpdatePollReq, params UpdatePollParams) (*Poll, error)
+	UpdatePoll(ctx context.Context, request *UpdatePollReq, params UpdatePollParams) (UpdatePollRes, error)
+	// VerifyBookingEmail invokes verifyBookingEmail operation.
+	//
+	// Confirms a pending booking that matched an email based auto-confirm rule once the guest proved
+	// they own the address.
+	//
+	// GET /actions/verify-email
+	VerifyBookingEmail(ctx context.Context, params VerifyBookingEmailParams) (VerifyBookingEmailRes, error)
 }
 
 // Client implements OAS client.
@@ -400,21 +542,21 @@ func (c *Client) sendAddCalendar(ctx context.Context, request *AddCalendarReq) (
 	return result, nil
 }
 
-// AddPollOption invokes addPollOption operation.
+// AddPollComment invokes addPollComment operation.
 //
-// Add an option to a poll.
+// Comment on a poll or one of its options.
 //
-// POST /polls/{id}/options
-func (c *Client) AddPollOption(ctx context.Context, request *AddPollOptionReq, params AddPollOptionParams) (*PollOption, error) {
-	res, err := c.sendAddPollOption(ctx, request, params)
+// POST /p/poll/{slug}/comments
+func (c *Client) AddPollComment(ctx context.Context, request *AddPollCommentReq, params AddPollCommentParams) (AddPollCommentRes, error) {
+	res, err := c.sendAddPollComment(ctx, request, params)
 	return res, err
 }
 
-func (c *Client) sendAddPollOption(ctx context.Context, request *AddPollOptionReq, params AddPollOptionParams) (res *PollOption, err error) {
+func (c *Client) sendAddPollComment(ctx context.Context, request *AddPollCommentReq, params AddPollCommentParams) (res AddPollCommentRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("addPollOption"),
+		otelogen.OperationID("addPollComment"),
 		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.URLTemplateKey.String("/polls/{id}/options"),
+		semconv.URLTemplateKey.String("/p/poll/{slug}/comments"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -430,7 +572,102 @@ func (c *Client) sendAddPollOption(ctx context.Context, request *AddPollOptionRe
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, AddPollOptionOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, AddPollCommentOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [3]string
+	pathParts[0] = "/p/poll/"
+	{
+		// Encode "slug" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "slug",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.Slug))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/comments"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeAddPollCommentRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeAddPollCommentResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// AddPollInvites invokes addPollInvites operation.
+//
+// Invite participants and email them a personal link.
+//
+// POST /polls/{id}/invites
+func (c *Client) AddPollInvites(ctx context.Context, request *AddPollInvitesReq, params AddPollInvitesParams) (AddPollInvitesRes, error) {
+	res, err := c.sendAddPollInvites(ctx, request, params)
+	return res, err
+}
+
+func (c *Client) sendAddPollInvites(ctx context.Context, request *AddPollInvitesReq, params AddPollInvitesParams) (res AddPollInvitesRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("addPollInvites"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/polls/{id}/invites"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, AddPollInvitesOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -467,7 +704,7 @@ func (c *Client) sendAddPollOption(ctx context.Context, request *AddPollOptionRe
 		}
 		pathParts[1] = encoded
 	}
-	pathParts[2] = "/options"
+	pathParts[2] = "/invites"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
@@ -475,7 +712,7 @@ func (c *Client) sendAddPollOption(ctx context.Context, request *AddPollOptionRe
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeAddPollOptionRequest(request, r); err != nil {
+	if err := encodeAddPollInvitesRequest(request, r); err != nil {
 		return res, errors.Wrap(err, "encode request")
 	}
 
@@ -484,7 +721,7 @@ func (c *Client) sendAddPollOption(ctx context.Context, request *AddPollOptionRe
 		var satisfied bitset
 		{
 			stage = "Security:CookieAuth"
-			switch err := c.securityCookieAuth(ctx, AddPollOptionOperation, r); {
+			switch err := c.securityCookieAuth(ctx, AddPollInvitesOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -520,7 +757,7 @@ func (c *Client) sendAddPollOption(ctx context.Context, request *AddPollOptionRe
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeAddPollOptionResponse(resp)
+	result, err := decodeAddPollInvitesResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -528,21 +765,21 @@ func (c *Client) sendAddPollOption(ctx context.Context, request *AddPollOptionRe
 	return result, nil
 }
 
-// ApproveBooking invokes approveBooking operation.
+// AddPollOption invokes addPollOption operation.
 //
-// Approve a booking.
+// Add an option to a poll.
 //
-// POST /bookings/{id}/approve
-func (c *Client) ApproveBooking(ctx context.Context, params ApproveBookingParams) (*Booking, error) {
-	res, err := c.sendApproveBooking(ctx, params)
+// POST /polls/{id}/options
+func (c *Client) AddPollOption(ctx context.Context, request *AddPollOptionReq, params AddPollOptionParams) (*PollOption, error) {
+	res, err := c.sendAddPollOption(ctx, request, params)
 	return res, err
 }
 
-func (c *Client) sendApproveBooking(ctx context.Context, params ApproveBookingParams) (res *Booking, err error) {
+func (c *Client) sendAddPollOption(ctx context.Context, request *AddPollOptionReq, params AddPollOptionParams) (res *PollOption, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("approveBooking"),
+		otelogen.OperationID("addPollOption"),
 		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.URLTemplateKey.String("/bookings/{id}/approve"),
+		semconv.URLTemplateKey.String("/polls/{id}/options"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -558,7 +795,7 @@ func (c *Client) sendApproveBooking(ctx context.Context, params ApproveBookingPa
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, ApproveBookingOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, AddPollOptionOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -576,7 +813,7 @@ func (c *Client) sendApproveBooking(ctx context.Context, params ApproveBookingPa
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [3]string
-	pathParts[0] = "/bookings/"
+	pathParts[0] = "/polls/"
 	{
 		// Encode "id" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
@@ -595,7 +832,7 @@ func (c *Client) sendApproveBooking(ctx context.Context, params ApproveBookingPa
 		}
 		pathParts[1] = encoded
 	}
-	pathParts[2] = "/approve"
+	pathParts[2] = "/options"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
@@ -603,13 +840,16 @@ func (c *Client) sendApproveBooking(ctx context.Context, params ApproveBookingPa
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
+	if err := encodeAddPollOptionRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
 
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 			stage = "Security:CookieAuth"
-			switch err := c.securityCookieAuth(ctx, ApproveBookingOperation, r); {
+			switch err := c.securityCookieAuth(ctx, AddPollOptionOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -645,7 +885,7 @@ func (c *Client) sendApproveBooking(ctx context.Context, params ApproveBookingPa
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeApproveBookingResponse(resp)
+	result, err := decodeAddPollOptionResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -653,21 +893,21 @@ func (c *Client) sendApproveBooking(ctx context.Context, params ApproveBookingPa
 	return result, nil
 }
 
-// ApproveViaEmail invokes approveViaEmail operation.
+// ApproveBooking invokes approveBooking operation.
 //
-// Approve booking via email link.
+// Approve a booking.
 //
-// GET /actions/approve
-func (c *Client) ApproveViaEmail(ctx context.Context) (ApproveViaEmailRes, error) {
-	res, err := c.sendApproveViaEmail(ctx)
+// POST /bookings/{id}/approve
+func (c *Client) ApproveBooking(ctx context.Context, params ApproveBookingParams) (*Booking, error) {
+	res, err := c.sendApproveBooking(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendApproveViaEmail(ctx context.Context) (res ApproveViaEmailRes, err error) {
+func (c *Client) sendApproveBooking(ctx context.Context, params ApproveBookingParams) (res *Booking, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("approveViaEmail"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.URLTemplateKey.String("/actions/approve"),
+		otelogen.OperationID("approveBooking"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/bookings/{id}/approve"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -683,7 +923,7 @@ func (c *Client) sendApproveViaEmail(ctx context.Context) (res ApproveViaEmailRe
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, ApproveViaEmailOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, ApproveBookingOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -700,12 +940,31 @@ func (c *Client) sendApproveViaEmail(ctx context.Context) (res ApproveViaEmailRe
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/actions/approve"
+	var pathParts [3]string
+	pathParts[0] = "/bookings/"
+	{
+		// Encode "id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.IntToString(params.ID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/approve"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "GET", u)
+	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
@@ -714,14 +973,14 @@ func (c *Client) sendApproveViaEmail(ctx context.Context) (res ApproveViaEmailRe
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			stage = "Security:ActionToken"
-			switch err := c.securityActionToken(ctx, ApproveViaEmailOperation, r); {
+			stage = "Security:CookieAuth"
+			switch err := c.securityCookieAuth(ctx, ApproveBookingOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
 				// Skip this security.
 			default:
-				return res, errors.Wrap(err, "security \"ActionToken\"")
+				return res, errors.Wrap(err, "security \"CookieAuth\"")
 			}
 		}
 
@@ -751,7 +1010,7 @@ func (c *Client) sendApproveViaEmail(ctx context.Context) (res ApproveViaEmailRe
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeApproveViaEmailResponse(resp)
+	result, err := decodeApproveBookingResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -759,21 +1018,21 @@ func (c *Client) sendApproveViaEmail(ctx context.Context) (res ApproveViaEmailRe
 	return result, nil
 }
 
-// AuthCallback invokes authCallback operation.
+// ApprovePollProposal invokes approvePollProposal operation.
 //
-// Handle OIDC callback.
+// Add a proposal to the options of the poll.
 //
-// GET /auth/callback
-func (c *Client) AuthCallback(ctx context.Context, params AuthCallbackParams) (AuthCallbackRes, error) {
-	res, err := c.sendAuthCallback(ctx, params)
+// POST /polls/{id}/proposals/{proposalId}/approve
+func (c *Client) ApprovePollProposal(ctx context.Context, params ApprovePollProposalParams) (ApprovePollProposalRes, error) {
+	res, err := c.sendApprovePollProposal(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendAuthCallback(ctx context.Context, params AuthCallbackParams) (res AuthCallbackRes, err error) {
+func (c *Client) sendApprovePollProposal(ctx context.Context, params ApprovePollProposalParams) (res ApprovePollProposalRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("authCallback"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.URLTemplateKey.String("/auth/callback"),
+		otelogen.OperationID("approvePollProposal"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/polls/{id}/proposals/{proposalId}/approve"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -789,7 +1048,7 @@ func (c *Client) sendAuthCallback(ctx context.Context, params AuthCallbackParams
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, AuthCallbackOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, ApprovePollProposalOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -806,20 +1065,270 @@ func (c *Client) sendAuthCallback(ctx context.Context, params AuthCallbackParams
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/auth/callback"
-	uri.AddPathParts(u, pathParts[:]...)
-
-	stage = "EncodeQueryParams"
-	q := uri.NewQueryEncoder()
+	var pathParts [5]string
+	pathParts[0] = "/polls/"
 	{
-		// Encode "code" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "code",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+		// Encode "id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.IntToString(params.ID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
 		}
-
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/proposals/"
+	{
+		// Encode "proposalId" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "proposalId",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.IntToString(params.ProposalId))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[3] = encoded
+	}
+	pathParts[4] = "/approve"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:CookieAuth"
+			switch err := c.securityCookieAuth(ctx, ApprovePollProposalOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"CookieAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeApprovePollProposalResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// ApproveViaEmail invokes approveViaEmail operation.
+//
+// Approve booking via email link.
+//
+// GET /actions/approve
+func (c *Client) ApproveViaEmail(ctx context.Context) (ApproveViaEmailRes, error) {
+	res, err := c.sendApproveViaEmail(ctx)
+	return res, err
+}
+
+func (c *Client) sendApproveViaEmail(ctx context.Context) (res ApproveViaEmailRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("approveViaEmail"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/actions/approve"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, ApproveViaEmailOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/actions/approve"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:ActionToken"
+			switch err := c.securityActionToken(ctx, ApproveViaEmailOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"ActionToken\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeApproveViaEmailResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// AuthCallback invokes authCallback operation.
+//
+// Handle OIDC callback.
+//
+// GET /auth/callback
+func (c *Client) AuthCallback(ctx context.Context, params AuthCallbackParams) (AuthCallbackRes, error) {
+	res, err := c.sendAuthCallback(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendAuthCallback(ctx context.Context, params AuthCallbackParams) (res AuthCallbackRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("authCallback"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/auth/callback"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, AuthCallbackOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/auth/callback"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "code" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "code",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
 		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
 			return e.EncodeValue(conv.StringToString(params.Code))
 		}); err != nil {
@@ -864,21 +1373,24 @@ func (c *Client) sendAuthCallback(ctx context.Context, params AuthCallbackParams
 	return result, nil
 }
 
-// CreateBooking invokes createBooking operation.
+// AutofillVote invokes autofillVote operation.
 //
-// Create a booking.
+// Reads the busy times from a private ICS or CalDAV free-busy URL, or from the contents of an
+// uploaded .ics file, and suggests an answer per option: no if the voter is busy, yes otherwise.
+// Full-day and multi-day options the voter is partly busy on get maybe. The calendar is only used to
+// compute the answers and is not stored.
 //
-// POST /p/booking/{slug}/book
-func (c *Client) CreateBooking(ctx context.Context, request *CreateBookingReq, params CreateBookingParams) (CreateBookingRes, error) {
-	res, err := c.sendCreateBooking(ctx, request, params)
+// POST /p/poll/{slug}/autofill
+func (c *Client) AutofillVote(ctx context.Context, request *AutofillVoteReq, params AutofillVoteParams) (AutofillVoteRes, error) {
+	res, err := c.sendAutofillVote(ctx, request, params)
 	return res, err
 }
 
-func (c *Client) sendCreateBooking(ctx context.Context, request *CreateBookingReq, params CreateBookingParams) (res CreateBookingRes, err error) {
+func (c *Client) sendAutofillVote(ctx context.Context, request *AutofillVoteReq, params AutofillVoteParams) (res AutofillVoteRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("createBooking"),
+		otelogen.OperationID("autofillVote"),
 		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.URLTemplateKey.String("/p/booking/{slug}/book"),
+		semconv.URLTemplateKey.String("/p/poll/{slug}/autofill"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -894,7 +1406,7 @@ func (c *Client) sendCreateBooking(ctx context.Context, request *CreateBookingRe
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, CreateBookingOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, AutofillVoteOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -912,7 +1424,7 @@ func (c *Client) sendCreateBooking(ctx context.Context, request *CreateBookingRe
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [3]string
-	pathParts[0] = "/p/booking/"
+	pathParts[0] = "/p/poll/"
 	{
 		// Encode "slug" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
@@ -931,7 +1443,7 @@ func (c *Client) sendCreateBooking(ctx context.Context, request *CreateBookingRe
 		}
 		pathParts[1] = encoded
 	}
-	pathParts[2] = "/book"
+	pathParts[2] = "/autofill"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
@@ -939,7 +1451,7 @@ func (c *Client) sendCreateBooking(ctx context.Context, request *CreateBookingRe
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeCreateBookingRequest(request, r); err != nil {
+	if err := encodeAutofillVoteRequest(request, r); err != nil {
 		return res, errors.Wrap(err, "encode request")
 	}
 
@@ -951,7 +1463,2111 @@ func (c *Client) sendCreateBooking(ctx context.Context, request *CreateBookingRe
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeCreateBookingResponse(resp)
+	result, err := decodeAutofillVoteResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// CreateBooking invokes createBooking operation.
+//
+// Create a booking.
+//
+// POST /p/booking/{slug}/book
+func (c *Client) CreateBooking(ctx context.Context, request *CreateBookingReq, params CreateBookingParams) (CreateBookingRes, error) {
+	res, err := c.sendCreateBooking(ctx, request, params)
+	return res, err
+}
+
+func (c *Client) sendCreateBooking(ctx context.Context, request *CreateBookingReq, params CreateBookingParams) (res CreateBookingRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("createBooking"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/p/booking/{slug}/book"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, CreateBookingOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [3]string
+	pathParts[0] = "/p/booking/"
+	{
+		// Encode "slug" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "slug",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.Slug))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/book"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeCreateBookingRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeCreateBookingResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// CreateBookingLink invokes createBookingLink operation.
+//
+// Create a booking link.
+//
+// POST /booking-links
+func (c *Client) CreateBookingLink(ctx context.Context, request *CreateBookingLinkReq) (CreateBookingLinkRes, error) {
+	res, err := c.sendCreateBookingLink(ctx, request)
+	return res, err
+}
+
+func (c *Client) sendCreateBookingLink(ctx context.Context, request *CreateBookingLinkReq) (res CreateBookingLinkRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("createBookingLink"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/booking-links"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, CreateBookingLinkOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/booking-links"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeCreateBookingLinkRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:CookieAuth"
+			switch err := c.securityCookieAuth(ctx, CreateBookingLinkOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"CookieAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeCreateBookingLinkResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// CreatePoll invokes createPoll operation.
+//
+// Create a poll.
+//
+// POST /polls
+func (c *Client) CreatePoll(ctx context.Context, request *CreatePollReq) (CreatePollRes, error) {
+	res, err := c.sendCreatePoll(ctx, request)
+	return res, err
+}
+
+func (c *Client) sendCreatePoll(ctx context.Context, request *CreatePollReq) (res CreatePollRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("createPoll"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/polls"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, CreatePollOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/polls"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeCreatePollRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:CookieAuth"
+			switch err := c.securityCookieAuth(ctx, CreatePollOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"CookieAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeCreatePollResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// DeclineBooking invokes declineBooking operation.
+//
+// Decline a booking.
+//
+// POST /bookings/{id}/decline
+func (c *Client) DeclineBooking(ctx context.Context, params DeclineBookingParams) (*Booking, error) {
+	res, err := c.sendDeclineBooking(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendDeclineBooking(ctx context.Context, params DeclineBookingParams) (res *Booking, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("declineBooking"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/bookings/{id}/decline"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, DeclineBookingOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [3]string
+	pathParts[0] = "/bookings/"
+	{
+		// Encode "id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.IntToString(params.ID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/decline"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:CookieAuth"
+			switch err := c.securityCookieAuth(ctx, DeclineBookingOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"CookieAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeDeclineBookingResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// DeclinePollProposal invokes declinePollProposal operation.
+//
+// Decline an option proposal.
+//
+// DELETE /polls/{id}/proposals/{proposalId}
+func (c *Client) DeclinePollProposal(ctx context.Context, params DeclinePollProposalParams) error {
+	_, err := c.sendDeclinePollProposal(ctx, params)
+	return err
+}
+
+func (c *Client) sendDeclinePollProposal(ctx context.Context, params DeclinePollProposalParams) (res *DeclinePollProposalNoContent, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("declinePollProposal"),
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.URLTemplateKey.String("/polls/{id}/proposals/{proposalId}"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, DeclinePollProposalOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [4]string
+	pathParts[0] = "/polls/"
+	{
+		// Encode "id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.IntToString(params.ID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/proposals/"
+	{
+		// Encode "proposalId" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "proposalId",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.IntToString(params.ProposalId))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[3] = encoded
+	}
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "DELETE", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:CookieAuth"
+			switch err := c.securityCookieAuth(ctx, DeclinePollProposalOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"CookieAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeDeclinePollProposalResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// DeclineViaEmail invokes declineViaEmail operation.
+//
+// Decline booking via email link.
+//
+// GET /actions/decline
+func (c *Client) DeclineViaEmail(ctx context.Context) (DeclineViaEmailRes, error) {
+	res, err := c.sendDeclineViaEmail(ctx)
+	return res, err
+}
+
+func (c *Client) sendDeclineViaEmail(ctx context.Context) (res DeclineViaEmailRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("declineViaEmail"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/actions/decline"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, DeclineViaEmailOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/actions/decline"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:ActionToken"
+			switch err := c.securityActionToken(ctx, DeclineViaEmailOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"ActionToken\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeDeclineViaEmailResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// DeleteBookingLink invokes deleteBookingLink operation.
+//
+// Delete a booking link.
+//
+// DELETE /booking-links/{id}
+func (c *Client) DeleteBookingLink(ctx context.Context, params DeleteBookingLinkParams) error {
+	_, err := c.sendDeleteBookingLink(ctx, params)
+	return err
+}
+
+func (c *Client) sendDeleteBookingLink(ctx context.Context, params DeleteBookingLinkParams) (res *DeleteBookingLinkNoContent, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("deleteBookingLink"),
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.URLTemplateKey.String("/booking-links/{id}"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, DeleteBookingLinkOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [2]string
+	pathParts[0] = "/booking-links/"
+	{
+		// Encode "id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.IntToString(params.ID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "DELETE", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:CookieAuth"
+			switch err := c.securityCookieAuth(ctx, DeleteBookingLinkOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"CookieAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeDeleteBookingLinkResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// DeleteOwnVote invokes deleteOwnVote operation.
+//
+// Delete own vote by edit token.
+//
+// DELETE /p/poll/{slug}/vote/{token}
+func (c *Client) DeleteOwnVote(ctx context.Context, params DeleteOwnVoteParams) (DeleteOwnVoteRes, error) {
+	res, err := c.sendDeleteOwnVote(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendDeleteOwnVote(ctx context.Context, params DeleteOwnVoteParams) (res DeleteOwnVoteRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("deleteOwnVote"),
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.URLTemplateKey.String("/p/poll/{slug}/vote/{token}"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, DeleteOwnVoteOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [4]string
+	pathParts[0] = "/p/poll/"
+	{
+		// Encode "slug" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "slug",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.Slug))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/vote/"
+	{
+		// Encode "token" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "token",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.Token))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[3] = encoded
+	}
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "DELETE", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeDeleteOwnVoteResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// DeletePoll invokes deletePoll operation.
+//
+// Delete a poll.
+//
+// DELETE /polls/{id}
+func (c *Client) DeletePoll(ctx context.Context, params DeletePollParams) error {
+	_, err := c.sendDeletePoll(ctx, params)
+	return err
+}
+
+func (c *Client) sendDeletePoll(ctx context.Context, params DeletePollParams) (res *DeletePollNoContent, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("deletePoll"),
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.URLTemplateKey.String("/polls/{id}"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, DeletePollOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [2]string
+	pathParts[0] = "/polls/"
+	{
+		// Encode "id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.IntToString(params.ID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "DELETE", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:CookieAuth"
+			switch err := c.securityCookieAuth(ctx, DeletePollOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"CookieAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeDeletePollResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// DeletePollComment invokes deletePollComment operation.
+//
+// Delete a comment.
+//
+// DELETE /polls/{id}/comments/{commentId}
+func (c *Client) DeletePollComment(ctx context.Context, params DeletePollCommentParams) error {
+	_, err := c.sendDeletePollComment(ctx, params)
+	return err
+}
+
+func (c *Client) sendDeletePollComment(ctx context.Context, params DeletePollCommentParams) (res *DeletePollCommentNoContent, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("deletePollComment"),
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.URLTemplateKey.String("/polls/{id}/comments/{commentId}"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, DeletePollCommentOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [4]string
+	pathParts[0] = "/polls/"
+	{
+		// Encode "id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.IntToString(params.ID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/comments/"
+	{
+		// Encode "commentId" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "commentId",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.IntToString(params.CommentId))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[3] = encoded
+	}
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "DELETE", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:CookieAuth"
+			switch err := c.securityCookieAuth(ctx, DeletePollCommentOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"CookieAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeDeletePollCommentResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// DeletePollInvite invokes deletePollInvite operation.
+//
+// Remove an invitee from a poll.
+//
+// DELETE /polls/{id}/invites/{inviteId}
+func (c *Client) DeletePollInvite(ctx context.Context, params DeletePollInviteParams) error {
+	_, err := c.sendDeletePollInvite(ctx, params)
+	return err
+}
+
+func (c *Client) sendDeletePollInvite(ctx context.Context, params DeletePollInviteParams) (res *DeletePollInviteNoContent, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("deletePollInvite"),
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.URLTemplateKey.String("/polls/{id}/invites/{inviteId}"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, DeletePollInviteOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [4]string
+	pathParts[0] = "/polls/"
+	{
+		// Encode "id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.IntToString(params.ID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/invites/"
+	{
+		// Encode "inviteId" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "inviteId",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.IntToString(params.InviteId))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[3] = encoded
+	}
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "DELETE", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:CookieAuth"
+			switch err := c.securityCookieAuth(ctx, DeletePollInviteOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"CookieAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeDeletePollInviteResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// DeletePollOption invokes deletePollOption operation.
+//
+// Delete an option from a poll.
+//
+// DELETE /polls/{id}/options/{optionId}
+func (c *Client) DeletePollOption(ctx context.Context, params DeletePollOptionParams) error {
+	_, err := c.sendDeletePollOption(ctx, params)
+	return err
+}
+
+func (c *Client) sendDeletePollOption(ctx context.Context, params DeletePollOptionParams) (res *DeletePollOptionNoContent, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("deletePollOption"),
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.URLTemplateKey.String("/polls/{id}/options/{optionId}"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, DeletePollOptionOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [4]string
+	pathParts[0] = "/polls/"
+	{
+		// Encode "id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.IntToString(params.ID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/options/"
+	{
+		// Encode "optionId" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "optionId",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.IntToString(params.OptionId))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[3] = encoded
+	}
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "DELETE", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:CookieAuth"
+			switch err := c.securityCookieAuth(ctx, DeletePollOptionOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"CookieAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeDeletePollOptionResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// DiscoverCalendars invokes discoverCalendars operation.
+//
+// Discover available calendars from a CalDAV server.
+//
+// POST /calendars/discover
+func (c *Client) DiscoverCalendars(ctx context.Context, request *DiscoverCalendarsReq) (*CalendarDiscoveryResult, error) {
+	res, err := c.sendDiscoverCalendars(ctx, request)
+	return res, err
+}
+
+func (c *Client) sendDiscoverCalendars(ctx context.Context, request *DiscoverCalendarsReq) (res *CalendarDiscoveryResult, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("discoverCalendars"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/calendars/discover"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, DiscoverCalendarsOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/calendars/discover"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeDiscoverCalendarsRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:CookieAuth"
+			switch err := c.securityCookieAuth(ctx, DiscoverCalendarsOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"CookieAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeDiscoverCalendarsResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GeneratePollOptions invokes generatePollOptions operation.
+//
+// Creates one option per matching day between start_date and end_date, for each of the given times.
+// Full-day options cover the whole day, multi-day options span span_days days. Times are wall-clock
+// times in time_zone, so they stay the same across daylight saving changes. Options the poll already
+// offers are skipped. Without add, the options are only previewed.
+//
+// POST /polls/{id}/options/generate
+func (c *Client) GeneratePollOptions(ctx context.Context, request *GeneratePollOptionsReq, params GeneratePollOptionsParams) (GeneratePollOptionsRes, error) {
+	res, err := c.sendGeneratePollOptions(ctx, request, params)
+	return res, err
+}
+
+func (c *Client) sendGeneratePollOptions(ctx context.Context, request *GeneratePollOptionsReq, params GeneratePollOptionsParams) (res GeneratePollOptionsRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("generatePollOptions"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/polls/{id}/options/generate"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, GeneratePollOptionsOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [3]string
+	pathParts[0] = "/polls/"
+	{
+		// Encode "id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.IntToString(params.ID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/options/generate"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeGeneratePollOptionsRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:CookieAuth"
+			switch err := c.securityCookieAuth(ctx, GeneratePollOptionsOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"CookieAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeGeneratePollOptionsResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GetBookingAvailability invokes getBookingAvailability operation.
+//
+// Get real-time availability for booking link.
+//
+// GET /p/booking/{slug}/availability
+func (c *Client) GetBookingAvailability(ctx context.Context, params GetBookingAvailabilityParams) (*GetBookingAvailabilityOK, error) {
+	res, err := c.sendGetBookingAvailability(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendGetBookingAvailability(ctx context.Context, params GetBookingAvailabilityParams) (res *GetBookingAvailabilityOK, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getBookingAvailability"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/p/booking/{slug}/availability"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, GetBookingAvailabilityOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [3]string
+	pathParts[0] = "/p/booking/"
+	{
+		// Encode "slug" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "slug",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.Slug))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/availability"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "start" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "start",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			return e.EncodeValue(conv.DateTimeToString(params.Start))
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "end" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "end",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			return e.EncodeValue(conv.DateTimeToString(params.End))
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	{
+		// Encode "duration" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "duration",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Duration.Get(); ok {
+				return e.EncodeValue(conv.IntToString(val))
+			}
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeGetBookingAvailabilityResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GetBookingLink invokes getBookingLink operation.
+//
+// Get booking link details.
+//
+// GET /booking-links/{id}
+func (c *Client) GetBookingLink(ctx context.Context, params GetBookingLinkParams) (*BookingLink, error) {
+	res, err := c.sendGetBookingLink(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendGetBookingLink(ctx context.Context, params GetBookingLinkParams) (res *BookingLink, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getBookingLink"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/booking-links/{id}"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, GetBookingLinkOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [2]string
+	pathParts[0] = "/booking-links/"
+	{
+		// Encode "id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.IntToString(params.ID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:CookieAuth"
+			switch err := c.securityCookieAuth(ctx, GetBookingLinkOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"CookieAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeGetBookingLinkResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// GetBookingLinkBookings invokes getBookingLinkBookings operation.
+//
+// Get bookings for a booking link.
+//
+// GET /booking-links/{id}/bookings
+func (c *Client) GetBookingLinkBookings(ctx context.Context, params GetBookingLinkBookingsParams) ([]Booking, error) {
+	res, err := c.sendGetBookingLinkBookings(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendGetBookingLinkBookings(ctx context.Context, params GetBookingLinkBookingsParams) (res []Booking, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getBookingLinkBookings"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/booking-links/{id}/bookings"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, GetBookingLinkBookingsOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [3]string
+	pathParts[0] = "/booking-links/"
+	{
+		// Encode "id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.IntToString(params.ID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/bookings"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:CookieAuth"
+			switch err := c.securityCookieAuth(ctx, GetBookingLinkBookingsOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"CookieAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeGetBookingLinkBookingsResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -959,21 +3575,21 @@ func (c *Client) sendCreateBooking(ctx context.Context, request *CreateBookingRe
 	return result, nil
 }
 
-// CreateBookingLink invokes createBookingLink operation.
+// GetCurrentUser invokes getCurrentUser operation.
 //
-// Create a booking link.
+// Get current user.
 //
-// POST /booking-links
-func (c *Client) CreateBookingLink(ctx context.Context, request *CreateBookingLinkReq) (*BookingLink, error) {
-	res, err := c.sendCreateBookingLink(ctx, request)
+// GET /auth/me
+func (c *Client) GetCurrentUser(ctx context.Context) (GetCurrentUserRes, error) {
+	res, err := c.sendGetCurrentUser(ctx)
 	return res, err
 }
 
-func (c *Client) sendCreateBookingLink(ctx context.Context, request *CreateBookingLinkReq) (res *BookingLink, err error) {
+func (c *Client) sendGetCurrentUser(ctx context.Context) (res GetCurrentUserRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("createBookingLink"),
-		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.URLTemplateKey.String("/booking-links"),
+		otelogen.OperationID("getCurrentUser"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/auth/me"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -989,7 +3605,7 @@ func (c *Client) sendCreateBookingLink(ctx context.Context, request *CreateBooki
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, CreateBookingLinkOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, GetCurrentUserOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -1007,24 +3623,21 @@ func (c *Client) sendCreateBookingLink(ctx context.Context, request *CreateBooki
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/booking-links"
+	pathParts[0] = "/auth/me"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "POST", u)
+	r, err := ht.NewRequest(ctx, "GET", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeCreateBookingLinkRequest(request, r); err != nil {
-		return res, errors.Wrap(err, "encode request")
-	}
 
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 			stage = "Security:CookieAuth"
-			switch err := c.securityCookieAuth(ctx, CreateBookingLinkOperation, r); {
+			switch err := c.securityCookieAuth(ctx, GetCurrentUserOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -1060,7 +3673,7 @@ func (c *Client) sendCreateBookingLink(ctx context.Context, request *CreateBooki
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeCreateBookingLinkResponse(resp)
+	result, err := decodeGetCurrentUserResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -1068,21 +3681,21 @@ func (c *Client) sendCreateBookingLink(ctx context.Context, request *CreateBooki
 	return result, nil
 }
 
-// CreatePoll invokes createPoll operation.
+// GetOwnVote invokes getOwnVote operation.
 //
-// Create a poll.
+// Get own vote by edit token.
 //
-// POST /polls
-func (c *Client) CreatePoll(ctx context.Context, request *CreatePollReq) (*Poll, error) {
-	res, err := c.sendCreatePoll(ctx, request)
+// GET /p/poll/{slug}/vote/{token}
+func (c *Client) GetOwnVote(ctx context.Context, params GetOwnVoteParams) (GetOwnVoteRes, error) {
+	res, err := c.sendGetOwnVote(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendCreatePoll(ctx context.Context, request *CreatePollReq) (res *Poll, err error) {
+func (c *Client) sendGetOwnVote(ctx context.Context, params GetOwnVoteParams) (res GetOwnVoteRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("createPoll"),
-		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.URLTemplateKey.String("/polls"),
+		otelogen.OperationID("getOwnVote"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/p/poll/{slug}/vote/{token}"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -1098,7 +3711,7 @@ func (c *Client) sendCreatePoll(ctx context.Context, request *CreatePollReq) (re
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, CreatePollOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, GetOwnVoteOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -1115,51 +3728,52 @@ func (c *Client) sendCreatePoll(ctx context.Context, request *CreatePollReq) (re
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/polls"
+	var pathParts [4]string
+	pathParts[0] = "/p/poll/"
+	{
+		// Encode "slug" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "slug",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.Slug))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/vote/"
+	{
+		// Encode "token" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "token",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.Token))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[3] = encoded
+	}
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "POST", u)
+	r, err := ht.NewRequest(ctx, "GET", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeCreatePollRequest(request, r); err != nil {
-		return res, errors.Wrap(err, "encode request")
-	}
-
-	{
-		type bitset = [1]uint8
-		var satisfied bitset
-		{
-			stage = "Security:CookieAuth"
-			switch err := c.securityCookieAuth(ctx, CreatePollOperation, r); {
-			case err == nil: // if NO error
-				satisfied[0] |= 1 << 0
-			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
-				// Skip this security.
-			default:
-				return res, errors.Wrap(err, "security \"CookieAuth\"")
-			}
-		}
-
-		if ok := func() bool {
-		nextRequirement:
-			for _, requirement := range []bitset{
-				{0b00000001},
-			} {
-				for i, mask := range requirement {
-					if satisfied[i]&mask != mask {
-						continue nextRequirement
-					}
-				}
-				return true
-			}
-			return false
-		}(); !ok {
-			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
-		}
-	}
 
 	stage = "SendRequest"
 	resp, err := c.cfg.Client.Do(r)
@@ -1169,7 +3783,7 @@ func (c *Client) sendCreatePoll(ctx context.Context, request *CreatePollReq) (re
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeCreatePollResponse(resp)
+	result, err := decodeGetOwnVoteResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -1177,21 +3791,21 @@ func (c *Client) sendCreatePoll(ctx context.Context, request *CreatePollReq) (re
 	return result, nil
 }
 
-// DeclineBooking invokes declineBooking operation.
+// GetPoll invokes getPoll operation.
 //
-// Decline a booking.
+// Get poll details.
 //
-// POST /bookings/{id}/decline
-func (c *Client) DeclineBooking(ctx context.Context, params DeclineBookingParams) (*Booking, error) {
-	res, err := c.sendDeclineBooking(ctx, params)
+// GET /polls/{id}
+func (c *Client) GetPoll(ctx context.Context, params GetPollParams) (*Poll, error) {
+	res, err := c.sendGetPoll(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendDeclineBooking(ctx context.Context, params DeclineBookingParams) (res *Booking, err error) {
+func (c *Client) sendGetPoll(ctx context.Context, params GetPollParams) (res *Poll, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("declineBooking"),
-		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.URLTemplateKey.String("/bookings/{id}/decline"),
+		otelogen.OperationID("getPoll"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/polls/{id}"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -1207,7 +3821,7 @@ func (c *Client) sendDeclineBooking(ctx context.Context, params DeclineBookingPa
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, DeclineBookingOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, GetPollOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -1224,8 +3838,8 @@ func (c *Client) sendDeclineBooking(ctx context.Context, params DeclineBookingPa
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [3]string
-	pathParts[0] = "/bookings/"
+	var pathParts [2]string
+	pathParts[0] = "/polls/"
 	{
 		// Encode "id" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
@@ -1244,11 +3858,10 @@ func (c *Client) sendDeclineBooking(ctx context.Context, params DeclineBookingPa
 		}
 		pathParts[1] = encoded
 	}
-	pathParts[2] = "/decline"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "POST", u)
+	r, err := ht.NewRequest(ctx, "GET", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
@@ -1258,7 +3871,7 @@ func (c *Client) sendDeclineBooking(ctx context.Context, params DeclineBookingPa
 		var satisfied bitset
 		{
 			stage = "Security:CookieAuth"
-			switch err := c.securityCookieAuth(ctx, DeclineBookingOperation, r); {
+			switch err := c.securityCookieAuth(ctx, GetPollOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -1294,7 +3907,7 @@ func (c *Client) sendDeclineBooking(ctx context.Context, params DeclineBookingPa
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeDeclineBookingResponse(resp)
+	result, err := decodeGetPollResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -1302,21 +3915,21 @@ func (c *Client) sendDeclineBooking(ctx context.Context, params DeclineBookingPa
 	return result, nil
 }
 
-// DeclineViaEmail invokes declineViaEmail operation.
+// GetPollOptions invokes getPollOptions operation.
 //
-// Decline booking via email link.
+// Get options for a poll.
 //
-// GET /actions/decline
-func (c *Client) DeclineViaEmail(ctx context.Context) (DeclineViaEmailRes, error) {
-	res, err := c.sendDeclineViaEmail(ctx)
+// GET /polls/{id}/options
+func (c *Client) GetPollOptions(ctx context.Context, params GetPollOptionsParams) ([]PollOption, error) {
+	res, err := c.sendGetPollOptions(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendDeclineViaEmail(ctx context.Context) (res DeclineViaEmailRes, err error) {
+func (c *Client) sendGetPollOptions(ctx context.Context, params GetPollOptionsParams) (res []PollOption, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("declineViaEmail"),
+		otelogen.OperationID("getPollOptions"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.URLTemplateKey.String("/actions/decline"),
+		semconv.URLTemplateKey.String("/polls/{id}/options"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -1332,7 +3945,7 @@ func (c *Client) sendDeclineViaEmail(ctx context.Context) (res DeclineViaEmailRe
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, DeclineViaEmailOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, GetPollOptionsOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -1349,8 +3962,27 @@ func (c *Client) sendDeclineViaEmail(ctx context.Context) (res DeclineViaEmailRe
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/actions/decline"
+	var pathParts [3]string
+	pathParts[0] = "/polls/"
+	{
+		// Encode "id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.IntToString(params.ID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/options"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
@@ -1363,14 +3995,14 @@ func (c *Client) sendDeclineViaEmail(ctx context.Context) (res DeclineViaEmailRe
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			stage = "Security:ActionToken"
-			switch err := c.securityActionToken(ctx, DeclineViaEmailOperation, r); {
+			stage = "Security:CookieAuth"
+			switch err := c.securityCookieAuth(ctx, GetPollOptionsOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
 				// Skip this security.
 			default:
-				return res, errors.Wrap(err, "security \"ActionToken\"")
+				return res, errors.Wrap(err, "security \"CookieAuth\"")
 			}
 		}
 
@@ -1400,7 +4032,7 @@ func (c *Client) sendDeclineViaEmail(ctx context.Context) (res DeclineViaEmailRe
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeDeclineViaEmailResponse(resp)
+	result, err := decodeGetPollOptionsResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -1408,21 +4040,21 @@ func (c *Client) sendDeclineViaEmail(ctx context.Context) (res DeclineViaEmailRe
 	return result, nil
 }
 
-// DeleteBookingLink invokes deleteBookingLink operation.
+// GetPollRanking invokes getPollRanking operation.
 //
-// Delete a booking link.
+// Get ranked results of a poll.
 //
-// DELETE /booking-links/{id}
-func (c *Client) DeleteBookingLink(ctx context.Context, params DeleteBookingLinkParams) error {
-	_, err := c.sendDeleteBookingLink(ctx, params)
-	return err
+// GET /polls/{id}/results
+func (c *Client) GetPollRanking(ctx context.Context, params GetPollRankingParams) (*PollResults, error) {
+	res, err := c.sendGetPollRanking(ctx, params)
+	return res, err
 }
 
-func (c *Client) sendDeleteBookingLink(ctx context.Context, params DeleteBookingLinkParams) (res *DeleteBookingLinkNoContent, err error) {
+func (c *Client) sendGetPollRanking(ctx context.Context, params GetPollRankingParams) (res *PollResults, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("deleteBookingLink"),
-		semconv.HTTPRequestMethodKey.String("DELETE"),
-		semconv.URLTemplateKey.String("/booking-links/{id}"),
+		otelogen.OperationID("getPollRanking"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/polls/{id}/results"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -1438,7 +4070,7 @@ func (c *Client) sendDeleteBookingLink(ctx context.Context, params DeleteBooking
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, DeleteBookingLinkOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, GetPollRankingOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -1455,8 +4087,8 @@ func (c *Client) sendDeleteBookingLink(ctx context.Context, params DeleteBooking
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [2]string
-	pathParts[0] = "/booking-links/"
+	var pathParts [3]string
+	pathParts[0] = "/polls/"
 	{
 		// Encode "id" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
@@ -1475,10 +4107,11 @@ func (c *Client) sendDeleteBookingLink(ctx context.Context, params DeleteBooking
 		}
 		pathParts[1] = encoded
 	}
+	pathParts[2] = "/results"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "DELETE", u)
+	r, err := ht.NewRequest(ctx, "GET", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
@@ -1488,7 +4121,7 @@ func (c *Client) sendDeleteBookingLink(ctx context.Context, params DeleteBooking
 		var satisfied bitset
 		{
 			stage = "Security:CookieAuth"
-			switch err := c.securityCookieAuth(ctx, DeleteBookingLinkOperation, r); {
+			switch err := c.securityCookieAuth(ctx, GetPollRankingOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -1524,7 +4157,7 @@ func (c *Client) sendDeleteBookingLink(ctx context.Context, params DeleteBooking
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeDeleteBookingLinkResponse(resp)
+	result, err := decodeGetPollRankingResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -1532,21 +4165,22 @@ func (c *Client) sendDeleteBookingLink(ctx context.Context, params DeleteBooking
 	return result, nil
 }
 
-// DeletePoll invokes deletePoll operation.
+// GetPollResults invokes getPollResults operation.
 //
-// Delete a poll.
+// Results are only returned if the poll shows results and its timing allows it. Voter details are
+// reduced to what the poll's visibility permits.
 //
-// DELETE /polls/{id}
-func (c *Client) DeletePoll(ctx context.Context, params DeletePollParams) error {
-	_, err := c.sendDeletePoll(ctx, params)
-	return err
+// GET /p/poll/{slug}/results
+func (c *Client) GetPollResults(ctx context.Context, params GetPollResultsParams) (GetPollResultsRes, error) {
+	res, err := c.sendGetPollResults(ctx, params)
+	return res, err
 }
 
-func (c *Client) sendDeletePoll(ctx context.Context, params DeletePollParams) (res *DeletePollNoContent, err error) {
+func (c *Client) sendGetPollResults(ctx context.Context, params GetPollResultsParams) (res GetPollResultsRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("deletePoll"),
-		semconv.HTTPRequestMethodKey.String("DELETE"),
-		semconv.URLTemplateKey.String("/polls/{id}"),
+		otelogen.OperationID("getPollResults"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/p/poll/{slug}/results"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -1562,7 +4196,7 @@ func (c *Client) sendDeletePoll(ctx context.Context, params DeletePollParams) (r
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, DeletePollOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, GetPollResultsOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -1579,17 +4213,17 @@ func (c *Client) sendDeletePoll(ctx context.Context, params DeletePollParams) (r
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [2]string
-	pathParts[0] = "/polls/"
+	var pathParts [3]string
+	pathParts[0] = "/p/poll/"
 	{
-		// Encode "id" parameter.
+		// Encode "slug" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "id",
+			Param:   "slug",
 			Style:   uri.PathStyleSimple,
 			Explode: false,
 		})
 		if err := func() error {
-			return e.EncodeValue(conv.IntToString(params.ID))
+			return e.EncodeValue(conv.StringToString(params.Slug))
 		}(); err != nil {
 			return res, errors.Wrap(err, "encode path")
 		}
@@ -1599,46 +4233,35 @@ func (c *Client) sendDeletePoll(ctx context.Context, params DeletePollParams) (r
 		}
 		pathParts[1] = encoded
 	}
+	pathParts[2] = "/results"
 	uri.AddPathParts(u, pathParts[:]...)
 
-	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "DELETE", u)
-	if err != nil {
-		return res, errors.Wrap(err, "create request")
-	}
-
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
 	{
-		type bitset = [1]uint8
-		var satisfied bitset
-		{
-			stage = "Security:CookieAuth"
-			switch err := c.securityCookieAuth(ctx, DeletePollOperation, r); {
-			case err == nil: // if NO error
-				satisfied[0] |= 1 << 0
-			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
-				// Skip this security.
-			default:
-				return res, errors.Wrap(err, "security \"CookieAuth\"")
-			}
+		// Encode "token" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "token",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
 		}
 
-		if ok := func() bool {
-		nextRequirement:
-			for _, requirement := range []bitset{
-				{0b00000001},
-			} {
-				for i, mask := range requirement {
-					if satisfied[i]&mask != mask {
-						continue nextRequirement
-					}
-				}
-				return true
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			if val, ok := params.Token.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
 			}
-			return false
-		}(); !ok {
-			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+			return nil
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
 		}
 	}
+	u.RawQuery = q.Values().Encode()
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
 
 	stage = "SendRequest"
 	resp, err := c.cfg.Client.Do(r)
@@ -1648,7 +4271,7 @@ func (c *Client) sendDeletePoll(ctx context.Context, params DeletePollParams) (r
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeDeletePollResponse(resp)
+	result, err := decodeGetPollResultsResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -1656,21 +4279,21 @@ func (c *Client) sendDeletePoll(ctx context.Context, params DeletePollParams) (r
 	return result, nil
 }
 
-// DeletePollOption invokes deletePollOption operation.
+// GetPollVotes invokes getPollVotes operation.
 //
-// Delete an option from a poll.
+// Get votes for a poll.
 //
-// DELETE /polls/{id}/options/{optionId}
-func (c *Client) DeletePollOption(ctx context.Context, params DeletePollOptionParams) error {
-	_, err := c.sendDeletePollOption(ctx, params)
-	return err
+// GET /polls/{id}/votes
+func (c *Client) GetPollVotes(ctx context.Context, params GetPollVotesParams) ([]Vote, error) {
+	res, err := c.sendGetPollVotes(ctx, params)
+	return res, err
 }
 
-func (c *Client) sendDeletePollOption(ctx context.Context, params DeletePollOptionParams) (res *DeletePollOptionNoContent, err error) {
+func (c *Client) sendGetPollVotes(ctx context.Context, params GetPollVotesParams) (res []Vote, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("deletePollOption"),
-		semconv.HTTPRequestMethodKey.String("DELETE"),
-		semconv.URLTemplateKey.String("/polls/{id}/options/{optionId}"),
+		otelogen.OperationID("getPollVotes"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/polls/{id}/votes"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -1686,7 +4309,7 @@ func (c *Client) sendDeletePollOption(ctx context.Context, params DeletePollOpti
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, DeletePollOptionOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, GetPollVotesOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -1703,7 +4326,7 @@ func (c *Client) sendDeletePollOption(ctx context.Context, params DeletePollOpti
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [4]string
+	var pathParts [3]string
 	pathParts[0] = "/polls/"
 	{
 		// Encode "id" parameter.
@@ -1723,29 +4346,11 @@ func (c *Client) sendDeletePollOption(ctx context.Context, params DeletePollOpti
 		}
 		pathParts[1] = encoded
 	}
-	pathParts[2] = "/options/"
-	{
-		// Encode "optionId" parameter.
-		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "optionId",
-			Style:   uri.PathStyleSimple,
-			Explode: false,
-		})
-		if err := func() error {
-			return e.EncodeValue(conv.IntToString(params.OptionId))
-		}(); err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		encoded, err := e.Result()
-		if err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		pathParts[3] = encoded
-	}
+	pathParts[2] = "/votes"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "DELETE", u)
+	r, err := ht.NewRequest(ctx, "GET", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
@@ -1755,7 +4360,7 @@ func (c *Client) sendDeletePollOption(ctx context.Context, params DeletePollOpti
 		var satisfied bitset
 		{
 			stage = "Security:CookieAuth"
-			switch err := c.securityCookieAuth(ctx, DeletePollOptionOperation, r); {
+			switch err := c.securityCookieAuth(ctx, GetPollVotesOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -1791,7 +4396,7 @@ func (c *Client) sendDeletePollOption(ctx context.Context, params DeletePollOpti
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeDeletePollOptionResponse(resp)
+	result, err := decodeGetPollVotesResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -1799,21 +4404,21 @@ func (c *Client) sendDeletePollOption(ctx context.Context, params DeletePollOpti
 	return result, nil
 }
 
-// DiscoverCalendars invokes discoverCalendars operation.
+// GetPublicBookingLink invokes getPublicBookingLink operation.
 //
-// Discover available calendars from a CalDAV server.
+// Get public booking link info.
 //
-// POST /calendars/discover
-func (c *Client) DiscoverCalendars(ctx context.Context, request *DiscoverCalendarsReq) (*CalendarDiscoveryResult, error) {
-	res, err := c.sendDiscoverCalendars(ctx, request)
+// GET /p/booking/{slug}
+func (c *Client) GetPublicBookingLink(ctx context.Context, params GetPublicBookingLinkParams) (GetPublicBookingLinkRes, error) {
+	res, err := c.sendGetPublicBookingLink(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendDiscoverCalendars(ctx context.Context, request *DiscoverCalendarsReq) (res *CalendarDiscoveryResult, err error) {
+func (c *Client) sendGetPublicBookingLink(ctx context.Context, params GetPublicBookingLinkParams) (res GetPublicBookingLinkRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("discoverCalendars"),
-		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.URLTemplateKey.String("/calendars/discover"),
+		otelogen.OperationID("getPublicBookingLink"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/p/booking/{slug}"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -1829,7 +4434,7 @@ func (c *Client) sendDiscoverCalendars(ctx context.Context, request *DiscoverCal
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, DiscoverCalendarsOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, GetPublicBookingLinkOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -1846,51 +4451,33 @@ func (c *Client) sendDiscoverCalendars(ctx context.Context, request *DiscoverCal
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/calendars/discover"
+	var pathParts [2]string
+	pathParts[0] = "/p/booking/"
+	{
+		// Encode "slug" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "slug",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.Slug))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "POST", u)
+	r, err := ht.NewRequest(ctx, "GET", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeDiscoverCalendarsRequest(request, r); err != nil {
-		return res, errors.Wrap(err, "encode request")
-	}
-
-	{
-		type bitset = [1]uint8
-		var satisfied bitset
-		{
-			stage = "Security:CookieAuth"
-			switch err := c.securityCookieAuth(ctx, DiscoverCalendarsOperation, r); {
-			case err == nil: // if NO error
-				satisfied[0] |= 1 << 0
-			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
-				// Skip this security.
-			default:
-				return res, errors.Wrap(err, "security \"CookieAuth\"")
-			}
-		}
-
-		if ok := func() bool {
-		nextRequirement:
-			for _, requirement := range []bitset{
-				{0b00000001},
-			} {
-				for i, mask := range requirement {
-					if satisfied[i]&mask != mask {
-						continue nextRequirement
-					}
-				}
-				return true
-			}
-			return false
-		}(); !ok {
-			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
-		}
-	}
 
 	stage = "SendRequest"
 	resp, err := c.cfg.Client.Do(r)
@@ -1900,7 +4487,7 @@ func (c *Client) sendDiscoverCalendars(ctx context.Context, request *DiscoverCal
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeDiscoverCalendarsResponse(resp)
+	result, err := decodeGetPublicBookingLinkResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -1908,21 +4495,21 @@ func (c *Client) sendDiscoverCalendars(ctx context.Context, request *DiscoverCal
 	return result, nil
 }
 
-// GetBookingAvailability invokes getBookingAvailability operation.
+// GetPublicPoll invokes getPublicPoll operation.
 //
-// Get real-time availability for booking link.
+// Get public poll info.
 //
-// GET /p/booking/{slug}/availability
-func (c *Client) GetBookingAvailability(ctx context.Context, params GetBookingAvailabilityParams) (*GetBookingAvailabilityOK, error) {
-	res, err := c.sendGetBookingAvailability(ctx, params)
+// GET /p/poll/{slug}
+func (c *Client) GetPublicPoll(ctx context.Context, params GetPublicPollParams) (GetPublicPollRes, error) {
+	res, err := c.sendGetPublicPoll(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendGetBookingAvailability(ctx context.Context, params GetBookingAvailabilityParams) (res *GetBookingAvailabilityOK, err error) {
+func (c *Client) sendGetPublicPoll(ctx context.Context, params GetPublicPollParams) (res GetPublicPollRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getBookingAvailability"),
+		otelogen.OperationID("getPublicPoll"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.URLTemplateKey.String("/p/booking/{slug}/availability"),
+		semconv.URLTemplateKey.String("/p/poll/{slug}"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -1938,7 +4525,7 @@ func (c *Client) sendGetBookingAvailability(ctx context.Context, params GetBooki
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, GetBookingAvailabilityOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, GetPublicPollOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -1955,8 +4542,8 @@ func (c *Client) sendGetBookingAvailability(ctx context.Context, params GetBooki
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [3]string
-	pathParts[0] = "/p/booking/"
+	var pathParts [2]string
+	pathParts[0] = "/p/poll/"
 	{
 		// Encode "slug" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
@@ -1975,50 +4562,21 @@ func (c *Client) sendGetBookingAvailability(ctx context.Context, params GetBooki
 		}
 		pathParts[1] = encoded
 	}
-	pathParts[2] = "/availability"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeQueryParams"
 	q := uri.NewQueryEncoder()
 	{
-		// Encode "start" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "start",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			return e.EncodeValue(conv.DateTimeToString(params.Start))
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
-	}
-	{
-		// Encode "end" parameter.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "end",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			return e.EncodeValue(conv.DateTimeToString(params.End))
-		}); err != nil {
-			return res, errors.Wrap(err, "encode query")
-		}
-	}
-	{
-		// Encode "duration" parameter.
+		// Encode "invite" parameter.
 		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "duration",
+			Name:    "invite",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := params.Duration.Get(); ok {
-				return e.EncodeValue(conv.IntToString(val))
+			if val, ok := params.Invite.Get(); ok {
+				return e.EncodeValue(conv.StringToString(val))
 			}
 			return nil
 		}); err != nil {
@@ -2041,7 +4599,7 @@ func (c *Client) sendGetBookingAvailability(ctx context.Context, params GetBooki
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeGetBookingAvailabilityResponse(resp)
+	result, err := decodeGetPublicPollResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -2049,21 +4607,21 @@ func (c *Client) sendGetBookingAvailability(ctx context.Context, params GetBooki
 	return result, nil
 }
 
-// GetBookingLink invokes getBookingLink operation.
+// GetPublicPollComments invokes getPublicPollComments operation.
 //
-// Get booking link details.
+// List the visible comments of a poll.
 //
-// GET /booking-links/{id}
-func (c *Client) GetBookingLink(ctx context.Context, params GetBookingLinkParams) (*BookingLink, error) {
-	res, err := c.sendGetBookingLink(ctx, params)
+// GET /p/poll/{slug}/comments
+func (c *Client) GetPublicPollComments(ctx context.Context, params GetPublicPollCommentsParams) (GetPublicPollCommentsRes, error) {
+	res, err := c.sendGetPublicPollComments(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendGetBookingLink(ctx context.Context, params GetBookingLinkParams) (res *BookingLink, err error) {
+func (c *Client) sendGetPublicPollComments(ctx context.Context, params GetPublicPollCommentsParams) (res GetPublicPollCommentsRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getBookingLink"),
+		otelogen.OperationID("getPublicPollComments"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.URLTemplateKey.String("/booking-links/{id}"),
+		semconv.URLTemplateKey.String("/p/poll/{slug}/comments"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -2079,7 +4637,7 @@ func (c *Client) sendGetBookingLink(ctx context.Context, params GetBookingLinkPa
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, GetBookingLinkOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, GetPublicPollCommentsOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -2096,17 +4654,17 @@ func (c *Client) sendGetBookingLink(ctx context.Context, params GetBookingLinkPa
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [2]string
-	pathParts[0] = "/booking-links/"
+	var pathParts [3]string
+	pathParts[0] = "/p/poll/"
 	{
-		// Encode "id" parameter.
+		// Encode "slug" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "id",
+			Param:   "slug",
 			Style:   uri.PathStyleSimple,
 			Explode: false,
 		})
 		if err := func() error {
-			return e.EncodeValue(conv.IntToString(params.ID))
+			return e.EncodeValue(conv.StringToString(params.Slug))
 		}(); err != nil {
 			return res, errors.Wrap(err, "encode path")
 		}
@@ -2116,6 +4674,7 @@ func (c *Client) sendGetBookingLink(ctx context.Context, params GetBookingLinkPa
 		}
 		pathParts[1] = encoded
 	}
+	pathParts[2] = "/comments"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
@@ -2124,39 +4683,6 @@ func (c *Client) sendGetBookingLink(ctx context.Context, params GetBookingLinkPa
 		return res, errors.Wrap(err, "create request")
 	}
 
-	{
-		type bitset = [1]uint8
-		var satisfied bitset
-		{
-			stage = "Security:CookieAuth"
-			switch err := c.securityCookieAuth(ctx, GetBookingLinkOperation, r); {
-			case err == nil: // if NO error
-				satisfied[0] |= 1 << 0
-			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
-				// Skip this security.
-			default:
-				return res, errors.Wrap(err, "security \"CookieAuth\"")
-			}
-		}
-
-		if ok := func() bool {
-		nextRequirement:
-			for _, requirement := range []bitset{
-				{0b00000001},
-			} {
-				for i, mask := range requirement {
-					if satisfied[i]&mask != mask {
-						continue nextRequirement
-					}
-				}
-				return true
-			}
-			return false
-		}(); !ok {
-			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
-		}
-	}
-
 	stage = "SendRequest"
 	resp, err := c.cfg.Client.Do(r)
 	if err != nil {
@@ -2165,7 +4691,7 @@ func (c *Client) sendGetBookingLink(ctx context.Context, params GetBookingLinkPa
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeGetBookingLinkResponse(resp)
+	result, err := decodeGetPublicPollCommentsResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -2173,21 +4699,21 @@ func (c *Client) sendGetBookingLink(ctx context.Context, params GetBookingLinkPa
 	return result, nil
 }
 
-// GetBookingLinkBookings invokes getBookingLinkBookings operation.
+// InitiateLogin invokes initiateLogin operation.
 //
-// Get bookings for a booking link.
+// Redirect to OIDC provider.
 //
-// GET /booking-links/{id}/bookings
-func (c *Client) GetBookingLinkBookings(ctx context.Context, params GetBookingLinkBookingsParams) ([]Booking, error) {
-	res, err := c.sendGetBookingLinkBookings(ctx, params)
+// GET /auth/login
+func (c *Client) InitiateLogin(ctx context.Context) (*InitiateLoginFound, error) {
+	res, err := c.sendInitiateLogin(ctx)
 	return res, err
 }
 
-func (c *Client) sendGetBookingLinkBookings(ctx context.Context, params GetBookingLinkBookingsParams) (res []Booking, err error) {
+func (c *Client) sendInitiateLogin(ctx context.Context) (res *InitiateLoginFound, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getBookingLinkBookings"),
+		otelogen.OperationID("initiateLogin"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.URLTemplateKey.String("/booking-links/{id}/bookings"),
+		semconv.URLTemplateKey.String("/auth/login"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -2203,7 +4729,7 @@ func (c *Client) sendGetBookingLinkBookings(ctx context.Context, params GetBooki
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, GetBookingLinkBookingsOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, InitiateLoginOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -2220,27 +4746,8 @@ func (c *Client) sendGetBookingLinkBookings(ctx context.Context, params GetBooki
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [3]string
-	pathParts[0] = "/booking-links/"
-	{
-		// Encode "id" parameter.
-		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "id",
-			Style:   uri.PathStyleSimple,
-			Explode: false,
-		})
-		if err := func() error {
-			return e.EncodeValue(conv.IntToString(params.ID))
-		}(); err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		encoded, err := e.Result()
-		if err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		pathParts[1] = encoded
-	}
-	pathParts[2] = "/bookings"
+	var pathParts [1]string
+	pathParts[0] = "/auth/login"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
@@ -2249,39 +4756,6 @@ func (c *Client) sendGetBookingLinkBookings(ctx context.Context, params GetBooki
 		return res, errors.Wrap(err, "create request")
 	}
 
-	{
-		type bitset = [1]uint8
-		var satisfied bitset
-		{
-			stage = "Security:CookieAuth"
-			switch err := c.securityCookieAuth(ctx, GetBookingLinkBookingsOperation, r); {
-			case err == nil: // if NO error
-				satisfied[0] |= 1 << 0
-			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
-				// Skip this security.
-			default:
-				return res, errors.Wrap(err, "security \"CookieAuth\"")
-			}
-		}
-
-		if ok := func() bool {
-		nextRequirement:
-			for _, requirement := range []bitset{
-				{0b00000001},
-			} {
-				for i, mask := range requirement {
-					if satisfied[i]&mask != mask {
-						continue nextRequirement
-					}
-				}
-				return true
-			}
-			return false
-		}(); !ok {
-			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
-		}
-	}
-
 	stage = "SendRequest"
 	resp, err := c.cfg.Client.Do(r)
 	if err != nil {
@@ -2290,7 +4764,7 @@ func (c *Client) sendGetBookingLinkBookings(ctx context.Context, params GetBooki
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeGetBookingLinkBookingsResponse(resp)
+	result, err := decodeInitiateLoginResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -2298,21 +4772,21 @@ func (c *Client) sendGetBookingLinkBookings(ctx context.Context, params GetBooki
 	return result, nil
 }
 
-// GetCurrentUser invokes getCurrentUser operation.
+// ListBookingLinks invokes listBookingLinks operation.
 //
-// Get current user.
+// List all booking links.
 //
-// GET /auth/me
-func (c *Client) GetCurrentUser(ctx context.Context) (GetCurrentUserRes, error) {
-	res, err := c.sendGetCurrentUser(ctx)
+// GET /booking-links
+func (c *Client) ListBookingLinks(ctx context.Context) ([]BookingLink, error) {
+	res, err := c.sendListBookingLinks(ctx)
 	return res, err
 }
 
-func (c *Client) sendGetCurrentUser(ctx context.Context) (res GetCurrentUserRes, err error) {
+func (c *Client) sendListBookingLinks(ctx context.Context) (res []BookingLink, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getCurrentUser"),
+		otelogen.OperationID("listBookingLinks"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.URLTemplateKey.String("/auth/me"),
+		semconv.URLTemplateKey.String("/booking-links"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -2328,7 +4802,7 @@ func (c *Client) sendGetCurrentUser(ctx context.Context) (res GetCurrentUserRes,
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, GetCurrentUserOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, ListBookingLinksOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -2346,7 +4820,7 @@ func (c *Client) sendGetCurrentUser(ctx context.Context) (res GetCurrentUserRes,
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/auth/me"
+	pathParts[0] = "/booking-links"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
@@ -2360,7 +4834,7 @@ func (c *Client) sendGetCurrentUser(ctx context.Context) (res GetCurrentUserRes,
 		var satisfied bitset
 		{
 			stage = "Security:CookieAuth"
-			switch err := c.securityCookieAuth(ctx, GetCurrentUserOperation, r); {
+			switch err := c.securityCookieAuth(ctx, ListBookingLinksOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -2396,7 +4870,7 @@ func (c *Client) sendGetCurrentUser(ctx context.Context) (res GetCurrentUserRes,
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeGetCurrentUserResponse(resp)
+	result, err := decodeListBookingLinksResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -2404,21 +4878,21 @@ func (c *Client) sendGetCurrentUser(ctx context.Context) (res GetCurrentUserRes,
 	return result, nil
 }
 
-// GetPoll invokes getPoll operation.
+// ListCalendars invokes listCalendars operation.
 //
-// Get poll details.
+// List calendar connections.
 //
-// GET /polls/{id}
-func (c *Client) GetPoll(ctx context.Context, params GetPollParams) (*Poll, error) {
-	res, err := c.sendGetPoll(ctx, params)
+// GET /calendars
+func (c *Client) ListCalendars(ctx context.Context) ([]CalendarConnection, error) {
+	res, err := c.sendListCalendars(ctx)
 	return res, err
 }
 
-func (c *Client) sendGetPoll(ctx context.Context, params GetPollParams) (res *Poll, err error) {
+func (c *Client) sendListCalendars(ctx context.Context) (res []CalendarConnection, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getPoll"),
+		otelogen.OperationID("listCalendars"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.URLTemplateKey.String("/polls/{id}"),
+		semconv.URLTemplateKey.String("/calendars"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -2434,7 +4908,7 @@ func (c *Client) sendGetPoll(ctx context.Context, params GetPollParams) (res *Po
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, GetPollOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, ListCalendarsOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -2451,26 +4925,8 @@ func (c *Client) sendGetPoll(ctx context.Context, params GetPollParams) (res *Po
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [2]string
-	pathParts[0] = "/polls/"
-	{
-		// Encode "id" parameter.
-		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "id",
-			Style:   uri.PathStyleSimple,
-			Explode: false,
-		})
-		if err := func() error {
-			return e.EncodeValue(conv.IntToString(params.ID))
-		}(); err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		encoded, err := e.Result()
-		if err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		pathParts[1] = encoded
-	}
+	var pathParts [1]string
+	pathParts[0] = "/calendars"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
@@ -2484,7 +4940,7 @@ func (c *Client) sendGetPoll(ctx context.Context, params GetPollParams) (res *Po
 		var satisfied bitset
 		{
 			stage = "Security:CookieAuth"
-			switch err := c.securityCookieAuth(ctx, GetPollOperation, r); {
+			switch err := c.securityCookieAuth(ctx, ListCalendarsOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -2520,7 +4976,7 @@ func (c *Client) sendGetPoll(ctx context.Context, params GetPollParams) (res *Po
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeGetPollResponse(resp)
+	result, err := decodeListCalendarsResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -2528,21 +4984,21 @@ func (c *Client) sendGetPoll(ctx context.Context, params GetPollParams) (res *Po
 	return result, nil
 }
 
-// GetPollOptions invokes getPollOptions operation.
+// ListMeetingProviders invokes listMeetingProviders operation.
 //
-// Get options for a poll.
+// List enabled meeting link providers.
 //
-// GET /polls/{id}/options
-func (c *Client) GetPollOptions(ctx context.Context, params GetPollOptionsParams) ([]PollOption, error) {
-	res, err := c.sendGetPollOptions(ctx, params)
+// GET /meeting-providers
+func (c *Client) ListMeetingProviders(ctx context.Context) ([]string, error) {
+	res, err := c.sendListMeetingProviders(ctx)
 	return res, err
 }
 
-func (c *Client) sendGetPollOptions(ctx context.Context, params GetPollOptionsParams) (res []PollOption, err error) {
+func (c *Client) sendListMeetingProviders(ctx context.Context) (res []string, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getPollOptions"),
+		otelogen.OperationID("listMeetingProviders"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.URLTemplateKey.String("/polls/{id}/options"),
+		semconv.URLTemplateKey.String("/meeting-providers"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -2558,7 +5014,7 @@ func (c *Client) sendGetPollOptions(ctx context.Context, params GetPollOptionsPa
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, GetPollOptionsOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, ListMeetingProvidersOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -2575,27 +5031,8 @@ func (c *Client) sendGetPollOptions(ctx context.Context, params GetPollOptionsPa
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [3]string
-	pathParts[0] = "/polls/"
-	{
-		// Encode "id" parameter.
-		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "id",
-			Style:   uri.PathStyleSimple,
-			Explode: false,
-		})
-		if err := func() error {
-			return e.EncodeValue(conv.IntToString(params.ID))
-		}(); err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		encoded, err := e.Result()
-		if err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		pathParts[1] = encoded
-	}
-	pathParts[2] = "/options"
+	var pathParts [1]string
+	pathParts[0] = "/meeting-providers"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
@@ -2609,7 +5046,7 @@ func (c *Client) sendGetPollOptions(ctx context.Context, params GetPollOptionsPa
 		var satisfied bitset
 		{
 			stage = "Security:CookieAuth"
-			switch err := c.securityCookieAuth(ctx, GetPollOptionsOperation, r); {
+			switch err := c.securityCookieAuth(ctx, ListMeetingProvidersOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -2645,7 +5082,7 @@ func (c *Client) sendGetPollOptions(ctx context.Context, params GetPollOptionsPa
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeGetPollOptionsResponse(resp)
+	result, err := decodeListMeetingProvidersResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -2653,21 +5090,21 @@ func (c *Client) sendGetPollOptions(ctx context.Context, params GetPollOptionsPa
 	return result, nil
 }
 
-// GetPollResults invokes getPollResults operation.
+// ListPollComments invokes listPollComments operation.
 //
-// Get poll results.
+// List all comments of a poll, including hidden ones.
 //
-// GET /p/poll/{slug}/results
-func (c *Client) GetPollResults(ctx context.Context, params GetPollResultsParams) (GetPollResultsRes, error) {
-	res, err := c.sendGetPollResults(ctx, params)
+// GET /polls/{id}/comments
+func (c *Client) ListPollComments(ctx context.Context, params ListPollCommentsParams) ([]PollComment, error) {
+	res, err := c.sendListPollComments(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendGetPollResults(ctx context.Context, params GetPollResultsParams) (res GetPollResultsRes, err error) {
+func (c *Client) sendListPollComments(ctx context.Context, params ListPollCommentsParams) (res []PollComment, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getPollResults"),
+		otelogen.OperationID("listPollComments"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.URLTemplateKey.String("/p/poll/{slug}/results"),
+		semconv.URLTemplateKey.String("/polls/{id}/comments"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -2683,7 +5120,7 @@ func (c *Client) sendGetPollResults(ctx context.Context, params GetPollResultsPa
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, GetPollResultsOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, ListPollCommentsOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -2701,16 +5138,16 @@ func (c *Client) sendGetPollResults(ctx context.Context, params GetPollResultsPa
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [3]string
-	pathParts[0] = "/p/poll/"
+	pathParts[0] = "/polls/"
 	{
-		// Encode "slug" parameter.
+		// Encode "id" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "slug",
+			Param:   "id",
 			Style:   uri.PathStyleSimple,
 			Explode: false,
 		})
 		if err := func() error {
-			return e.EncodeValue(conv.StringToString(params.Slug))
+			return e.EncodeValue(conv.IntToString(params.ID))
 		}(); err != nil {
 			return res, errors.Wrap(err, "encode path")
 		}
@@ -2720,7 +5157,7 @@ func (c *Client) sendGetPollResults(ctx context.Context, params GetPollResultsPa
 		}
 		pathParts[1] = encoded
 	}
-	pathParts[2] = "/results"
+	pathParts[2] = "/comments"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
@@ -2729,6 +5166,39 @@ func (c *Client) sendGetPollResults(ctx context.Context, params GetPollResultsPa
 		return res, errors.Wrap(err, "create request")
 	}
 
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:CookieAuth"
+			switch err := c.securityCookieAuth(ctx, ListPollCommentsOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"CookieAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
 	stage = "SendRequest"
 	resp, err := c.cfg.Client.Do(r)
 	if err != nil {
@@ -2737,7 +5207,7 @@ func (c *Client) sendGetPollResults(ctx context.Context, params GetPollResultsPa
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeGetPollResultsResponse(resp)
+	result, err := decodeListPollCommentsResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -2745,21 +5215,21 @@ func (c *Client) sendGetPollResults(ctx context.Context, params GetPollResultsPa
 	return result, nil
 }
 
-// GetPollVotes invokes getPollVotes operation.
+// ListPollInvites invokes listPollInvites operation.
 //
-// Get votes for a poll.
+// List invitees of a poll and whether they voted.
 //
-// GET /polls/{id}/votes
-func (c *Client) GetPollVotes(ctx context.Context, params GetPollVotesParams) ([]Vote, error) {
-	res, err := c.sendGetPollVotes(ctx, params)
+// GET /polls/{id}/invites
+func (c *Client) ListPollInvites(ctx context.Context, params ListPollInvitesParams) ([]PollInvite, error) {
+	res, err := c.sendListPollInvites(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendGetPollVotes(ctx context.Context, params GetPollVotesParams) (res []Vote, err error) {
+func (c *Client) sendListPollInvites(ctx context.Context, params ListPollInvitesParams) (res []PollInvite, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getPollVotes"),
+		otelogen.OperationID("listPollInvites"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.URLTemplateKey.String("/polls/{id}/votes"),
+		semconv.URLTemplateKey.String("/polls/{id}/invites"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -2775,7 +5245,7 @@ func (c *Client) sendGetPollVotes(ctx context.Context, params GetPollVotesParams
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, GetPollVotesOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, ListPollInvitesOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -2812,7 +5282,7 @@ func (c *Client) sendGetPollVotes(ctx context.Context, params GetPollVotesParams
 		}
 		pathParts[1] = encoded
 	}
-	pathParts[2] = "/votes"
+	pathParts[2] = "/invites"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
@@ -2826,7 +5296,7 @@ func (c *Client) sendGetPollVotes(ctx context.Context, params GetPollVotesParams
 		var satisfied bitset
 		{
 			stage = "Security:CookieAuth"
-			switch err := c.securityCookieAuth(ctx, GetPollVotesOperation, r); {
+			switch err := c.securityCookieAuth(ctx, ListPollInvitesOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -2862,7 +5332,7 @@ func (c *Client) sendGetPollVotes(ctx context.Context, params GetPollVotesParams
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeGetPollVotesResponse(resp)
+	result, err := decodeListPollInvitesResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -2870,21 +5340,21 @@ func (c *Client) sendGetPollVotes(ctx context.Context, params GetPollVotesParams
 	return result, nil
 }
 
-// GetPublicBookingLink invokes getPublicBookingLink operation.
+// ListPollProposals invokes listPollProposals operation.
 //
-// Get public booking link info.
+// List the option proposals waiting for approval.
 //
-// GET /p/booking/{slug}
-func (c *Client) GetPublicBookingLink(ctx context.Context, params GetPublicBookingLinkParams) (GetPublicBookingLinkRes, error) {
-	res, err := c.sendGetPublicBookingLink(ctx, params)
+// GET /polls/{id}/proposals
+func (c *Client) ListPollProposals(ctx context.Context, params ListPollProposalsParams) ([]PollProposal, error) {
+	res, err := c.sendListPollProposals(ctx, params)
 	return res, err
 }
 
-func (c *Client) sendGetPublicBookingLink(ctx context.Context, params GetPublicBookingLinkParams) (res GetPublicBookingLinkRes, err error) {
+func (c *Client) sendListPollProposals(ctx context.Context, params ListPollProposalsParams) (res []PollProposal, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getPublicBookingLink"),
+		otelogen.OperationID("listPollProposals"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.URLTemplateKey.String("/p/booking/{slug}"),
+		semconv.URLTemplateKey.String("/polls/{id}/proposals"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -2900,7 +5370,7 @@ func (c *Client) sendGetPublicBookingLink(ctx context.Context, params GetPublicB
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, GetPublicBookingLinkOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, ListPollProposalsOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -2917,17 +5387,17 @@ func (c *Client) sendGetPublicBookingLink(ctx context.Context, params GetPublicB
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [2]string
-	pathParts[0] = "/p/booking/"
+	var pathParts [3]string
+	pathParts[0] = "/polls/"
 	{
-		// Encode "slug" parameter.
+		// Encode "id" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "slug",
+			Param:   "id",
 			Style:   uri.PathStyleSimple,
 			Explode: false,
 		})
 		if err := func() error {
-			return e.EncodeValue(conv.StringToString(params.Slug))
+			return e.EncodeValue(conv.IntToString(params.ID))
 		}(); err != nil {
 			return res, errors.Wrap(err, "encode path")
 		}
@@ -2937,6 +5407,7 @@ func (c *Client) sendGetPublicBookingLink(ctx context.Context, params GetPublicB
 		}
 		pathParts[1] = encoded
 	}
+	pathParts[2] = "/proposals"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
@@ -2945,6 +5416,39 @@ func (c *Client) sendGetPublicBookingLink(ctx context.Context, params GetPublicB
 		return res, errors.Wrap(err, "create request")
 	}
 
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:CookieAuth"
+			switch err := c.securityCookieAuth(ctx, ListPollProposalsOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"CookieAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
 	stage = "SendRequest"
 	resp, err := c.cfg.Client.Do(r)
 	if err != nil {
@@ -2953,7 +5457,7 @@ func (c *Client) sendGetPublicBookingLink(ctx context.Context, params GetPublicB
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeGetPublicBookingLinkResponse(resp)
+	result, err := decodeListPollProposalsResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -2961,21 +5465,21 @@ func (c *Client) sendGetPublicBookingLink(ctx context.Context, params GetPublicB
 	return result, nil
 }
 
-// GetPublicPoll invokes getPublicPoll operation.
+// ListPolls invokes listPolls operation.
 //
-// Get public poll info.
+// List all polls.
 //
-// GET /p/poll/{slug}
-func (c *Client) GetPublicPoll(ctx context.Context, params GetPublicPollParams) (GetPublicPollRes, error) {
-	res, err := c.sendGetPublicPoll(ctx, params)
+// GET /polls
+func (c *Client) ListPolls(ctx context.Context) ([]Poll, error) {
+	res, err := c.sendListPolls(ctx)
 	return res, err
 }
 
-func (c *Client) sendGetPublicPoll(ctx context.Context, params GetPublicPollParams) (res GetPublicPollRes, err error) {
+func (c *Client) sendListPolls(ctx context.Context) (res []Poll, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getPublicPoll"),
+		otelogen.OperationID("listPolls"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.URLTemplateKey.String("/p/poll/{slug}"),
+		semconv.URLTemplateKey.String("/polls"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -2991,7 +5495,7 @@ func (c *Client) sendGetPublicPoll(ctx context.Context, params GetPublicPollPara
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, GetPublicPollOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, ListPollsOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -3008,26 +5512,8 @@ func (c *Client) sendGetPublicPoll(ctx context.Context, params GetPublicPollPara
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [2]string
-	pathParts[0] = "/p/poll/"
-	{
-		// Encode "slug" parameter.
-		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "slug",
-			Style:   uri.PathStyleSimple,
-			Explode: false,
-		})
-		if err := func() error {
-			return e.EncodeValue(conv.StringToString(params.Slug))
-		}(); err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		encoded, err := e.Result()
-		if err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		pathParts[1] = encoded
-	}
+	var pathParts [1]string
+	pathParts[0] = "/polls"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
@@ -3036,6 +5522,39 @@ func (c *Client) sendGetPublicPoll(ctx context.Context, params GetPublicPollPara
 		return res, errors.Wrap(err, "create request")
 	}
 
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:CookieAuth"
+			switch err := c.securityCookieAuth(ctx, ListPollsOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"CookieAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
 	stage = "SendRequest"
 	resp, err := c.cfg.Client.Do(r)
 	if err != nil {
@@ -3044,7 +5563,7 @@ func (c *Client) sendGetPublicPoll(ctx context.Context, params GetPublicPollPara
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeGetPublicPollResponse(resp)
+	result, err := decodeListPollsResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -3052,21 +5571,21 @@ func (c *Client) sendGetPublicPoll(ctx context.Context, params GetPublicPollPara
 	return result, nil
 }
 
-// InitiateLogin invokes initiateLogin operation.
+// Logout invokes logout operation.
 //
-// Redirect to OIDC provider.
+// Clear session.
 //
-// GET /auth/login
-func (c *Client) InitiateLogin(ctx context.Context) (*InitiateLoginFound, error) {
-	res, err := c.sendInitiateLogin(ctx)
-	return res, err
+// POST /auth/logout
+func (c *Client) Logout(ctx context.Context) error {
+	_, err := c.sendLogout(ctx)
+	return err
 }
 
-func (c *Client) sendInitiateLogin(ctx context.Context) (res *InitiateLoginFound, err error) {
+func (c *Client) sendLogout(ctx context.Context) (res *LogoutOK, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("initiateLogin"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.URLTemplateKey.String("/auth/login"),
+		otelogen.OperationID("logout"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/auth/logout"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -3082,7 +5601,7 @@ func (c *Client) sendInitiateLogin(ctx context.Context) (res *InitiateLoginFound
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, InitiateLoginOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, LogoutOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -3100,15 +5619,48 @@ func (c *Client) sendInitiateLogin(ctx context.Context) (res *InitiateLoginFound
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [1]string
-	pathParts[0] = "/auth/login"
+	pathParts[0] = "/auth/logout"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "GET", u)
+	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
 
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:CookieAuth"
+			switch err := c.securityCookieAuth(ctx, LogoutOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"CookieAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
 	stage = "SendRequest"
 	resp, err := c.cfg.Client.Do(r)
 	if err != nil {
@@ -3117,7 +5669,7 @@ func (c *Client) sendInitiateLogin(ctx context.Context) (res *InitiateLoginFound
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeInitiateLoginResponse(resp)
+	result, err := decodeLogoutResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -3125,21 +5677,21 @@ func (c *Client) sendInitiateLogin(ctx context.Context) (res *InitiateLoginFound
 	return result, nil
 }
 
-// ListBookingLinks invokes listBookingLinks operation.
+// ModeratePollComment invokes moderatePollComment operation.
 //
-// List all booking links.
+// Hide or show a comment.
 //
-// GET /booking-links
-func (c *Client) ListBookingLinks(ctx context.Context) ([]BookingLink, error) {
-	res, err := c.sendListBookingLinks(ctx)
+// PUT /polls/{id}/comments/{commentId}
+func (c *Client) ModeratePollComment(ctx context.Context, request *ModeratePollCommentReq, params ModeratePollCommentParams) (*PollComment, error) {
+	res, err := c.sendModeratePollComment(ctx, request, params)
 	return res, err
 }
 
-func (c *Client) sendListBookingLinks(ctx context.Context) (res []BookingLink, err error) {
+func (c *Client) sendModeratePollComment(ctx context.Context, request *ModeratePollCommentReq, params ModeratePollCommentParams) (res *PollComment, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("listBookingLinks"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.URLTemplateKey.String("/booking-links"),
+		otelogen.OperationID("moderatePollComment"),
+		semconv.HTTPRequestMethodKey.String("PUT"),
+		semconv.URLTemplateKey.String("/polls/{id}/comments/{commentId}"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -3155,7 +5707,7 @@ func (c *Client) sendListBookingLinks(ctx context.Context) (res []BookingLink, e
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, ListBookingLinksOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, ModeratePollCommentOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -3167,27 +5719,67 @@ func (c *Client) sendListBookingLinks(ctx context.Context) (res []BookingLink, e
 			span.SetStatus(codes.Error, stage)
 			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 		}
-		span.End()
-	}()
-
-	stage = "BuildURL"
-	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/booking-links"
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [4]string
+	pathParts[0] = "/polls/"
+	{
+		// Encode "id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.IntToString(params.ID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/comments/"
+	{
+		// Encode "commentId" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "commentId",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.IntToString(params.CommentId))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[3] = encoded
+	}
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "GET", u)
+	r, err := ht.NewRequest(ctx, "PUT", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
+	if err := encodeModeratePollCommentRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
 
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 			stage = "Security:CookieAuth"
-			switch err := c.securityCookieAuth(ctx, ListBookingLinksOperation, r); {
+			switch err := c.securityCookieAuth(ctx, ModeratePollCommentOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -3223,7 +5815,7 @@ func (c *Client) sendListBookingLinks(ctx context.Context) (res []BookingLink, e
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeListBookingLinksResponse(resp)
+	result, err := decodeModeratePollCommentResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -3231,21 +5823,21 @@ func (c *Client) sendListBookingLinks(ctx context.Context) (res []BookingLink, e
 	return result, nil
 }
 
-// ListCalendars invokes listCalendars operation.
+// PickPollWinner invokes pickPollWinner operation.
 //
-// List calendar connections.
+// Pick winning option for poll.
 //
-// GET /calendars
-func (c *Client) ListCalendars(ctx context.Context) ([]CalendarConnection, error) {
-	res, err := c.sendListCalendars(ctx)
+// POST /polls/{id}/pick-winner
+func (c *Client) PickPollWinner(ctx context.Context, request *PickPollWinnerReq, params PickPollWinnerParams) (PickPollWinnerRes, error) {
+	res, err := c.sendPickPollWinner(ctx, request, params)
 	return res, err
 }
 
-func (c *Client) sendListCalendars(ctx context.Context) (res []CalendarConnection, err error) {
+func (c *Client) sendPickPollWinner(ctx context.Context, request *PickPollWinnerReq, params PickPollWinnerParams) (res PickPollWinnerRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("listCalendars"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.URLTemplateKey.String("/calendars"),
+		otelogen.OperationID("pickPollWinner"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/polls/{id}/pick-winner"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -3261,7 +5853,7 @@ func (c *Client) sendListCalendars(ctx context.Context) (res []CalendarConnectio
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, ListCalendarsOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, PickPollWinnerOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -3278,22 +5870,44 @@ func (c *Client) sendListCalendars(ctx context.Context) (res []CalendarConnectio
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/calendars"
+	var pathParts [3]string
+	pathParts[0] = "/polls/"
+	{
+		// Encode "id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.IntToString(params.ID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/pick-winner"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "GET", u)
+	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
+	if err := encodePickPollWinnerRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
 
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 			stage = "Security:CookieAuth"
-			switch err := c.securityCookieAuth(ctx, ListCalendarsOperation, r); {
+			switch err := c.securityCookieAuth(ctx, PickPollWinnerOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -3329,7 +5943,7 @@ func (c *Client) sendListCalendars(ctx context.Context) (res []CalendarConnectio
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeListCalendarsResponse(resp)
+	result, err := decodePickPollWinnerResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -3337,21 +5951,22 @@ func (c *Client) sendListCalendars(ctx context.Context) (res []CalendarConnectio
 	return result, nil
 }
 
-// ListPolls invokes listPolls operation.
+// ProposePollOption invokes proposePollOption operation.
 //
-// List all polls.
+// Only accepted if the poll allows proposals. Depending on the poll, the option is added right away
+// or waits for the organizer's approval.
 //
-// GET /polls
-func (c *Client) ListPolls(ctx context.Context) ([]Poll, error) {
-	res, err := c.sendListPolls(ctx)
+// POST /p/poll/{slug}/proposals
+func (c *Client) ProposePollOption(ctx context.Context, request *ProposePollOptionReq, params ProposePollOptionParams) (ProposePollOptionRes, error) {
+	res, err := c.sendProposePollOption(ctx, request, params)
 	return res, err
 }
 
-func (c *Client) sendListPolls(ctx context.Context) (res []Poll, err error) {
+func (c *Client) sendProposePollOption(ctx context.Context, request *ProposePollOptionReq, params ProposePollOptionParams) (res ProposePollOptionRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("listPolls"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.URLTemplateKey.String("/polls"),
+		otelogen.OperationID("proposePollOption"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/p/poll/{slug}/proposals"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -3367,7 +5982,7 @@ func (c *Client) sendListPolls(ctx context.Context) (res []Poll, err error) {
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, ListPollsOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, ProposePollOptionOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -3384,47 +5999,36 @@ func (c *Client) sendListPolls(ctx context.Context) (res []Poll, err error) {
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/polls"
+	var pathParts [3]string
+	pathParts[0] = "/p/poll/"
+	{
+		// Encode "slug" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "slug",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.Slug))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/proposals"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "GET", u)
+	r, err := ht.NewRequest(ctx, "POST", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-
-	{
-		type bitset = [1]uint8
-		var satisfied bitset
-		{
-			stage = "Security:CookieAuth"
-			switch err := c.securityCookieAuth(ctx, ListPollsOperation, r); {
-			case err == nil: // if NO error
-				satisfied[0] |= 1 << 0
-			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
-				// Skip this security.
-			default:
-				return res, errors.Wrap(err, "security \"CookieAuth\"")
-			}
-		}
-
-		if ok := func() bool {
-		nextRequirement:
-			for _, requirement := range []bitset{
-				{0b00000001},
-			} {
-				for i, mask := range requirement {
-					if satisfied[i]&mask != mask {
-						continue nextRequirement
-					}
-				}
-				return true
-			}
-			return false
-		}(); !ok {
-			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
-		}
+	if err := encodeProposePollOptionRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
 	}
 
 	stage = "SendRequest"
@@ -3435,7 +6039,7 @@ func (c *Client) sendListPolls(ctx context.Context) (res []Poll, err error) {
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeListPollsResponse(resp)
+	result, err := decodeProposePollOptionResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -3443,21 +6047,21 @@ func (c *Client) sendListPolls(ctx context.Context) (res []Poll, err error) {
 	return result, nil
 }
 
-// Logout invokes logout operation.
+// RemindPollInvites invokes remindPollInvites operation.
 //
-// Clear session.
+// Send a reminder to all invitees who haven't voted yet.
 //
-// POST /auth/logout
-func (c *Client) Logout(ctx context.Context) error {
-	_, err := c.sendLogout(ctx)
-	return err
+// POST /polls/{id}/invites/remind
+func (c *Client) RemindPollInvites(ctx context.Context, params RemindPollInvitesParams) (*RemindPollInvitesOK, error) {
+	res, err := c.sendRemindPollInvites(ctx, params)
+	return res, err
 }
 
-func (c *Client) sendLogout(ctx context.Context) (res *LogoutOK, err error) {
+func (c *Client) sendRemindPollInvites(ctx context.Context, params RemindPollInvitesParams) (res *RemindPollInvitesOK, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("logout"),
+		otelogen.OperationID("remindPollInvites"),
 		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.URLTemplateKey.String("/auth/logout"),
+		semconv.URLTemplateKey.String("/polls/{id}/invites/remind"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -3473,7 +6077,7 @@ func (c *Client) sendLogout(ctx context.Context) (res *LogoutOK, err error) {
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, LogoutOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, RemindPollInvitesOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -3490,8 +6094,27 @@ func (c *Client) sendLogout(ctx context.Context) (res *LogoutOK, err error) {
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [1]string
-	pathParts[0] = "/auth/logout"
+	var pathParts [3]string
+	pathParts[0] = "/polls/"
+	{
+		// Encode "id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.IntToString(params.ID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/invites/remind"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
@@ -3505,7 +6128,7 @@ func (c *Client) sendLogout(ctx context.Context) (res *LogoutOK, err error) {
 		var satisfied bitset
 		{
 			stage = "Security:CookieAuth"
-			switch err := c.securityCookieAuth(ctx, LogoutOperation, r); {
+			switch err := c.securityCookieAuth(ctx, RemindPollInvitesOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -3541,7 +6164,7 @@ func (c *Client) sendLogout(ctx context.Context) (res *LogoutOK, err error) {
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeLogoutResponse(resp)
+	result, err := decodeRemindPollInvitesResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -3549,21 +6172,21 @@ func (c *Client) sendLogout(ctx context.Context) (res *LogoutOK, err error) {
 	return result, nil
 }
 
-// PickPollWinner invokes pickPollWinner operation.
+// RemoveCalendar invokes removeCalendar operation.
 //
-// Pick winning option for poll.
+// Remove calendar connection.
 //
-// POST /polls/{id}/pick-winner
-func (c *Client) PickPollWinner(ctx context.Context, request *PickPollWinnerReq, params PickPollWinnerParams) error {
-	_, err := c.sendPickPollWinner(ctx, request, params)
+// DELETE /calendars/{id}
+func (c *Client) RemoveCalendar(ctx context.Context, params RemoveCalendarParams) error {
+	_, err := c.sendRemoveCalendar(ctx, params)
 	return err
 }
 
-func (c *Client) sendPickPollWinner(ctx context.Context, request *PickPollWinnerReq, params PickPollWinnerParams) (res *PickPollWinnerOK, err error) {
+func (c *Client) sendRemoveCalendar(ctx context.Context, params RemoveCalendarParams) (res *RemoveCalendarNoContent, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("pickPollWinner"),
-		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.URLTemplateKey.String("/polls/{id}/pick-winner"),
+		otelogen.OperationID("removeCalendar"),
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.URLTemplateKey.String("/calendars/{id}"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -3579,7 +6202,7 @@ func (c *Client) sendPickPollWinner(ctx context.Context, request *PickPollWinner
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, PickPollWinnerOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, RemoveCalendarOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -3596,8 +6219,8 @@ func (c *Client) sendPickPollWinner(ctx context.Context, request *PickPollWinner
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [3]string
-	pathParts[0] = "/polls/"
+	var pathParts [2]string
+	pathParts[0] = "/calendars/"
 	{
 		// Encode "id" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
@@ -3616,24 +6239,20 @@ func (c *Client) sendPickPollWinner(ctx context.Context, request *PickPollWinner
 		}
 		pathParts[1] = encoded
 	}
-	pathParts[2] = "/pick-winner"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "POST", u)
+	r, err := ht.NewRequest(ctx, "DELETE", u)
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodePickPollWinnerRequest(request, r); err != nil {
-		return res, errors.Wrap(err, "encode request")
-	}
 
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
 			stage = "Security:CookieAuth"
-			switch err := c.securityCookieAuth(ctx, PickPollWinnerOperation, r); {
+			switch err := c.securityCookieAuth(ctx, RemoveCalendarOperation, r); {
 			case err == nil: // if NO error
 				satisfied[0] |= 1 << 0
 			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
@@ -3669,7 +6288,7 @@ func (c *Client) sendPickPollWinner(ctx context.Context, request *PickPollWinner
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodePickPollWinnerResponse(resp)
+	result, err := decodeRemoveCalendarResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -3677,21 +6296,21 @@ func (c *Client) sendPickPollWinner(ctx context.Context, request *PickPollWinner
 	return result, nil
 }
 
-// RemoveCalendar invokes removeCalendar operation.
+// SubmitVote invokes submitVote operation.
 //
-// Remove calendar connection.
+// Submit poll vote.
 //
-// DELETE /calendars/{id}
-func (c *Client) RemoveCalendar(ctx context.Context, params RemoveCalendarParams) error {
-	_, err := c.sendRemoveCalendar(ctx, params)
-	return err
+// POST /p/poll/{slug}/vote
+func (c *Client) SubmitVote(ctx context.Context, request *SubmitVoteReq, params SubmitVoteParams) (SubmitVoteRes, error) {
+	res, err := c.sendSubmitVote(ctx, request, params)
+	return res, err
 }
 
-func (c *Client) sendRemoveCalendar(ctx context.Context, params RemoveCalendarParams) (res *RemoveCalendarNoContent, err error) {
+func (c *Client) sendSubmitVote(ctx context.Context, request *SubmitVoteReq, params SubmitVoteParams) (res SubmitVoteRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("removeCalendar"),
-		semconv.HTTPRequestMethodKey.String("DELETE"),
-		semconv.URLTemplateKey.String("/calendars/{id}"),
+		otelogen.OperationID("submitVote"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.URLTemplateKey.String("/p/poll/{slug}/vote"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -3707,7 +6326,7 @@ func (c *Client) sendRemoveCalendar(ctx context.Context, params RemoveCalendarPa
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, RemoveCalendarOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, SubmitVoteOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -3724,65 +6343,36 @@ func (c *Client) sendRemoveCalendar(ctx context.Context, params RemoveCalendarPa
 
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
-	var pathParts [2]string
-	pathParts[0] = "/calendars/"
+	var pathParts [3]string
+	pathParts[0] = "/p/poll/"
 	{
-		// Encode "id" parameter.
+		// Encode "slug" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "id",
+			Param:   "slug",
 			Style:   uri.PathStyleSimple,
 			Explode: false,
 		})
 		if err := func() error {
-			return e.EncodeValue(conv.IntToString(params.ID))
+			return e.EncodeValue(conv.StringToString(params.Slug))
 		}(); err != nil {
 			return res, errors.Wrap(err, "encode path")
 		}
 		encoded, err := e.Result()
-		if err != nil {
-			return res, errors.Wrap(err, "encode path")
-		}
-		pathParts[1] = encoded
-	}
-	uri.AddPathParts(u, pathParts[:]...)
-
-	stage = "EncodeRequest"
-	r, err := ht.NewRequest(ctx, "DELETE", u)
-	if err != nil {
-		return res, errors.Wrap(err, "create request")
-	}
-
-	{
-		type bitset = [1]uint8
-		var satisfied bitset
-		{
-			stage = "Security:CookieAuth"
-			switch err := c.securityCookieAuth(ctx, RemoveCalendarOperation, r); {
-			case err == nil: // if NO error
-				satisfied[0] |= 1 << 0
-			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
-				// Skip this security.
-			default:
-				return res, errors.Wrap(err, "security \"CookieAuth\"")
-			}
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
 		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/vote"
+	uri.AddPathParts(u, pathParts[:]...)
 
-		if ok := func() bool {
-		nextRequirement:
-			for _, requirement := range []bitset{
-				{0b00000001},
-			} {
-				for i, mask := range requirement {
-					if satisfied[i]&mask != mask {
-						continue nextRequirement
-					}
-				}
-				return true
-			}
-			return false
-		}(); !ok {
-			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
-		}
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeSubmitVoteRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
 	}
 
 	stage = "SendRequest"
@@ -3793,7 +6383,7 @@ func (c *Client) sendRemoveCalendar(ctx context.Context, params RemoveCalendarPa
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeRemoveCalendarResponse(resp)
+	result, err := decodeSubmitVoteResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -3801,21 +6391,22 @@ func (c *Client) sendRemoveCalendar(ctx context.Context, params RemoveCalendarPa
 	return result, nil
 }
 
-// SubmitVote invokes submitVote operation.
+// SuggestPollOptions invokes suggestPollOptions operation.
 //
-// Submit poll vote.
+// Runs the booking availability logic for the given range: slots within the availability rules that
+// don't overlap busy times in the connected calendars. Times the poll already offers are skipped.
 //
-// POST /p/poll/{slug}/vote
-func (c *Client) SubmitVote(ctx context.Context, request *SubmitVoteReq, params SubmitVoteParams) (*Vote, error) {
-	res, err := c.sendSubmitVote(ctx, request, params)
+// POST /polls/{id}/options/suggest
+func (c *Client) SuggestPollOptions(ctx context.Context, request *SuggestPollOptionsReq, params SuggestPollOptionsParams) (SuggestPollOptionsRes, error) {
+	res, err := c.sendSuggestPollOptions(ctx, request, params)
 	return res, err
 }
 
-func (c *Client) sendSubmitVote(ctx context.Context, request *SubmitVoteReq, params SubmitVoteParams) (res *Vote, err error) {
+func (c *Client) sendSuggestPollOptions(ctx context.Context, request *SuggestPollOptionsReq, params SuggestPollOptionsParams) (res SuggestPollOptionsRes, err error) {
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("submitVote"),
+		otelogen.OperationID("suggestPollOptions"),
 		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.URLTemplateKey.String("/p/poll/{slug}/vote"),
+		semconv.URLTemplateKey.String("/polls/{id}/options/suggest"),
 	}
 	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
 
@@ -3831,7 +6422,7 @@ func (c *Client) sendSubmitVote(ctx context.Context, request *SubmitVoteReq, par
 	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
 
 	// Start a span for this request.
-	ctx, span := c.cfg.Tracer.Start(ctx, SubmitVoteOperation,
+	ctx, span := c.cfg.Tracer.Start(ctx, SuggestPollOptionsOperation,
 		trace.WithAttributes(otelAttrs...),
 		clientSpanKind,
 	)
@@ -3849,16 +6440,16 @@ func (c *Client) sendSubmitVote(ctx context.Context, request *SubmitVoteReq, par
 	stage = "BuildURL"
 	u := uri.Clone(c.requestURL(ctx))
 	var pathParts [3]string
-	pathParts[0] = "/p/poll/"
+	pathParts[0] = "/polls/"
 	{
-		// Encode "slug" parameter.
+		// Encode "id" parameter.
 		e := uri.NewPathEncoder(uri.PathEncoderConfig{
-			Param:   "slug",
+			Param:   "id",
 			Style:   uri.PathStyleSimple,
 			Explode: false,
 		})
 		if err := func() error {
-			return e.EncodeValue(conv.StringToString(params.Slug))
+			return e.EncodeValue(conv.IntToString(params.ID))
 		}(); err != nil {
 			return res, errors.Wrap(err, "encode path")
 		}
@@ -3868,7 +6459,7 @@ func (c *Client) sendSubmitVote(ctx context.Context, request *SubmitVoteReq, par
 		}
 		pathParts[1] = encoded
 	}
-	pathParts[2] = "/vote"
+	pathParts[2] = "/options/suggest"
 	uri.AddPathParts(u, pathParts[:]...)
 
 	stage = "EncodeRequest"
@@ -3876,10 +6467,43 @@ func (c *Client) sendSubmitVote(ctx context.Context, request *SubmitVoteReq, par
 	if err != nil {
 		return res, errors.Wrap(err, "create request")
 	}
-	if err := encodeSubmitVoteRequest(request, r); err != nil {
+	if err := encodeSuggestPollOptionsRequest(request, r); err != nil {
 		return res, errors.Wrap(err, "encode request")
 	}
 
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			stage = "Security:CookieAuth"
+			switch err := c.securityCookieAuth(ctx, SuggestPollOptionsOperation, r); {
+			case err == nil: // if NO error
+				satisfied[0] |= 1 << 0
+			case errors.Is(err, ogenerrors.ErrSkipClientSecurity):
+				// Skip this security.
+			default:
+				return res, errors.Wrap(err, "security \"CookieAuth\"")
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			return res, ogenerrors.ErrSecurityRequirementIsNotSatisfied
+		}
+	}
+
 	stage = "SendRequest"
 	resp, err := c.cfg.Client.Do(r)
 	if err != nil {
@@ -3888,7 +6512,7 @@ func (c *Client) sendSubmitVote(ctx context.Context, request *SubmitVoteReq, par
 	defer resp.Body.Close()
 
 	stage = "DecodeResponse"
-	result, err := decodeSubmitVoteResponse(resp)
+	result, err := decodeSuggestPollOptionsResponse(resp)
 	if err != nil {
 		return res, errors.Wrap(err, "decode response")
 	}
@@ -4026,12 +6650,12 @@ func (c *Client) sendTestCalendar(ctx context.Context, params TestCalendarParams
 // Update a booking link.
 //
 // PUT /booking-links/{id}
-func (c *Client) UpdateBookingLink(ctx context.Context, request *UpdateBookingLinkReq, params UpdateBookingLinkParams) (*BookingLink, error) {
+func (c *Client) UpdateBookingLink(ctx context.Context, request *UpdateBookingLinkReq, params UpdateBookingLinkParams) (UpdateBookingLinkRes, error) {
 	res, err := c.sendUpdateBookingLink(ctx, request, params)
 	return res, err
 }
 
-func (c *Client) sendUpdateBookingLink(ctx context.Context, request *UpdateBookingLinkReq, params UpdateBookingLinkParams) (res *BookingLink, err error) {
+func (c *Client) sendUpdateBookingLink(ctx context.Context, request *UpdateBookingLinkReq, params UpdateBookingLinkParams) (res UpdateBookingLinkRes, err error) {
 	otelAttrs := []attribute.KeyValue{
 		otelogen.OperationID("updateBookingLink"),
 		semconv.HTTPRequestMethodKey.String("PUT"),
@@ -4257,17 +6881,130 @@ func (c *Client) sendUpdateCurrentUser(ctx context.Context, request *UpdateCurre
 	return result, nil
 }
 
+// UpdateOwnVote invokes updateOwnVote operation.
+//
+// Update own vote by edit token.
+//
+// PUT /p/poll/{slug}/vote/{token}
+func (c *Client) UpdateOwnVote(ctx context.Context, request *UpdateOwnVoteReq, params UpdateOwnVoteParams) (UpdateOwnVoteRes, error) {
+	res, err := c.sendUpdateOwnVote(ctx, request, params)
+	return res, err
+}
+
+func (c *Client) sendUpdateOwnVote(ctx context.Context, request *UpdateOwnVoteReq, params UpdateOwnVoteParams) (res UpdateOwnVoteRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("updateOwnVote"),
+		semconv.HTTPRequestMethodKey.String("PUT"),
+		semconv.URLTemplateKey.String("/p/poll/{slug}/vote/{token}"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, UpdateOwnVoteOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [4]string
+	pathParts[0] = "/p/poll/"
+	{
+		// Encode "slug" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "slug",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.Slug))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/vote/"
+	{
+		// Encode "token" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "token",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.Token))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[3] = encoded
+	}
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "PUT", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeUpdateOwnVoteRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeUpdateOwnVoteResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
 // UpdatePoll invokes updatePoll operation.
 //
 // Update a poll.
 //
 // PUT /polls/{id}
-func (c *Client) UpdatePoll(ctx context.Context, request *UpdatePollReq, params UpdatePollParams) (*Poll, error) {
+func (c *Client) UpdatePoll(ctx context.Context, request *UpdatePollReq, params UpdatePollParams) (UpdatePollRes, error) {
 	res, err := c.sendUpdatePoll(ctx, request, params)
 	return res, err
 }
 
-func (c *Client) sendUpdatePoll(ctx context.Context, request *UpdatePollReq, params UpdatePollParams) (res *Poll, err error) {
+func (c *Client) sendUpdatePoll(ctx context.Context, request *UpdatePollReq, params UpdatePollParams) (res UpdatePollRes, err error) {
 	otelAttrs := []attribute.KeyValue{
 		otelogen.OperationID("updatePoll"),
 		semconv.HTTPRequestMethodKey.String("PUT"),
@@ -4383,3 +7120,95 @@ func (c *Client) sendUpdatePoll(ctx context.Context, request *UpdatePollReq, par
 
 	return result, nil
 }
+
+// VerifyBookingEmail invokes verifyBookingEmail operation.
+//
+// Confirms a pending booking that matched an email based auto-confirm rule once the guest proved
+// they own the address.
+//
+// GET /actions/verify-email
+func (c *Client) VerifyBookingEmail(ctx context.Context, params VerifyBookingEmailParams) (VerifyBookingEmailRes, error) {
+	res, err := c.sendVerifyBookingEmail(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendVerifyBookingEmail(ctx context.Context, params VerifyBookingEmailParams) (res VerifyBookingEmailRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("verifyBookingEmail"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.URLTemplateKey.String("/actions/verify-email"),
+	}
+	otelAttrs = append(otelAttrs, c.cfg.Attributes...)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, VerifyBookingEmailOperation,
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/actions/verify-email"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeQueryParams"
+	q := uri.NewQueryEncoder()
+	{
+		// Encode "token" parameter.
+		cfg := uri.QueryParameterEncodingConfig{
+			Name:    "token",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
+			return e.EncodeValue(conv.StringToString(params.Token))
+		}); err != nil {
+			return res, errors.Wrap(err, "encode query")
+		}
+	}
+	u.RawQuery = q.Values().Encode()
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeVerifyBookingEmailResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
@@ -218,22 +218,22 @@ func (s *Server) handleAddCalendarRequest(args [0]string, argsEscaped bool, w ht
 	}
 }
 
-// handleAddPollOptionRequest handles addPollOption operation.
+// handleAddPollCommentRequest handles addPollComment operation.
 //
-// Add an option to a poll.
+// Comment on a poll or one of its options.
 //
-// POST /polls/{id}/options
-func (s *Server) handleAddPollOptionRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /p/poll/{slug}/comments
+func (s *Server) handleAddPollCommentRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("addPollOption"),
+		otelogen.OperationID("addPollComment"),
 		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/polls/{id}/options"),
+		semconv.HTTPRouteKey.String("/p/poll/{slug}/comments"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), AddPollOptionOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), AddPollCommentOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -288,55 +288,11 @@ func (s *Server) handleAddPollOptionRequest(args [1]string, argsEscaped bool, w
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: AddPollOptionOperation,
-			ID:   "addPollOption",
+			Name: AddPollCommentOperation,
+			ID:   "addPollComment",
 		}
 	)
-	{
-		type bitset = [1]uint8
-		var satisfied bitset
-		{
-			sctx, ok, err := s.securityCookieAuth(ctx, AddPollOptionOperation, r)
-			if err != nil {
-				err = &ogenerrors.SecurityError{
-					OperationContext: opErrContext,
-					Security:         "CookieAuth",
-					Err:              err,
-				}
-				defer recordError("Security:CookieAuth", err)
-				s.cfg.ErrorHandler(ctx, w, r, err)
-				return
-			}
-			if ok {
-				satisfied[0] |= 1 << 0
-				ctx = sctx
-			}
-		}
-
-		if ok := func() bool {
-		nextRequirement:
-			for _, requirement := range []bitset{
-				{0b00000001},
-			} {
-				for i, mask := range requirement {
-					if satisfied[i]&mask != mask {
-						continue nextRequirement
-					}
-				}
-				return true
-			}
-			return false
-		}(); !ok {
-			err = &ogenerrors.SecurityError{
-				OperationContext: opErrContext,
-				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
-			}
-			defer recordError("Security", err)
-			s.cfg.ErrorHandler(ctx, w, r, err)
-			return
-		}
-	}
-	params, err := decodeAddPollOptionParams(args, argsEscaped, r)
+	params, err := decodeAddPollCommentParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -348,7 +304,7 @@ func (s *Server) handleAddPollOptionRequest(args [1]string, argsEscaped bool, w
 	}
 
 	var rawBody []byte
-	request, rawBody, close, err := s.decodeAddPollOptionRequest(r)
+	request, rawBody, close, err := s.decodeAddPollCommentRequest(r)
 	if err != nil {
 		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
@@ -364,28 +320,28 @@ func (s *Server) handleAddPollOptionRequest(args [1]string, argsEscaped bool, w
 		}
 	}()
 
-	var response *PollOption
+	var response AddPollCommentRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    AddPollOptionOperation,
-			OperationSummary: "Add an option to a poll",
-			OperationID:      "addPollOption",
+			OperationName:    AddPollCommentOperation,
+			OperationSummary: "Comment on a poll or one of its options",
+			OperationID:      "addPollComment",
 			Body:             request,
 			RawBody:          rawBody,
 			Params: middleware.Parameters{
 				{
-					Name: "id",
+					Name: "slug",
 					In:   "path",
-				}: params.ID,
+				}: params.Slug,
 			},
 			Raw: r,
 		}
 
 		type (
-			Request  = *AddPollOptionReq
-			Params   = AddPollOptionParams
-			Response = *PollOption
+			Request  = *AddPollCommentReq
+			Params   = AddPollCommentParams
+			Response = AddPollCommentRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -394,14 +350,14 @@ func (s *Server) handleAddPollOptionRequest(args [1]string, argsEscaped bool, w
 		](
 			m,
 			mreq,
-			unpackAddPollOptionParams,
+			unpackAddPollCommentParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.AddPollOption(ctx, request, params)
+				response, err = s.h.AddPollComment(ctx, request, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.AddPollOption(ctx, request, params)
+		response, err = s.h.AddPollComment(ctx, request, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -409,7 +365,7 @@ func (s *Server) handleAddPollOptionRequest(args [1]string, argsEscaped bool, w
 		return
 	}
 
-	if err := encodeAddPollOptionResponse(response, w, span); err != nil {
+	if err := encodeAddPollCommentResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -418,22 +374,22 @@ func (s *Server) handleAddPollOptionRequest(args [1]string, argsEscaped bool, w
 	}
 }
 
-// handleApproveBookingRequest handles approveBooking operation.
+// handleAddPollInvitesRequest handles addPollInvites operation.
 //
-// Approve a booking.
+// Invite participants and email them a personal link.
 //
-// POST /bookings/{id}/approve
-func (s *Server) handleApproveBookingRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /polls/{id}/invites
+func (s *Server) handleAddPollInvitesRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("approveBooking"),
+		otelogen.OperationID("addPollInvites"),
 		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/bookings/{id}/approve"),
+		semconv.HTTPRouteKey.String("/polls/{id}/invites"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), ApproveBookingOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), AddPollInvitesOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -488,15 +444,15 @@ func (s *Server) handleApproveBookingRequest(args [1]string, argsEscaped bool, w
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: ApproveBookingOperation,
-			ID:   "approveBooking",
+			Name: AddPollInvitesOperation,
+			ID:   "addPollInvites",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityCookieAuth(ctx, ApproveBookingOperation, r)
+			sctx, ok, err := s.securityCookieAuth(ctx, AddPollInvitesOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -536,7 +492,7 @@ func (s *Server) handleApproveBookingRequest(args [1]string, argsEscaped bool, w
 			return
 		}
 	}
-	params, err := decodeApproveBookingParams(args, argsEscaped, r)
+	params, err := decodeAddPollInvitesParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -548,15 +504,30 @@ func (s *Server) handleApproveBookingRequest(args [1]string, argsEscaped bool, w
 	}
 
 	var rawBody []byte
+	request, rawBody, close, err := s.decodeAddPollInvitesRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
 
-	var response *Booking
+	var response AddPollInvitesRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    ApproveBookingOperation,
-			OperationSummary: "Approve a booking",
-			OperationID:      "approveBooking",
-			Body:             nil,
+			OperationName:    AddPollInvitesOperation,
+			OperationSummary: "Invite participants and email them a personal link",
+			OperationID:      "addPollInvites",
+			Body:             request,
 			RawBody:          rawBody,
 			Params: middleware.Parameters{
 				{
@@ -568,9 +539,9 @@ func (s *Server) handleApproveBookingRequest(args [1]string, argsEscaped bool, w
 		}
 
 		type (
-			Request  = struct{}
-			Params   = ApproveBookingParams
-			Response = *Booking
+			Request  = *AddPollInvitesReq
+			Params   = AddPollInvitesParams
+			Response = AddPollInvitesRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -579,14 +550,14 @@ func (s *Server) handleApproveBookingRequest(args [1]string, argsEscaped bool, w
 		](
 			m,
 			mreq,
-			unpackApproveBookingParams,
+			unpackAddPollInvitesParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.ApproveBooking(ctx, params)
+				response, err = s.h.AddPollInvites(ctx, request, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.ApproveBooking(ctx, params)
+		response, err = s.h.AddPollInvites(ctx, request, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -594,7 +565,7 @@ func (s *Server) handleApproveBookingRequest(args [1]string, argsEscaped bool, w
 		return
 	}
 
-	if err := encodeApproveBookingResponse(response, w, span); err != nil {
+	if err := encodeAddPollInvitesResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -603,22 +574,22 @@ func (s *Server) handleApproveBookingRequest(args [1]string, argsEscaped bool, w
 	}
 }
 
-// handleApproveViaEmailRequest handles approveViaEmail operation.
+// handleAddPollOptionRequest handles addPollOption operation.
 //
-// Approve booking via email link.
+// Add an option to a poll.
 //
-// GET /actions/approve
-func (s *Server) handleApproveViaEmailRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /polls/{id}/options
+func (s *Server) handleAddPollOptionRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("approveViaEmail"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/actions/approve"),
+		otelogen.OperationID("addPollOption"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/polls/{id}/options"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), ApproveViaEmailOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), AddPollOptionOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -673,22 +644,22 @@ func (s *Server) handleApproveViaEmailRequest(args [0]string, argsEscaped bool,
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: ApproveViaEmailOperation,
-			ID:   "approveViaEmail",
+			Name: AddPollOptionOperation,
+			ID:   "addPollOption",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityActionToken(ctx, ApproveViaEmailOperation, r)
+			sctx, ok, err := s.securityCookieAuth(ctx, AddPollOptionOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
-					Security:         "ActionToken",
+					Security:         "CookieAuth",
 					Err:              err,
 				}
-				defer recordError("Security:ActionToken", err)
+				defer recordError("Security:CookieAuth", err)
 				s.cfg.ErrorHandler(ctx, w, r, err)
 				return
 			}
@@ -721,26 +692,56 @@ func (s *Server) handleApproveViaEmailRequest(args [0]string, argsEscaped bool,
 			return
 		}
 	}
+	params, err := decodeAddPollOptionParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
 
 	var rawBody []byte
+	request, rawBody, close, err := s.decodeAddPollOptionRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
 
-	var response ApproveViaEmailRes
+	var response *PollOption
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    ApproveViaEmailOperation,
-			OperationSummary: "Approve booking via email link",
-			OperationID:      "approveViaEmail",
-			Body:             nil,
+			OperationName:    AddPollOptionOperation,
+			OperationSummary: "Add an option to a poll",
+			OperationID:      "addPollOption",
+			Body:             request,
 			RawBody:          rawBody,
-			Params:           middleware.Parameters{},
-			Raw:              r,
+			Params: middleware.Parameters{
+				{
+					Name: "id",
+					In:   "path",
+				}: params.ID,
+			},
+			Raw: r,
 		}
 
 		type (
-			Request  = struct{}
-			Params   = struct{}
-			Response = ApproveViaEmailRes
+			Request  = *AddPollOptionReq
+			Params   = AddPollOptionParams
+			Response = *PollOption
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -749,14 +750,14 @@ func (s *Server) handleApproveViaEmailRequest(args [0]string, argsEscaped bool,
 		](
 			m,
 			mreq,
-			nil,
+			unpackAddPollOptionParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.ApproveViaEmail(ctx)
+				response, err = s.h.AddPollOption(ctx, request, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.ApproveViaEmail(ctx)
+		response, err = s.h.AddPollOption(ctx, request, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -764,7 +765,7 @@ func (s *Server) handleApproveViaEmailRequest(args [0]string, argsEscaped bool,
 		return
 	}
 
-	if err := encodeApproveViaEmailResponse(response, w, span); err != nil {
+	if err := encodeAddPollOptionResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -773,22 +774,22 @@ func (s *Server) handleApproveViaEmailRequest(args [0]string, argsEscaped bool,
 	}
 }
 
-// handleAuthCallbackRequest handles authCallback operation.
+// handleApproveBookingRequest handles approveBooking operation.
 //
-// Handle OIDC callback.
+// Approve a booking.
 //
-// GET /auth/callback
-func (s *Server) handleAuthCallbackRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /bookings/{id}/approve
+func (s *Server) handleApproveBookingRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("authCallback"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/auth/callback"),
+		otelogen.OperationID("approveBooking"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/bookings/{id}/approve"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), AuthCallbackOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), ApproveBookingOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -843,49 +844,89 @@ func (s *Server) handleAuthCallbackRequest(args [0]string, argsEscaped bool, w h
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: AuthCallbackOperation,
-			ID:   "authCallback",
+			Name: ApproveBookingOperation,
+			ID:   "approveBooking",
 		}
 	)
-	params, err := decodeAuthCallbackParams(args, argsEscaped, r)
-	if err != nil {
-		err = &ogenerrors.DecodeParamsError{
-			OperationContext: opErrContext,
-			Err:              err,
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityCookieAuth(ctx, ApproveBookingOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "CookieAuth",
+					Err:              err,
+				}
+				defer recordError("Security:CookieAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
 		}
-		defer recordError("DecodeParams", err)
-		s.cfg.ErrorHandler(ctx, w, r, err)
-		return
-	}
 
-	var rawBody []byte
-
-	var response AuthCallbackRes
-	if m := s.cfg.Middleware; m != nil {
-		mreq := middleware.Request{
-			Context:          ctx,
-			OperationName:    AuthCallbackOperation,
-			OperationSummary: "Handle OIDC callback",
-			OperationID:      "authCallback",
-			Body:             nil,
-			RawBody:          rawBody,
-			Params: middleware.Parameters{
-				{
-					Name: "code",
-					In:   "query",
-				}: params.Code,
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodeApproveBookingParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var rawBody []byte
+
+	var response *Booking
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    ApproveBookingOperation,
+			OperationSummary: "Approve a booking",
+			OperationID:      "approveBooking",
+			Body:             nil,
+			RawBody:          rawBody,
+			Params: middleware.Parameters{
 				{
-					Name: "state",
-					In:   "query",
-				}: params.State,
+					Name: "id",
+					In:   "path",
+				}: params.ID,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = AuthCallbackParams
-			Response = AuthCallbackRes
+			Params   = ApproveBookingParams
+			Response = *Booking
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -894,14 +935,14 @@ func (s *Server) handleAuthCallbackRequest(args [0]string, argsEscaped bool, w h
 		](
 			m,
 			mreq,
-			unpackAuthCallbackParams,
+			unpackApproveBookingParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.AuthCallback(ctx, params)
+				response, err = s.h.ApproveBooking(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.AuthCallback(ctx, params)
+		response, err = s.h.ApproveBooking(ctx, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -909,7 +950,7 @@ func (s *Server) handleAuthCallbackRequest(args [0]string, argsEscaped bool, w h
 		return
 	}
 
-	if err := encodeAuthCallbackResponse(response, w, span); err != nil {
+	if err := encodeApproveBookingResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -918,22 +959,22 @@ func (s *Server) handleAuthCallbackRequest(args [0]string, argsEscaped bool, w h
 	}
 }
 
-// handleCreateBookingRequest handles createBooking operation.
+// handleApprovePollProposalRequest handles approvePollProposal operation.
 //
-// Create a booking.
+// Add a proposal to the options of the poll.
 //
-// POST /p/booking/{slug}/book
-func (s *Server) handleCreateBookingRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /polls/{id}/proposals/{proposalId}/approve
+func (s *Server) handleApprovePollProposalRequest(args [2]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("createBooking"),
+		otelogen.OperationID("approvePollProposal"),
 		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/p/booking/{slug}/book"),
+		semconv.HTTPRouteKey.String("/polls/{id}/proposals/{proposalId}/approve"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), CreateBookingOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), ApprovePollProposalOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -988,11 +1029,55 @@ func (s *Server) handleCreateBookingRequest(args [1]string, argsEscaped bool, w
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: CreateBookingOperation,
-			ID:   "createBooking",
+			Name: ApprovePollProposalOperation,
+			ID:   "approvePollProposal",
 		}
 	)
-	params, err := decodeCreateBookingParams(args, argsEscaped, r)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityCookieAuth(ctx, ApprovePollProposalOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "CookieAuth",
+					Err:              err,
+				}
+				defer recordError("Security:CookieAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodeApprovePollProposalParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -1004,44 +1089,33 @@ func (s *Server) handleCreateBookingRequest(args [1]string, argsEscaped bool, w
 	}
 
 	var rawBody []byte
-	request, rawBody, close, err := s.decodeCreateBookingRequest(r)
-	if err != nil {
-		err = &ogenerrors.DecodeRequestError{
-			OperationContext: opErrContext,
-			Err:              err,
-		}
-		defer recordError("DecodeRequest", err)
-		s.cfg.ErrorHandler(ctx, w, r, err)
-		return
-	}
-	defer func() {
-		if err := close(); err != nil {
-			recordError("CloseRequest", err)
-		}
-	}()
 
-	var response CreateBookingRes
+	var response ApprovePollProposalRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    CreateBookingOperation,
-			OperationSummary: "Create a booking",
-			OperationID:      "createBooking",
-			Body:             request,
+			OperationName:    ApprovePollProposalOperation,
+			OperationSummary: "Add a proposal to the options of the poll",
+			OperationID:      "approvePollProposal",
+			Body:             nil,
 			RawBody:          rawBody,
 			Params: middleware.Parameters{
 				{
-					Name: "slug",
+					Name: "id",
 					In:   "path",
-				}: params.Slug,
+				}: params.ID,
+				{
+					Name: "proposalId",
+					In:   "path",
+				}: params.ProposalId,
 			},
 			Raw: r,
 		}
 
 		type (
-			Request  = *CreateBookingReq
-			Params   = CreateBookingParams
-			Response = CreateBookingRes
+			Request  = struct{}
+			Params   = ApprovePollProposalParams
+			Response = ApprovePollProposalRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -1050,14 +1124,14 @@ func (s *Server) handleCreateBookingRequest(args [1]string, argsEscaped bool, w
 		](
 			m,
 			mreq,
-			unpackCreateBookingParams,
+			unpackApprovePollProposalParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.CreateBooking(ctx, request, params)
+				response, err = s.h.ApprovePollProposal(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.CreateBooking(ctx, request, params)
+		response, err = s.h.ApprovePollProposal(ctx, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -1065,7 +1139,7 @@ func (s *Server) handleCreateBookingRequest(args [1]string, argsEscaped bool, w
 		return
 	}
 
-	if err := encodeCreateBookingResponse(response, w, span); err != nil {
+	if err := encodeApprovePollProposalResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -1074,22 +1148,22 @@ func (s *Server) handleCreateBookingRequest(args [1]string, argsEscaped bool, w
 	}
 }
 
-// handleCreateBookingLinkRequest handles createBookingLink operation.
+// handleApproveViaEmailRequest handles approveViaEmail operation.
 //
-// Create a booking link.
+// Approve booking via email link.
 //
-// POST /booking-links
-func (s *Server) handleCreateBookingLinkRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /actions/approve
+func (s *Server) handleApproveViaEmailRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("createBookingLink"),
-		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/booking-links"),
+		otelogen.OperationID("approveViaEmail"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/actions/approve"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), CreateBookingLinkOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), ApproveViaEmailOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -1144,22 +1218,22 @@ func (s *Server) handleCreateBookingLinkRequest(args [0]string, argsEscaped bool
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: CreateBookingLinkOperation,
-			ID:   "createBookingLink",
+			Name: ApproveViaEmailOperation,
+			ID:   "approveViaEmail",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityCookieAuth(ctx, CreateBookingLinkOperation, r)
+			sctx, ok, err := s.securityActionToken(ctx, ApproveViaEmailOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
-					Security:         "CookieAuth",
+					Security:         "ActionToken",
 					Err:              err,
 				}
-				defer recordError("Security:CookieAuth", err)
+				defer recordError("Security:ActionToken", err)
 				s.cfg.ErrorHandler(ctx, w, r, err)
 				return
 			}
@@ -1194,39 +1268,24 @@ func (s *Server) handleCreateBookingLinkRequest(args [0]string, argsEscaped bool
 	}
 
 	var rawBody []byte
-	request, rawBody, close, err := s.decodeCreateBookingLinkRequest(r)
-	if err != nil {
-		err = &ogenerrors.DecodeRequestError{
-			OperationContext: opErrContext,
-			Err:              err,
-		}
-		defer recordError("DecodeRequest", err)
-		s.cfg.ErrorHandler(ctx, w, r, err)
-		return
-	}
-	defer func() {
-		if err := close(); err != nil {
-			recordError("CloseRequest", err)
-		}
-	}()
 
-	var response *BookingLink
+	var response ApproveViaEmailRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    CreateBookingLinkOperation,
-			OperationSummary: "Create a booking link",
-			OperationID:      "createBookingLink",
-			Body:             request,
+			OperationName:    ApproveViaEmailOperation,
+			OperationSummary: "Approve booking via email link",
+			OperationID:      "approveViaEmail",
+			Body:             nil,
 			RawBody:          rawBody,
 			Params:           middleware.Parameters{},
 			Raw:              r,
 		}
 
 		type (
-			Request  = *CreateBookingLinkReq
+			Request  = struct{}
 			Params   = struct{}
-			Response = *BookingLink
+			Response = ApproveViaEmailRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -1237,12 +1296,12 @@ func (s *Server) handleCreateBookingLinkRequest(args [0]string, argsEscaped bool
 			mreq,
 			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.CreateBookingLink(ctx, request)
+				response, err = s.h.ApproveViaEmail(ctx)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.CreateBookingLink(ctx, request)
+		response, err = s.h.ApproveViaEmail(ctx)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -1250,7 +1309,7 @@ func (s *Server) handleCreateBookingLinkRequest(args [0]string, argsEscaped bool
 		return
 	}
 
-	if err := encodeCreateBookingLinkResponse(response, w, span); err != nil {
+	if err := encodeApproveViaEmailResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -1259,22 +1318,22 @@ func (s *Server) handleCreateBookingLinkRequest(args [0]string, argsEscaped bool
 	}
 }
 
-// handleCreatePollRequest handles createPoll operation.
+// handleAuthCallbackRequest handles authCallback operation.
 //
-// Create a poll.
+// Handle OIDC callback.
 //
-// POST /polls
-func (s *Server) handleCreatePollRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /auth/callback
+func (s *Server) handleAuthCallbackRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("createPoll"),
-		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/polls"),
+		otelogen.OperationID("authCallback"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/auth/callback"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), CreatePollOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), AuthCallbackOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -1329,33 +1388,3715 @@ func (s *Server) handleCreatePollRequest(args [0]string, argsEscaped bool, w htt
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: CreatePollOperation,
-			ID:   "createPoll",
+			Name: AuthCallbackOperation,
+			ID:   "authCallback",
 		}
 	)
-	{
-		type bitset = [1]uint8
-		var satisfied bitset
-		{
-			sctx, ok, err := s.securityCookieAuth(ctx, CreatePollOperation, r)
-			if err != nil {
-				err = &ogenerrors.SecurityError{
-					OperationContext: opErrContext,
-					Security:         "CookieAuth",
-					Err:              err,
-				}
-				defer recordError("Security:CookieAuth", err)
-				s.cfg.ErrorHandler(ctx, w, r, err)
-				return
-			}
-			if ok {
-				satisfied[0] |= 1 << 0
-				ctx = sctx
-			}
-		}
-
-		if ok := func() bool {
-		nextRequirement:
+	params, err := decodeAuthCallbackParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var rawBody []byte
+
+	var response AuthCallbackRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    AuthCallbackOperation,
+			OperationSummary: "Handle OIDC callback",
+			OperationID:      "authCallback",
+			Body:             nil,
+			RawBody:          rawBody,
+			Params: middleware.Parameters{
+				{
+					Name: "code",
+					In:   "query",
+				}: params.Code,
+				{
+					Name: "state",
+					In:   "query",
+				}: params.State,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = AuthCallbackParams
+			Response = AuthCallbackRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackAuthCallbackParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.AuthCallback(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.AuthCallback(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeAuthCallbackResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleAutofillVoteRequest handles autofillVote operation.
+//
+// Reads the busy times from a private ICS or CalDAV free-busy URL, or from the contents of an
+// uploaded .ics file, and suggests an answer per option: no if the voter is busy, yes otherwise.
+// Full-day and multi-day options the voter is partly busy on get maybe. The calendar is only used to
+// compute the answers and is not stored.
+//
+// POST /p/poll/{slug}/autofill
+func (s *Server) handleAutofillVoteRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("autofillVote"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/p/poll/{slug}/autofill"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), AutofillVoteOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code < 100 || code >= 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: AutofillVoteOperation,
+			ID:   "autofillVote",
+		}
+	)
+	params, err := decodeAutofillVoteParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var rawBody []byte
+	request, rawBody, close, err := s.decodeAutofillVoteRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
+
+	var response AutofillVoteRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    AutofillVoteOperation,
+			OperationSummary: "Suggest answers from the voter's own calendar",
+			OperationID:      "autofillVote",
+			Body:             request,
+			RawBody:          rawBody,
+			Params: middleware.Parameters{
+				{
+					Name: "slug",
+					In:   "path",
+				}: params.Slug,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = *AutofillVoteReq
+			Params   = AutofillVoteParams
+			Response = AutofillVoteRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackAutofillVoteParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.AutofillVote(ctx, request, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.AutofillVote(ctx, request, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeAutofillVoteResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleCreateBookingRequest handles createBooking operation.
+//
+// Create a booking.
+//
+// POST /p/booking/{slug}/book
+func (s *Server) handleCreateBookingRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("createBooking"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/p/booking/{slug}/book"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), CreateBookingOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code < 100 || code >= 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: CreateBookingOperation,
+			ID:   "createBooking",
+		}
+	)
+	params, err := decodeCreateBookingParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var rawBody []byte
+	request, rawBody, close, err := s.decodeCreateBookingRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
+
+	var response CreateBookingRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    CreateBookingOperation,
+			OperationSummary: "Create a booking",
+			OperationID:      "createBooking",
+			Body:             request,
+			RawBody:          rawBody,
+			Params: middleware.Parameters{
+				{
+					Name: "slug",
+					In:   "path",
+				}: params.Slug,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = *CreateBookingReq
+			Params   = CreateBookingParams
+			Response = CreateBookingRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackCreateBookingParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.CreateBooking(ctx, request, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.CreateBooking(ctx, request, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeCreateBookingResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleCreateBookingLinkRequest handles createBookingLink operation.
+//
+// Create a booking link.
+//
+// POST /booking-links
+func (s *Server) handleCreateBookingLinkRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("createBookingLink"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/booking-links"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), CreateBookingLinkOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code < 100 || code >= 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: CreateBookingLinkOperation,
+			ID:   "createBookingLink",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityCookieAuth(ctx, CreateBookingLinkOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "CookieAuth",
+					Err:              err,
+				}
+				defer recordError("Security:CookieAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+
+	var rawBody []byte
+	request, rawBody, close, err := s.decodeCreateBookingLinkRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
+
+	var response CreateBookingLinkRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    CreateBookingLinkOperation,
+			OperationSummary: "Create a booking link",
+			OperationID:      "createBookingLink",
+			Body:             request,
+			RawBody:          rawBody,
+			Params:           middleware.Parameters{},
+			Raw:              r,
+		}
+
+		type (
+			Request  = *CreateBookingLinkReq
+			Params   = struct{}
+			Response = CreateBookingLinkRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			nil,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.CreateBookingLink(ctx, request)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.CreateBookingLink(ctx, request)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeCreateBookingLinkResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleCreatePollRequest handles createPoll operation.
+//
+// Create a poll.
+//
+// POST /polls
+func (s *Server) handleCreatePollRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("createPoll"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/polls"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), CreatePollOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code < 100 || code >= 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: CreatePollOperation,
+			ID:   "createPoll",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityCookieAuth(ctx, CreatePollOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "CookieAuth",
+					Err:              err,
+				}
+				defer recordError("Security:CookieAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+
+	var rawBody []byte
+	request, rawBody, close, err := s.decodeCreatePollRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
+
+	var response CreatePollRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    CreatePollOperation,
+			OperationSummary: "Create a poll",
+			OperationID:      "createPoll",
+			Body:             request,
+			RawBody:          rawBody,
+			Params:           middleware.Parameters{},
+			Raw:              r,
+		}
+
+		type (
+			Request  = *CreatePollReq
+			Params   = struct{}
+			Response = CreatePollRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			nil,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.CreatePoll(ctx, request)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.CreatePoll(ctx, request)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeCreatePollResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleDeclineBookingRequest handles declineBooking operation.
+//
+// Decline a booking.
+//
+// POST /bookings/{id}/decline
+func (s *Server) handleDeclineBookingRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("declineBooking"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/bookings/{id}/decline"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), DeclineBookingOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code < 100 || code >= 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: DeclineBookingOperation,
+			ID:   "declineBooking",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityCookieAuth(ctx, DeclineBookingOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "CookieAuth",
+					Err:              err,
+				}
+				defer recordError("Security:CookieAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodeDeclineBookingParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var rawBody []byte
+
+	var response *Booking
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    DeclineBookingOperation,
+			OperationSummary: "Decline a booking",
+			OperationID:      "declineBooking",
+			Body:             nil,
+			RawBody:          rawBody,
+			Params: middleware.Parameters{
+				{
+					Name: "id",
+					In:   "path",
+				}: params.ID,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = DeclineBookingParams
+			Response = *Booking
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackDeclineBookingParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.DeclineBooking(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.DeclineBooking(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeDeclineBookingResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleDeclinePollProposalRequest handles declinePollProposal operation.
+//
+// Decline an option proposal.
+//
+// DELETE /polls/{id}/proposals/{proposalId}
+func (s *Server) handleDeclinePollProposalRequest(args [2]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("declinePollProposal"),
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.HTTPRouteKey.String("/polls/{id}/proposals/{proposalId}"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), DeclinePollProposalOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code < 100 || code >= 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: DeclinePollProposalOperation,
+			ID:   "declinePollProposal",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityCookieAuth(ctx, DeclinePollProposalOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "CookieAuth",
+					Err:              err,
+				}
+				defer recordError("Security:CookieAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodeDeclinePollProposalParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var rawBody []byte
+
+	var response *DeclinePollProposalNoContent
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    DeclinePollProposalOperation,
+			OperationSummary: "Decline an option proposal",
+			OperationID:      "declinePollProposal",
+			Body:             nil,
+			RawBody:          rawBody,
+			Params: middleware.Parameters{
+				{
+					Name: "id",
+					In:   "path",
+				}: params.ID,
+				{
+					Name: "proposalId",
+					In:   "path",
+				}: params.ProposalId,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = DeclinePollProposalParams
+			Response = *DeclinePollProposalNoContent
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackDeclinePollProposalParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				err = s.h.DeclinePollProposal(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		err = s.h.DeclinePollProposal(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeDeclinePollProposalResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleDeclineViaEmailRequest handles declineViaEmail operation.
+//
+// Decline booking via email link.
+//
+// GET /actions/decline
+func (s *Server) handleDeclineViaEmailRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("declineViaEmail"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/actions/decline"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), DeclineViaEmailOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code < 100 || code >= 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: DeclineViaEmailOperation,
+			ID:   "declineViaEmail",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityActionToken(ctx, DeclineViaEmailOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "ActionToken",
+					Err:              err,
+				}
+				defer recordError("Security:ActionToken", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+
+	var rawBody []byte
+
+	var response DeclineViaEmailRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    DeclineViaEmailOperation,
+			OperationSummary: "Decline booking via email link",
+			OperationID:      "declineViaEmail",
+			Body:             nil,
+			RawBody:          rawBody,
+			Params:           middleware.Parameters{},
+			Raw:              r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = struct{}
+			Response = DeclineViaEmailRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			nil,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.DeclineViaEmail(ctx)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.DeclineViaEmail(ctx)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeDeclineViaEmailResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleDeleteBookingLinkRequest handles deleteBookingLink operation.
+//
+// Delete a booking link.
+//
+// DELETE /booking-links/{id}
+func (s *Server) handleDeleteBookingLinkRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("deleteBookingLink"),
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.HTTPRouteKey.String("/booking-links/{id}"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), DeleteBookingLinkOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code < 100 || code >= 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: DeleteBookingLinkOperation,
+			ID:   "deleteBookingLink",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityCookieAuth(ctx, DeleteBookingLinkOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "CookieAuth",
+					Err:              err,
+				}
+				defer recordError("Security:CookieAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodeDeleteBookingLinkParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var rawBody []byte
+
+	var response *DeleteBookingLinkNoContent
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    DeleteBookingLinkOperation,
+			OperationSummary: "Delete a booking link",
+			OperationID:      "deleteBookingLink",
+			Body:             nil,
+			RawBody:          rawBody,
+			Params: middleware.Parameters{
+				{
+					Name: "id",
+					In:   "path",
+				}: params.ID,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = DeleteBookingLinkParams
+			Response = *DeleteBookingLinkNoContent
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackDeleteBookingLinkParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				err = s.h.DeleteBookingLink(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		err = s.h.DeleteBookingLink(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeDeleteBookingLinkResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleDeleteOwnVoteRequest handles deleteOwnVote operation.
+//
+// Delete own vote by edit token.
+//
+// DELETE /p/poll/{slug}/vote/{token}
+func (s *Server) handleDeleteOwnVoteRequest(args [2]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("deleteOwnVote"),
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.HTTPRouteKey.String("/p/poll/{slug}/vote/{token}"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), DeleteOwnVoteOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code < 100 || code >= 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: DeleteOwnVoteOperation,
+			ID:   "deleteOwnVote",
+		}
+	)
+	params, err := decodeDeleteOwnVoteParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var rawBody []byte
+
+	var response DeleteOwnVoteRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    DeleteOwnVoteOperation,
+			OperationSummary: "Delete own vote by edit token",
+			OperationID:      "deleteOwnVote",
+			Body:             nil,
+			RawBody:          rawBody,
+			Params: middleware.Parameters{
+				{
+					Name: "slug",
+					In:   "path",
+				}: params.Slug,
+				{
+					Name: "token",
+					In:   "path",
+				}: params.Token,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = DeleteOwnVoteParams
+			Response = DeleteOwnVoteRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackDeleteOwnVoteParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.DeleteOwnVote(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.DeleteOwnVote(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeDeleteOwnVoteResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleDeletePollRequest handles deletePoll operation.
+//
+// Delete a poll.
+//
+// DELETE /polls/{id}
+func (s *Server) handleDeletePollRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("deletePoll"),
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.HTTPRouteKey.String("/polls/{id}"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), DeletePollOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code < 100 || code >= 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: DeletePollOperation,
+			ID:   "deletePoll",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityCookieAuth(ctx, DeletePollOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "CookieAuth",
+					Err:              err,
+				}
+				defer recordError("Security:CookieAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodeDeletePollParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var rawBody []byte
+
+	var response *DeletePollNoContent
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    DeletePollOperation,
+			OperationSummary: "Delete a poll",
+			OperationID:      "deletePoll",
+			Body:             nil,
+			RawBody:          rawBody,
+			Params: middleware.Parameters{
+				{
+					Name: "id",
+					In:   "path",
+				}: params.ID,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = DeletePollParams
+			Response = *DeletePollNoContent
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackDeletePollParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				err = s.h.DeletePoll(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		err = s.h.DeletePoll(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeDeletePollResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleDeletePollCommentRequest handles deletePollComment operation.
+//
+// Delete a comment.
+//
+// DELETE /polls/{id}/comments/{commentId}
+func (s *Server) handleDeletePollCommentRequest(args [2]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("deletePollComment"),
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.HTTPRouteKey.String("/polls/{id}/comments/{commentId}"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), DeletePollCommentOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code < 100 || code >= 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: DeletePollCommentOperation,
+			ID:   "deletePollComment",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityCookieAuth(ctx, DeletePollCommentOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "CookieAuth",
+					Err:              err,
+				}
+				defer recordError("Security:CookieAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodeDeletePollCommentParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var rawBody []byte
+
+	var response *DeletePollCommentNoContent
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    DeletePollCommentOperation,
+			OperationSummary: "Delete a comment",
+			OperationID:      "deletePollComment",
+			Body:             nil,
+			RawBody:          rawBody,
+			Params: middleware.Parameters{
+				{
+					Name: "id",
+					In:   "path",
+				}: params.ID,
+				{
+					Name: "commentId",
+					In:   "path",
+				}: params.CommentId,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = DeletePollCommentParams
+			Response = *DeletePollCommentNoContent
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackDeletePollCommentParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				err = s.h.DeletePollComment(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		err = s.h.DeletePollComment(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeDeletePollCommentResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleDeletePollInviteRequest handles deletePollInvite operation.
+//
+// Remove an invitee from a poll.
+//
+// DELETE /polls/{id}/invites/{inviteId}
+func (s *Server) handleDeletePollInviteRequest(args [2]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("deletePollInvite"),
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.HTTPRouteKey.String("/polls/{id}/invites/{inviteId}"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), DeletePollInviteOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code < 100 || code >= 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: DeletePollInviteOperation,
+			ID:   "deletePollInvite",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityCookieAuth(ctx, DeletePollInviteOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "CookieAuth",
+					Err:              err,
+				}
+				defer recordError("Security:CookieAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodeDeletePollInviteParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var rawBody []byte
+
+	var response *DeletePollInviteNoContent
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    DeletePollInviteOperation,
+			OperationSummary: "Remove an invitee from a poll",
+			OperationID:      "deletePollInvite",
+			Body:             nil,
+			RawBody:          rawBody,
+			Params: middleware.Parameters{
+				{
+					Name: "id",
+					In:   "path",
+				}: params.ID,
+				{
+					Name: "inviteId",
+					In:   "path",
+				}: params.InviteId,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = DeletePollInviteParams
+			Response = *DeletePollInviteNoContent
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackDeletePollInviteParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				err = s.h.DeletePollInvite(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		err = s.h.DeletePollInvite(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeDeletePollInviteResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleDeletePollOptionRequest handles deletePollOption operation.
+//
+// Delete an option from a poll.
+//
+// DELETE /polls/{id}/options/{optionId}
+func (s *Server) handleDeletePollOptionRequest(args [2]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("deletePollOption"),
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.HTTPRouteKey.String("/polls/{id}/options/{optionId}"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), DeletePollOptionOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code < 100 || code >= 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: DeletePollOptionOperation,
+			ID:   "deletePollOption",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityCookieAuth(ctx, DeletePollOptionOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "CookieAuth",
+					Err:              err,
+				}
+				defer recordError("Security:CookieAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodeDeletePollOptionParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var rawBody []byte
+
+	var response *DeletePollOptionNoContent
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    DeletePollOptionOperation,
+			OperationSummary: "Delete an option from a poll",
+			OperationID:      "deletePollOption",
+			Body:             nil,
+			RawBody:          rawBody,
+			Params: middleware.Parameters{
+				{
+					Name: "id",
+					In:   "path",
+				}: params.ID,
+				{
+					Name: "optionId",
+					In:   "path",
+				}: params.OptionId,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = DeletePollOptionParams
+			Response = *DeletePollOptionNoContent
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackDeletePollOptionParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				err = s.h.DeletePollOption(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		err = s.h.DeletePollOption(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeDeletePollOptionResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleDiscoverCalendarsRequest handles discoverCalendars operation.
+//
+// Discover available calendars from a CalDAV server.
+//
+// POST /calendars/discover
+func (s *Server) handleDiscoverCalendarsRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("discoverCalendars"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/calendars/discover"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), DiscoverCalendarsOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code < 100 || code >= 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: DiscoverCalendarsOperation,
+			ID:   "discoverCalendars",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityCookieAuth(ctx, DiscoverCalendarsOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "CookieAuth",
+					Err:              err,
+				}
+				defer recordError("Security:CookieAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+
+	var rawBody []byte
+	request, rawBody, close, err := s.decodeDiscoverCalendarsRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
+
+	var response *CalendarDiscoveryResult
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    DiscoverCalendarsOperation,
+			OperationSummary: "Discover available calendars from a CalDAV server",
+			OperationID:      "discoverCalendars",
+			Body:             request,
+			RawBody:          rawBody,
+			Params:           middleware.Parameters{},
+			Raw:              r,
+		}
+
+		type (
+			Request  = *DiscoverCalendarsReq
+			Params   = struct{}
+			Response = *CalendarDiscoveryResult
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			nil,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.DiscoverCalendars(ctx, request)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.DiscoverCalendars(ctx, request)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeDiscoverCalendarsResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGeneratePollOptionsRequest handles generatePollOptions operation.
+//
+// Creates one option per matching day between start_date and end_date, for each of the given times.
+// Full-day options cover the whole day, multi-day options span span_days days. Times are wall-clock
+// times in time_zone, so they stay the same across daylight saving changes. Options the poll already
+// offers are skipped. Without add, the options are only previewed.
+//
+// POST /polls/{id}/options/generate
+func (s *Server) handleGeneratePollOptionsRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("generatePollOptions"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/polls/{id}/options/generate"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GeneratePollOptionsOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code < 100 || code >= 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GeneratePollOptionsOperation,
+			ID:   "generatePollOptions",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityCookieAuth(ctx, GeneratePollOptionsOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "CookieAuth",
+					Err:              err,
+				}
+				defer recordError("Security:CookieAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodeGeneratePollOptionsParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var rawBody []byte
+	request, rawBody, close, err := s.decodeGeneratePollOptionsRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
+
+	var response GeneratePollOptionsRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GeneratePollOptionsOperation,
+			OperationSummary: "Generate poll options from a recurrence pattern",
+			OperationID:      "generatePollOptions",
+			Body:             request,
+			RawBody:          rawBody,
+			Params: middleware.Parameters{
+				{
+					Name: "id",
+					In:   "path",
+				}: params.ID,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = *GeneratePollOptionsReq
+			Params   = GeneratePollOptionsParams
+			Response = GeneratePollOptionsRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGeneratePollOptionsParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GeneratePollOptions(ctx, request, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GeneratePollOptions(ctx, request, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeGeneratePollOptionsResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetBookingAvailabilityRequest handles getBookingAvailability operation.
+//
+// Get real-time availability for booking link.
+//
+// GET /p/booking/{slug}/availability
+func (s *Server) handleGetBookingAvailabilityRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getBookingAvailability"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/p/booking/{slug}/availability"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetBookingAvailabilityOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code < 100 || code >= 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetBookingAvailabilityOperation,
+			ID:   "getBookingAvailability",
+		}
+	)
+	params, err := decodeGetBookingAvailabilityParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var rawBody []byte
+
+	var response *GetBookingAvailabilityOK
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetBookingAvailabilityOperation,
+			OperationSummary: "Get real-time availability for booking link",
+			OperationID:      "getBookingAvailability",
+			Body:             nil,
+			RawBody:          rawBody,
+			Params: middleware.Parameters{
+				{
+					Name: "slug",
+					In:   "path",
+				}: params.Slug,
+				{
+					Name: "start",
+					In:   "query",
+				}: params.Start,
+				{
+					Name: "end",
+					In:   "query",
+				}: params.End,
+				{
+					Name: "duration",
+					In:   "query",
+				}: params.Duration,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetBookingAvailabilityParams
+			Response = *GetBookingAvailabilityOK
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetBookingAvailabilityParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetBookingAvailability(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetBookingAvailability(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeGetBookingAvailabilityResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetBookingLinkRequest handles getBookingLink operation.
+//
+// Get booking link details.
+//
+// GET /booking-links/{id}
+func (s *Server) handleGetBookingLinkRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getBookingLink"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/booking-links/{id}"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetBookingLinkOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code < 100 || code >= 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetBookingLinkOperation,
+			ID:   "getBookingLink",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityCookieAuth(ctx, GetBookingLinkOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "CookieAuth",
+					Err:              err,
+				}
+				defer recordError("Security:CookieAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodeGetBookingLinkParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var rawBody []byte
+
+	var response *BookingLink
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetBookingLinkOperation,
+			OperationSummary: "Get booking link details",
+			OperationID:      "getBookingLink",
+			Body:             nil,
+			RawBody:          rawBody,
+			Params: middleware.Parameters{
+				{
+					Name: "id",
+					In:   "path",
+				}: params.ID,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetBookingLinkParams
+			Response = *BookingLink
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetBookingLinkParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetBookingLink(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetBookingLink(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeGetBookingLinkResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetBookingLinkBookingsRequest handles getBookingLinkBookings operation.
+//
+// Get bookings for a booking link.
+//
+// GET /booking-links/{id}/bookings
+func (s *Server) handleGetBookingLinkBookingsRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getBookingLinkBookings"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/booking-links/{id}/bookings"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetBookingLinkBookingsOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code < 100 || code >= 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetBookingLinkBookingsOperation,
+			ID:   "getBookingLinkBookings",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityCookieAuth(ctx, GetBookingLinkBookingsOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "CookieAuth",
+					Err:              err,
+				}
+				defer recordError("Security:CookieAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodeGetBookingLinkBookingsParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var rawBody []byte
+
+	var response []Booking
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetBookingLinkBookingsOperation,
+			OperationSummary: "Get bookings for a booking link",
+			OperationID:      "getBookingLinkBookings",
+			Body:             nil,
+			RawBody:          rawBody,
+			Params: middleware.Parameters{
+				{
+					Name: "id",
+					In:   "path",
+				}: params.ID,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetBookingLinkBookingsParams
+			Response = []Booking
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetBookingLinkBookingsParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetBookingLinkBookings(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetBookingLinkBookings(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeGetBookingLinkBookingsResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetCurrentUserRequest handles getCurrentUser operation.
+//
+// Get current user.
+//
+// GET /auth/me
+func (s *Server) handleGetCurrentUserRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getCurrentUser"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/auth/me"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetCurrentUserOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code < 100 || code >= 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetCurrentUserOperation,
+			ID:   "getCurrentUser",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityCookieAuth(ctx, GetCurrentUserOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "CookieAuth",
+					Err:              err,
+				}
+				defer recordError("Security:CookieAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+
+	var rawBody []byte
+
+	var response GetCurrentUserRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetCurrentUserOperation,
+			OperationSummary: "Get current user",
+			OperationID:      "getCurrentUser",
+			Body:             nil,
+			RawBody:          rawBody,
+			Params:           middleware.Parameters{},
+			Raw:              r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = struct{}
+			Response = GetCurrentUserRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			nil,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetCurrentUser(ctx)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetCurrentUser(ctx)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeGetCurrentUserResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetOwnVoteRequest handles getOwnVote operation.
+//
+// Get own vote by edit token.
+//
+// GET /p/poll/{slug}/vote/{token}
+func (s *Server) handleGetOwnVoteRequest(args [2]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getOwnVote"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/p/poll/{slug}/vote/{token}"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetOwnVoteOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code < 100 || code >= 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetOwnVoteOperation,
+			ID:   "getOwnVote",
+		}
+	)
+	params, err := decodeGetOwnVoteParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var rawBody []byte
+
+	var response GetOwnVoteRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetOwnVoteOperation,
+			OperationSummary: "Get own vote by edit token",
+			OperationID:      "getOwnVote",
+			Body:             nil,
+			RawBody:          rawBody,
+			Params: middleware.Parameters{
+				{
+					Name: "slug",
+					In:   "path",
+				}: params.Slug,
+				{
+					Name: "token",
+					In:   "path",
+				}: params.Token,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetOwnVoteParams
+			Response = GetOwnVoteRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetOwnVoteParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetOwnVote(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetOwnVote(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeGetOwnVoteResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetPollRequest handles getPoll operation.
+//
+// Get poll details.
+//
+// GET /polls/{id}
+func (s *Server) handleGetPollRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getPoll"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/polls/{id}"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetPollOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code < 100 || code >= 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetPollOperation,
+			ID:   "getPoll",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityCookieAuth(ctx, GetPollOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "CookieAuth",
+					Err:              err,
+				}
+				defer recordError("Security:CookieAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
 			for _, requirement := range []bitset{
 				{0b00000001},
 			} {
@@ -1377,40 +5118,40 @@ func (s *Server) handleCreatePollRequest(args [0]string, argsEscaped bool, w htt
 			return
 		}
 	}
-
-	var rawBody []byte
-	request, rawBody, close, err := s.decodeCreatePollRequest(r)
+	params, err := decodeGetPollParams(args, argsEscaped, r)
 	if err != nil {
-		err = &ogenerrors.DecodeRequestError{
+		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
 			Err:              err,
 		}
-		defer recordError("DecodeRequest", err)
+		defer recordError("DecodeParams", err)
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
-	defer func() {
-		if err := close(); err != nil {
-			recordError("CloseRequest", err)
-		}
-	}()
+
+	var rawBody []byte
 
 	var response *Poll
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    CreatePollOperation,
-			OperationSummary: "Create a poll",
-			OperationID:      "createPoll",
-			Body:             request,
+			OperationName:    GetPollOperation,
+			OperationSummary: "Get poll details",
+			OperationID:      "getPoll",
+			Body:             nil,
 			RawBody:          rawBody,
-			Params:           middleware.Parameters{},
-			Raw:              r,
+			Params: middleware.Parameters{
+				{
+					Name: "id",
+					In:   "path",
+				}: params.ID,
+			},
+			Raw: r,
 		}
 
 		type (
-			Request  = *CreatePollReq
-			Params   = struct{}
+			Request  = struct{}
+			Params   = GetPollParams
 			Response = *Poll
 		)
 		response, err = middleware.HookMiddleware[
@@ -1420,14 +5161,14 @@ func (s *Server) handleCreatePollRequest(args [0]string, argsEscaped bool, w htt
 		](
 			m,
 			mreq,
-			nil,
+			unpackGetPollParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.CreatePoll(ctx, request)
+				response, err = s.h.GetPoll(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.CreatePoll(ctx, request)
+		response, err = s.h.GetPoll(ctx, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -1435,7 +5176,7 @@ func (s *Server) handleCreatePollRequest(args [0]string, argsEscaped bool, w htt
 		return
 	}
 
-	if err := encodeCreatePollResponse(response, w, span); err != nil {
+	if err := encodeGetPollResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -1444,22 +5185,22 @@ func (s *Server) handleCreatePollRequest(args [0]string, argsEscaped bool, w htt
 	}
 }
 
-// handleDeclineBookingRequest handles declineBooking operation.
+// handleGetPollOptionsRequest handles getPollOptions operation.
 //
-// Decline a booking.
+// Get options for a poll.
 //
-// POST /bookings/{id}/decline
-func (s *Server) handleDeclineBookingRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /polls/{id}/options
+func (s *Server) handleGetPollOptionsRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("declineBooking"),
-		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/bookings/{id}/decline"),
+		otelogen.OperationID("getPollOptions"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/polls/{id}/options"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), DeclineBookingOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetPollOptionsOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -1514,15 +5255,15 @@ func (s *Server) handleDeclineBookingRequest(args [1]string, argsEscaped bool, w
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: DeclineBookingOperation,
-			ID:   "declineBooking",
+			Name: GetPollOptionsOperation,
+			ID:   "getPollOptions",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityCookieAuth(ctx, DeclineBookingOperation, r)
+			sctx, ok, err := s.securityCookieAuth(ctx, GetPollOptionsOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -1562,7 +5303,7 @@ func (s *Server) handleDeclineBookingRequest(args [1]string, argsEscaped bool, w
 			return
 		}
 	}
-	params, err := decodeDeclineBookingParams(args, argsEscaped, r)
+	params, err := decodeGetPollOptionsParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -1575,13 +5316,13 @@ func (s *Server) handleDeclineBookingRequest(args [1]string, argsEscaped bool, w
 
 	var rawBody []byte
 
-	var response *Booking
+	var response []PollOption
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    DeclineBookingOperation,
-			OperationSummary: "Decline a booking",
-			OperationID:      "declineBooking",
+			OperationName:    GetPollOptionsOperation,
+			OperationSummary: "Get options for a poll",
+			OperationID:      "getPollOptions",
 			Body:             nil,
 			RawBody:          rawBody,
 			Params: middleware.Parameters{
@@ -1595,8 +5336,8 @@ func (s *Server) handleDeclineBookingRequest(args [1]string, argsEscaped bool, w
 
 		type (
 			Request  = struct{}
-			Params   = DeclineBookingParams
-			Response = *Booking
+			Params   = GetPollOptionsParams
+			Response = []PollOption
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -1605,14 +5346,14 @@ func (s *Server) handleDeclineBookingRequest(args [1]string, argsEscaped bool, w
 		](
 			m,
 			mreq,
-			unpackDeclineBookingParams,
+			unpackGetPollOptionsParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.DeclineBooking(ctx, params)
+				response, err = s.h.GetPollOptions(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.DeclineBooking(ctx, params)
+		response, err = s.h.GetPollOptions(ctx, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -1620,7 +5361,7 @@ func (s *Server) handleDeclineBookingRequest(args [1]string, argsEscaped bool, w
 		return
 	}
 
-	if err := encodeDeclineBookingResponse(response, w, span); err != nil {
+	if err := encodeGetPollOptionsResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -1629,22 +5370,22 @@ func (s *Server) handleDeclineBookingRequest(args [1]string, argsEscaped bool, w
 	}
 }
 
-// handleDeclineViaEmailRequest handles declineViaEmail operation.
+// handleGetPollRankingRequest handles getPollRanking operation.
 //
-// Decline booking via email link.
+// Get ranked results of a poll.
 //
-// GET /actions/decline
-func (s *Server) handleDeclineViaEmailRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /polls/{id}/results
+func (s *Server) handleGetPollRankingRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("declineViaEmail"),
+		otelogen.OperationID("getPollRanking"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/actions/decline"),
+		semconv.HTTPRouteKey.String("/polls/{id}/results"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), DeclineViaEmailOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetPollRankingOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -1699,22 +5440,22 @@ func (s *Server) handleDeclineViaEmailRequest(args [0]string, argsEscaped bool,
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: DeclineViaEmailOperation,
-			ID:   "declineViaEmail",
+			Name: GetPollRankingOperation,
+			ID:   "getPollRanking",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityActionToken(ctx, DeclineViaEmailOperation, r)
+			sctx, ok, err := s.securityCookieAuth(ctx, GetPollRankingOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
-					Security:         "ActionToken",
+					Security:         "CookieAuth",
 					Err:              err,
 				}
-				defer recordError("Security:ActionToken", err)
+				defer recordError("Security:CookieAuth", err)
 				s.cfg.ErrorHandler(ctx, w, r, err)
 				return
 			}
@@ -1747,26 +5488,41 @@ func (s *Server) handleDeclineViaEmailRequest(args [0]string, argsEscaped bool,
 			return
 		}
 	}
+	params, err := decodeGetPollRankingParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
 
 	var rawBody []byte
 
-	var response DeclineViaEmailRes
+	var response *PollResults
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    DeclineViaEmailOperation,
-			OperationSummary: "Decline booking via email link",
-			OperationID:      "declineViaEmail",
+			OperationName:    GetPollRankingOperation,
+			OperationSummary: "Get ranked results of a poll",
+			OperationID:      "getPollRanking",
 			Body:             nil,
 			RawBody:          rawBody,
-			Params:           middleware.Parameters{},
-			Raw:              r,
+			Params: middleware.Parameters{
+				{
+					Name: "id",
+					In:   "path",
+				}: params.ID,
+			},
+			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = struct{}
-			Response = DeclineViaEmailRes
+			Params   = GetPollRankingParams
+			Response = *PollResults
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -1775,14 +5531,14 @@ func (s *Server) handleDeclineViaEmailRequest(args [0]string, argsEscaped bool,
 		](
 			m,
 			mreq,
-			nil,
+			unpackGetPollRankingParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.DeclineViaEmail(ctx)
+				response, err = s.h.GetPollRanking(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.DeclineViaEmail(ctx)
+		response, err = s.h.GetPollRanking(ctx, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -1790,7 +5546,7 @@ func (s *Server) handleDeclineViaEmailRequest(args [0]string, argsEscaped bool,
 		return
 	}
 
-	if err := encodeDeclineViaEmailResponse(response, w, span); err != nil {
+	if err := encodeGetPollRankingResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -1799,22 +5555,23 @@ func (s *Server) handleDeclineViaEmailRequest(args [0]string, argsEscaped bool,
 	}
 }
 
-// handleDeleteBookingLinkRequest handles deleteBookingLink operation.
+// handleGetPollResultsRequest handles getPollResults operation.
 //
-// Delete a booking link.
+// Results are only returned if the poll shows results and its timing allows it. Voter details are
+// reduced to what the poll's visibility permits.
 //
-// DELETE /booking-links/{id}
-func (s *Server) handleDeleteBookingLinkRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /p/poll/{slug}/results
+func (s *Server) handleGetPollResultsRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("deleteBookingLink"),
-		semconv.HTTPRequestMethodKey.String("DELETE"),
-		semconv.HTTPRouteKey.String("/booking-links/{id}"),
+		otelogen.OperationID("getPollResults"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/p/poll/{slug}/results"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), DeleteBookingLinkOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetPollResultsOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -1869,55 +5626,11 @@ func (s *Server) handleDeleteBookingLinkRequest(args [1]string, argsEscaped bool
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: DeleteBookingLinkOperation,
-			ID:   "deleteBookingLink",
+			Name: GetPollResultsOperation,
+			ID:   "getPollResults",
 		}
 	)
-	{
-		type bitset = [1]uint8
-		var satisfied bitset
-		{
-			sctx, ok, err := s.securityCookieAuth(ctx, DeleteBookingLinkOperation, r)
-			if err != nil {
-				err = &ogenerrors.SecurityError{
-					OperationContext: opErrContext,
-					Security:         "CookieAuth",
-					Err:              err,
-				}
-				defer recordError("Security:CookieAuth", err)
-				s.cfg.ErrorHandler(ctx, w, r, err)
-				return
-			}
-			if ok {
-				satisfied[0] |= 1 << 0
-				ctx = sctx
-			}
-		}
-
-		if ok := func() bool {
-		nextRequirement:
-			for _, requirement := range []bitset{
-				{0b00000001},
-			} {
-				for i, mask := range requirement {
-					if satisfied[i]&mask != mask {
-						continue nextRequirement
-					}
-				}
-				return true
-			}
-			return false
-		}(); !ok {
-			err = &ogenerrors.SecurityError{
-				OperationContext: opErrContext,
-				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
-			}
-			defer recordError("Security", err)
-			s.cfg.ErrorHandler(ctx, w, r, err)
-			return
-		}
-	}
-	params, err := decodeDeleteBookingLinkParams(args, argsEscaped, r)
+	params, err := decodeGetPollResultsParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -1930,28 +5643,32 @@ func (s *Server) handleDeleteBookingLinkRequest(args [1]string, argsEscaped bool
 
 	var rawBody []byte
 
-	var response *DeleteBookingLinkNoContent
+	var response GetPollResultsRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    DeleteBookingLinkOperation,
-			OperationSummary: "Delete a booking link",
-			OperationID:      "deleteBookingLink",
+			OperationName:    GetPollResultsOperation,
+			OperationSummary: "Get poll results",
+			OperationID:      "getPollResults",
 			Body:             nil,
 			RawBody:          rawBody,
 			Params: middleware.Parameters{
 				{
-					Name: "id",
+					Name: "slug",
 					In:   "path",
-				}: params.ID,
+				}: params.Slug,
+				{
+					Name: "token",
+					In:   "query",
+				}: params.Token,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = DeleteBookingLinkParams
-			Response = *DeleteBookingLinkNoContent
+			Params   = GetPollResultsParams
+			Response = GetPollResultsRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -1960,14 +5677,14 @@ func (s *Server) handleDeleteBookingLinkRequest(args [1]string, argsEscaped bool
 		](
 			m,
 			mreq,
-			unpackDeleteBookingLinkParams,
+			unpackGetPollResultsParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				err = s.h.DeleteBookingLink(ctx, params)
+				response, err = s.h.GetPollResults(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		err = s.h.DeleteBookingLink(ctx, params)
+		response, err = s.h.GetPollResults(ctx, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -1975,7 +5692,7 @@ func (s *Server) handleDeleteBookingLinkRequest(args [1]string, argsEscaped bool
 		return
 	}
 
-	if err := encodeDeleteBookingLinkResponse(response, w, span); err != nil {
+	if err := encodeGetPollResultsResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -1984,22 +5701,22 @@ func (s *Server) handleDeleteBookingLinkRequest(args [1]string, argsEscaped bool
 	}
 }
 
-// handleDeletePollRequest handles deletePoll operation.
+// handleGetPollVotesRequest handles getPollVotes operation.
 //
-// Delete a poll.
+// Get votes for a poll.
 //
-// DELETE /polls/{id}
-func (s *Server) handleDeletePollRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /polls/{id}/votes
+func (s *Server) handleGetPollVotesRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("deletePoll"),
-		semconv.HTTPRequestMethodKey.String("DELETE"),
-		semconv.HTTPRouteKey.String("/polls/{id}"),
+		otelogen.OperationID("getPollVotes"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/polls/{id}/votes"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), DeletePollOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetPollVotesOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -2054,15 +5771,15 @@ func (s *Server) handleDeletePollRequest(args [1]string, argsEscaped bool, w htt
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: DeletePollOperation,
-			ID:   "deletePoll",
+			Name: GetPollVotesOperation,
+			ID:   "getPollVotes",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityCookieAuth(ctx, DeletePollOperation, r)
+			sctx, ok, err := s.securityCookieAuth(ctx, GetPollVotesOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -2102,7 +5819,7 @@ func (s *Server) handleDeletePollRequest(args [1]string, argsEscaped bool, w htt
 			return
 		}
 	}
-	params, err := decodeDeletePollParams(args, argsEscaped, r)
+	params, err := decodeGetPollVotesParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -2115,13 +5832,13 @@ func (s *Server) handleDeletePollRequest(args [1]string, argsEscaped bool, w htt
 
 	var rawBody []byte
 
-	var response *DeletePollNoContent
+	var response []Vote
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    DeletePollOperation,
-			OperationSummary: "Delete a poll",
-			OperationID:      "deletePoll",
+			OperationName:    GetPollVotesOperation,
+			OperationSummary: "Get votes for a poll",
+			OperationID:      "getPollVotes",
 			Body:             nil,
 			RawBody:          rawBody,
 			Params: middleware.Parameters{
@@ -2135,8 +5852,8 @@ func (s *Server) handleDeletePollRequest(args [1]string, argsEscaped bool, w htt
 
 		type (
 			Request  = struct{}
-			Params   = DeletePollParams
-			Response = *DeletePollNoContent
+			Params   = GetPollVotesParams
+			Response = []Vote
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -2145,14 +5862,14 @@ func (s *Server) handleDeletePollRequest(args [1]string, argsEscaped bool, w htt
 		](
 			m,
 			mreq,
-			unpackDeletePollParams,
+			unpackGetPollVotesParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				err = s.h.DeletePoll(ctx, params)
+				response, err = s.h.GetPollVotes(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		err = s.h.DeletePoll(ctx, params)
+		response, err = s.h.GetPollVotes(ctx, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -2160,7 +5877,7 @@ func (s *Server) handleDeletePollRequest(args [1]string, argsEscaped bool, w htt
 		return
 	}
 
-	if err := encodeDeletePollResponse(response, w, span); err != nil {
+	if err := encodeGetPollVotesResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -2169,22 +5886,22 @@ func (s *Server) handleDeletePollRequest(args [1]string, argsEscaped bool, w htt
 	}
 }
 
-// handleDeletePollOptionRequest handles deletePollOption operation.
+// handleGetPublicBookingLinkRequest handles getPublicBookingLink operation.
 //
-// Delete an option from a poll.
+// Get public booking link info.
 //
-// DELETE /polls/{id}/options/{optionId}
-func (s *Server) handleDeletePollOptionRequest(args [2]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /p/booking/{slug}
+func (s *Server) handleGetPublicBookingLinkRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("deletePollOption"),
-		semconv.HTTPRequestMethodKey.String("DELETE"),
-		semconv.HTTPRouteKey.String("/polls/{id}/options/{optionId}"),
+		otelogen.OperationID("getPublicBookingLink"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/p/booking/{slug}"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), DeletePollOptionOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetPublicBookingLinkOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -2239,55 +5956,11 @@ func (s *Server) handleDeletePollOptionRequest(args [2]string, argsEscaped bool,
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: DeletePollOptionOperation,
-			ID:   "deletePollOption",
+			Name: GetPublicBookingLinkOperation,
+			ID:   "getPublicBookingLink",
 		}
 	)
-	{
-		type bitset = [1]uint8
-		var satisfied bitset
-		{
-			sctx, ok, err := s.securityCookieAuth(ctx, DeletePollOptionOperation, r)
-			if err != nil {
-				err = &ogenerrors.SecurityError{
-					OperationContext: opErrContext,
-					Security:         "CookieAuth",
-					Err:              err,
-				}
-				defer recordError("Security:CookieAuth", err)
-				s.cfg.ErrorHandler(ctx, w, r, err)
-				return
-			}
-			if ok {
-				satisfied[0] |= 1 << 0
-				ctx = sctx
-			}
-		}
-
-		if ok := func() bool {
-		nextRequirement:
-			for _, requirement := range []bitset{
-				{0b00000001},
-			} {
-				for i, mask := range requirement {
-					if satisfied[i]&mask != mask {
-						continue nextRequirement
-					}
-				}
-				return true
-			}
-			return false
-		}(); !ok {
-			err = &ogenerrors.SecurityError{
-				OperationContext: opErrContext,
-				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
-			}
-			defer recordError("Security", err)
-			s.cfg.ErrorHandler(ctx, w, r, err)
-			return
-		}
-	}
-	params, err := decodeDeletePollOptionParams(args, argsEscaped, r)
+	params, err := decodeGetPublicBookingLinkParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -2300,32 +5973,28 @@ func (s *Server) handleDeletePollOptionRequest(args [2]string, argsEscaped bool,
 
 	var rawBody []byte
 
-	var response *DeletePollOptionNoContent
+	var response GetPublicBookingLinkRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    DeletePollOptionOperation,
-			OperationSummary: "Delete an option from a poll",
-			OperationID:      "deletePollOption",
+			OperationName:    GetPublicBookingLinkOperation,
+			OperationSummary: "Get public booking link info",
+			OperationID:      "getPublicBookingLink",
 			Body:             nil,
 			RawBody:          rawBody,
 			Params: middleware.Parameters{
 				{
-					Name: "id",
-					In:   "path",
-				}: params.ID,
-				{
-					Name: "optionId",
+					Name: "slug",
 					In:   "path",
-				}: params.OptionId,
+				}: params.Slug,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = DeletePollOptionParams
-			Response = *DeletePollOptionNoContent
+			Params   = GetPublicBookingLinkParams
+			Response = GetPublicBookingLinkRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -2334,14 +6003,14 @@ func (s *Server) handleDeletePollOptionRequest(args [2]string, argsEscaped bool,
 		](
 			m,
 			mreq,
-			unpackDeletePollOptionParams,
+			unpackGetPublicBookingLinkParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				err = s.h.DeletePollOption(ctx, params)
+				response, err = s.h.GetPublicBookingLink(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		err = s.h.DeletePollOption(ctx, params)
+		response, err = s.h.GetPublicBookingLink(ctx, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -2349,7 +6018,7 @@ func (s *Server) handleDeletePollOptionRequest(args [2]string, argsEscaped bool,
 		return
 	}
 
-	if err := encodeDeletePollOptionResponse(response, w, span); err != nil {
+	if err := encodeGetPublicBookingLinkResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -2358,22 +6027,22 @@ func (s *Server) handleDeletePollOptionRequest(args [2]string, argsEscaped bool,
 	}
 }
 
-// handleDiscoverCalendarsRequest handles discoverCalendars operation.
+// handleGetPublicPollRequest handles getPublicPoll operation.
 //
-// Discover available calendars from a CalDAV server.
+// Get public poll info.
 //
-// POST /calendars/discover
-func (s *Server) handleDiscoverCalendarsRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /p/poll/{slug}
+func (s *Server) handleGetPublicPollRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("discoverCalendars"),
-		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/calendars/discover"),
+		otelogen.OperationID("getPublicPoll"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/p/poll/{slug}"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), DiscoverCalendarsOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetPublicPollOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -2428,89 +6097,49 @@ func (s *Server) handleDiscoverCalendarsRequest(args [0]string, argsEscaped bool
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: DiscoverCalendarsOperation,
-			ID:   "discoverCalendars",
+			Name: GetPublicPollOperation,
+			ID:   "getPublicPoll",
 		}
 	)
-	{
-		type bitset = [1]uint8
-		var satisfied bitset
-		{
-			sctx, ok, err := s.securityCookieAuth(ctx, DiscoverCalendarsOperation, r)
-			if err != nil {
-				err = &ogenerrors.SecurityError{
-					OperationContext: opErrContext,
-					Security:         "CookieAuth",
-					Err:              err,
-				}
-				defer recordError("Security:CookieAuth", err)
-				s.cfg.ErrorHandler(ctx, w, r, err)
-				return
-			}
-			if ok {
-				satisfied[0] |= 1 << 0
-				ctx = sctx
-			}
-		}
-
-		if ok := func() bool {
-		nextRequirement:
-			for _, requirement := range []bitset{
-				{0b00000001},
-			} {
-				for i, mask := range requirement {
-					if satisfied[i]&mask != mask {
-						continue nextRequirement
-					}
-				}
-				return true
-			}
-			return false
-		}(); !ok {
-			err = &ogenerrors.SecurityError{
-				OperationContext: opErrContext,
-				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
-			}
-			defer recordError("Security", err)
-			s.cfg.ErrorHandler(ctx, w, r, err)
-			return
-		}
-	}
-
-	var rawBody []byte
-	request, rawBody, close, err := s.decodeDiscoverCalendarsRequest(r)
+	params, err := decodeGetPublicPollParams(args, argsEscaped, r)
 	if err != nil {
-		err = &ogenerrors.DecodeRequestError{
+		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
 			Err:              err,
 		}
-		defer recordError("DecodeRequest", err)
+		defer recordError("DecodeParams", err)
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
-	defer func() {
-		if err := close(); err != nil {
-			recordError("CloseRequest", err)
-		}
-	}()
 
-	var response *CalendarDiscoveryResult
+	var rawBody []byte
+
+	var response GetPublicPollRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    DiscoverCalendarsOperation,
-			OperationSummary: "Discover available calendars from a CalDAV server",
-			OperationID:      "discoverCalendars",
-			Body:             request,
+			OperationName:    GetPublicPollOperation,
+			OperationSummary: "Get public poll info",
+			OperationID:      "getPublicPoll",
+			Body:             nil,
 			RawBody:          rawBody,
-			Params:           middleware.Parameters{},
-			Raw:              r,
+			Params: middleware.Parameters{
+				{
+					Name: "slug",
+					In:   "path",
+				}: params.Slug,
+				{
+					Name: "invite",
+					In:   "query",
+				}: params.Invite,
+			},
+			Raw: r,
 		}
 
 		type (
-			Request  = *DiscoverCalendarsReq
-			Params   = struct{}
-			Response = *CalendarDiscoveryResult
+			Request  = struct{}
+			Params   = GetPublicPollParams
+			Response = GetPublicPollRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -2519,14 +6148,14 @@ func (s *Server) handleDiscoverCalendarsRequest(args [0]string, argsEscaped bool
 		](
 			m,
 			mreq,
-			nil,
+			unpackGetPublicPollParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.DiscoverCalendars(ctx, request)
+				response, err = s.h.GetPublicPoll(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.DiscoverCalendars(ctx, request)
+		response, err = s.h.GetPublicPoll(ctx, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -2534,7 +6163,7 @@ func (s *Server) handleDiscoverCalendarsRequest(args [0]string, argsEscaped bool
 		return
 	}
 
-	if err := encodeDiscoverCalendarsResponse(response, w, span); err != nil {
+	if err := encodeGetPublicPollResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -2543,22 +6172,22 @@ func (s *Server) handleDiscoverCalendarsRequest(args [0]string, argsEscaped bool
 	}
 }
 
-// handleGetBookingAvailabilityRequest handles getBookingAvailability operation.
+// handleGetPublicPollCommentsRequest handles getPublicPollComments operation.
 //
-// Get real-time availability for booking link.
+// List the visible comments of a poll.
 //
-// GET /p/booking/{slug}/availability
-func (s *Server) handleGetBookingAvailabilityRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /p/poll/{slug}/comments
+func (s *Server) handleGetPublicPollCommentsRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getBookingAvailability"),
+		otelogen.OperationID("getPublicPollComments"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/p/booking/{slug}/availability"),
+		semconv.HTTPRouteKey.String("/p/poll/{slug}/comments"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), GetBookingAvailabilityOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetPublicPollCommentsOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -2613,11 +6242,11 @@ func (s *Server) handleGetBookingAvailabilityRequest(args [1]string, argsEscaped
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetBookingAvailabilityOperation,
-			ID:   "getBookingAvailability",
+			Name: GetPublicPollCommentsOperation,
+			ID:   "getPublicPollComments",
 		}
 	)
-	params, err := decodeGetBookingAvailabilityParams(args, argsEscaped, r)
+	params, err := decodeGetPublicPollCommentsParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -2630,13 +6259,13 @@ func (s *Server) handleGetBookingAvailabilityRequest(args [1]string, argsEscaped
 
 	var rawBody []byte
 
-	var response *GetBookingAvailabilityOK
+	var response GetPublicPollCommentsRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetBookingAvailabilityOperation,
-			OperationSummary: "Get real-time availability for booking link",
-			OperationID:      "getBookingAvailability",
+			OperationName:    GetPublicPollCommentsOperation,
+			OperationSummary: "List the visible comments of a poll",
+			OperationID:      "getPublicPollComments",
 			Body:             nil,
 			RawBody:          rawBody,
 			Params: middleware.Parameters{
@@ -2644,26 +6273,14 @@ func (s *Server) handleGetBookingAvailabilityRequest(args [1]string, argsEscaped
 					Name: "slug",
 					In:   "path",
 				}: params.Slug,
-				{
-					Name: "start",
-					In:   "query",
-				}: params.Start,
-				{
-					Name: "end",
-					In:   "query",
-				}: params.End,
-				{
-					Name: "duration",
-					In:   "query",
-				}: params.Duration,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = GetBookingAvailabilityParams
-			Response = *GetBookingAvailabilityOK
+			Params   = GetPublicPollCommentsParams
+			Response = GetPublicPollCommentsRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -2672,14 +6289,14 @@ func (s *Server) handleGetBookingAvailabilityRequest(args [1]string, argsEscaped
 		](
 			m,
 			mreq,
-			unpackGetBookingAvailabilityParams,
+			unpackGetPublicPollCommentsParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetBookingAvailability(ctx, params)
+				response, err = s.h.GetPublicPollComments(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetBookingAvailability(ctx, params)
+		response, err = s.h.GetPublicPollComments(ctx, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -2687,7 +6304,7 @@ func (s *Server) handleGetBookingAvailabilityRequest(args [1]string, argsEscaped
 		return
 	}
 
-	if err := encodeGetBookingAvailabilityResponse(response, w, span); err != nil {
+	if err := encodeGetPublicPollCommentsResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -2696,22 +6313,22 @@ func (s *Server) handleGetBookingAvailabilityRequest(args [1]string, argsEscaped
 	}
 }
 
-// handleGetBookingLinkRequest handles getBookingLink operation.
+// handleInitiateLoginRequest handles initiateLogin operation.
 //
-// Get booking link details.
+// Redirect to OIDC provider.
 //
-// GET /booking-links/{id}
-func (s *Server) handleGetBookingLinkRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /auth/login
+func (s *Server) handleInitiateLoginRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getBookingLink"),
+		otelogen.OperationID("initiateLogin"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/booking-links/{id}"),
+		semconv.HTTPRouteKey.String("/auth/login"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), GetBookingLinkOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), InitiateLoginOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -2764,91 +6381,28 @@ func (s *Server) handleGetBookingLinkRequest(args [1]string, argsEscaped bool, w
 
 			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
 		}
-		err          error
-		opErrContext = ogenerrors.OperationContext{
-			Name: GetBookingLinkOperation,
-			ID:   "getBookingLink",
-		}
+		err error
 	)
-	{
-		type bitset = [1]uint8
-		var satisfied bitset
-		{
-			sctx, ok, err := s.securityCookieAuth(ctx, GetBookingLinkOperation, r)
-			if err != nil {
-				err = &ogenerrors.SecurityError{
-					OperationContext: opErrContext,
-					Security:         "CookieAuth",
-					Err:              err,
-				}
-				defer recordError("Security:CookieAuth", err)
-				s.cfg.ErrorHandler(ctx, w, r, err)
-				return
-			}
-			if ok {
-				satisfied[0] |= 1 << 0
-				ctx = sctx
-			}
-		}
-
-		if ok := func() bool {
-		nextRequirement:
-			for _, requirement := range []bitset{
-				{0b00000001},
-			} {
-				for i, mask := range requirement {
-					if satisfied[i]&mask != mask {
-						continue nextRequirement
-					}
-				}
-				return true
-			}
-			return false
-		}(); !ok {
-			err = &ogenerrors.SecurityError{
-				OperationContext: opErrContext,
-				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
-			}
-			defer recordError("Security", err)
-			s.cfg.ErrorHandler(ctx, w, r, err)
-			return
-		}
-	}
-	params, err := decodeGetBookingLinkParams(args, argsEscaped, r)
-	if err != nil {
-		err = &ogenerrors.DecodeParamsError{
-			OperationContext: opErrContext,
-			Err:              err,
-		}
-		defer recordError("DecodeParams", err)
-		s.cfg.ErrorHandler(ctx, w, r, err)
-		return
-	}
 
 	var rawBody []byte
 
-	var response *BookingLink
+	var response *InitiateLoginFound
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetBookingLinkOperation,
-			OperationSummary: "Get booking link details",
-			OperationID:      "getBookingLink",
+			OperationName:    InitiateLoginOperation,
+			OperationSummary: "Redirect to OIDC provider",
+			OperationID:      "initiateLogin",
 			Body:             nil,
 			RawBody:          rawBody,
-			Params: middleware.Parameters{
-				{
-					Name: "id",
-					In:   "path",
-				}: params.ID,
-			},
-			Raw: r,
+			Params:           middleware.Parameters{},
+			Raw:              r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = GetBookingLinkParams
-			Response = *BookingLink
+			Params   = struct{}
+			Response = *InitiateLoginFound
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -2857,14 +6411,14 @@ func (s *Server) handleGetBookingLinkRequest(args [1]string, argsEscaped bool, w
 		](
 			m,
 			mreq,
-			unpackGetBookingLinkParams,
+			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetBookingLink(ctx, params)
+				response, err = s.h.InitiateLogin(ctx)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetBookingLink(ctx, params)
+		response, err = s.h.InitiateLogin(ctx)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -2872,7 +6426,7 @@ func (s *Server) handleGetBookingLinkRequest(args [1]string, argsEscaped bool, w
 		return
 	}
 
-	if err := encodeGetBookingLinkResponse(response, w, span); err != nil {
+	if err := encodeInitiateLoginResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -2881,22 +6435,22 @@ func (s *Server) handleGetBookingLinkRequest(args [1]string, argsEscaped bool, w
 	}
 }
 
-// handleGetBookingLinkBookingsRequest handles getBookingLinkBookings operation.
+// handleListBookingLinksRequest handles listBookingLinks operation.
 //
-// Get bookings for a booking link.
+// List all booking links.
 //
-// GET /booking-links/{id}/bookings
-func (s *Server) handleGetBookingLinkBookingsRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /booking-links
+func (s *Server) handleListBookingLinksRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getBookingLinkBookings"),
+		otelogen.OperationID("listBookingLinks"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/booking-links/{id}/bookings"),
+		semconv.HTTPRouteKey.String("/booking-links"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), GetBookingLinkBookingsOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), ListBookingLinksOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -2951,15 +6505,15 @@ func (s *Server) handleGetBookingLinkBookingsRequest(args [1]string, argsEscaped
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetBookingLinkBookingsOperation,
-			ID:   "getBookingLinkBookings",
+			Name: ListBookingLinksOperation,
+			ID:   "listBookingLinks",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityCookieAuth(ctx, GetBookingLinkBookingsOperation, r)
+			sctx, ok, err := s.securityCookieAuth(ctx, ListBookingLinksOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -2999,41 +6553,26 @@ func (s *Server) handleGetBookingLinkBookingsRequest(args [1]string, argsEscaped
 			return
 		}
 	}
-	params, err := decodeGetBookingLinkBookingsParams(args, argsEscaped, r)
-	if err != nil {
-		err = &ogenerrors.DecodeParamsError{
-			OperationContext: opErrContext,
-			Err:              err,
-		}
-		defer recordError("DecodeParams", err)
-		s.cfg.ErrorHandler(ctx, w, r, err)
-		return
-	}
 
 	var rawBody []byte
 
-	var response []Booking
+	var response []BookingLink
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetBookingLinkBookingsOperation,
-			OperationSummary: "Get bookings for a booking link",
-			OperationID:      "getBookingLinkBookings",
+			OperationName:    ListBookingLinksOperation,
+			OperationSummary: "List all booking links",
+			OperationID:      "listBookingLinks",
 			Body:             nil,
 			RawBody:          rawBody,
-			Params: middleware.Parameters{
-				{
-					Name: "id",
-					In:   "path",
-				}: params.ID,
-			},
-			Raw: r,
+			Params:           middleware.Parameters{},
+			Raw:              r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = GetBookingLinkBookingsParams
-			Response = []Booking
+			Params   = struct{}
+			Response = []BookingLink
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -3042,14 +6581,14 @@ func (s *Server) handleGetBookingLinkBookingsRequest(args [1]string, argsEscaped
 		](
 			m,
 			mreq,
-			unpackGetBookingLinkBookingsParams,
+			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetBookingLinkBookings(ctx, params)
+				response, err = s.h.ListBookingLinks(ctx)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetBookingLinkBookings(ctx, params)
+		response, err = s.h.ListBookingLinks(ctx)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -3057,7 +6596,7 @@ func (s *Server) handleGetBookingLinkBookingsRequest(args [1]string, argsEscaped
 		return
 	}
 
-	if err := encodeGetBookingLinkBookingsResponse(response, w, span); err != nil {
+	if err := encodeListBookingLinksResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -3066,22 +6605,22 @@ func (s *Server) handleGetBookingLinkBookingsRequest(args [1]string, argsEscaped
 	}
 }
 
-// handleGetCurrentUserRequest handles getCurrentUser operation.
+// handleListCalendarsRequest handles listCalendars operation.
 //
-// Get current user.
+// List calendar connections.
 //
-// GET /auth/me
-func (s *Server) handleGetCurrentUserRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /calendars
+func (s *Server) handleListCalendarsRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getCurrentUser"),
+		otelogen.OperationID("listCalendars"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/auth/me"),
+		semconv.HTTPRouteKey.String("/calendars"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), GetCurrentUserOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), ListCalendarsOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -3136,15 +6675,15 @@ func (s *Server) handleGetCurrentUserRequest(args [0]string, argsEscaped bool, w
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetCurrentUserOperation,
-			ID:   "getCurrentUser",
+			Name: ListCalendarsOperation,
+			ID:   "listCalendars",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityCookieAuth(ctx, GetCurrentUserOperation, r)
+			sctx, ok, err := s.securityCookieAuth(ctx, ListCalendarsOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -3187,13 +6726,13 @@ func (s *Server) handleGetCurrentUserRequest(args [0]string, argsEscaped bool, w
 
 	var rawBody []byte
 
-	var response GetCurrentUserRes
+	var response []CalendarConnection
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetCurrentUserOperation,
-			OperationSummary: "Get current user",
-			OperationID:      "getCurrentUser",
+			OperationName:    ListCalendarsOperation,
+			OperationSummary: "List calendar connections",
+			OperationID:      "listCalendars",
 			Body:             nil,
 			RawBody:          rawBody,
 			Params:           middleware.Parameters{},
@@ -3203,7 +6742,7 @@ func (s *Server) handleGetCurrentUserRequest(args [0]string, argsEscaped bool, w
 		type (
 			Request  = struct{}
 			Params   = struct{}
-			Response = GetCurrentUserRes
+			Response = []CalendarConnection
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -3214,12 +6753,12 @@ func (s *Server) handleGetCurrentUserRequest(args [0]string, argsEscaped bool, w
 			mreq,
 			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetCurrentUser(ctx)
+				response, err = s.h.ListCalendars(ctx)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetCurrentUser(ctx)
+		response, err = s.h.ListCalendars(ctx)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -3227,7 +6766,7 @@ func (s *Server) handleGetCurrentUserRequest(args [0]string, argsEscaped bool, w
 		return
 	}
 
-	if err := encodeGetCurrentUserResponse(response, w, span); err != nil {
+	if err := encodeListCalendarsResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -3236,22 +6775,22 @@ func (s *Server) handleGetCurrentUserRequest(args [0]string, argsEscaped bool, w
 	}
 }
 
-// handleGetPollRequest handles getPoll operation.
+// handleListMeetingProvidersRequest handles listMeetingProviders operation.
 //
-// Get poll details.
+// List enabled meeting link providers.
 //
-// GET /polls/{id}
-func (s *Server) handleGetPollRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /meeting-providers
+func (s *Server) handleListMeetingProvidersRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getPoll"),
+		otelogen.OperationID("listMeetingProviders"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/polls/{id}"),
+		semconv.HTTPRouteKey.String("/meeting-providers"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), GetPollOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), ListMeetingProvidersOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -3306,15 +6845,15 @@ func (s *Server) handleGetPollRequest(args [1]string, argsEscaped bool, w http.R
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetPollOperation,
-			ID:   "getPoll",
+			Name: ListMeetingProvidersOperation,
+			ID:   "listMeetingProviders",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityCookieAuth(ctx, GetPollOperation, r)
+			sctx, ok, err := s.securityCookieAuth(ctx, ListMeetingProvidersOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -3354,41 +6893,26 @@ func (s *Server) handleGetPollRequest(args [1]string, argsEscaped bool, w http.R
 			return
 		}
 	}
-	params, err := decodeGetPollParams(args, argsEscaped, r)
-	if err != nil {
-		err = &ogenerrors.DecodeParamsError{
-			OperationContext: opErrContext,
-			Err:              err,
-		}
-		defer recordError("DecodeParams", err)
-		s.cfg.ErrorHandler(ctx, w, r, err)
-		return
-	}
 
 	var rawBody []byte
 
-	var response *Poll
+	var response []string
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetPollOperation,
-			OperationSummary: "Get poll details",
-			OperationID:      "getPoll",
+			OperationName:    ListMeetingProvidersOperation,
+			OperationSummary: "List enabled meeting link providers",
+			OperationID:      "listMeetingProviders",
 			Body:             nil,
 			RawBody:          rawBody,
-			Params: middleware.Parameters{
-				{
-					Name: "id",
-					In:   "path",
-				}: params.ID,
-			},
-			Raw: r,
+			Params:           middleware.Parameters{},
+			Raw:              r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = GetPollParams
-			Response = *Poll
+			Params   = struct{}
+			Response = []string
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -3397,14 +6921,14 @@ func (s *Server) handleGetPollRequest(args [1]string, argsEscaped bool, w http.R
 		](
 			m,
 			mreq,
-			unpackGetPollParams,
+			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetPoll(ctx, params)
+				response, err = s.h.ListMeetingProviders(ctx)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetPoll(ctx, params)
+		response, err = s.h.ListMeetingProviders(ctx)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -3412,7 +6936,7 @@ func (s *Server) handleGetPollRequest(args [1]string, argsEscaped bool, w http.R
 		return
 	}
 
-	if err := encodeGetPollResponse(response, w, span); err != nil {
+	if err := encodeListMeetingProvidersResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -3421,22 +6945,22 @@ func (s *Server) handleGetPollRequest(args [1]string, argsEscaped bool, w http.R
 	}
 }
 
-// handleGetPollOptionsRequest handles getPollOptions operation.
+// handleListPollCommentsRequest handles listPollComments operation.
 //
-// Get options for a poll.
+// List all comments of a poll, including hidden ones.
 //
-// GET /polls/{id}/options
-func (s *Server) handleGetPollOptionsRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /polls/{id}/comments
+func (s *Server) handleListPollCommentsRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getPollOptions"),
+		otelogen.OperationID("listPollComments"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/polls/{id}/options"),
+		semconv.HTTPRouteKey.String("/polls/{id}/comments"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), GetPollOptionsOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), ListPollCommentsOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -3491,15 +7015,15 @@ func (s *Server) handleGetPollOptionsRequest(args [1]string, argsEscaped bool, w
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetPollOptionsOperation,
-			ID:   "getPollOptions",
+			Name: ListPollCommentsOperation,
+			ID:   "listPollComments",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityCookieAuth(ctx, GetPollOptionsOperation, r)
+			sctx, ok, err := s.securityCookieAuth(ctx, ListPollCommentsOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -3539,7 +7063,7 @@ func (s *Server) handleGetPollOptionsRequest(args [1]string, argsEscaped bool, w
 			return
 		}
 	}
-	params, err := decodeGetPollOptionsParams(args, argsEscaped, r)
+	params, err := decodeListPollCommentsParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -3552,13 +7076,13 @@ func (s *Server) handleGetPollOptionsRequest(args [1]string, argsEscaped bool, w
 
 	var rawBody []byte
 
-	var response []PollOption
+	var response []PollComment
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetPollOptionsOperation,
-			OperationSummary: "Get options for a poll",
-			OperationID:      "getPollOptions",
+			OperationName:    ListPollCommentsOperation,
+			OperationSummary: "List all comments of a poll, including hidden ones",
+			OperationID:      "listPollComments",
 			Body:             nil,
 			RawBody:          rawBody,
 			Params: middleware.Parameters{
@@ -3572,8 +7096,8 @@ func (s *Server) handleGetPollOptionsRequest(args [1]string, argsEscaped bool, w
 
 		type (
 			Request  = struct{}
-			Params   = GetPollOptionsParams
-			Response = []PollOption
+			Params   = ListPollCommentsParams
+			Response = []PollComment
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -3582,14 +7106,14 @@ func (s *Server) handleGetPollOptionsRequest(args [1]string, argsEscaped bool, w
 		](
 			m,
 			mreq,
-			unpackGetPollOptionsParams,
+			unpackListPollCommentsParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetPollOptions(ctx, params)
+				response, err = s.h.ListPollComments(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetPollOptions(ctx, params)
+		response, err = s.h.ListPollComments(ctx, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -3597,7 +7121,7 @@ func (s *Server) handleGetPollOptionsRequest(args [1]string, argsEscaped bool, w
 		return
 	}
 
-	if err := encodeGetPollOptionsResponse(response, w, span); err != nil {
+	if err := encodeListPollCommentsResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -3606,22 +7130,22 @@ func (s *Server) handleGetPollOptionsRequest(args [1]string, argsEscaped bool, w
 	}
 }
 
-// handleGetPollResultsRequest handles getPollResults operation.
+// handleListPollInvitesRequest handles listPollInvites operation.
 //
-// Get poll results.
+// List invitees of a poll and whether they voted.
 //
-// GET /p/poll/{slug}/results
-func (s *Server) handleGetPollResultsRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /polls/{id}/invites
+func (s *Server) handleListPollInvitesRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getPollResults"),
+		otelogen.OperationID("listPollInvites"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/p/poll/{slug}/results"),
+		semconv.HTTPRouteKey.String("/polls/{id}/invites"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), GetPollResultsOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), ListPollInvitesOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -3676,11 +7200,55 @@ func (s *Server) handleGetPollResultsRequest(args [1]string, argsEscaped bool, w
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetPollResultsOperation,
-			ID:   "getPollResults",
+			Name: ListPollInvitesOperation,
+			ID:   "listPollInvites",
 		}
 	)
-	params, err := decodeGetPollResultsParams(args, argsEscaped, r)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityCookieAuth(ctx, ListPollInvitesOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "CookieAuth",
+					Err:              err,
+				}
+				defer recordError("Security:CookieAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodeListPollInvitesParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -3693,28 +7261,28 @@ func (s *Server) handleGetPollResultsRequest(args [1]string, argsEscaped bool, w
 
 	var rawBody []byte
 
-	var response GetPollResultsRes
+	var response []PollInvite
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetPollResultsOperation,
-			OperationSummary: "Get poll results",
-			OperationID:      "getPollResults",
+			OperationName:    ListPollInvitesOperation,
+			OperationSummary: "List invitees of a poll and whether they voted",
+			OperationID:      "listPollInvites",
 			Body:             nil,
 			RawBody:          rawBody,
 			Params: middleware.Parameters{
 				{
-					Name: "slug",
+					Name: "id",
 					In:   "path",
-				}: params.Slug,
+				}: params.ID,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = GetPollResultsParams
-			Response = GetPollResultsRes
+			Params   = ListPollInvitesParams
+			Response = []PollInvite
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -3723,14 +7291,14 @@ func (s *Server) handleGetPollResultsRequest(args [1]string, argsEscaped bool, w
 		](
 			m,
 			mreq,
-			unpackGetPollResultsParams,
+			unpackListPollInvitesParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetPollResults(ctx, params)
+				response, err = s.h.ListPollInvites(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetPollResults(ctx, params)
+		response, err = s.h.ListPollInvites(ctx, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -3738,7 +7306,7 @@ func (s *Server) handleGetPollResultsRequest(args [1]string, argsEscaped bool, w
 		return
 	}
 
-	if err := encodeGetPollResultsResponse(response, w, span); err != nil {
+	if err := encodeListPollInvitesResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -3747,22 +7315,22 @@ func (s *Server) handleGetPollResultsRequest(args [1]string, argsEscaped bool, w
 	}
 }
 
-// handleGetPollVotesRequest handles getPollVotes operation.
+// handleListPollProposalsRequest handles listPollProposals operation.
 //
-// Get votes for a poll.
+// List the option proposals waiting for approval.
 //
-// GET /polls/{id}/votes
-func (s *Server) handleGetPollVotesRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /polls/{id}/proposals
+func (s *Server) handleListPollProposalsRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getPollVotes"),
+		otelogen.OperationID("listPollProposals"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/polls/{id}/votes"),
+		semconv.HTTPRouteKey.String("/polls/{id}/proposals"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), GetPollVotesOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), ListPollProposalsOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -3817,15 +7385,15 @@ func (s *Server) handleGetPollVotesRequest(args [1]string, argsEscaped bool, w h
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetPollVotesOperation,
-			ID:   "getPollVotes",
+			Name: ListPollProposalsOperation,
+			ID:   "listPollProposals",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityCookieAuth(ctx, GetPollVotesOperation, r)
+			sctx, ok, err := s.securityCookieAuth(ctx, ListPollProposalsOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -3865,7 +7433,7 @@ func (s *Server) handleGetPollVotesRequest(args [1]string, argsEscaped bool, w h
 			return
 		}
 	}
-	params, err := decodeGetPollVotesParams(args, argsEscaped, r)
+	params, err := decodeListPollProposalsParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -3878,13 +7446,13 @@ func (s *Server) handleGetPollVotesRequest(args [1]string, argsEscaped bool, w h
 
 	var rawBody []byte
 
-	var response []Vote
+	var response []PollProposal
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetPollVotesOperation,
-			OperationSummary: "Get votes for a poll",
-			OperationID:      "getPollVotes",
+			OperationName:    ListPollProposalsOperation,
+			OperationSummary: "List the option proposals waiting for approval",
+			OperationID:      "listPollProposals",
 			Body:             nil,
 			RawBody:          rawBody,
 			Params: middleware.Parameters{
@@ -3898,8 +7466,8 @@ func (s *Server) handleGetPollVotesRequest(args [1]string, argsEscaped bool, w h
 
 		type (
 			Request  = struct{}
-			Params   = GetPollVotesParams
-			Response = []Vote
+			Params   = ListPollProposalsParams
+			Response = []PollProposal
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -3908,14 +7476,14 @@ func (s *Server) handleGetPollVotesRequest(args [1]string, argsEscaped bool, w h
 		](
 			m,
 			mreq,
-			unpackGetPollVotesParams,
+			unpackListPollProposalsParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetPollVotes(ctx, params)
+				response, err = s.h.ListPollProposals(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetPollVotes(ctx, params)
+		response, err = s.h.ListPollProposals(ctx, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -3923,7 +7491,7 @@ func (s *Server) handleGetPollVotesRequest(args [1]string, argsEscaped bool, w h
 		return
 	}
 
-	if err := encodeGetPollVotesResponse(response, w, span); err != nil {
+	if err := encodeListPollProposalsResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -3932,22 +7500,22 @@ func (s *Server) handleGetPollVotesRequest(args [1]string, argsEscaped bool, w h
 	}
 }
 
-// handleGetPublicBookingLinkRequest handles getPublicBookingLink operation.
+// handleListPollsRequest handles listPolls operation.
 //
-// Get public booking link info.
+// List all polls.
 //
-// GET /p/booking/{slug}
-func (s *Server) handleGetPublicBookingLinkRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /polls
+func (s *Server) handleListPollsRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getPublicBookingLink"),
+		otelogen.OperationID("listPolls"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/p/booking/{slug}"),
+		semconv.HTTPRouteKey.String("/polls"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), GetPublicBookingLinkOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), ListPollsOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -4002,45 +7570,74 @@ func (s *Server) handleGetPublicBookingLinkRequest(args [1]string, argsEscaped b
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetPublicBookingLinkOperation,
-			ID:   "getPublicBookingLink",
+			Name: ListPollsOperation,
+			ID:   "listPolls",
 		}
 	)
-	params, err := decodeGetPublicBookingLinkParams(args, argsEscaped, r)
-	if err != nil {
-		err = &ogenerrors.DecodeParamsError{
-			OperationContext: opErrContext,
-			Err:              err,
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityCookieAuth(ctx, ListPollsOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "CookieAuth",
+					Err:              err,
+				}
+				defer recordError("Security:CookieAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
 		}
-		defer recordError("DecodeParams", err)
-		s.cfg.ErrorHandler(ctx, w, r, err)
-		return
 	}
 
 	var rawBody []byte
 
-	var response GetPublicBookingLinkRes
+	var response []Poll
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetPublicBookingLinkOperation,
-			OperationSummary: "Get public booking link info",
-			OperationID:      "getPublicBookingLink",
+			OperationName:    ListPollsOperation,
+			OperationSummary: "List all polls",
+			OperationID:      "listPolls",
 			Body:             nil,
 			RawBody:          rawBody,
-			Params: middleware.Parameters{
-				{
-					Name: "slug",
-					In:   "path",
-				}: params.Slug,
-			},
-			Raw: r,
+			Params:           middleware.Parameters{},
+			Raw:              r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = GetPublicBookingLinkParams
-			Response = GetPublicBookingLinkRes
+			Params   = struct{}
+			Response = []Poll
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -4049,14 +7646,14 @@ func (s *Server) handleGetPublicBookingLinkRequest(args [1]string, argsEscaped b
 		](
 			m,
 			mreq,
-			unpackGetPublicBookingLinkParams,
+			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetPublicBookingLink(ctx, params)
+				response, err = s.h.ListPolls(ctx)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetPublicBookingLink(ctx, params)
+		response, err = s.h.ListPolls(ctx)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -4064,7 +7661,7 @@ func (s *Server) handleGetPublicBookingLinkRequest(args [1]string, argsEscaped b
 		return
 	}
 
-	if err := encodeGetPublicBookingLinkResponse(response, w, span); err != nil {
+	if err := encodeListPollsResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -4073,22 +7670,22 @@ func (s *Server) handleGetPublicBookingLinkRequest(args [1]string, argsEscaped b
 	}
 }
 
-// handleGetPublicPollRequest handles getPublicPoll operation.
+// handleLogoutRequest handles logout operation.
 //
-// Get public poll info.
+// Clear session.
 //
-// GET /p/poll/{slug}
-func (s *Server) handleGetPublicPollRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /auth/logout
+func (s *Server) handleLogoutRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getPublicPoll"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/p/poll/{slug}"),
+		otelogen.OperationID("logout"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/auth/logout"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), GetPublicPollOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), LogoutOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -4138,50 +7735,79 @@ func (s *Server) handleGetPublicPollRequest(args [1]string, argsEscaped bool, w
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
-
-			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
-		}
-		err          error
-		opErrContext = ogenerrors.OperationContext{
-			Name: GetPublicPollOperation,
-			ID:   "getPublicPoll",
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: LogoutOperation,
+			ID:   "logout",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityCookieAuth(ctx, LogoutOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "CookieAuth",
+					Err:              err,
+				}
+				defer recordError("Security:CookieAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
 		}
-	)
-	params, err := decodeGetPublicPollParams(args, argsEscaped, r)
-	if err != nil {
-		err = &ogenerrors.DecodeParamsError{
-			OperationContext: opErrContext,
-			Err:              err,
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
 		}
-		defer recordError("DecodeParams", err)
-		s.cfg.ErrorHandler(ctx, w, r, err)
-		return
 	}
 
 	var rawBody []byte
 
-	var response GetPublicPollRes
+	var response *LogoutOK
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetPublicPollOperation,
-			OperationSummary: "Get public poll info",
-			OperationID:      "getPublicPoll",
+			OperationName:    LogoutOperation,
+			OperationSummary: "Clear session",
+			OperationID:      "logout",
 			Body:             nil,
 			RawBody:          rawBody,
-			Params: middleware.Parameters{
-				{
-					Name: "slug",
-					In:   "path",
-				}: params.Slug,
-			},
-			Raw: r,
+			Params:           middleware.Parameters{},
+			Raw:              r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = GetPublicPollParams
-			Response = GetPublicPollRes
+			Params   = struct{}
+			Response = *LogoutOK
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -4190,14 +7816,14 @@ func (s *Server) handleGetPublicPollRequest(args [1]string, argsEscaped bool, w
 		](
 			m,
 			mreq,
-			unpackGetPublicPollParams,
+			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetPublicPoll(ctx, params)
+				err = s.h.Logout(ctx)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetPublicPoll(ctx, params)
+		err = s.h.Logout(ctx)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -4205,7 +7831,7 @@ func (s *Server) handleGetPublicPollRequest(args [1]string, argsEscaped bool, w
 		return
 	}
 
-	if err := encodeGetPublicPollResponse(response, w, span); err != nil {
+	if err := encodeLogoutResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -4214,22 +7840,22 @@ func (s *Server) handleGetPublicPollRequest(args [1]string, argsEscaped bool, w
 	}
 }
 
-// handleInitiateLoginRequest handles initiateLogin operation.
+// handleModeratePollCommentRequest handles moderatePollComment operation.
 //
-// Redirect to OIDC provider.
+// Hide or show a comment.
 //
-// GET /auth/login
-func (s *Server) handleInitiateLoginRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// PUT /polls/{id}/comments/{commentId}
+func (s *Server) handleModeratePollCommentRequest(args [2]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("initiateLogin"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/auth/login"),
+		otelogen.OperationID("moderatePollComment"),
+		semconv.HTTPRequestMethodKey.String("PUT"),
+		semconv.HTTPRouteKey.String("/polls/{id}/comments/{commentId}"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), InitiateLoginOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), ModeratePollCommentOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -4282,28 +7908,110 @@ func (s *Server) handleInitiateLoginRequest(args [0]string, argsEscaped bool, w
 
 			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
 		}
-		err error
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: ModeratePollCommentOperation,
+			ID:   "moderatePollComment",
+		}
 	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityCookieAuth(ctx, ModeratePollCommentOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "CookieAuth",
+					Err:              err,
+				}
+				defer recordError("Security:CookieAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodeModeratePollCommentParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
 
 	var rawBody []byte
+	request, rawBody, close, err := s.decodeModeratePollCommentRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
 
-	var response *InitiateLoginFound
+	var response *PollComment
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    InitiateLoginOperation,
-			OperationSummary: "Redirect to OIDC provider",
-			OperationID:      "initiateLogin",
-			Body:             nil,
+			OperationName:    ModeratePollCommentOperation,
+			OperationSummary: "Hide or show a comment",
+			OperationID:      "moderatePollComment",
+			Body:             request,
 			RawBody:          rawBody,
-			Params:           middleware.Parameters{},
-			Raw:              r,
+			Params: middleware.Parameters{
+				{
+					Name: "id",
+					In:   "path",
+				}: params.ID,
+				{
+					Name: "commentId",
+					In:   "path",
+				}: params.CommentId,
+			},
+			Raw: r,
 		}
 
 		type (
-			Request  = struct{}
-			Params   = struct{}
-			Response = *InitiateLoginFound
+			Request  = *ModeratePollCommentReq
+			Params   = ModeratePollCommentParams
+			Response = *PollComment
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -4312,14 +8020,14 @@ func (s *Server) handleInitiateLoginRequest(args [0]string, argsEscaped bool, w
 		](
 			m,
 			mreq,
-			nil,
+			unpackModeratePollCommentParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.InitiateLogin(ctx)
+				response, err = s.h.ModeratePollComment(ctx, request, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.InitiateLogin(ctx)
+		response, err = s.h.ModeratePollComment(ctx, request, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -4327,7 +8035,7 @@ func (s *Server) handleInitiateLoginRequest(args [0]string, argsEscaped bool, w
 		return
 	}
 
-	if err := encodeInitiateLoginResponse(response, w, span); err != nil {
+	if err := encodeModeratePollCommentResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -4336,22 +8044,22 @@ func (s *Server) handleInitiateLoginRequest(args [0]string, argsEscaped bool, w
 	}
 }
 
-// handleListBookingLinksRequest handles listBookingLinks operation.
+// handlePickPollWinnerRequest handles pickPollWinner operation.
 //
-// List all booking links.
+// Pick winning option for poll.
 //
-// GET /booking-links
-func (s *Server) handleListBookingLinksRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /polls/{id}/pick-winner
+func (s *Server) handlePickPollWinnerRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("listBookingLinks"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/booking-links"),
+		otelogen.OperationID("pickPollWinner"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/polls/{id}/pick-winner"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), ListBookingLinksOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), PickPollWinnerOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -4406,15 +8114,15 @@ func (s *Server) handleListBookingLinksRequest(args [0]string, argsEscaped bool,
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: ListBookingLinksOperation,
-			ID:   "listBookingLinks",
+			Name: PickPollWinnerOperation,
+			ID:   "pickPollWinner",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityCookieAuth(ctx, ListBookingLinksOperation, r)
+			sctx, ok, err := s.securityCookieAuth(ctx, PickPollWinnerOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -4454,26 +8162,56 @@ func (s *Server) handleListBookingLinksRequest(args [0]string, argsEscaped bool,
 			return
 		}
 	}
+	params, err := decodePickPollWinnerParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
 
 	var rawBody []byte
+	request, rawBody, close, err := s.decodePickPollWinnerRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
 
-	var response []BookingLink
+	var response PickPollWinnerRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    ListBookingLinksOperation,
-			OperationSummary: "List all booking links",
-			OperationID:      "listBookingLinks",
-			Body:             nil,
+			OperationName:    PickPollWinnerOperation,
+			OperationSummary: "Pick winning option for poll",
+			OperationID:      "pickPollWinner",
+			Body:             request,
 			RawBody:          rawBody,
-			Params:           middleware.Parameters{},
-			Raw:              r,
+			Params: middleware.Parameters{
+				{
+					Name: "id",
+					In:   "path",
+				}: params.ID,
+			},
+			Raw: r,
 		}
 
 		type (
-			Request  = struct{}
-			Params   = struct{}
-			Response = []BookingLink
+			Request  = *PickPollWinnerReq
+			Params   = PickPollWinnerParams
+			Response = PickPollWinnerRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -4482,14 +8220,14 @@ func (s *Server) handleListBookingLinksRequest(args [0]string, argsEscaped bool,
 		](
 			m,
 			mreq,
-			nil,
+			unpackPickPollWinnerParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.ListBookingLinks(ctx)
+				response, err = s.h.PickPollWinner(ctx, request, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.ListBookingLinks(ctx)
+		response, err = s.h.PickPollWinner(ctx, request, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -4497,7 +8235,7 @@ func (s *Server) handleListBookingLinksRequest(args [0]string, argsEscaped bool,
 		return
 	}
 
-	if err := encodeListBookingLinksResponse(response, w, span); err != nil {
+	if err := encodePickPollWinnerResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -4506,22 +8244,23 @@ func (s *Server) handleListBookingLinksRequest(args [0]string, argsEscaped bool,
 	}
 }
 
-// handleListCalendarsRequest handles listCalendars operation.
+// handleProposePollOptionRequest handles proposePollOption operation.
 //
-// List calendar connections.
+// Only accepted if the poll allows proposals. Depending on the poll, the option is added right away
+// or waits for the organizer's approval.
 //
-// GET /calendars
-func (s *Server) handleListCalendarsRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /p/poll/{slug}/proposals
+func (s *Server) handleProposePollOptionRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("listCalendars"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/calendars"),
+		otelogen.OperationID("proposePollOption"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/p/poll/{slug}/proposals"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), ListCalendarsOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), ProposePollOptionOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -4576,74 +8315,60 @@ func (s *Server) handleListCalendarsRequest(args [0]string, argsEscaped bool, w
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: ListCalendarsOperation,
-			ID:   "listCalendars",
+			Name: ProposePollOptionOperation,
+			ID:   "proposePollOption",
 		}
 	)
-	{
-		type bitset = [1]uint8
-		var satisfied bitset
-		{
-			sctx, ok, err := s.securityCookieAuth(ctx, ListCalendarsOperation, r)
-			if err != nil {
-				err = &ogenerrors.SecurityError{
-					OperationContext: opErrContext,
-					Security:         "CookieAuth",
-					Err:              err,
-				}
-				defer recordError("Security:CookieAuth", err)
-				s.cfg.ErrorHandler(ctx, w, r, err)
-				return
-			}
-			if ok {
-				satisfied[0] |= 1 << 0
-				ctx = sctx
-			}
-		}
-
-		if ok := func() bool {
-		nextRequirement:
-			for _, requirement := range []bitset{
-				{0b00000001},
-			} {
-				for i, mask := range requirement {
-					if satisfied[i]&mask != mask {
-						continue nextRequirement
-					}
-				}
-				return true
-			}
-			return false
-		}(); !ok {
-			err = &ogenerrors.SecurityError{
-				OperationContext: opErrContext,
-				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
-			}
-			defer recordError("Security", err)
-			s.cfg.ErrorHandler(ctx, w, r, err)
-			return
+	params, err := decodeProposePollOptionParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
 		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
 	}
 
 	var rawBody []byte
+	request, rawBody, close, err := s.decodeProposePollOptionRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
 
-	var response []CalendarConnection
+	var response ProposePollOptionRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    ListCalendarsOperation,
-			OperationSummary: "List calendar connections",
-			OperationID:      "listCalendars",
-			Body:             nil,
+			OperationName:    ProposePollOptionOperation,
+			OperationSummary: "Propose an option for a poll",
+			OperationID:      "proposePollOption",
+			Body:             request,
 			RawBody:          rawBody,
-			Params:           middleware.Parameters{},
-			Raw:              r,
+			Params: middleware.Parameters{
+				{
+					Name: "slug",
+					In:   "path",
+				}: params.Slug,
+			},
+			Raw: r,
 		}
 
 		type (
-			Request  = struct{}
-			Params   = struct{}
-			Response = []CalendarConnection
+			Request  = *ProposePollOptionReq
+			Params   = ProposePollOptionParams
+			Response = ProposePollOptionRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -4652,14 +8377,14 @@ func (s *Server) handleListCalendarsRequest(args [0]string, argsEscaped bool, w
 		](
 			m,
 			mreq,
-			nil,
+			unpackProposePollOptionParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.ListCalendars(ctx)
+				response, err = s.h.ProposePollOption(ctx, request, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.ListCalendars(ctx)
+		response, err = s.h.ProposePollOption(ctx, request, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -4667,7 +8392,7 @@ func (s *Server) handleListCalendarsRequest(args [0]string, argsEscaped bool, w
 		return
 	}
 
-	if err := encodeListCalendarsResponse(response, w, span); err != nil {
+	if err := encodeProposePollOptionResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -4676,22 +8401,22 @@ func (s *Server) handleListCalendarsRequest(args [0]string, argsEscaped bool, w
 	}
 }
 
-// handleListPollsRequest handles listPolls operation.
+// handleRemindPollInvitesRequest handles remindPollInvites operation.
 //
-// List all polls.
+// Send a reminder to all invitees who haven't voted yet.
 //
-// GET /polls
-func (s *Server) handleListPollsRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /polls/{id}/invites/remind
+func (s *Server) handleRemindPollInvitesRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("listPolls"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/polls"),
+		otelogen.OperationID("remindPollInvites"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/polls/{id}/invites/remind"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), ListPollsOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), RemindPollInvitesOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -4746,15 +8471,15 @@ func (s *Server) handleListPollsRequest(args [0]string, argsEscaped bool, w http
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: ListPollsOperation,
-			ID:   "listPolls",
+			Name: RemindPollInvitesOperation,
+			ID:   "remindPollInvites",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityCookieAuth(ctx, ListPollsOperation, r)
+			sctx, ok, err := s.securityCookieAuth(ctx, RemindPollInvitesOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -4794,26 +8519,41 @@ func (s *Server) handleListPollsRequest(args [0]string, argsEscaped bool, w http
 			return
 		}
 	}
+	params, err := decodeRemindPollInvitesParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
 
 	var rawBody []byte
 
-	var response []Poll
+	var response *RemindPollInvitesOK
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    ListPollsOperation,
-			OperationSummary: "List all polls",
-			OperationID:      "listPolls",
+			OperationName:    RemindPollInvitesOperation,
+			OperationSummary: "Send a reminder to all invitees who haven't voted yet",
+			OperationID:      "remindPollInvites",
 			Body:             nil,
 			RawBody:          rawBody,
-			Params:           middleware.Parameters{},
-			Raw:              r,
+			Params: middleware.Parameters{
+				{
+					Name: "id",
+					In:   "path",
+				}: params.ID,
+			},
+			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = struct{}
-			Response = []Poll
+			Params   = RemindPollInvitesParams
+			Response = *RemindPollInvitesOK
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -4822,14 +8562,14 @@ func (s *Server) handleListPollsRequest(args [0]string, argsEscaped bool, w http
 		](
 			m,
 			mreq,
-			nil,
+			unpackRemindPollInvitesParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.ListPolls(ctx)
+				response, err = s.h.RemindPollInvites(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.ListPolls(ctx)
+		response, err = s.h.RemindPollInvites(ctx, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -4837,7 +8577,7 @@ func (s *Server) handleListPollsRequest(args [0]string, argsEscaped bool, w http
 		return
 	}
 
-	if err := encodeListPollsResponse(response, w, span); err != nil {
+	if err := encodeRemindPollInvitesResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -4846,22 +8586,22 @@ func (s *Server) handleListPollsRequest(args [0]string, argsEscaped bool, w http
 	}
 }
 
-// handleLogoutRequest handles logout operation.
+// handleRemoveCalendarRequest handles removeCalendar operation.
 //
-// Clear session.
+// Remove calendar connection.
 //
-// POST /auth/logout
-func (s *Server) handleLogoutRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// DELETE /calendars/{id}
+func (s *Server) handleRemoveCalendarRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("logout"),
-		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/auth/logout"),
+		otelogen.OperationID("removeCalendar"),
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.HTTPRouteKey.String("/calendars/{id}"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), LogoutOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), RemoveCalendarOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -4916,15 +8656,15 @@ func (s *Server) handleLogoutRequest(args [0]string, argsEscaped bool, w http.Re
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: LogoutOperation,
-			ID:   "logout",
+			Name: RemoveCalendarOperation,
+			ID:   "removeCalendar",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityCookieAuth(ctx, LogoutOperation, r)
+			sctx, ok, err := s.securityCookieAuth(ctx, RemoveCalendarOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -4964,26 +8704,41 @@ func (s *Server) handleLogoutRequest(args [0]string, argsEscaped bool, w http.Re
 			return
 		}
 	}
+	params, err := decodeRemoveCalendarParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
 
 	var rawBody []byte
 
-	var response *LogoutOK
+	var response *RemoveCalendarNoContent
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    LogoutOperation,
-			OperationSummary: "Clear session",
-			OperationID:      "logout",
+			OperationName:    RemoveCalendarOperation,
+			OperationSummary: "Remove calendar connection",
+			OperationID:      "removeCalendar",
 			Body:             nil,
 			RawBody:          rawBody,
-			Params:           middleware.Parameters{},
-			Raw:              r,
+			Params: middleware.Parameters{
+				{
+					Name: "id",
+					In:   "path",
+				}: params.ID,
+			},
+			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = struct{}
-			Response = *LogoutOK
+			Params   = RemoveCalendarParams
+			Response = *RemoveCalendarNoContent
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -4992,14 +8747,14 @@ func (s *Server) handleLogoutRequest(args [0]string, argsEscaped bool, w http.Re
 		](
 			m,
 			mreq,
-			nil,
+			unpackRemoveCalendarParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				err = s.h.Logout(ctx)
+				err = s.h.RemoveCalendar(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		err = s.h.Logout(ctx)
+		err = s.h.RemoveCalendar(ctx, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -5007,7 +8762,7 @@ func (s *Server) handleLogoutRequest(args [0]string, argsEscaped bool, w http.Re
 		return
 	}
 
-	if err := encodeLogoutResponse(response, w, span); err != nil {
+	if err := encodeRemoveCalendarResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -5016,22 +8771,22 @@ func (s *Server) handleLogoutRequest(args [0]string, argsEscaped bool, w http.Re
 	}
 }
 
-// handlePickPollWinnerRequest handles pickPollWinner operation.
+// handleSubmitVoteRequest handles submitVote operation.
 //
-// Pick winning option for poll.
+// Submit poll vote.
 //
-// POST /polls/{id}/pick-winner
-func (s *Server) handlePickPollWinnerRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /p/poll/{slug}/vote
+func (s *Server) handleSubmitVoteRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("pickPollWinner"),
+		otelogen.OperationID("submitVote"),
 		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/polls/{id}/pick-winner"),
+		semconv.HTTPRouteKey.String("/p/poll/{slug}/vote"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), PickPollWinnerOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), SubmitVoteOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -5075,66 +8830,22 @@ func (s *Server) handlePickPollWinnerRequest(args [1]string, argsEscaped bool, w
 			if code < 100 || code >= 500 {
 				span.SetStatus(codes.Error, stage)
 			}
-
-			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
-			if code != 0 {
-				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
-			}
-
-			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
-		}
-		err          error
-		opErrContext = ogenerrors.OperationContext{
-			Name: PickPollWinnerOperation,
-			ID:   "pickPollWinner",
-		}
-	)
-	{
-		type bitset = [1]uint8
-		var satisfied bitset
-		{
-			sctx, ok, err := s.securityCookieAuth(ctx, PickPollWinnerOperation, r)
-			if err != nil {
-				err = &ogenerrors.SecurityError{
-					OperationContext: opErrContext,
-					Security:         "CookieAuth",
-					Err:              err,
-				}
-				defer recordError("Security:CookieAuth", err)
-				s.cfg.ErrorHandler(ctx, w, r, err)
-				return
-			}
-			if ok {
-				satisfied[0] |= 1 << 0
-				ctx = sctx
-			}
-		}
-
-		if ok := func() bool {
-		nextRequirement:
-			for _, requirement := range []bitset{
-				{0b00000001},
-			} {
-				for i, mask := range requirement {
-					if satisfied[i]&mask != mask {
-						continue nextRequirement
-					}
-				}
-				return true
-			}
-			return false
-		}(); !ok {
-			err = &ogenerrors.SecurityError{
-				OperationContext: opErrContext,
-				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
-			defer recordError("Security", err)
-			s.cfg.ErrorHandler(ctx, w, r, err)
-			return
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
 		}
-	}
-	params, err := decodePickPollWinnerParams(args, argsEscaped, r)
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: SubmitVoteOperation,
+			ID:   "submitVote",
+		}
+	)
+	params, err := decodeSubmitVoteParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -5146,7 +8857,7 @@ func (s *Server) handlePickPollWinnerRequest(args [1]string, argsEscaped bool, w
 	}
 
 	var rawBody []byte
-	request, rawBody, close, err := s.decodePickPollWinnerRequest(r)
+	request, rawBody, close, err := s.decodeSubmitVoteRequest(r)
 	if err != nil {
 		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
@@ -5162,28 +8873,28 @@ func (s *Server) handlePickPollWinnerRequest(args [1]string, argsEscaped bool, w
 		}
 	}()
 
-	var response *PickPollWinnerOK
+	var response SubmitVoteRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    PickPollWinnerOperation,
-			OperationSummary: "Pick winning option for poll",
-			OperationID:      "pickPollWinner",
+			OperationName:    SubmitVoteOperation,
+			OperationSummary: "Submit poll vote",
+			OperationID:      "submitVote",
 			Body:             request,
 			RawBody:          rawBody,
 			Params: middleware.Parameters{
 				{
-					Name: "id",
+					Name: "slug",
 					In:   "path",
-				}: params.ID,
+				}: params.Slug,
 			},
 			Raw: r,
 		}
 
 		type (
-			Request  = *PickPollWinnerReq
-			Params   = PickPollWinnerParams
-			Response = *PickPollWinnerOK
+			Request  = *SubmitVoteReq
+			Params   = SubmitVoteParams
+			Response = SubmitVoteRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -5192,14 +8903,14 @@ func (s *Server) handlePickPollWinnerRequest(args [1]string, argsEscaped bool, w
 		](
 			m,
 			mreq,
-			unpackPickPollWinnerParams,
+			unpackSubmitVoteParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				err = s.h.PickPollWinner(ctx, request, params)
+				response, err = s.h.SubmitVote(ctx, request, params)
 				return response, err
 			},
 		)
 	} else {
-		err = s.h.PickPollWinner(ctx, request, params)
+		response, err = s.h.SubmitVote(ctx, request, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -5207,7 +8918,7 @@ func (s *Server) handlePickPollWinnerRequest(args [1]string, argsEscaped bool, w
 		return
 	}
 
-	if err := encodePickPollWinnerResponse(response, w, span); err != nil {
+	if err := encodeSubmitVoteResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -5216,22 +8927,23 @@ func (s *Server) handlePickPollWinnerRequest(args [1]string, argsEscaped bool, w
 	}
 }
 
-// handleRemoveCalendarRequest handles removeCalendar operation.
+// handleSuggestPollOptionsRequest handles suggestPollOptions operation.
 //
-// Remove calendar connection.
+// Runs the booking availability logic for the given range: slots within the availability rules that
+// don't overlap busy times in the connected calendars. Times the poll already offers are skipped.
 //
-// DELETE /calendars/{id}
-func (s *Server) handleRemoveCalendarRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /polls/{id}/options/suggest
+func (s *Server) handleSuggestPollOptionsRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("removeCalendar"),
-		semconv.HTTPRequestMethodKey.String("DELETE"),
-		semconv.HTTPRouteKey.String("/calendars/{id}"),
+		otelogen.OperationID("suggestPollOptions"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/polls/{id}/options/suggest"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), RemoveCalendarOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), SuggestPollOptionsOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -5286,15 +8998,15 @@ func (s *Server) handleRemoveCalendarRequest(args [1]string, argsEscaped bool, w
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: RemoveCalendarOperation,
-			ID:   "removeCalendar",
+			Name: SuggestPollOptionsOperation,
+			ID:   "suggestPollOptions",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityCookieAuth(ctx, RemoveCalendarOperation, r)
+			sctx, ok, err := s.securityCookieAuth(ctx, SuggestPollOptionsOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -5334,7 +9046,7 @@ func (s *Server) handleRemoveCalendarRequest(args [1]string, argsEscaped bool, w
 			return
 		}
 	}
-	params, err := decodeRemoveCalendarParams(args, argsEscaped, r)
+	params, err := decodeSuggestPollOptionsParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -5346,15 +9058,30 @@ func (s *Server) handleRemoveCalendarRequest(args [1]string, argsEscaped bool, w
 	}
 
 	var rawBody []byte
+	request, rawBody, close, err := s.decodeSuggestPollOptionsRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
 
-	var response *RemoveCalendarNoContent
+	var response SuggestPollOptionsRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    RemoveCalendarOperation,
-			OperationSummary: "Remove calendar connection",
-			OperationID:      "removeCalendar",
-			Body:             nil,
+			OperationName:    SuggestPollOptionsOperation,
+			OperationSummary: "Suggest poll options from the organizer's free time",
+			OperationID:      "suggestPollOptions",
+			Body:             request,
 			RawBody:          rawBody,
 			Params: middleware.Parameters{
 				{
@@ -5366,9 +9093,9 @@ func (s *Server) handleRemoveCalendarRequest(args [1]string, argsEscaped bool, w
 		}
 
 		type (
-			Request  = struct{}
-			Params   = RemoveCalendarParams
-			Response = *RemoveCalendarNoContent
+			Request  = *SuggestPollOptionsReq
+			Params   = SuggestPollOptionsParams
+			Response = SuggestPollOptionsRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -5377,14 +9104,14 @@ func (s *Server) handleRemoveCalendarRequest(args [1]string, argsEscaped bool, w
 		](
 			m,
 			mreq,
-			unpackRemoveCalendarParams,
+			unpackSuggestPollOptionsParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				err = s.h.RemoveCalendar(ctx, params)
+				response, err = s.h.SuggestPollOptions(ctx, request, params)
 				return response, err
 			},
 		)
 	} else {
-		err = s.h.RemoveCalendar(ctx, params)
+		response, err = s.h.SuggestPollOptions(ctx, request, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -5392,7 +9119,7 @@ func (s *Server) handleRemoveCalendarRequest(args [1]string, argsEscaped bool, w
 		return
 	}
 
-	if err := encodeRemoveCalendarResponse(response, w, span); err != nil {
+	if err := encodeSuggestPollOptionsResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -5401,22 +9128,22 @@ func (s *Server) handleRemoveCalendarRequest(args [1]string, argsEscaped bool, w
 	}
 }
 
-// handleSubmitVoteRequest handles submitVote operation.
+// handleTestCalendarRequest handles testCalendar operation.
 //
-// Submit poll vote.
+// Test calendar connection by fetching events.
 //
-// POST /p/poll/{slug}/vote
-func (s *Server) handleSubmitVoteRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /calendars/{id}/test
+func (s *Server) handleTestCalendarRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("submitVote"),
+		otelogen.OperationID("testCalendar"),
 		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/p/poll/{slug}/vote"),
+		semconv.HTTPRouteKey.String("/calendars/{id}/test"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), SubmitVoteOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), TestCalendarOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -5471,11 +9198,55 @@ func (s *Server) handleSubmitVoteRequest(args [1]string, argsEscaped bool, w htt
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: SubmitVoteOperation,
-			ID:   "submitVote",
+			Name: TestCalendarOperation,
+			ID:   "testCalendar",
 		}
 	)
-	params, err := decodeSubmitVoteParams(args, argsEscaped, r)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityCookieAuth(ctx, TestCalendarOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "CookieAuth",
+					Err:              err,
+				}
+				defer recordError("Security:CookieAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodeTestCalendarParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -5487,44 +9258,29 @@ func (s *Server) handleSubmitVoteRequest(args [1]string, argsEscaped bool, w htt
 	}
 
 	var rawBody []byte
-	request, rawBody, close, err := s.decodeSubmitVoteRequest(r)
-	if err != nil {
-		err = &ogenerrors.DecodeRequestError{
-			OperationContext: opErrContext,
-			Err:              err,
-		}
-		defer recordError("DecodeRequest", err)
-		s.cfg.ErrorHandler(ctx, w, r, err)
-		return
-	}
-	defer func() {
-		if err := close(); err != nil {
-			recordError("CloseRequest", err)
-		}
-	}()
 
-	var response *Vote
+	var response TestCalendarRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    SubmitVoteOperation,
-			OperationSummary: "Submit poll vote",
-			OperationID:      "submitVote",
-			Body:             request,
+			OperationName:    TestCalendarOperation,
+			OperationSummary: "Test calendar connection by fetching events",
+			OperationID:      "testCalendar",
+			Body:             nil,
 			RawBody:          rawBody,
 			Params: middleware.Parameters{
 				{
-					Name: "slug",
+					Name: "id",
 					In:   "path",
-				}: params.Slug,
+				}: params.ID,
 			},
 			Raw: r,
 		}
 
 		type (
-			Request  = *SubmitVoteReq
-			Params   = SubmitVoteParams
-			Response = *Vote
+			Request  = struct{}
+			Params   = TestCalendarParams
+			Response = TestCalendarRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -5533,14 +9289,14 @@ func (s *Server) handleSubmitVoteRequest(args [1]string, argsEscaped bool, w htt
 		](
 			m,
 			mreq,
-			unpackSubmitVoteParams,
+			unpackTestCalendarParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.SubmitVote(ctx, request, params)
+				response, err = s.h.TestCalendar(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.SubmitVote(ctx, request, params)
+		response, err = s.h.TestCalendar(ctx, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -5548,7 +9304,7 @@ func (s *Server) handleSubmitVoteRequest(args [1]string, argsEscaped bool, w htt
 		return
 	}
 
-	if err := encodeSubmitVoteResponse(response, w, span); err != nil {
+	if err := encodeTestCalendarResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -5557,22 +9313,22 @@ func (s *Server) handleSubmitVoteRequest(args [1]string, argsEscaped bool, w htt
 	}
 }
 
-// handleTestCalendarRequest handles testCalendar operation.
+// handleUpdateBookingLinkRequest handles updateBookingLink operation.
 //
-// Test calendar connection by fetching events.
+// Update a booking link.
 //
-// POST /calendars/{id}/test
-func (s *Server) handleTestCalendarRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// PUT /booking-links/{id}
+func (s *Server) handleUpdateBookingLinkRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("testCalendar"),
-		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/calendars/{id}/test"),
+		otelogen.OperationID("updateBookingLink"),
+		semconv.HTTPRequestMethodKey.String("PUT"),
+		semconv.HTTPRouteKey.String("/booking-links/{id}"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), TestCalendarOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), UpdateBookingLinkOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -5627,15 +9383,15 @@ func (s *Server) handleTestCalendarRequest(args [1]string, argsEscaped bool, w h
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: TestCalendarOperation,
-			ID:   "testCalendar",
+			Name: UpdateBookingLinkOperation,
+			ID:   "updateBookingLink",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityCookieAuth(ctx, TestCalendarOperation, r)
+			sctx, ok, err := s.securityCookieAuth(ctx, UpdateBookingLinkOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -5675,7 +9431,7 @@ func (s *Server) handleTestCalendarRequest(args [1]string, argsEscaped bool, w h
 			return
 		}
 	}
-	params, err := decodeTestCalendarParams(args, argsEscaped, r)
+	params, err := decodeUpdateBookingLinkParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -5687,15 +9443,30 @@ func (s *Server) handleTestCalendarRequest(args [1]string, argsEscaped bool, w h
 	}
 
 	var rawBody []byte
+	request, rawBody, close, err := s.decodeUpdateBookingLinkRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
 
-	var response TestCalendarRes
+	var response UpdateBookingLinkRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    TestCalendarOperation,
-			OperationSummary: "Test calendar connection by fetching events",
-			OperationID:      "testCalendar",
-			Body:             nil,
+			OperationName:    UpdateBookingLinkOperation,
+			OperationSummary: "Update a booking link",
+			OperationID:      "updateBookingLink",
+			Body:             request,
 			RawBody:          rawBody,
 			Params: middleware.Parameters{
 				{
@@ -5707,9 +9478,9 @@ func (s *Server) handleTestCalendarRequest(args [1]string, argsEscaped bool, w h
 		}
 
 		type (
-			Request  = struct{}
-			Params   = TestCalendarParams
-			Response = TestCalendarRes
+			Request  = *UpdateBookingLinkReq
+			Params   = UpdateBookingLinkParams
+			Response = UpdateBookingLinkRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -5718,14 +9489,14 @@ func (s *Server) handleTestCalendarRequest(args [1]string, argsEscaped bool, w h
 		](
 			m,
 			mreq,
-			unpackTestCalendarParams,
+			unpackUpdateBookingLinkParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.TestCalendar(ctx, params)
+				response, err = s.h.UpdateBookingLink(ctx, request, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.TestCalendar(ctx, params)
+		response, err = s.h.UpdateBookingLink(ctx, request, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -5733,7 +9504,7 @@ func (s *Server) handleTestCalendarRequest(args [1]string, argsEscaped bool, w h
 		return
 	}
 
-	if err := encodeTestCalendarResponse(response, w, span); err != nil {
+	if err := encodeUpdateBookingLinkResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -5742,22 +9513,22 @@ func (s *Server) handleTestCalendarRequest(args [1]string, argsEscaped bool, w h
 	}
 }
 
-// handleUpdateBookingLinkRequest handles updateBookingLink operation.
+// handleUpdateCurrentUserRequest handles updateCurrentUser operation.
 //
-// Update a booking link.
+// Update current user profile.
 //
-// PUT /booking-links/{id}
-func (s *Server) handleUpdateBookingLinkRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// PUT /auth/me
+func (s *Server) handleUpdateCurrentUserRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("updateBookingLink"),
+		otelogen.OperationID("updateCurrentUser"),
 		semconv.HTTPRequestMethodKey.String("PUT"),
-		semconv.HTTPRouteKey.String("/booking-links/{id}"),
+		semconv.HTTPRouteKey.String("/auth/me"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), UpdateBookingLinkOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), UpdateCurrentUserOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -5812,15 +9583,15 @@ func (s *Server) handleUpdateBookingLinkRequest(args [1]string, argsEscaped bool
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: UpdateBookingLinkOperation,
-			ID:   "updateBookingLink",
+			Name: UpdateCurrentUserOperation,
+			ID:   "updateCurrentUser",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityCookieAuth(ctx, UpdateBookingLinkOperation, r)
+			sctx, ok, err := s.securityCookieAuth(ctx, UpdateCurrentUserOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -5860,19 +9631,9 @@ func (s *Server) handleUpdateBookingLinkRequest(args [1]string, argsEscaped bool
 			return
 		}
 	}
-	params, err := decodeUpdateBookingLinkParams(args, argsEscaped, r)
-	if err != nil {
-		err = &ogenerrors.DecodeParamsError{
-			OperationContext: opErrContext,
-			Err:              err,
-		}
-		defer recordError("DecodeParams", err)
-		s.cfg.ErrorHandler(ctx, w, r, err)
-		return
-	}
 
 	var rawBody []byte
-	request, rawBody, close, err := s.decodeUpdateBookingLinkRequest(r)
+	request, rawBody, close, err := s.decodeUpdateCurrentUserRequest(r)
 	if err != nil {
 		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
@@ -5888,28 +9649,23 @@ func (s *Server) handleUpdateBookingLinkRequest(args [1]string, argsEscaped bool
 		}
 	}()
 
-	var response *BookingLink
+	var response UpdateCurrentUserRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    UpdateBookingLinkOperation,
-			OperationSummary: "Update a booking link",
-			OperationID:      "updateBookingLink",
+			OperationName:    UpdateCurrentUserOperation,
+			OperationSummary: "Update current user profile",
+			OperationID:      "updateCurrentUser",
 			Body:             request,
 			RawBody:          rawBody,
-			Params: middleware.Parameters{
-				{
-					Name: "id",
-					In:   "path",
-				}: params.ID,
-			},
-			Raw: r,
+			Params:           middleware.Parameters{},
+			Raw:              r,
 		}
 
 		type (
-			Request  = *UpdateBookingLinkReq
-			Params   = UpdateBookingLinkParams
-			Response = *BookingLink
+			Request  = *UpdateCurrentUserReq
+			Params   = struct{}
+			Response = UpdateCurrentUserRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -5918,14 +9674,14 @@ func (s *Server) handleUpdateBookingLinkRequest(args [1]string, argsEscaped bool
 		](
 			m,
 			mreq,
-			unpackUpdateBookingLinkParams,
+			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.UpdateBookingLink(ctx, request, params)
+				response, err = s.h.UpdateCurrentUser(ctx, request)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.UpdateBookingLink(ctx, request, params)
+		response, err = s.h.UpdateCurrentUser(ctx, request)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -5933,7 +9689,7 @@ func (s *Server) handleUpdateBookingLinkRequest(args [1]string, argsEscaped bool
 		return
 	}
 
-	if err := encodeUpdateBookingLinkResponse(response, w, span); err != nil {
+	if err := encodeUpdateCurrentUserResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -5942,22 +9698,22 @@ func (s *Server) handleUpdateBookingLinkRequest(args [1]string, argsEscaped bool
 	}
 }
 
-// handleUpdateCurrentUserRequest handles updateCurrentUser operation.
+// handleUpdateOwnVoteRequest handles updateOwnVote operation.
 //
-// Update current user profile.
+// Update own vote by edit token.
 //
-// PUT /auth/me
-func (s *Server) handleUpdateCurrentUserRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// PUT /p/poll/{slug}/vote/{token}
+func (s *Server) handleUpdateOwnVoteRequest(args [2]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("updateCurrentUser"),
+		otelogen.OperationID("updateOwnVote"),
 		semconv.HTTPRequestMethodKey.String("PUT"),
-		semconv.HTTPRouteKey.String("/auth/me"),
+		semconv.HTTPRouteKey.String("/p/poll/{slug}/vote/{token}"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), UpdateCurrentUserOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), UpdateOwnVoteOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -6012,57 +9768,23 @@ func (s *Server) handleUpdateCurrentUserRequest(args [0]string, argsEscaped bool
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: UpdateCurrentUserOperation,
-			ID:   "updateCurrentUser",
+			Name: UpdateOwnVoteOperation,
+			ID:   "updateOwnVote",
 		}
 	)
-	{
-		type bitset = [1]uint8
-		var satisfied bitset
-		{
-			sctx, ok, err := s.securityCookieAuth(ctx, UpdateCurrentUserOperation, r)
-			if err != nil {
-				err = &ogenerrors.SecurityError{
-					OperationContext: opErrContext,
-					Security:         "CookieAuth",
-					Err:              err,
-				}
-				defer recordError("Security:CookieAuth", err)
-				s.cfg.ErrorHandler(ctx, w, r, err)
-				return
-			}
-			if ok {
-				satisfied[0] |= 1 << 0
-				ctx = sctx
-			}
-		}
-
-		if ok := func() bool {
-		nextRequirement:
-			for _, requirement := range []bitset{
-				{0b00000001},
-			} {
-				for i, mask := range requirement {
-					if satisfied[i]&mask != mask {
-						continue nextRequirement
-					}
-				}
-				return true
-			}
-			return false
-		}(); !ok {
-			err = &ogenerrors.SecurityError{
-				OperationContext: opErrContext,
-				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
-			}
-			defer recordError("Security", err)
-			s.cfg.ErrorHandler(ctx, w, r, err)
-			return
+	params, err := decodeUpdateOwnVoteParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
 		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
 	}
 
 	var rawBody []byte
-	request, rawBody, close, err := s.decodeUpdateCurrentUserRequest(r)
+	request, rawBody, close, err := s.decodeUpdateOwnVoteRequest(r)
 	if err != nil {
 		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
@@ -6078,23 +9800,32 @@ func (s *Server) handleUpdateCurrentUserRequest(args [0]string, argsEscaped bool
 		}
 	}()
 
-	var response UpdateCurrentUserRes
+	var response UpdateOwnVoteRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    UpdateCurrentUserOperation,
-			OperationSummary: "Update current user profile",
-			OperationID:      "updateCurrentUser",
+			OperationName:    UpdateOwnVoteOperation,
+			OperationSummary: "Update own vote by edit token",
+			OperationID:      "updateOwnVote",
 			Body:             request,
 			RawBody:          rawBody,
-			Params:           middleware.Parameters{},
-			Raw:              r,
+			Params: middleware.Parameters{
+				{
+					Name: "slug",
+					In:   "path",
+				}: params.Slug,
+				{
+					Name: "token",
+					In:   "path",
+				}: params.Token,
+			},
+			Raw: r,
 		}
 
 		type (
-			Request  = *UpdateCurrentUserReq
-			Params   = struct{}
-			Response = UpdateCurrentUserRes
+			Request  = *UpdateOwnVoteReq
+			Params   = UpdateOwnVoteParams
+			Response = UpdateOwnVoteRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -6103,14 +9834,14 @@ func (s *Server) handleUpdateCurrentUserRequest(args [0]string, argsEscaped bool
 		](
 			m,
 			mreq,
-			nil,
+			unpackUpdateOwnVoteParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.UpdateCurrentUser(ctx, request)
+				response, err = s.h.UpdateOwnVote(ctx, request, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.UpdateCurrentUser(ctx, request)
+		response, err = s.h.UpdateOwnVote(ctx, request, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -6118,7 +9849,7 @@ func (s *Server) handleUpdateCurrentUserRequest(args [0]string, argsEscaped bool
 		return
 	}
 
-	if err := encodeUpdateCurrentUserResponse(response, w, span); err != nil {
+	if err := encodeUpdateOwnVoteResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -6273,7 +10004,7 @@ func (s *Server) handleUpdatePollRequest(args [1]string, argsEscaped bool, w htt
 		}
 	}()
 
-	var response *Poll
+	var response UpdatePollRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
@@ -6294,7 +10025,7 @@ func (s *Server) handleUpdatePollRequest(args [1]string, argsEscaped bool, w htt
 		type (
 			Request  = *UpdatePollReq
 			Params   = UpdatePollParams
-			Response = *Poll
+			Response = UpdatePollRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -6326,3 +10057,145 @@ func (s *Server) handleUpdatePollRequest(args [1]string, argsEscaped bool, w htt
 		return
 	}
 }
+
+// handleVerifyBookingEmailRequest handles verifyBookingEmail operation.
+//
+// Confirms a pending booking that matched an email based auto-confirm rule once the guest proved
+// they own the address.
+//
+// GET /actions/verify-email
+func (s *Server) handleVerifyBookingEmailRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("verifyBookingEmail"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/actions/verify-email"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), VerifyBookingEmailOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code < 100 || code >= 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: VerifyBookingEmailOperation,
+			ID:   "verifyBookingEmail",
+		}
+	)
+	params, err := decodeVerifyBookingEmailParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var rawBody []byte
+
+	var response VerifyBookingEmailRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    VerifyBookingEmailOperation,
+			OperationSummary: "Confirm a guest's email address via email link",
+			OperationID:      "verifyBookingEmail",
+			Body:             nil,
+			RawBody:          rawBody,
+			Params: middleware.Parameters{
+				{
+					Name: "token",
+					In:   "query",
+				}: params.Token,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = VerifyBookingEmailParams
+			Response = VerifyBookingEmailRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackVerifyBookingEmailParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.VerifyBookingEmail(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.VerifyBookingEmail(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeVerifyBookingEmailResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
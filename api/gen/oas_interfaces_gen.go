@@ -1,6 +1,18 @@
 // Code generated by ogen, DO NOT EDIT.
 package api
 
+type AddPollCommentRes interface {
+	addPollCommentRes()
+}
+
+type AddPollInvitesRes interface {
+	addPollInvitesRes()
+}
+
+type ApprovePollProposalRes interface {
+	approvePollProposalRes()
+}
+
 type ApproveViaEmailRes interface {
 	approveViaEmailRes()
 }
@@ -9,18 +21,42 @@ type AuthCallbackRes interface {
 	authCallbackRes()
 }
 
+type AutofillVoteRes interface {
+	autofillVoteRes()
+}
+
+type CreateBookingLinkRes interface {
+	createBookingLinkRes()
+}
+
 type CreateBookingRes interface {
 	createBookingRes()
 }
 
+type CreatePollRes interface {
+	createPollRes()
+}
+
 type DeclineViaEmailRes interface {
 	declineViaEmailRes()
 }
 
+type DeleteOwnVoteRes interface {
+	deleteOwnVoteRes()
+}
+
+type GeneratePollOptionsRes interface {
+	generatePollOptionsRes()
+}
+
 type GetCurrentUserRes interface {
 	getCurrentUserRes()
 }
 
+type GetOwnVoteRes interface {
+	getOwnVoteRes()
+}
+
 type GetPollResultsRes interface {
 	getPollResultsRes()
 }
@@ -29,14 +65,50 @@ type GetPublicBookingLinkRes interface {
 	getPublicBookingLinkRes()
 }
 
+type GetPublicPollCommentsRes interface {
+	getPublicPollCommentsRes()
+}
+
 type GetPublicPollRes interface {
 	getPublicPollRes()
 }
 
+type PickPollWinnerRes interface {
+	pickPollWinnerRes()
+}
+
+type ProposePollOptionRes interface {
+	proposePollOptionRes()
+}
+
+type SubmitVoteRes interface {
+	submitVoteRes()
+}
+
+type SuggestPollOptionsRes interface {
+	suggestPollOptionsRes()
+}
+
 type TestCalendarRes interface {
 	testCalendarRes()
 }
 
+type UpdateBookingLinkRes interface {
+	updateBookingLinkRes()
+}
+
 type UpdateCurrentUserRes interface {
 	updateCurrentUserRes()
 }
+
+type UpdateOwnVoteRes interface {
+	updateOwnVoteRes()
+}
+
+type UpdatePollRes interface {
+	updatePollRes()
+}
+
+type VerifyBookingEmailRes interface {
+	verifyBookingEmailRes()
+}
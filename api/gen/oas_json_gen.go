@@ -191,88 +191,107 @@ func (s *AddCalendarReq) UnmarshalJSON(data []byte) error {
 }
 
 // Encode implements json.Marshaler.
-func (s *AddPollOptionReq) Encode(e *jx.Encoder) {
+func (s *AddPollCommentReq) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *AddPollOptionReq) encodeFields(e *jx.Encoder) {
+func (s *AddPollCommentReq) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("type")
-		s.Type.Encode(e)
+		e.FieldStart("author_name")
+		e.Str(s.AuthorName)
 	}
 	{
-		e.FieldStart("start_time")
-		json.EncodeDateTime(e, s.StartTime)
+		if s.AuthorEmail.Set {
+			e.FieldStart("author_email")
+			s.AuthorEmail.Encode(e)
+		}
 	}
 	{
-		e.FieldStart("end_time")
-		json.EncodeDateTime(e, s.EndTime)
+		e.FieldStart("body")
+		e.Str(s.Body)
+	}
+	{
+		if s.OptionID.Set {
+			e.FieldStart("option_id")
+			s.OptionID.Encode(e)
+		}
 	}
 }
 
-var jsonFieldsNameOfAddPollOptionReq = [3]string{
-	0: "type",
-	1: "start_time",
-	2: "end_time",
+var jsonFieldsNameOfAddPollCommentReq = [4]string{
+	0: "author_name",
+	1: "author_email",
+	2: "body",
+	3: "option_id",
 }
 
-// Decode decodes AddPollOptionReq from json.
-func (s *AddPollOptionReq) Decode(d *jx.Decoder) error {
+// Decode decodes AddPollCommentReq from json.
+func (s *AddPollCommentReq) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode AddPollOptionReq to nil")
+		return errors.New("invalid: unable to decode AddPollCommentReq to nil")
 	}
 	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "type":
+		case "author_name":
 			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				if err := s.Type.Decode(d); err != nil {
+				v, err := d.Str()
+				s.AuthorName = string(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"type\"")
+				return errors.Wrap(err, "decode field \"author_name\"")
 			}
-		case "start_time":
-			requiredBitSet[0] |= 1 << 1
+		case "author_email":
 			if err := func() error {
-				v, err := json.DecodeDateTime(d)
-				s.StartTime = v
-				if err != nil {
+				s.AuthorEmail.Reset()
+				if err := s.AuthorEmail.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"start_time\"")
+				return errors.Wrap(err, "decode field \"author_email\"")
 			}
-		case "end_time":
+		case "body":
 			requiredBitSet[0] |= 1 << 2
 			if err := func() error {
-				v, err := json.DecodeDateTime(d)
-				s.EndTime = v
+				v, err := d.Str()
+				s.Body = string(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"end_time\"")
+				return errors.Wrap(err, "decode field \"body\"")
+			}
+		case "option_id":
+			if err := func() error {
+				s.OptionID.Reset()
+				if err := s.OptionID.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"option_id\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode AddPollOptionReq")
+		return errors.Wrap(err, "decode AddPollCommentReq")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
 	for i, mask := range [1]uint8{
-		0b00000111,
+		0b00000101,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -284,8 +303,8 @@ func (s *AddPollOptionReq) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfAddPollOptionReq) {
-					name = jsonFieldsNameOfAddPollOptionReq[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfAddPollCommentReq) {
+					name = jsonFieldsNameOfAddPollCommentReq[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -306,178 +325,129 @@ func (s *AddPollOptionReq) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *AddPollOptionReq) MarshalJSON() ([]byte, error) {
+func (s *AddPollCommentReq) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *AddPollOptionReq) UnmarshalJSON(data []byte) error {
+func (s *AddPollCommentReq) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode implements json.Marshaler.
-func (s *ApproveViaEmailOK) Encode(e *jx.Encoder) {
-	e.ObjStart()
-	s.encodeFields(e)
-	e.ObjEnd()
-}
+// Encode encodes AddPollInvitesCreatedApplicationJSON as json.
+func (s AddPollInvitesCreatedApplicationJSON) Encode(e *jx.Encoder) {
+	unwrapped := []PollInvite(s)
 
-// encodeFields encodes fields.
-func (s *ApproveViaEmailOK) encodeFields(e *jx.Encoder) {
-	{
-		if s.Message.Set {
-			e.FieldStart("message")
-			s.Message.Encode(e)
-		}
+	e.ArrStart()
+	for _, elem := range unwrapped {
+		elem.Encode(e)
 	}
+	e.ArrEnd()
 }
 
-var jsonFieldsNameOfApproveViaEmailOK = [1]string{
-	0: "message",
-}
-
-// Decode decodes ApproveViaEmailOK from json.
-func (s *ApproveViaEmailOK) Decode(d *jx.Decoder) error {
+// Decode decodes AddPollInvitesCreatedApplicationJSON from json.
+func (s *AddPollInvitesCreatedApplicationJSON) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode ApproveViaEmailOK to nil")
+		return errors.New("invalid: unable to decode AddPollInvitesCreatedApplicationJSON to nil")
 	}
-
-	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		switch string(k) {
-		case "message":
-			if err := func() error {
-				s.Message.Reset()
-				if err := s.Message.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"message\"")
+	var unwrapped []PollInvite
+	if err := func() error {
+		unwrapped = make([]PollInvite, 0)
+		if err := d.Arr(func(d *jx.Decoder) error {
+			var elem PollInvite
+			if err := elem.Decode(d); err != nil {
+				return err
 			}
-		default:
-			return d.Skip()
+			unwrapped = append(unwrapped, elem)
+			return nil
+		}); err != nil {
+			return err
 		}
 		return nil
-	}); err != nil {
-		return errors.Wrap(err, "decode ApproveViaEmailOK")
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
 	}
-
+	*s = AddPollInvitesCreatedApplicationJSON(unwrapped)
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *ApproveViaEmailOK) MarshalJSON() ([]byte, error) {
+func (s AddPollInvitesCreatedApplicationJSON) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *ApproveViaEmailOK) UnmarshalJSON(data []byte) error {
+func (s *AddPollInvitesCreatedApplicationJSON) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *AvailabilityRule) Encode(e *jx.Encoder) {
+func (s *AddPollInvitesReq) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *AvailabilityRule) encodeFields(e *jx.Encoder) {
+func (s *AddPollInvitesReq) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("days_of_week")
+		e.FieldStart("invitees")
 		e.ArrStart()
-		for _, elem := range s.DaysOfWeek {
-			e.Int(elem)
+		for _, elem := range s.Invitees {
+			elem.Encode(e)
 		}
 		e.ArrEnd()
 	}
-	{
-		e.FieldStart("start_time")
-		e.Str(s.StartTime)
-	}
-	{
-		e.FieldStart("end_time")
-		e.Str(s.EndTime)
-	}
 }
 
-var jsonFieldsNameOfAvailabilityRule = [3]string{
-	0: "days_of_week",
-	1: "start_time",
-	2: "end_time",
+var jsonFieldsNameOfAddPollInvitesReq = [1]string{
+	0: "invitees",
 }
 
-// Decode decodes AvailabilityRule from json.
-func (s *AvailabilityRule) Decode(d *jx.Decoder) error {
+// Decode decodes AddPollInvitesReq from json.
+func (s *AddPollInvitesReq) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode AvailabilityRule to nil")
+		return errors.New("invalid: unable to decode AddPollInvitesReq to nil")
 	}
 	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "days_of_week":
+		case "invitees":
 			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				s.DaysOfWeek = make([]int, 0)
+				s.Invitees = make([]Invitee, 0)
 				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem int
-					v, err := d.Int()
-					elem = int(v)
-					if err != nil {
+					var elem Invitee
+					if err := elem.Decode(d); err != nil {
 						return err
 					}
-					s.DaysOfWeek = append(s.DaysOfWeek, elem)
+					s.Invitees = append(s.Invitees, elem)
 					return nil
 				}); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"days_of_week\"")
-			}
-		case "start_time":
-			requiredBitSet[0] |= 1 << 1
-			if err := func() error {
-				v, err := d.Str()
-				s.StartTime = string(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"start_time\"")
-			}
-		case "end_time":
-			requiredBitSet[0] |= 1 << 2
-			if err := func() error {
-				v, err := d.Str()
-				s.EndTime = string(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"end_time\"")
+				return errors.Wrap(err, "decode field \"invitees\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode AvailabilityRule")
+		return errors.Wrap(err, "decode AddPollInvitesReq")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
 	for i, mask := range [1]uint8{
-		0b00000111,
+		0b00000001,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -489,8 +459,8 @@ func (s *AvailabilityRule) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfAvailabilityRule) {
-					name = jsonFieldsNameOfAvailabilityRule[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfAddPollInvitesReq) {
+					name = jsonFieldsNameOfAddPollInvitesReq[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -511,167 +481,118 @@ func (s *AvailabilityRule) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *AvailabilityRule) MarshalJSON() ([]byte, error) {
+func (s *AddPollInvitesReq) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *AvailabilityRule) UnmarshalJSON(data []byte) error {
+func (s *AddPollInvitesReq) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *Booking) Encode(e *jx.Encoder) {
+func (s *AddPollOptionReq) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *Booking) encodeFields(e *jx.Encoder) {
-	{
-		e.FieldStart("id")
-		e.Int(s.ID)
-	}
-	{
-		e.FieldStart("slot")
-		s.Slot.Encode(e)
-	}
-	{
-		e.FieldStart("guest_email")
-		e.Str(s.GuestEmail)
-	}
+func (s *AddPollOptionReq) encodeFields(e *jx.Encoder) {
 	{
-		if s.GuestName.Set {
-			e.FieldStart("guest_name")
-			s.GuestName.Encode(e)
-		}
+		e.FieldStart("type")
+		s.Type.Encode(e)
 	}
 	{
-		e.FieldStart("status")
-		s.Status.Encode(e)
+		e.FieldStart("start_time")
+		json.EncodeDateTime(e, s.StartTime)
 	}
 	{
-		if s.CustomFields.Set {
-			e.FieldStart("custom_fields")
-			s.CustomFields.Encode(e)
-		}
+		e.FieldStart("end_time")
+		json.EncodeDateTime(e, s.EndTime)
 	}
 	{
-		if s.CreatedAt.Set {
-			e.FieldStart("created_at")
-			s.CreatedAt.Encode(e, json.EncodeDateTime)
+		if s.Capacity.Set {
+			e.FieldStart("capacity")
+			s.Capacity.Encode(e)
 		}
 	}
 }
 
-var jsonFieldsNameOfBooking = [7]string{
-	0: "id",
-	1: "slot",
-	2: "guest_email",
-	3: "guest_name",
-	4: "status",
-	5: "custom_fields",
-	6: "created_at",
+var jsonFieldsNameOfAddPollOptionReq = [4]string{
+	0: "type",
+	1: "start_time",
+	2: "end_time",
+	3: "capacity",
 }
 
-// Decode decodes Booking from json.
-func (s *Booking) Decode(d *jx.Decoder) error {
+// Decode decodes AddPollOptionReq from json.
+func (s *AddPollOptionReq) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode Booking to nil")
+		return errors.New("invalid: unable to decode AddPollOptionReq to nil")
 	}
 	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "id":
+		case "type":
 			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				v, err := d.Int()
-				s.ID = int(v)
-				if err != nil {
+				if err := s.Type.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"id\"")
+				return errors.Wrap(err, "decode field \"type\"")
 			}
-		case "slot":
+		case "start_time":
 			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
-				if err := s.Slot.Decode(d); err != nil {
+				v, err := json.DecodeDateTime(d)
+				s.StartTime = v
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"slot\"")
+				return errors.Wrap(err, "decode field \"start_time\"")
 			}
-		case "guest_email":
+		case "end_time":
 			requiredBitSet[0] |= 1 << 2
 			if err := func() error {
-				v, err := d.Str()
-				s.GuestEmail = string(v)
+				v, err := json.DecodeDateTime(d)
+				s.EndTime = v
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"guest_email\"")
-			}
-		case "guest_name":
-			if err := func() error {
-				s.GuestName.Reset()
-				if err := s.GuestName.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"guest_name\"")
-			}
-		case "status":
-			requiredBitSet[0] |= 1 << 4
-			if err := func() error {
-				if err := s.Status.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"status\"")
+				return errors.Wrap(err, "decode field \"end_time\"")
 			}
-		case "custom_fields":
+		case "capacity":
 			if err := func() error {
-				s.CustomFields.Reset()
-				if err := s.CustomFields.Decode(d); err != nil {
+				s.Capacity.Reset()
+				if err := s.Capacity.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"custom_fields\"")
-			}
-		case "created_at":
-			if err := func() error {
-				s.CreatedAt.Reset()
-				if err := s.CreatedAt.Decode(d, json.DecodeDateTime); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"created_at\"")
+				return errors.Wrap(err, "decode field \"capacity\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode Booking")
+		return errors.Wrap(err, "decode AddPollOptionReq")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
 	for i, mask := range [1]uint8{
-		0b00010111,
+		0b00000111,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -683,8 +604,8 @@ func (s *Booking) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfBooking) {
-					name = jsonFieldsNameOfBooking[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfAddPollOptionReq) {
+					name = jsonFieldsNameOfAddPollOptionReq[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -705,398 +626,283 @@ func (s *Booking) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *Booking) MarshalJSON() ([]byte, error) {
+func (s *AddPollOptionReq) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *Booking) UnmarshalJSON(data []byte) error {
+func (s *AddPollOptionReq) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes ApprovalTimeoutAction as json.
+func (s ApprovalTimeoutAction) Encode(e *jx.Encoder) {
+	e.Int(int(s))
+}
+
+// Decode decodes ApprovalTimeoutAction from json.
+func (s *ApprovalTimeoutAction) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode ApprovalTimeoutAction to nil")
+	}
+	v, err := d.Int()
+	if err != nil {
+		return err
+	}
+	*s = ApprovalTimeoutAction(v)
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s ApprovalTimeoutAction) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *ApprovalTimeoutAction) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s BookingCustomFields) Encode(e *jx.Encoder) {
+func (s *ApproveViaEmailOK) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
-// encodeFields implements json.Marshaler.
-func (s BookingCustomFields) encodeFields(e *jx.Encoder) {
-	for k, elem := range s {
-		e.FieldStart(k)
-
-		e.Str(elem)
+// encodeFields encodes fields.
+func (s *ApproveViaEmailOK) encodeFields(e *jx.Encoder) {
+	{
+		if s.Message.Set {
+			e.FieldStart("message")
+			s.Message.Encode(e)
+		}
 	}
 }
 
-// Decode decodes BookingCustomFields from json.
-func (s *BookingCustomFields) Decode(d *jx.Decoder) error {
+var jsonFieldsNameOfApproveViaEmailOK = [1]string{
+	0: "message",
+}
+
+// Decode decodes ApproveViaEmailOK from json.
+func (s *ApproveViaEmailOK) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode BookingCustomFields to nil")
+		return errors.New("invalid: unable to decode ApproveViaEmailOK to nil")
 	}
-	m := s.init()
+
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		var elem string
-		if err := func() error {
-			v, err := d.Str()
-			elem = string(v)
-			if err != nil {
-				return err
+		switch string(k) {
+		case "message":
+			if err := func() error {
+				s.Message.Reset()
+				if err := s.Message.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"message\"")
 			}
-			return nil
-		}(); err != nil {
-			return errors.Wrapf(err, "decode field %q", k)
+		default:
+			return d.Skip()
 		}
-		m[string(k)] = elem
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode BookingCustomFields")
+		return errors.Wrap(err, "decode ApproveViaEmailOK")
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s BookingCustomFields) MarshalJSON() ([]byte, error) {
+func (s *ApproveViaEmailOK) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *BookingCustomFields) UnmarshalJSON(data []byte) error {
+func (s *ApproveViaEmailOK) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *BookingLink) Encode(e *jx.Encoder) {
+func (s *AutoConfirmRules) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *BookingLink) encodeFields(e *jx.Encoder) {
-	{
-		e.FieldStart("id")
-		e.Int(s.ID)
-	}
-	{
-		e.FieldStart("slug")
-		e.Str(s.Slug)
-	}
-	{
-		e.FieldStart("name")
-		e.Str(s.Name)
-	}
-	{
-		if s.Description.Set {
-			e.FieldStart("description")
-			s.Description.Encode(e)
-		}
-	}
-	{
-		e.FieldStart("status")
-		s.Status.Encode(e)
-	}
-	{
-		if s.AutoConfirm.Set {
-			e.FieldStart("auto_confirm")
-			s.AutoConfirm.Encode(e)
-		}
-	}
-	{
-		if s.SlotDurationMinutes.Set {
-			e.FieldStart("slot_duration_minutes")
-			s.SlotDurationMinutes.Encode(e)
-		}
-	}
-	{
-		if s.SlotDurationsMinutes != nil {
-			e.FieldStart("slot_durations_minutes")
-			e.ArrStart()
-			for _, elem := range s.SlotDurationsMinutes {
-				e.Int(elem)
-			}
-			e.ArrEnd()
-		}
-	}
-	{
-		if s.BufferMinutes.Set {
-			e.FieldStart("buffer_minutes")
-			s.BufferMinutes.Encode(e)
-		}
-	}
-	{
-		if s.RequireEmail.Set {
-			e.FieldStart("require_email")
-			s.RequireEmail.Encode(e)
-		}
-	}
+func (s *AutoConfirmRules) encodeFields(e *jx.Encoder) {
 	{
-		if s.MeetingLink.Set {
-			e.FieldStart("meeting_link")
-			s.MeetingLink.Encode(e)
+		if s.KnownGuests.Set {
+			e.FieldStart("known_guests")
+			s.KnownGuests.Encode(e)
 		}
 	}
 	{
-		if s.AvailabilityRules != nil {
-			e.FieldStart("availability_rules")
+		if s.EmailDomains != nil {
+			e.FieldStart("email_domains")
 			e.ArrStart()
-			for _, elem := range s.AvailabilityRules {
-				elem.Encode(e)
+			for _, elem := range s.EmailDomains {
+				e.Str(elem)
 			}
 			e.ArrEnd()
 		}
 	}
 	{
-		if s.CustomFields != nil {
-			e.FieldStart("custom_fields")
+		if s.Hours != nil {
+			e.FieldStart("hours")
 			e.ArrStart()
-			for _, elem := range s.CustomFields {
+			for _, elem := range s.Hours {
 				elem.Encode(e)
 			}
 			e.ArrEnd()
 		}
 	}
-	{
-		if s.EventTemplate.Set {
-			e.FieldStart("event_template")
-			s.EventTemplate.Encode(e)
-		}
-	}
-	{
-		if s.CreatedAt.Set {
-			e.FieldStart("created_at")
-			s.CreatedAt.Encode(e, json.EncodeDateTime)
-		}
-	}
 }
 
-var jsonFieldsNameOfBookingLink = [15]string{
-	0:  "id",
-	1:  "slug",
-	2:  "name",
-	3:  "description",
-	4:  "status",
-	5:  "auto_confirm",
-	6:  "slot_duration_minutes",
-	7:  "slot_durations_minutes",
-	8:  "buffer_minutes",
-	9:  "require_email",
-	10: "meeting_link",
-	11: "availability_rules",
-	12: "custom_fields",
-	13: "event_template",
-	14: "created_at",
+var jsonFieldsNameOfAutoConfirmRules = [3]string{
+	0: "known_guests",
+	1: "email_domains",
+	2: "hours",
 }
 
-// Decode decodes BookingLink from json.
-func (s *BookingLink) Decode(d *jx.Decoder) error {
+// Decode decodes AutoConfirmRules from json.
+func (s *AutoConfirmRules) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode BookingLink to nil")
+		return errors.New("invalid: unable to decode AutoConfirmRules to nil")
 	}
-	var requiredBitSet [2]uint8
-	s.setDefaults()
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "id":
-			requiredBitSet[0] |= 1 << 0
-			if err := func() error {
-				v, err := d.Int()
-				s.ID = int(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"id\"")
-			}
-		case "slug":
-			requiredBitSet[0] |= 1 << 1
-			if err := func() error {
-				v, err := d.Str()
-				s.Slug = string(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"slug\"")
-			}
-		case "name":
-			requiredBitSet[0] |= 1 << 2
+		case "known_guests":
 			if err := func() error {
-				v, err := d.Str()
-				s.Name = string(v)
-				if err != nil {
+				s.KnownGuests.Reset()
+				if err := s.KnownGuests.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"name\"")
+				return errors.Wrap(err, "decode field \"known_guests\"")
 			}
-		case "description":
+		case "email_domains":
 			if err := func() error {
-				s.Description.Reset()
-				if err := s.Description.Decode(d); err != nil {
+				s.EmailDomains = make([]string, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem string
+					v, err := d.Str()
+					elem = string(v)
+					if err != nil {
+						return err
+					}
+					s.EmailDomains = append(s.EmailDomains, elem)
+					return nil
+				}); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"description\"")
+				return errors.Wrap(err, "decode field \"email_domains\"")
 			}
-		case "status":
-			requiredBitSet[0] |= 1 << 4
+		case "hours":
 			if err := func() error {
-				if err := s.Status.Decode(d); err != nil {
+				s.Hours = make([]AvailabilityRule, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem AvailabilityRule
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Hours = append(s.Hours, elem)
+					return nil
+				}); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"status\"")
+				return errors.Wrap(err, "decode field \"hours\"")
 			}
-		case "auto_confirm":
-			if err := func() error {
-				s.AutoConfirm.Reset()
-				if err := s.AutoConfirm.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"auto_confirm\"")
-			}
-		case "slot_duration_minutes":
-			if err := func() error {
-				s.SlotDurationMinutes.Reset()
-				if err := s.SlotDurationMinutes.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"slot_duration_minutes\"")
-			}
-		case "slot_durations_minutes":
-			if err := func() error {
-				s.SlotDurationsMinutes = make([]int, 0)
-				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem int
-					v, err := d.Int()
-					elem = int(v)
-					if err != nil {
-						return err
-					}
-					s.SlotDurationsMinutes = append(s.SlotDurationsMinutes, elem)
-					return nil
-				}); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"slot_durations_minutes\"")
-			}
-		case "buffer_minutes":
-			if err := func() error {
-				s.BufferMinutes.Reset()
-				if err := s.BufferMinutes.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"buffer_minutes\"")
-			}
-		case "require_email":
-			if err := func() error {
-				s.RequireEmail.Reset()
-				if err := s.RequireEmail.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"require_email\"")
-			}
-		case "meeting_link":
-			if err := func() error {
-				s.MeetingLink.Reset()
-				if err := s.MeetingLink.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"meeting_link\"")
-			}
-		case "availability_rules":
-			if err := func() error {
-				s.AvailabilityRules = make([]AvailabilityRule, 0)
-				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem AvailabilityRule
-					if err := elem.Decode(d); err != nil {
-						return err
-					}
-					s.AvailabilityRules = append(s.AvailabilityRules, elem)
-					return nil
-				}); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"availability_rules\"")
-			}
-		case "custom_fields":
-			if err := func() error {
-				s.CustomFields = make([]CustomField, 0)
-				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem CustomField
-					if err := elem.Decode(d); err != nil {
-						return err
-					}
-					s.CustomFields = append(s.CustomFields, elem)
-					return nil
-				}); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"custom_fields\"")
-			}
-		case "event_template":
-			if err := func() error {
-				s.EventTemplate.Reset()
-				if err := s.EventTemplate.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"event_template\"")
-			}
-		case "created_at":
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode AutoConfirmRules")
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *AutoConfirmRules) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *AutoConfirmRules) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *AutofillVoteOK) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *AutofillVoteOK) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("responses")
+		s.Responses.Encode(e)
+	}
+}
+
+var jsonFieldsNameOfAutofillVoteOK = [1]string{
+	0: "responses",
+}
+
+// Decode decodes AutofillVoteOK from json.
+func (s *AutofillVoteOK) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode AutofillVoteOK to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "responses":
+			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				s.CreatedAt.Reset()
-				if err := s.CreatedAt.Decode(d, json.DecodeDateTime); err != nil {
+				if err := s.Responses.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"created_at\"")
+				return errors.Wrap(err, "decode field \"responses\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode BookingLink")
+		return errors.Wrap(err, "decode AutofillVoteOK")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
-	for i, mask := range [2]uint8{
-		0b00010111,
-		0b00000000,
+	for i, mask := range [1]uint8{
+		0b00000001,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -1108,8 +914,8 @@ func (s *BookingLink) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfBookingLink) {
-					name = jsonFieldsNameOfBookingLink[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfAutofillVoteOK) {
+					name = jsonFieldsNameOfAutofillVoteOK[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -1130,322 +936,249 @@ func (s *BookingLink) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *BookingLink) MarshalJSON() ([]byte, error) {
+func (s *AutofillVoteOK) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *BookingLink) UnmarshalJSON(data []byte) error {
+func (s *AutofillVoteOK) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes BookingStatus as json.
-func (s BookingStatus) Encode(e *jx.Encoder) {
-	e.Int(int(s))
+// Encode implements json.Marshaler.
+func (s AutofillVoteOKResponses) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
 }
 
-// Decode decodes BookingStatus from json.
-func (s *BookingStatus) Decode(d *jx.Decoder) error {
+// encodeFields implements json.Marshaler.
+func (s AutofillVoteOKResponses) encodeFields(e *jx.Encoder) {
+	for k, elem := range s {
+		e.FieldStart(k)
+
+		elem.Encode(e)
+	}
+}
+
+// Decode decodes AutofillVoteOKResponses from json.
+func (s *AutofillVoteOKResponses) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode BookingStatus to nil")
+		return errors.New("invalid: unable to decode AutofillVoteOKResponses to nil")
 	}
-	v, err := d.Int()
-	if err != nil {
-		return err
+	m := s.init()
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		var elem VoteResponse
+		if err := func() error {
+			if err := elem.Decode(d); err != nil {
+				return err
+			}
+			return nil
+		}(); err != nil {
+			return errors.Wrapf(err, "decode field %q", k)
+		}
+		m[string(k)] = elem
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode AutofillVoteOKResponses")
 	}
-	*s = BookingStatus(v)
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s BookingStatus) MarshalJSON() ([]byte, error) {
+func (s AutofillVoteOKResponses) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *BookingStatus) UnmarshalJSON(data []byte) error {
+func (s *AutofillVoteOKResponses) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *CalendarConnection) Encode(e *jx.Encoder) {
+func (s *AutofillVoteReq) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *CalendarConnection) encodeFields(e *jx.Encoder) {
-	{
-		e.FieldStart("id")
-		e.Int(s.ID)
-	}
+func (s *AutofillVoteReq) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("server_url")
-		e.Str(s.ServerURL)
-	}
-	{
-		e.FieldStart("username")
-		e.Str(s.Username)
-	}
-	{
-		if s.CalendarUrls != nil {
-			e.FieldStart("calendar_urls")
-			e.ArrStart()
-			for _, elem := range s.CalendarUrls {
-				e.Str(elem)
-			}
-			e.ArrEnd()
+		if s.URL.Set {
+			e.FieldStart("url")
+			s.URL.Encode(e)
 		}
 	}
 	{
-		if s.WriteURL.Set {
-			e.FieldStart("write_url")
-			s.WriteURL.Encode(e)
+		if s.Ics.Set {
+			e.FieldStart("ics")
+			s.Ics.Encode(e)
 		}
 	}
 }
 
-var jsonFieldsNameOfCalendarConnection = [5]string{
-	0: "id",
-	1: "server_url",
-	2: "username",
-	3: "calendar_urls",
-	4: "write_url",
+var jsonFieldsNameOfAutofillVoteReq = [2]string{
+	0: "url",
+	1: "ics",
 }
 
-// Decode decodes CalendarConnection from json.
-func (s *CalendarConnection) Decode(d *jx.Decoder) error {
+// Decode decodes AutofillVoteReq from json.
+func (s *AutofillVoteReq) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode CalendarConnection to nil")
+		return errors.New("invalid: unable to decode AutofillVoteReq to nil")
 	}
-	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "id":
-			requiredBitSet[0] |= 1 << 0
+		case "url":
 			if err := func() error {
-				v, err := d.Int()
-				s.ID = int(v)
-				if err != nil {
+				s.URL.Reset()
+				if err := s.URL.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"id\"")
+				return errors.Wrap(err, "decode field \"url\"")
 			}
-		case "server_url":
-			requiredBitSet[0] |= 1 << 1
+		case "ics":
 			if err := func() error {
-				v, err := d.Str()
-				s.ServerURL = string(v)
-				if err != nil {
+				s.Ics.Reset()
+				if err := s.Ics.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"server_url\"")
-			}
-		case "username":
-			requiredBitSet[0] |= 1 << 2
-			if err := func() error {
-				v, err := d.Str()
-				s.Username = string(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"username\"")
-			}
-		case "calendar_urls":
-			if err := func() error {
-				s.CalendarUrls = make([]string, 0)
-				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem string
-					v, err := d.Str()
-					elem = string(v)
-					if err != nil {
-						return err
-					}
-					s.CalendarUrls = append(s.CalendarUrls, elem)
-					return nil
-				}); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"calendar_urls\"")
-			}
-		case "write_url":
-			if err := func() error {
-				s.WriteURL.Reset()
-				if err := s.WriteURL.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"write_url\"")
+				return errors.Wrap(err, "decode field \"ics\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode CalendarConnection")
-	}
-	// Validate required fields.
-	var failures []validate.FieldError
-	for i, mask := range [1]uint8{
-		0b00000111,
-	} {
-		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
-			// Mask only required fields and check equality to mask using XOR.
-			//
-			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
-			// Bits of fields which would be set are actually bits of missed fields.
-			missed := bits.OnesCount8(result)
-			for bitN := 0; bitN < missed; bitN++ {
-				bitIdx := bits.TrailingZeros8(result)
-				fieldIdx := i*8 + bitIdx
-				var name string
-				if fieldIdx < len(jsonFieldsNameOfCalendarConnection) {
-					name = jsonFieldsNameOfCalendarConnection[fieldIdx]
-				} else {
-					name = strconv.Itoa(fieldIdx)
-				}
-				failures = append(failures, validate.FieldError{
-					Name:  name,
-					Error: validate.ErrFieldRequired,
-				})
-				// Reset bit.
-				result &^= 1 << bitIdx
-			}
-		}
-	}
-	if len(failures) > 0 {
-		return &validate.Error{Fields: failures}
+		return errors.Wrap(err, "decode AutofillVoteReq")
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *CalendarConnection) MarshalJSON() ([]byte, error) {
+func (s *AutofillVoteReq) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *CalendarConnection) UnmarshalJSON(data []byte) error {
+func (s *AutofillVoteReq) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *CalendarDiscoveryResult) Encode(e *jx.Encoder) {
+func (s *AvailabilityRule) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *CalendarDiscoveryResult) encodeFields(e *jx.Encoder) {
+func (s *AvailabilityRule) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("success")
-		e.Bool(s.Success)
+		e.FieldStart("days_of_week")
+		e.ArrStart()
+		for _, elem := range s.DaysOfWeek {
+			e.Int(elem)
+		}
+		e.ArrEnd()
 	}
 	{
-		if s.Error.Set {
-			e.FieldStart("error")
-			s.Error.Encode(e)
-		}
+		e.FieldStart("start_time")
+		e.Str(s.StartTime)
 	}
 	{
-		e.FieldStart("calendars")
-		e.ArrStart()
-		for _, elem := range s.Calendars {
-			elem.Encode(e)
-		}
-		e.ArrEnd()
+		e.FieldStart("end_time")
+		e.Str(s.EndTime)
 	}
 }
 
-var jsonFieldsNameOfCalendarDiscoveryResult = [3]string{
-	0: "success",
-	1: "error",
-	2: "calendars",
+var jsonFieldsNameOfAvailabilityRule = [3]string{
+	0: "days_of_week",
+	1: "start_time",
+	2: "end_time",
 }
 
-// Decode decodes CalendarDiscoveryResult from json.
-func (s *CalendarDiscoveryResult) Decode(d *jx.Decoder) error {
+// Decode decodes AvailabilityRule from json.
+func (s *AvailabilityRule) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode CalendarDiscoveryResult to nil")
+		return errors.New("invalid: unable to decode AvailabilityRule to nil")
 	}
 	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "success":
+		case "days_of_week":
 			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				v, err := d.Bool()
-				s.Success = bool(v)
-				if err != nil {
+				s.DaysOfWeek = make([]int, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem int
+					v, err := d.Int()
+					elem = int(v)
+					if err != nil {
+						return err
+					}
+					s.DaysOfWeek = append(s.DaysOfWeek, elem)
+					return nil
+				}); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"success\"")
+				return errors.Wrap(err, "decode field \"days_of_week\"")
 			}
-		case "error":
+		case "start_time":
+			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
-				s.Error.Reset()
-				if err := s.Error.Decode(d); err != nil {
+				v, err := d.Str()
+				s.StartTime = string(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"error\"")
+				return errors.Wrap(err, "decode field \"start_time\"")
 			}
-		case "calendars":
+		case "end_time":
 			requiredBitSet[0] |= 1 << 2
 			if err := func() error {
-				s.Calendars = make([]DiscoveredCalendar, 0)
-				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem DiscoveredCalendar
-					if err := elem.Decode(d); err != nil {
-						return err
-					}
-					s.Calendars = append(s.Calendars, elem)
-					return nil
-				}); err != nil {
+				v, err := d.Str()
+				s.EndTime = string(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"calendars\"")
+				return errors.Wrap(err, "decode field \"end_time\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode CalendarDiscoveryResult")
+		return errors.Wrap(err, "decode AvailabilityRule")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
 	for i, mask := range [1]uint8{
-		0b00000101,
+		0b00000111,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -1457,8 +1190,8 @@ func (s *CalendarDiscoveryResult) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfCalendarDiscoveryResult) {
-					name = jsonFieldsNameOfCalendarDiscoveryResult[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfAvailabilityRule) {
+					name = jsonFieldsNameOfAvailabilityRule[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -1479,113 +1212,213 @@ func (s *CalendarDiscoveryResult) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *CalendarDiscoveryResult) MarshalJSON() ([]byte, error) {
+func (s *AvailabilityRule) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *CalendarDiscoveryResult) UnmarshalJSON(data []byte) error {
+func (s *AvailabilityRule) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *CalendarTestResult) Encode(e *jx.Encoder) {
+func (s *Booking) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *CalendarTestResult) encodeFields(e *jx.Encoder) {
+func (s *Booking) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("success")
-		e.Bool(s.Success)
+		e.FieldStart("id")
+		e.Int(s.ID)
 	}
 	{
-		if s.Error.Set {
-			e.FieldStart("error")
-			s.Error.Encode(e)
+		e.FieldStart("slot")
+		s.Slot.Encode(e)
+	}
+	{
+		e.FieldStart("guest_email")
+		e.Str(s.GuestEmail)
+	}
+	{
+		if s.GuestName.Set {
+			e.FieldStart("guest_name")
+			s.GuestName.Encode(e)
 		}
 	}
 	{
-		e.FieldStart("events")
-		e.ArrStart()
-		for _, elem := range s.Events {
-			elem.Encode(e)
+		e.FieldStart("status")
+		s.Status.Encode(e)
+	}
+	{
+		if s.MeetingLink.Set {
+			e.FieldStart("meeting_link")
+			s.MeetingLink.Encode(e)
+		}
+	}
+	{
+		if s.CustomFields.Set {
+			e.FieldStart("custom_fields")
+			s.CustomFields.Encode(e)
+		}
+	}
+	{
+		if s.Attachments != nil {
+			e.FieldStart("attachments")
+			e.ArrStart()
+			for _, elem := range s.Attachments {
+				elem.Encode(e)
+			}
+			e.ArrEnd()
+		}
+	}
+	{
+		if s.CreatedAt.Set {
+			e.FieldStart("created_at")
+			s.CreatedAt.Encode(e, json.EncodeDateTime)
 		}
-		e.ArrEnd()
 	}
 }
 
-var jsonFieldsNameOfCalendarTestResult = [3]string{
-	0: "success",
-	1: "error",
-	2: "events",
+var jsonFieldsNameOfBooking = [9]string{
+	0: "id",
+	1: "slot",
+	2: "guest_email",
+	3: "guest_name",
+	4: "status",
+	5: "meeting_link",
+	6: "custom_fields",
+	7: "attachments",
+	8: "created_at",
 }
 
-// Decode decodes CalendarTestResult from json.
-func (s *CalendarTestResult) Decode(d *jx.Decoder) error {
+// Decode decodes Booking from json.
+func (s *Booking) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode CalendarTestResult to nil")
+		return errors.New("invalid: unable to decode Booking to nil")
 	}
-	var requiredBitSet [1]uint8
+	var requiredBitSet [2]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "success":
+		case "id":
 			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				v, err := d.Bool()
-				s.Success = bool(v)
+				v, err := d.Int()
+				s.ID = int(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"success\"")
+				return errors.Wrap(err, "decode field \"id\"")
 			}
-		case "error":
+		case "slot":
+			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
-				s.Error.Reset()
-				if err := s.Error.Decode(d); err != nil {
+				if err := s.Slot.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"error\"")
+				return errors.Wrap(err, "decode field \"slot\"")
 			}
-		case "events":
+		case "guest_email":
 			requiredBitSet[0] |= 1 << 2
 			if err := func() error {
-				s.Events = make([]CalendarTestResultEventsItem, 0)
+				v, err := d.Str()
+				s.GuestEmail = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"guest_email\"")
+			}
+		case "guest_name":
+			if err := func() error {
+				s.GuestName.Reset()
+				if err := s.GuestName.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"guest_name\"")
+			}
+		case "status":
+			requiredBitSet[0] |= 1 << 4
+			if err := func() error {
+				if err := s.Status.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"status\"")
+			}
+		case "meeting_link":
+			if err := func() error {
+				s.MeetingLink.Reset()
+				if err := s.MeetingLink.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"meeting_link\"")
+			}
+		case "custom_fields":
+			if err := func() error {
+				s.CustomFields.Reset()
+				if err := s.CustomFields.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"custom_fields\"")
+			}
+		case "attachments":
+			if err := func() error {
+				s.Attachments = make([]BookingAttachment, 0)
 				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem CalendarTestResultEventsItem
+					var elem BookingAttachment
 					if err := elem.Decode(d); err != nil {
 						return err
 					}
-					s.Events = append(s.Events, elem)
+					s.Attachments = append(s.Attachments, elem)
 					return nil
 				}); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"events\"")
+				return errors.Wrap(err, "decode field \"attachments\"")
+			}
+		case "created_at":
+			if err := func() error {
+				s.CreatedAt.Reset()
+				if err := s.CreatedAt.Decode(d, json.DecodeDateTime); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"created_at\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode CalendarTestResult")
+		return errors.Wrap(err, "decode Booking")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
-	for i, mask := range [1]uint8{
-		0b00000101,
+	for i, mask := range [2]uint8{
+		0b00010111,
+		0b00000000,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -1597,8 +1430,8 @@ func (s *CalendarTestResult) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfCalendarTestResult) {
-					name = jsonFieldsNameOfCalendarTestResult[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfBooking) {
+					name = jsonFieldsNameOfBooking[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -1619,103 +1452,154 @@ func (s *CalendarTestResult) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *CalendarTestResult) MarshalJSON() ([]byte, error) {
+func (s *Booking) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *CalendarTestResult) UnmarshalJSON(data []byte) error {
+func (s *Booking) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *CalendarTestResultEventsItem) Encode(e *jx.Encoder) {
+func (s *BookingAttachment) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *CalendarTestResultEventsItem) encodeFields(e *jx.Encoder) {
+func (s *BookingAttachment) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("title")
-		e.Str(s.Title)
+		e.FieldStart("id")
+		e.Int(s.ID)
 	}
 	{
-		e.FieldStart("start")
-		json.EncodeDateTime(e, s.Start)
+		e.FieldStart("field")
+		e.Str(s.Field)
 	}
 	{
-		e.FieldStart("end")
-		json.EncodeDateTime(e, s.End)
+		e.FieldStart("filename")
+		e.Str(s.Filename)
+	}
+	{
+		if s.ContentType.Set {
+			e.FieldStart("content_type")
+			s.ContentType.Encode(e)
+		}
+	}
+	{
+		e.FieldStart("size")
+		e.Int64(s.Size)
+	}
+	{
+		e.FieldStart("url")
+		e.Str(s.URL)
 	}
 }
 
-var jsonFieldsNameOfCalendarTestResultEventsItem = [3]string{
-	0: "title",
-	1: "start",
-	2: "end",
+var jsonFieldsNameOfBookingAttachment = [6]string{
+	0: "id",
+	1: "field",
+	2: "filename",
+	3: "content_type",
+	4: "size",
+	5: "url",
 }
 
-// Decode decodes CalendarTestResultEventsItem from json.
-func (s *CalendarTestResultEventsItem) Decode(d *jx.Decoder) error {
+// Decode decodes BookingAttachment from json.
+func (s *BookingAttachment) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode CalendarTestResultEventsItem to nil")
+		return errors.New("invalid: unable to decode BookingAttachment to nil")
 	}
 	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "title":
+		case "id":
 			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				v, err := d.Str()
-				s.Title = string(v)
+				v, err := d.Int()
+				s.ID = int(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"title\"")
+				return errors.Wrap(err, "decode field \"id\"")
 			}
-		case "start":
+		case "field":
 			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
-				v, err := json.DecodeDateTime(d)
-				s.Start = v
+				v, err := d.Str()
+				s.Field = string(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"start\"")
+				return errors.Wrap(err, "decode field \"field\"")
 			}
-		case "end":
+		case "filename":
 			requiredBitSet[0] |= 1 << 2
 			if err := func() error {
-				v, err := json.DecodeDateTime(d)
-				s.End = v
+				v, err := d.Str()
+				s.Filename = string(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"end\"")
+				return errors.Wrap(err, "decode field \"filename\"")
+			}
+		case "content_type":
+			if err := func() error {
+				s.ContentType.Reset()
+				if err := s.ContentType.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"content_type\"")
+			}
+		case "size":
+			requiredBitSet[0] |= 1 << 4
+			if err := func() error {
+				v, err := d.Int64()
+				s.Size = int64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"size\"")
+			}
+		case "url":
+			requiredBitSet[0] |= 1 << 5
+			if err := func() error {
+				v, err := d.Str()
+				s.URL = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"url\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode CalendarTestResultEventsItem")
+		return errors.Wrap(err, "decode BookingAttachment")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
 	for i, mask := range [1]uint8{
-		0b00000111,
+		0b00110111,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -1727,8 +1611,8 @@ func (s *CalendarTestResultEventsItem) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfCalendarTestResultEventsItem) {
-					name = jsonFieldsNameOfCalendarTestResultEventsItem[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfBookingAttachment) {
+					name = jsonFieldsNameOfBookingAttachment[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -1749,138 +1633,91 @@ func (s *CalendarTestResultEventsItem) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *CalendarTestResultEventsItem) MarshalJSON() ([]byte, error) {
+func (s *BookingAttachment) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *CalendarTestResultEventsItem) UnmarshalJSON(data []byte) error {
+func (s *BookingAttachment) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *CreateBookingCreated) Encode(e *jx.Encoder) {
+func (s BookingCustomFields) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
-// encodeFields encodes fields.
-func (s *CreateBookingCreated) encodeFields(e *jx.Encoder) {
-	{
-		e.FieldStart("status")
-		s.Status.Encode(e)
-	}
-	{
-		if s.Message.Set {
-			e.FieldStart("message")
-			s.Message.Encode(e)
-		}
-	}
-}
+// encodeFields implements json.Marshaler.
+func (s BookingCustomFields) encodeFields(e *jx.Encoder) {
+	for k, elem := range s {
+		e.FieldStart(k)
 
-var jsonFieldsNameOfCreateBookingCreated = [2]string{
-	0: "status",
-	1: "message",
+		e.Str(elem)
+	}
 }
 
-// Decode decodes CreateBookingCreated from json.
-func (s *CreateBookingCreated) Decode(d *jx.Decoder) error {
+// Decode decodes BookingCustomFields from json.
+func (s *BookingCustomFields) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode CreateBookingCreated to nil")
+		return errors.New("invalid: unable to decode BookingCustomFields to nil")
 	}
-	var requiredBitSet [1]uint8
-
+	m := s.init()
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		switch string(k) {
-		case "status":
-			requiredBitSet[0] |= 1 << 0
-			if err := func() error {
-				if err := s.Status.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"status\"")
-			}
-		case "message":
-			if err := func() error {
-				s.Message.Reset()
-				if err := s.Message.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"message\"")
+		var elem string
+		if err := func() error {
+			v, err := d.Str()
+			elem = string(v)
+			if err != nil {
+				return err
 			}
-		default:
-			return d.Skip()
+			return nil
+		}(); err != nil {
+			return errors.Wrapf(err, "decode field %q", k)
 		}
+		m[string(k)] = elem
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode CreateBookingCreated")
-	}
-	// Validate required fields.
-	var failures []validate.FieldError
-	for i, mask := range [1]uint8{
-		0b00000001,
-	} {
-		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
-			// Mask only required fields and check equality to mask using XOR.
-			//
-			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
-			// Bits of fields which would be set are actually bits of missed fields.
-			missed := bits.OnesCount8(result)
-			for bitN := 0; bitN < missed; bitN++ {
-				bitIdx := bits.TrailingZeros8(result)
-				fieldIdx := i*8 + bitIdx
-				var name string
-				if fieldIdx < len(jsonFieldsNameOfCreateBookingCreated) {
-					name = jsonFieldsNameOfCreateBookingCreated[fieldIdx]
-				} else {
-					name = strconv.Itoa(fieldIdx)
-				}
-				failures = append(failures, validate.FieldError{
-					Name:  name,
-					Error: validate.ErrFieldRequired,
-				})
-				// Reset bit.
-				result &^= 1 << bitIdx
-			}
-		}
-	}
-	if len(failures) > 0 {
-		return &validate.Error{Fields: failures}
+		return errors.Wrap(err, "decode BookingCustomFields")
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *CreateBookingCreated) MarshalJSON() ([]byte, error) {
+func (s BookingCustomFields) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *CreateBookingCreated) UnmarshalJSON(data []byte) error {
+func (s *BookingCustomFields) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *CreateBookingLinkReq) Encode(e *jx.Encoder) {
+func (s *BookingLink) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *CreateBookingLinkReq) encodeFields(e *jx.Encoder) {
+func (s *BookingLink) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("id")
+		e.Int(s.ID)
+	}
+	{
+		e.FieldStart("slug")
+		e.Str(s.Slug)
+	}
 	{
 		e.FieldStart("name")
 		e.Str(s.Name)
@@ -1891,12 +1728,34 @@ func (s *CreateBookingLinkReq) encodeFields(e *jx.Encoder) {
 			s.Description.Encode(e)
 		}
 	}
+	{
+		e.FieldStart("status")
+		s.Status.Encode(e)
+	}
 	{
 		if s.AutoConfirm.Set {
 			e.FieldStart("auto_confirm")
 			s.AutoConfirm.Encode(e)
 		}
 	}
+	{
+		if s.AutoConfirmRules.Set {
+			e.FieldStart("auto_confirm_rules")
+			s.AutoConfirmRules.Encode(e)
+		}
+	}
+	{
+		if s.ApprovalTimeoutHours.Set {
+			e.FieldStart("approval_timeout_hours")
+			s.ApprovalTimeoutHours.Encode(e)
+		}
+	}
+	{
+		if s.ApprovalTimeoutAction.Set {
+			e.FieldStart("approval_timeout_action")
+			s.ApprovalTimeoutAction.Encode(e)
+		}
+	}
 	{
 		if s.SlotDurationMinutes.Set {
 			e.FieldStart("slot_duration_minutes")
@@ -1931,6 +1790,12 @@ func (s *CreateBookingLinkReq) encodeFields(e *jx.Encoder) {
 			s.MeetingLink.Encode(e)
 		}
 	}
+	{
+		if s.MeetingProvider.Set {
+			e.FieldStart("meeting_provider")
+			s.MeetingProvider.Encode(e)
+		}
+	}
 	{
 		if s.AvailabilityRules != nil {
 			e.FieldStart("availability_rules")
@@ -1957,34 +1822,79 @@ func (s *CreateBookingLinkReq) encodeFields(e *jx.Encoder) {
 			s.EventTemplate.Encode(e)
 		}
 	}
+	{
+		if s.Branding.Set {
+			e.FieldStart("branding")
+			s.Branding.Encode(e)
+		}
+	}
+	{
+		if s.CreatedAt.Set {
+			e.FieldStart("created_at")
+			s.CreatedAt.Encode(e, json.EncodeDateTime)
+		}
+	}
 }
 
-var jsonFieldsNameOfCreateBookingLinkReq = [11]string{
-	0:  "name",
-	1:  "description",
-	2:  "auto_confirm",
-	3:  "slot_duration_minutes",
-	4:  "slot_durations_minutes",
-	5:  "buffer_minutes",
-	6:  "require_email",
-	7:  "meeting_link",
-	8:  "availability_rules",
-	9:  "custom_fields",
-	10: "event_template",
+var jsonFieldsNameOfBookingLink = [20]string{
+	0:  "id",
+	1:  "slug",
+	2:  "name",
+	3:  "description",
+	4:  "status",
+	5:  "auto_confirm",
+	6:  "auto_confirm_rules",
+	7:  "approval_timeout_hours",
+	8:  "approval_timeout_action",
+	9:  "slot_duration_minutes",
+	10: "slot_durations_minutes",
+	11: "buffer_minutes",
+	12: "require_email",
+	13: "meeting_link",
+	14: "meeting_provider",
+	15: "availability_rules",
+	16: "custom_fields",
+	17: "event_template",
+	18: "branding",
+	19: "created_at",
 }
 
-// Decode decodes CreateBookingLinkReq from json.
-func (s *CreateBookingLinkReq) Decode(d *jx.Decoder) error {
+// Decode decodes BookingLink from json.
+func (s *BookingLink) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode CreateBookingLinkReq to nil")
+		return errors.New("invalid: unable to decode BookingLink to nil")
 	}
-	var requiredBitSet [2]uint8
+	var requiredBitSet [3]uint8
 	s.setDefaults()
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "name":
+		case "id":
 			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Int()
+				s.ID = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"id\"")
+			}
+		case "slug":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.Str()
+				s.Slug = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"slug\"")
+			}
+		case "name":
+			requiredBitSet[0] |= 1 << 2
 			if err := func() error {
 				v, err := d.Str()
 				s.Name = string(v)
@@ -2005,6 +1915,16 @@ func (s *CreateBookingLinkReq) Decode(d *jx.Decoder) error {
 			}(); err != nil {
 				return errors.Wrap(err, "decode field \"description\"")
 			}
+		case "status":
+			requiredBitSet[0] |= 1 << 4
+			if err := func() error {
+				if err := s.Status.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"status\"")
+			}
 		case "auto_confirm":
 			if err := func() error {
 				s.AutoConfirm.Reset()
@@ -2015,6 +1935,36 @@ func (s *CreateBookingLinkReq) Decode(d *jx.Decoder) error {
 			}(); err != nil {
 				return errors.Wrap(err, "decode field \"auto_confirm\"")
 			}
+		case "auto_confirm_rules":
+			if err := func() error {
+				s.AutoConfirmRules.Reset()
+				if err := s.AutoConfirmRules.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"auto_confirm_rules\"")
+			}
+		case "approval_timeout_hours":
+			if err := func() error {
+				s.ApprovalTimeoutHours.Reset()
+				if err := s.ApprovalTimeoutHours.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"approval_timeout_hours\"")
+			}
+		case "approval_timeout_action":
+			if err := func() error {
+				s.ApprovalTimeoutAction.Reset()
+				if err := s.ApprovalTimeoutAction.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"approval_timeout_action\"")
+			}
 		case "slot_duration_minutes":
 			if err := func() error {
 				s.SlotDurationMinutes.Reset()
@@ -2074,6 +2024,16 @@ func (s *CreateBookingLinkReq) Decode(d *jx.Decoder) error {
 			}(); err != nil {
 				return errors.Wrap(err, "decode field \"meeting_link\"")
 			}
+		case "meeting_provider":
+			if err := func() error {
+				s.MeetingProvider.Reset()
+				if err := s.MeetingProvider.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"meeting_provider\"")
+			}
 		case "availability_rules":
 			if err := func() error {
 				s.AvailabilityRules = make([]AvailabilityRule, 0)
@@ -2118,17 +2078,38 @@ func (s *CreateBookingLinkReq) Decode(d *jx.Decoder) error {
 			}(); err != nil {
 				return errors.Wrap(err, "decode field \"event_template\"")
 			}
+		case "branding":
+			if err := func() error {
+				s.Branding.Reset()
+				if err := s.Branding.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"branding\"")
+			}
+		case "created_at":
+			if err := func() error {
+				s.CreatedAt.Reset()
+				if err := s.CreatedAt.Decode(d, json.DecodeDateTime); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"created_at\"")
+			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode CreateBookingLinkReq")
+		return errors.Wrap(err, "decode BookingLink")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
-	for i, mask := range [2]uint8{
-		0b00000001,
+	for i, mask := range [3]uint8{
+		0b00010111,
+		0b00000000,
 		0b00000000,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
@@ -2141,8 +2122,8 @@ func (s *CreateBookingLinkReq) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfCreateBookingLinkReq) {
-					name = jsonFieldsNameOfCreateBookingLinkReq[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfBookingLink) {
+					name = jsonFieldsNameOfBookingLink[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -2163,368 +2144,296 @@ func (s *CreateBookingLinkReq) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *CreateBookingLinkReq) MarshalJSON() ([]byte, error) {
+func (s *BookingLink) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *CreateBookingLinkReq) UnmarshalJSON(data []byte) error {
+func (s *BookingLink) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes BookingStatus as json.
+func (s BookingStatus) Encode(e *jx.Encoder) {
+	e.Int(int(s))
+}
+
+// Decode decodes BookingStatus from json.
+func (s *BookingStatus) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode BookingStatus to nil")
+	}
+	v, err := d.Int()
+	if err != nil {
+		return err
+	}
+	*s = BookingStatus(v)
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s BookingStatus) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *BookingStatus) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *CreateBookingReq) Encode(e *jx.Encoder) {
+func (s *Branding) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *CreateBookingReq) encodeFields(e *jx.Encoder) {
-	{
-		e.FieldStart("guest_email")
-		e.Str(s.GuestEmail)
-	}
+func (s *Branding) encodeFields(e *jx.Encoder) {
 	{
-		if s.GuestName.Set {
-			e.FieldStart("guest_name")
-			s.GuestName.Encode(e)
+		if s.PrimaryColor.Set {
+			e.FieldStart("primary_color")
+			s.PrimaryColor.Encode(e)
 		}
 	}
 	{
-		e.FieldStart("start_time")
-		json.EncodeDateTime(e, s.StartTime)
+		if s.AccentColor.Set {
+			e.FieldStart("accent_color")
+			s.AccentColor.Encode(e)
+		}
 	}
 	{
-		e.FieldStart("end_time")
-		json.EncodeDateTime(e, s.EndTime)
+		if s.LogoURL.Set {
+			e.FieldStart("logo_url")
+			s.LogoURL.Encode(e)
+		}
 	}
 	{
-		if s.CustomFields.Set {
-			e.FieldStart("custom_fields")
-			s.CustomFields.Encode(e)
+		if s.CoverURL.Set {
+			e.FieldStart("cover_url")
+			s.CoverURL.Encode(e)
 		}
 	}
 }
 
-var jsonFieldsNameOfCreateBookingReq = [5]string{
-	0: "guest_email",
-	1: "guest_name",
-	2: "start_time",
-	3: "end_time",
-	4: "custom_fields",
+var jsonFieldsNameOfBranding = [4]string{
+	0: "primary_color",
+	1: "accent_color",
+	2: "logo_url",
+	3: "cover_url",
 }
 
-// Decode decodes CreateBookingReq from json.
-func (s *CreateBookingReq) Decode(d *jx.Decoder) error {
+// Decode decodes Branding from json.
+func (s *Branding) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode CreateBookingReq to nil")
+		return errors.New("invalid: unable to decode Branding to nil")
 	}
-	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "guest_email":
-			requiredBitSet[0] |= 1 << 0
+		case "primary_color":
 			if err := func() error {
-				v, err := d.Str()
-				s.GuestEmail = string(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"guest_email\"")
-			}
-		case "guest_name":
-			if err := func() error {
-				s.GuestName.Reset()
-				if err := s.GuestName.Decode(d); err != nil {
+				s.PrimaryColor.Reset()
+				if err := s.PrimaryColor.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"guest_name\"")
+				return errors.Wrap(err, "decode field \"primary_color\"")
 			}
-		case "start_time":
-			requiredBitSet[0] |= 1 << 2
+		case "accent_color":
 			if err := func() error {
-				v, err := json.DecodeDateTime(d)
-				s.StartTime = v
-				if err != nil {
+				s.AccentColor.Reset()
+				if err := s.AccentColor.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"start_time\"")
+				return errors.Wrap(err, "decode field \"accent_color\"")
 			}
-		case "end_time":
-			requiredBitSet[0] |= 1 << 3
+		case "logo_url":
 			if err := func() error {
-				v, err := json.DecodeDateTime(d)
-				s.EndTime = v
-				if err != nil {
+				s.LogoURL.Reset()
+				if err := s.LogoURL.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"end_time\"")
+				return errors.Wrap(err, "decode field \"logo_url\"")
 			}
-		case "custom_fields":
+		case "cover_url":
 			if err := func() error {
-				s.CustomFields.Reset()
-				if err := s.CustomFields.Decode(d); err != nil {
+				s.CoverURL.Reset()
+				if err := s.CoverURL.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"custom_fields\"")
+				return errors.Wrap(err, "decode field \"cover_url\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode CreateBookingReq")
-	}
-	// Validate required fields.
-	var failures []validate.FieldError
-	for i, mask := range [1]uint8{
-		0b00001101,
-	} {
-		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
-			// Mask only required fields and check equality to mask using XOR.
-			//
-			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
-			// Bits of fields which would be set are actually bits of missed fields.
-			missed := bits.OnesCount8(result)
-			for bitN := 0; bitN < missed; bitN++ {
-				bitIdx := bits.TrailingZeros8(result)
-				fieldIdx := i*8 + bitIdx
-				var name string
-				if fieldIdx < len(jsonFieldsNameOfCreateBookingReq) {
-					name = jsonFieldsNameOfCreateBookingReq[fieldIdx]
-				} else {
-					name = strconv.Itoa(fieldIdx)
-				}
-				failures = append(failures, validate.FieldError{
-					Name:  name,
-					Error: validate.ErrFieldRequired,
-				})
-				// Reset bit.
-				result &^= 1 << bitIdx
-			}
-		}
-	}
-	if len(failures) > 0 {
-		return &validate.Error{Fields: failures}
-	}
-
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s *CreateBookingReq) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *CreateBookingReq) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
-
-// Encode implements json.Marshaler.
-func (s CreateBookingReqCustomFields) Encode(e *jx.Encoder) {
-	e.ObjStart()
-	s.encodeFields(e)
-	e.ObjEnd()
-}
-
-// encodeFields implements json.Marshaler.
-func (s CreateBookingReqCustomFields) encodeFields(e *jx.Encoder) {
-	for k, elem := range s {
-		e.FieldStart(k)
-
-		e.Str(elem)
-	}
-}
-
-// Decode decodes CreateBookingReqCustomFields from json.
-func (s *CreateBookingReqCustomFields) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode CreateBookingReqCustomFields to nil")
-	}
-	m := s.init()
-	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		var elem string
-		if err := func() error {
-			v, err := d.Str()
-			elem = string(v)
-			if err != nil {
-				return err
-			}
-			return nil
-		}(); err != nil {
-			return errors.Wrapf(err, "decode field %q", k)
-		}
-		m[string(k)] = elem
-		return nil
-	}); err != nil {
-		return errors.Wrap(err, "decode CreateBookingReqCustomFields")
+		return errors.Wrap(err, "decode Branding")
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s CreateBookingReqCustomFields) MarshalJSON() ([]byte, error) {
+func (s *Branding) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *CreateBookingReqCustomFields) UnmarshalJSON(data []byte) error {
+func (s *Branding) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *CreatePollReq) Encode(e *jx.Encoder) {
+func (s *CalendarConnection) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *CreatePollReq) encodeFields(e *jx.Encoder) {
-	{
-		e.FieldStart("name")
-		e.Str(s.Name)
-	}
+func (s *CalendarConnection) encodeFields(e *jx.Encoder) {
 	{
-		if s.Description.Set {
-			e.FieldStart("description")
-			s.Description.Encode(e)
-		}
+		e.FieldStart("id")
+		e.Int(s.ID)
 	}
 	{
-		if s.ShowResults.Set {
-			e.FieldStart("show_results")
-			s.ShowResults.Encode(e)
-		}
+		e.FieldStart("server_url")
+		e.Str(s.ServerURL)
 	}
 	{
-		if s.RequireEmail.Set {
-			e.FieldStart("require_email")
-			s.RequireEmail.Encode(e)
-		}
+		e.FieldStart("username")
+		e.Str(s.Username)
 	}
 	{
-		if s.CustomFields != nil {
-			e.FieldStart("custom_fields")
+		if s.CalendarUrls != nil {
+			e.FieldStart("calendar_urls")
 			e.ArrStart()
-			for _, elem := range s.CustomFields {
-				elem.Encode(e)
+			for _, elem := range s.CalendarUrls {
+				e.Str(elem)
 			}
 			e.ArrEnd()
 		}
 	}
+	{
+		if s.WriteURL.Set {
+			e.FieldStart("write_url")
+			s.WriteURL.Encode(e)
+		}
+	}
 }
 
-var jsonFieldsNameOfCreatePollReq = [5]string{
-	0: "name",
-	1: "description",
-	2: "show_results",
-	3: "require_email",
-	4: "custom_fields",
+var jsonFieldsNameOfCalendarConnection = [5]string{
+	0: "id",
+	1: "server_url",
+	2: "username",
+	3: "calendar_urls",
+	4: "write_url",
 }
 
-// Decode decodes CreatePollReq from json.
-func (s *CreatePollReq) Decode(d *jx.Decoder) error {
+// Decode decodes CalendarConnection from json.
+func (s *CalendarConnection) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode CreatePollReq to nil")
+		return errors.New("invalid: unable to decode CalendarConnection to nil")
 	}
 	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "name":
+		case "id":
 			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				v, err := d.Str()
-				s.Name = string(v)
+				v, err := d.Int()
+				s.ID = int(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"name\"")
+				return errors.Wrap(err, "decode field \"id\"")
 			}
-		case "description":
+		case "server_url":
+			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
-				s.Description.Reset()
-				if err := s.Description.Decode(d); err != nil {
+				v, err := d.Str()
+				s.ServerURL = string(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"description\"")
+				return errors.Wrap(err, "decode field \"server_url\"")
 			}
-		case "show_results":
+		case "username":
+			requiredBitSet[0] |= 1 << 2
 			if err := func() error {
-				s.ShowResults.Reset()
-				if err := s.ShowResults.Decode(d); err != nil {
+				v, err := d.Str()
+				s.Username = string(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"show_results\"")
+				return errors.Wrap(err, "decode field \"username\"")
 			}
-		case "require_email":
+		case "calendar_urls":
 			if err := func() error {
-				s.RequireEmail.Reset()
-				if err := s.RequireEmail.Decode(d); err != nil {
+				s.CalendarUrls = make([]string, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem string
+					v, err := d.Str()
+					elem = string(v)
+					if err != nil {
+						return err
+					}
+					s.CalendarUrls = append(s.CalendarUrls, elem)
+					return nil
+				}); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"require_email\"")
+				return errors.Wrap(err, "decode field \"calendar_urls\"")
 			}
-		case "custom_fields":
+		case "write_url":
 			if err := func() error {
-				s.CustomFields = make([]CustomField, 0)
-				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem CustomField
-					if err := elem.Decode(d); err != nil {
-						return err
-					}
-					s.CustomFields = append(s.CustomFields, elem)
-					return nil
-				}); err != nil {
+				s.WriteURL.Reset()
+				if err := s.WriteURL.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"custom_fields\"")
+				return errors.Wrap(err, "decode field \"write_url\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode CreatePollReq")
+		return errors.Wrap(err, "decode CalendarConnection")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
 	for i, mask := range [1]uint8{
-		0b00000001,
+		0b00000111,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -2536,8 +2445,8 @@ func (s *CreatePollReq) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfCreatePollReq) {
-					name = jsonFieldsNameOfCreatePollReq[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfCalendarConnection) {
+					name = jsonFieldsNameOfCalendarConnection[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -2558,148 +2467,113 @@ func (s *CreatePollReq) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *CreatePollReq) MarshalJSON() ([]byte, error) {
+func (s *CalendarConnection) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *CreatePollReq) UnmarshalJSON(data []byte) error {
+func (s *CalendarConnection) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *CustomField) Encode(e *jx.Encoder) {
+func (s *CalendarDiscoveryResult) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *CustomField) encodeFields(e *jx.Encoder) {
-	{
-		e.FieldStart("name")
-		e.Str(s.Name)
-	}
-	{
-		e.FieldStart("label")
-		e.Str(s.Label)
-	}
+func (s *CalendarDiscoveryResult) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("type")
-		s.Type.Encode(e)
+		e.FieldStart("success")
+		e.Bool(s.Success)
 	}
 	{
-		e.FieldStart("required")
-		e.Bool(s.Required)
+		if s.Error.Set {
+			e.FieldStart("error")
+			s.Error.Encode(e)
+		}
 	}
 	{
-		if s.Options != nil {
-			e.FieldStart("options")
-			e.ArrStart()
-			for _, elem := range s.Options {
-				e.Str(elem)
-			}
-			e.ArrEnd()
+		e.FieldStart("calendars")
+		e.ArrStart()
+		for _, elem := range s.Calendars {
+			elem.Encode(e)
 		}
+		e.ArrEnd()
 	}
 }
 
-var jsonFieldsNameOfCustomField = [5]string{
-	0: "name",
-	1: "label",
-	2: "type",
-	3: "required",
-	4: "options",
+var jsonFieldsNameOfCalendarDiscoveryResult = [3]string{
+	0: "success",
+	1: "error",
+	2: "calendars",
 }
 
-// Decode decodes CustomField from json.
-func (s *CustomField) Decode(d *jx.Decoder) error {
+// Decode decodes CalendarDiscoveryResult from json.
+func (s *CalendarDiscoveryResult) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode CustomField to nil")
+		return errors.New("invalid: unable to decode CalendarDiscoveryResult to nil")
 	}
 	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "name":
+		case "success":
 			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				v, err := d.Str()
-				s.Name = string(v)
+				v, err := d.Bool()
+				s.Success = bool(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"name\"")
+				return errors.Wrap(err, "decode field \"success\"")
 			}
-		case "label":
-			requiredBitSet[0] |= 1 << 1
+		case "error":
 			if err := func() error {
-				v, err := d.Str()
-				s.Label = string(v)
-				if err != nil {
+				s.Error.Reset()
+				if err := s.Error.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"label\"")
+				return errors.Wrap(err, "decode field \"error\"")
 			}
-		case "type":
+		case "calendars":
 			requiredBitSet[0] |= 1 << 2
 			if err := func() error {
-				if err := s.Type.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"type\"")
-			}
-		case "required":
-			requiredBitSet[0] |= 1 << 3
-			if err := func() error {
-				v, err := d.Bool()
-				s.Required = bool(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"required\"")
-			}
-		case "options":
-			if err := func() error {
-				s.Options = make([]string, 0)
+				s.Calendars = make([]DiscoveredCalendar, 0)
 				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem string
-					v, err := d.Str()
-					elem = string(v)
-					if err != nil {
+					var elem DiscoveredCalendar
+					if err := elem.Decode(d); err != nil {
 						return err
 					}
-					s.Options = append(s.Options, elem)
+					s.Calendars = append(s.Calendars, elem)
 					return nil
 				}); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"options\"")
+				return errors.Wrap(err, "decode field \"calendars\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode CustomField")
+		return errors.Wrap(err, "decode CalendarDiscoveryResult")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
 	for i, mask := range [1]uint8{
-		0b00001111,
+		0b00000101,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -2711,8 +2585,8 @@ func (s *CustomField) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfCustomField) {
-					name = jsonFieldsNameOfCustomField[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfCalendarDiscoveryResult) {
+					name = jsonFieldsNameOfCalendarDiscoveryResult[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -2733,193 +2607,238 @@ func (s *CustomField) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *CustomField) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *CustomField) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
-
-// Encode encodes CustomFieldType as json.
-func (s CustomFieldType) Encode(e *jx.Encoder) {
-	e.Int(int(s))
-}
-
-// Decode decodes CustomFieldType from json.
-func (s *CustomFieldType) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode CustomFieldType to nil")
-	}
-	v, err := d.Int()
-	if err != nil {
-		return err
-	}
-	*s = CustomFieldType(v)
-
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s CustomFieldType) MarshalJSON() ([]byte, error) {
+func (s *CalendarDiscoveryResult) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *CustomFieldType) UnmarshalJSON(data []byte) error {
+func (s *CalendarDiscoveryResult) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *DeclineViaEmailOK) Encode(e *jx.Encoder) {
+func (s *CalendarTestResult) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *DeclineViaEmailOK) encodeFields(e *jx.Encoder) {
+func (s *CalendarTestResult) encodeFields(e *jx.Encoder) {
 	{
-		if s.Message.Set {
-			e.FieldStart("message")
-			s.Message.Encode(e)
+		e.FieldStart("success")
+		e.Bool(s.Success)
+	}
+	{
+		if s.Error.Set {
+			e.FieldStart("error")
+			s.Error.Encode(e)
+		}
+	}
+	{
+		e.FieldStart("events")
+		e.ArrStart()
+		for _, elem := range s.Events {
+			elem.Encode(e)
 		}
+		e.ArrEnd()
 	}
 }
 
-var jsonFieldsNameOfDeclineViaEmailOK = [1]string{
-	0: "message",
+var jsonFieldsNameOfCalendarTestResult = [3]string{
+	0: "success",
+	1: "error",
+	2: "events",
 }
 
-// Decode decodes DeclineViaEmailOK from json.
-func (s *DeclineViaEmailOK) Decode(d *jx.Decoder) error {
+// Decode decodes CalendarTestResult from json.
+func (s *CalendarTestResult) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode DeclineViaEmailOK to nil")
+		return errors.New("invalid: unable to decode CalendarTestResult to nil")
 	}
+	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "message":
+		case "success":
+			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				s.Message.Reset()
-				if err := s.Message.Decode(d); err != nil {
+				v, err := d.Bool()
+				s.Success = bool(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"message\"")
+				return errors.Wrap(err, "decode field \"success\"")
+			}
+		case "error":
+			if err := func() error {
+				s.Error.Reset()
+				if err := s.Error.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"error\"")
+			}
+		case "events":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				s.Events = make([]CalendarTestResultEventsItem, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem CalendarTestResultEventsItem
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Events = append(s.Events, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"events\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode DeclineViaEmailOK")
+		return errors.Wrap(err, "decode CalendarTestResult")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000101,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfCalendarTestResult) {
+					name = jsonFieldsNameOfCalendarTestResult[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *DeclineViaEmailOK) MarshalJSON() ([]byte, error) {
+func (s *CalendarTestResult) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *DeclineViaEmailOK) UnmarshalJSON(data []byte) error {
+func (s *CalendarTestResult) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *DiscoverCalendarsReq) Encode(e *jx.Encoder) {
+func (s *CalendarTestResultEventsItem) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *DiscoverCalendarsReq) encodeFields(e *jx.Encoder) {
+func (s *CalendarTestResultEventsItem) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("server_url")
-		e.Str(s.ServerURL)
+		e.FieldStart("title")
+		e.Str(s.Title)
 	}
 	{
-		e.FieldStart("username")
-		e.Str(s.Username)
+		e.FieldStart("start")
+		json.EncodeDateTime(e, s.Start)
 	}
 	{
-		e.FieldStart("password")
-		e.Str(s.Password)
+		e.FieldStart("end")
+		json.EncodeDateTime(e, s.End)
 	}
 }
 
-var jsonFieldsNameOfDiscoverCalendarsReq = [3]string{
-	0: "server_url",
-	1: "username",
-	2: "password",
+var jsonFieldsNameOfCalendarTestResultEventsItem = [3]string{
+	0: "title",
+	1: "start",
+	2: "end",
 }
 
-// Decode decodes DiscoverCalendarsReq from json.
-func (s *DiscoverCalendarsReq) Decode(d *jx.Decoder) error {
+// Decode decodes CalendarTestResultEventsItem from json.
+func (s *CalendarTestResultEventsItem) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode DiscoverCalendarsReq to nil")
+		return errors.New("invalid: unable to decode CalendarTestResultEventsItem to nil")
 	}
 	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "server_url":
+		case "title":
 			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
 				v, err := d.Str()
-				s.ServerURL = string(v)
+				s.Title = string(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"server_url\"")
+				return errors.Wrap(err, "decode field \"title\"")
 			}
-		case "username":
+		case "start":
 			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
-				v, err := d.Str()
-				s.Username = string(v)
+				v, err := json.DecodeDateTime(d)
+				s.Start = v
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"username\"")
+				return errors.Wrap(err, "decode field \"start\"")
 			}
-		case "password":
+		case "end":
 			requiredBitSet[0] |= 1 << 2
 			if err := func() error {
-				v, err := d.Str()
-				s.Password = string(v)
+				v, err := json.DecodeDateTime(d)
+				s.End = v
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"password\"")
+				return errors.Wrap(err, "decode field \"end\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode DiscoverCalendarsReq")
+		return errors.Wrap(err, "decode CalendarTestResultEventsItem")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
@@ -2936,8 +2855,8 @@ func (s *DiscoverCalendarsReq) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfDiscoverCalendarsReq) {
-					name = jsonFieldsNameOfDiscoverCalendarsReq[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfCalendarTestResultEventsItem) {
+					name = jsonFieldsNameOfCalendarTestResultEventsItem[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -2958,133 +2877,101 @@ func (s *DiscoverCalendarsReq) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *DiscoverCalendarsReq) MarshalJSON() ([]byte, error) {
+func (s *CalendarTestResultEventsItem) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *DiscoverCalendarsReq) UnmarshalJSON(data []byte) error {
+func (s *CalendarTestResultEventsItem) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *DiscoveredCalendar) Encode(e *jx.Encoder) {
+func (s *CreateBookingCreated) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *DiscoveredCalendar) encodeFields(e *jx.Encoder) {
-	{
-		e.FieldStart("url")
-		e.Str(s.URL)
-	}
+func (s *CreateBookingCreated) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("name")
-		e.Str(s.Name)
+		e.FieldStart("status")
+		s.Status.Encode(e)
 	}
 	{
-		if s.Description.Set {
-			e.FieldStart("description")
-			s.Description.Encode(e)
+		if s.Message.Set {
+			e.FieldStart("message")
+			s.Message.Encode(e)
 		}
 	}
 	{
-		if s.SupportedComponents != nil {
-			e.FieldStart("supported_components")
-			e.ArrStart()
-			for _, elem := range s.SupportedComponents {
-				e.Str(elem)
-			}
-			e.ArrEnd()
+		if s.VerifyEmail.Set {
+			e.FieldStart("verify_email")
+			s.VerifyEmail.Encode(e)
 		}
 	}
 }
 
-var jsonFieldsNameOfDiscoveredCalendar = [4]string{
-	0: "url",
-	1: "name",
-	2: "description",
-	3: "supported_components",
+var jsonFieldsNameOfCreateBookingCreated = [3]string{
+	0: "status",
+	1: "message",
+	2: "verify_email",
 }
 
-// Decode decodes DiscoveredCalendar from json.
-func (s *DiscoveredCalendar) Decode(d *jx.Decoder) error {
+// Decode decodes CreateBookingCreated from json.
+func (s *CreateBookingCreated) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode DiscoveredCalendar to nil")
+		return errors.New("invalid: unable to decode CreateBookingCreated to nil")
 	}
 	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "url":
+		case "status":
 			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				v, err := d.Str()
-				s.URL = string(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"url\"")
-			}
-		case "name":
-			requiredBitSet[0] |= 1 << 1
-			if err := func() error {
-				v, err := d.Str()
-				s.Name = string(v)
-				if err != nil {
+				if err := s.Status.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"name\"")
+				return errors.Wrap(err, "decode field \"status\"")
 			}
-		case "description":
+		case "message":
 			if err := func() error {
-				s.Description.Reset()
-				if err := s.Description.Decode(d); err != nil {
+				s.Message.Reset()
+				if err := s.Message.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"description\"")
+				return errors.Wrap(err, "decode field \"message\"")
 			}
-		case "supported_components":
+		case "verify_email":
 			if err := func() error {
-				s.SupportedComponents = make([]string, 0)
-				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem string
-					v, err := d.Str()
-					elem = string(v)
-					if err != nil {
-						return err
-					}
-					s.SupportedComponents = append(s.SupportedComponents, elem)
-					return nil
-				}); err != nil {
+				s.VerifyEmail.Reset()
+				if err := s.VerifyEmail.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"supported_components\"")
+				return errors.Wrap(err, "decode field \"verify_email\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode DiscoveredCalendar")
+		return errors.Wrap(err, "decode CreateBookingCreated")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
 	for i, mask := range [1]uint8{
-		0b00000011,
+		0b00000001,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -3096,8 +2983,8 @@ func (s *DiscoveredCalendar) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfDiscoveredCalendar) {
-					name = jsonFieldsNameOfDiscoveredCalendar[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfCreateBookingCreated) {
+					name = jsonFieldsNameOfCreateBookingCreated[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -3118,92 +3005,5044 @@ func (s *DiscoveredCalendar) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *DiscoveredCalendar) MarshalJSON() ([]byte, error) {
+func (s *CreateBookingCreated) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *DiscoveredCalendar) UnmarshalJSON(data []byte) error {
+func (s *CreateBookingCreated) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *Error) Encode(e *jx.Encoder) {
+func (s *CreateBookingLinkReq) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *Error) encodeFields(e *jx.Encoder) {
+func (s *CreateBookingLinkReq) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("message")
-		e.Str(s.Message)
+		e.FieldStart("name")
+		e.Str(s.Name)
+	}
+	{
+		if s.Description.Set {
+			e.FieldStart("description")
+			s.Description.Encode(e)
+		}
+	}
+	{
+		if s.AutoConfirm.Set {
+			e.FieldStart("auto_confirm")
+			s.AutoConfirm.Encode(e)
+		}
+	}
+	{
+		if s.AutoConfirmRules.Set {
+			e.FieldStart("auto_confirm_rules")
+			s.AutoConfirmRules.Encode(e)
+		}
+	}
+	{
+		if s.ApprovalTimeoutHours.Set {
+			e.FieldStart("approval_timeout_hours")
+			s.ApprovalTimeoutHours.Encode(e)
+		}
+	}
+	{
+		if s.ApprovalTimeoutAction.Set {
+			e.FieldStart("approval_timeout_action")
+			s.ApprovalTimeoutAction.Encode(e)
+		}
+	}
+	{
+		if s.SlotDurationMinutes.Set {
+			e.FieldStart("slot_duration_minutes")
+			s.SlotDurationMinutes.Encode(e)
+		}
+	}
+	{
+		if s.SlotDurationsMinutes != nil {
+			e.FieldStart("slot_durations_minutes")
+			e.ArrStart()
+			for _, elem := range s.SlotDurationsMinutes {
+				e.Int(elem)
+			}
+			e.ArrEnd()
+		}
+	}
+	{
+		if s.BufferMinutes.Set {
+			e.FieldStart("buffer_minutes")
+			s.BufferMinutes.Encode(e)
+		}
+	}
+	{
+		if s.RequireEmail.Set {
+			e.FieldStart("require_email")
+			s.RequireEmail.Encode(e)
+		}
+	}
+	{
+		if s.MeetingLink.Set {
+			e.FieldStart("meeting_link")
+			s.MeetingLink.Encode(e)
+		}
+	}
+	{
+		if s.MeetingProvider.Set {
+			e.FieldStart("meeting_provider")
+			s.MeetingProvider.Encode(e)
+		}
+	}
+	{
+		if s.AvailabilityRules != nil {
+			e.FieldStart("availability_rules")
+			e.ArrStart()
+			for _, elem := range s.AvailabilityRules {
+				elem.Encode(e)
+			}
+			e.ArrEnd()
+		}
+	}
+	{
+		if s.CustomFields != nil {
+			e.FieldStart("custom_fields")
+			e.ArrStart()
+			for _, elem := range s.CustomFields {
+				elem.Encode(e)
+			}
+			e.ArrEnd()
+		}
+	}
+	{
+		if s.EventTemplate.Set {
+			e.FieldStart("event_template")
+			s.EventTemplate.Encode(e)
+		}
+	}
+	{
+		if s.Branding.Set {
+			e.FieldStart("branding")
+			s.Branding.Encode(e)
+		}
 	}
 }
 
-var jsonFieldsNameOfError = [1]string{
-	0: "message",
+var jsonFieldsNameOfCreateBookingLinkReq = [16]string{
+	0:  "name",
+	1:  "description",
+	2:  "auto_confirm",
+	3:  "auto_confirm_rules",
+	4:  "approval_timeout_hours",
+	5:  "approval_timeout_action",
+	6:  "slot_duration_minutes",
+	7:  "slot_durations_minutes",
+	8:  "buffer_minutes",
+	9:  "require_email",
+	10: "meeting_link",
+	11: "meeting_provider",
+	12: "availability_rules",
+	13: "custom_fields",
+	14: "event_template",
+	15: "branding",
 }
 
-// Decode decodes Error from json.
-func (s *Error) Decode(d *jx.Decoder) error {
+// Decode decodes CreateBookingLinkReq from json.
+func (s *CreateBookingLinkReq) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode Error to nil")
+		return errors.New("invalid: unable to decode CreateBookingLinkReq to nil")
 	}
-	var requiredBitSet [1]uint8
+	var requiredBitSet [2]uint8
+	s.setDefaults()
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "message":
+		case "name":
 			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
 				v, err := d.Str()
-				s.Message = string(v)
+				s.Name = string(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"message\"")
+				return errors.Wrap(err, "decode field \"name\"")
 			}
-		default:
-			return d.Skip()
-		}
-		return nil
-	}); err != nil {
-		return errors.Wrap(err, "decode Error")
-	}
-	// Validate required fields.
-	var failures []validate.FieldError
-	for i, mask := range [1]uint8{
-		0b00000001,
-	} {
-		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
-			// Mask only required fields and check equality to mask using XOR.
-			//
-			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
-			// Bits of fields which would be set are actually bits of missed fields.
-			missed := bits.OnesCount8(result)
-			for bitN := 0; bitN < missed; bitN++ {
-				bitIdx := bits.TrailingZeros8(result)
-				fieldIdx := i*8 + bitIdx
-				var name string
-				if fieldIdx < len(jsonFieldsNameOfError) {
-					name = jsonFieldsNameOfError[fieldIdx]
-				} else {
-					name = strconv.Itoa(fieldIdx)
+		case "description":
+			if err := func() error {
+				s.Description.Reset()
+				if err := s.Description.Decode(d); err != nil {
+					return err
 				}
-				failures = append(failures, validate.FieldError{
-					Name:  name,
-					Error: validate.ErrFieldRequired,
-				})
-				// Reset bit.
-				result &^= 1 << bitIdx
-			}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"description\"")
+			}
+		case "auto_confirm":
+			if err := func() error {
+				s.AutoConfirm.Reset()
+				if err := s.AutoConfirm.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"auto_confirm\"")
+			}
+		case "auto_confirm_rules":
+			if err := func() error {
+				s.AutoConfirmRules.Reset()
+				if err := s.AutoConfirmRules.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"auto_confirm_rules\"")
+			}
+		case "approval_timeout_hours":
+			if err := func() error {
+				s.ApprovalTimeoutHours.Reset()
+				if err := s.ApprovalTimeoutHours.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"approval_timeout_hours\"")
+			}
+		case "approval_timeout_action":
+			if err := func() error {
+				s.ApprovalTimeoutAction.Reset()
+				if err := s.ApprovalTimeoutAction.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"approval_timeout_action\"")
+			}
+		case "slot_duration_minutes":
+			if err := func() error {
+				s.SlotDurationMinutes.Reset()
+				if err := s.SlotDurationMinutes.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"slot_duration_minutes\"")
+			}
+		case "slot_durations_minutes":
+			if err := func() error {
+				s.SlotDurationsMinutes = make([]int, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem int
+					v, err := d.Int()
+					elem = int(v)
+					if err != nil {
+						return err
+					}
+					s.SlotDurationsMinutes = append(s.SlotDurationsMinutes, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"slot_durations_minutes\"")
+			}
+		case "buffer_minutes":
+			if err := func() error {
+				s.BufferMinutes.Reset()
+				if err := s.BufferMinutes.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"buffer_minutes\"")
+			}
+		case "require_email":
+			if err := func() error {
+				s.RequireEmail.Reset()
+				if err := s.RequireEmail.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"require_email\"")
+			}
+		case "meeting_link":
+			if err := func() error {
+				s.MeetingLink.Reset()
+				if err := s.MeetingLink.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"meeting_link\"")
+			}
+		case "meeting_provider":
+			if err := func() error {
+				s.MeetingProvider.Reset()
+				if err := s.MeetingProvider.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"meeting_provider\"")
+			}
+		case "availability_rules":
+			if err := func() error {
+				s.AvailabilityRules = make([]AvailabilityRule, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem AvailabilityRule
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.AvailabilityRules = append(s.AvailabilityRules, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"availability_rules\"")
+			}
+		case "custom_fields":
+			if err := func() error {
+				s.CustomFields = make([]CustomField, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem CustomField
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.CustomFields = append(s.CustomFields, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"custom_fields\"")
+			}
+		case "event_template":
+			if err := func() error {
+				s.EventTemplate.Reset()
+				if err := s.EventTemplate.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"event_template\"")
+			}
+		case "branding":
+			if err := func() error {
+				s.Branding.Reset()
+				if err := s.Branding.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"branding\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode CreateBookingLinkReq")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [2]uint8{
+		0b00000001,
+		0b00000000,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfCreateBookingLinkReq) {
+					name = jsonFieldsNameOfCreateBookingLinkReq[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *CreateBookingLinkReq) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *CreateBookingLinkReq) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *CreateBookingReq) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *CreateBookingReq) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("guest_email")
+		e.Str(s.GuestEmail)
+	}
+	{
+		if s.GuestName.Set {
+			e.FieldStart("guest_name")
+			s.GuestName.Encode(e)
+		}
+	}
+	{
+		e.FieldStart("start_time")
+		json.EncodeDateTime(e, s.StartTime)
+	}
+	{
+		e.FieldStart("end_time")
+		json.EncodeDateTime(e, s.EndTime)
+	}
+	{
+		if s.CustomFields.Set {
+			e.FieldStart("custom_fields")
+			s.CustomFields.Encode(e)
+		}
+	}
+}
+
+var jsonFieldsNameOfCreateBookingReq = [5]string{
+	0: "guest_email",
+	1: "guest_name",
+	2: "start_time",
+	3: "end_time",
+	4: "custom_fields",
+}
+
+// Decode decodes CreateBookingReq from json.
+func (s *CreateBookingReq) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode CreateBookingReq to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "guest_email":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Str()
+				s.GuestEmail = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"guest_email\"")
+			}
+		case "guest_name":
+			if err := func() error {
+				s.GuestName.Reset()
+				if err := s.GuestName.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"guest_name\"")
+			}
+		case "start_time":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				v, err := json.DecodeDateTime(d)
+				s.StartTime = v
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"start_time\"")
+			}
+		case "end_time":
+			requiredBitSet[0] |= 1 << 3
+			if err := func() error {
+				v, err := json.DecodeDateTime(d)
+				s.EndTime = v
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"end_time\"")
+			}
+		case "custom_fields":
+			if err := func() error {
+				s.CustomFields.Reset()
+				if err := s.CustomFields.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"custom_fields\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode CreateBookingReq")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00001101,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfCreateBookingReq) {
+					name = jsonFieldsNameOfCreateBookingReq[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *CreateBookingReq) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *CreateBookingReq) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s CreateBookingReqCustomFields) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields implements json.Marshaler.
+func (s CreateBookingReqCustomFields) encodeFields(e *jx.Encoder) {
+	for k, elem := range s {
+		e.FieldStart(k)
+
+		e.Str(elem)
+	}
+}
+
+// Decode decodes CreateBookingReqCustomFields from json.
+func (s *CreateBookingReqCustomFields) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode CreateBookingReqCustomFields to nil")
+	}
+	m := s.init()
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		var elem string
+		if err := func() error {
+			v, err := d.Str()
+			elem = string(v)
+			if err != nil {
+				return err
+			}
+			return nil
+		}(); err != nil {
+			return errors.Wrapf(err, "decode field %q", k)
+		}
+		m[string(k)] = elem
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode CreateBookingReqCustomFields")
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s CreateBookingReqCustomFields) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *CreateBookingReqCustomFields) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *CreatePollReq) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *CreatePollReq) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("name")
+		e.Str(s.Name)
+	}
+	{
+		if s.Description.Set {
+			e.FieldStart("description")
+			s.Description.Encode(e)
+		}
+	}
+	{
+		if s.ShowResults.Set {
+			e.FieldStart("show_results")
+			s.ShowResults.Encode(e)
+		}
+	}
+	{
+		if s.ResultsVisibility.Set {
+			e.FieldStart("results_visibility")
+			s.ResultsVisibility.Encode(e)
+		}
+	}
+	{
+		if s.ResultsTiming.Set {
+			e.FieldStart("results_timing")
+			s.ResultsTiming.Encode(e)
+		}
+	}
+	{
+		if s.RequireEmail.Set {
+			e.FieldStart("require_email")
+			s.RequireEmail.Encode(e)
+		}
+	}
+	{
+		if s.Mode.Set {
+			e.FieldStart("mode")
+			s.Mode.Encode(e)
+		}
+	}
+	{
+		if s.MaxChoices.Set {
+			e.FieldStart("max_choices")
+			s.MaxChoices.Encode(e)
+		}
+	}
+	{
+		if s.CustomFields != nil {
+			e.FieldStart("custom_fields")
+			e.ArrStart()
+			for _, elem := range s.CustomFields {
+				elem.Encode(e)
+			}
+			e.ArrEnd()
+		}
+	}
+	{
+		if s.Branding.Set {
+			e.FieldStart("branding")
+			s.Branding.Encode(e)
+		}
+	}
+	{
+		if s.Deadline.Set {
+			e.FieldStart("deadline")
+			s.Deadline.Encode(e, json.EncodeDateTime)
+		}
+	}
+	{
+		if s.EventTemplate.Set {
+			e.FieldStart("event_template")
+			s.EventTemplate.Encode(e)
+		}
+	}
+	{
+		if s.ReminderHours.Set {
+			e.FieldStart("reminder_hours")
+			s.ReminderHours.Encode(e)
+		}
+	}
+	{
+		if s.Scoring.Set {
+			e.FieldStart("scoring")
+			s.Scoring.Encode(e)
+		}
+	}
+	{
+		if s.AutoPickOnClose.Set {
+			e.FieldStart("auto_pick_on_close")
+			s.AutoPickOnClose.Encode(e)
+		}
+	}
+	{
+		if s.AutoPickWhenAllYes.Set {
+			e.FieldStart("auto_pick_when_all_yes")
+			s.AutoPickWhenAllYes.Encode(e)
+		}
+	}
+	{
+		if s.HoldTimes.Set {
+			e.FieldStart("hold_times")
+			s.HoldTimes.Encode(e)
+		}
+	}
+	{
+		if s.OptionProposals.Set {
+			e.FieldStart("option_proposals")
+			s.OptionProposals.Encode(e)
+		}
+	}
+}
+
+var jsonFieldsNameOfCreatePollReq = [18]string{
+	0:  "name",
+	1:  "description",
+	2:  "show_results",
+	3:  "results_visibility",
+	4:  "results_timing",
+	5:  "require_email",
+	6:  "mode",
+	7:  "max_choices",
+	8:  "custom_fields",
+	9:  "branding",
+	10: "deadline",
+	11: "event_template",
+	12: "reminder_hours",
+	13: "scoring",
+	14: "auto_pick_on_close",
+	15: "auto_pick_when_all_yes",
+	16: "hold_times",
+	17: "option_proposals",
+}
+
+// Decode decodes CreatePollReq from json.
+func (s *CreatePollReq) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode CreatePollReq to nil")
+	}
+	var requiredBitSet [3]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "name":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Str()
+				s.Name = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"name\"")
+			}
+		case "description":
+			if err := func() error {
+				s.Description.Reset()
+				if err := s.Description.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"description\"")
+			}
+		case "show_results":
+			if err := func() error {
+				s.ShowResults.Reset()
+				if err := s.ShowResults.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"show_results\"")
+			}
+		case "results_visibility":
+			if err := func() error {
+				s.ResultsVisibility.Reset()
+				if err := s.ResultsVisibility.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"results_visibility\"")
+			}
+		case "results_timing":
+			if err := func() error {
+				s.ResultsTiming.Reset()
+				if err := s.ResultsTiming.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"results_timing\"")
+			}
+		case "require_email":
+			if err := func() error {
+				s.RequireEmail.Reset()
+				if err := s.RequireEmail.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"require_email\"")
+			}
+		case "mode":
+			if err := func() error {
+				s.Mode.Reset()
+				if err := s.Mode.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"mode\"")
+			}
+		case "max_choices":
+			if err := func() error {
+				s.MaxChoices.Reset()
+				if err := s.MaxChoices.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"max_choices\"")
+			}
+		case "custom_fields":
+			if err := func() error {
+				s.CustomFields = make([]CustomField, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem CustomField
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.CustomFields = append(s.CustomFields, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"custom_fields\"")
+			}
+		case "branding":
+			if err := func() error {
+				s.Branding.Reset()
+				if err := s.Branding.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"branding\"")
+			}
+		case "deadline":
+			if err := func() error {
+				s.Deadline.Reset()
+				if err := s.Deadline.Decode(d, json.DecodeDateTime); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"deadline\"")
+			}
+		case "event_template":
+			if err := func() error {
+				s.EventTemplate.Reset()
+				if err := s.EventTemplate.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"event_template\"")
+			}
+		case "reminder_hours":
+			if err := func() error {
+				s.ReminderHours.Reset()
+				if err := s.ReminderHours.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"reminder_hours\"")
+			}
+		case "scoring":
+			if err := func() error {
+				s.Scoring.Reset()
+				if err := s.Scoring.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"scoring\"")
+			}
+		case "auto_pick_on_close":
+			if err := func() error {
+				s.AutoPickOnClose.Reset()
+				if err := s.AutoPickOnClose.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"auto_pick_on_close\"")
+			}
+		case "auto_pick_when_all_yes":
+			if err := func() error {
+				s.AutoPickWhenAllYes.Reset()
+				if err := s.AutoPickWhenAllYes.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"auto_pick_when_all_yes\"")
+			}
+		case "hold_times":
+			if err := func() error {
+				s.HoldTimes.Reset()
+				if err := s.HoldTimes.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"hold_times\"")
+			}
+		case "option_proposals":
+			if err := func() error {
+				s.OptionProposals.Reset()
+				if err := s.OptionProposals.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"option_proposals\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode CreatePollReq")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [3]uint8{
+		0b00000001,
+		0b00000000,
+		0b00000000,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfCreatePollReq) {
+					name = jsonFieldsNameOfCreatePollReq[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *CreatePollReq) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *CreatePollReq) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *CustomField) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *CustomField) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("name")
+		e.Str(s.Name)
+	}
+	{
+		e.FieldStart("label")
+		e.Str(s.Label)
+	}
+	{
+		e.FieldStart("type")
+		s.Type.Encode(e)
+	}
+	{
+		e.FieldStart("required")
+		e.Bool(s.Required)
+	}
+	{
+		if s.Options != nil {
+			e.FieldStart("options")
+			e.ArrStart()
+			for _, elem := range s.Options {
+				e.Str(elem)
+			}
+			e.ArrEnd()
+		}
+	}
+	{
+		if s.Pattern.Set {
+			e.FieldStart("pattern")
+			s.Pattern.Encode(e)
+		}
+	}
+	{
+		if s.MaxFileSizeMB.Set {
+			e.FieldStart("max_file_size_mb")
+			s.MaxFileSizeMB.Encode(e)
+		}
+	}
+	{
+		if s.FileTypes != nil {
+			e.FieldStart("file_types")
+			e.ArrStart()
+			for _, elem := range s.FileTypes {
+				e.Str(elem)
+			}
+			e.ArrEnd()
+		}
+	}
+}
+
+var jsonFieldsNameOfCustomField = [8]string{
+	0: "name",
+	1: "label",
+	2: "type",
+	3: "required",
+	4: "options",
+	5: "pattern",
+	6: "max_file_size_mb",
+	7: "file_types",
+}
+
+// Decode decodes CustomField from json.
+func (s *CustomField) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode CustomField to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "name":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Str()
+				s.Name = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"name\"")
+			}
+		case "label":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.Str()
+				s.Label = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"label\"")
+			}
+		case "type":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				if err := s.Type.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"type\"")
+			}
+		case "required":
+			requiredBitSet[0] |= 1 << 3
+			if err := func() error {
+				v, err := d.Bool()
+				s.Required = bool(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"required\"")
+			}
+		case "options":
+			if err := func() error {
+				s.Options = make([]string, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem string
+					v, err := d.Str()
+					elem = string(v)
+					if err != nil {
+						return err
+					}
+					s.Options = append(s.Options, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"options\"")
+			}
+		case "pattern":
+			if err := func() error {
+				s.Pattern.Reset()
+				if err := s.Pattern.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"pattern\"")
+			}
+		case "max_file_size_mb":
+			if err := func() error {
+				s.MaxFileSizeMB.Reset()
+				if err := s.MaxFileSizeMB.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"max_file_size_mb\"")
+			}
+		case "file_types":
+			if err := func() error {
+				s.FileTypes = make([]string, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem string
+					v, err := d.Str()
+					elem = string(v)
+					if err != nil {
+						return err
+					}
+					s.FileTypes = append(s.FileTypes, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"file_types\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode CustomField")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00001111,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfCustomField) {
+					name = jsonFieldsNameOfCustomField[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *CustomField) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *CustomField) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes CustomFieldType as json.
+func (s CustomFieldType) Encode(e *jx.Encoder) {
+	e.Int(int(s))
+}
+
+// Decode decodes CustomFieldType from json.
+func (s *CustomFieldType) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode CustomFieldType to nil")
+	}
+	v, err := d.Int()
+	if err != nil {
+		return err
+	}
+	*s = CustomFieldType(v)
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s CustomFieldType) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *CustomFieldType) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *DeclineViaEmailOK) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *DeclineViaEmailOK) encodeFields(e *jx.Encoder) {
+	{
+		if s.Message.Set {
+			e.FieldStart("message")
+			s.Message.Encode(e)
+		}
+	}
+}
+
+var jsonFieldsNameOfDeclineViaEmailOK = [1]string{
+	0: "message",
+}
+
+// Decode decodes DeclineViaEmailOK from json.
+func (s *DeclineViaEmailOK) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode DeclineViaEmailOK to nil")
+	}
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "message":
+			if err := func() error {
+				s.Message.Reset()
+				if err := s.Message.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"message\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode DeclineViaEmailOK")
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *DeclineViaEmailOK) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *DeclineViaEmailOK) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *DiscoverCalendarsReq) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *DiscoverCalendarsReq) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("server_url")
+		e.Str(s.ServerURL)
+	}
+	{
+		e.FieldStart("username")
+		e.Str(s.Username)
+	}
+	{
+		e.FieldStart("password")
+		e.Str(s.Password)
+	}
+}
+
+var jsonFieldsNameOfDiscoverCalendarsReq = [3]string{
+	0: "server_url",
+	1: "username",
+	2: "password",
+}
+
+// Decode decodes DiscoverCalendarsReq from json.
+func (s *DiscoverCalendarsReq) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode DiscoverCalendarsReq to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "server_url":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Str()
+				s.ServerURL = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"server_url\"")
+			}
+		case "username":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.Str()
+				s.Username = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"username\"")
+			}
+		case "password":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				v, err := d.Str()
+				s.Password = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"password\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode DiscoverCalendarsReq")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000111,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfDiscoverCalendarsReq) {
+					name = jsonFieldsNameOfDiscoverCalendarsReq[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *DiscoverCalendarsReq) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *DiscoverCalendarsReq) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *DiscoveredCalendar) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *DiscoveredCalendar) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("url")
+		e.Str(s.URL)
+	}
+	{
+		e.FieldStart("name")
+		e.Str(s.Name)
+	}
+	{
+		if s.Description.Set {
+			e.FieldStart("description")
+			s.Description.Encode(e)
+		}
+	}
+	{
+		if s.SupportedComponents != nil {
+			e.FieldStart("supported_components")
+			e.ArrStart()
+			for _, elem := range s.SupportedComponents {
+				e.Str(elem)
+			}
+			e.ArrEnd()
+		}
+	}
+}
+
+var jsonFieldsNameOfDiscoveredCalendar = [4]string{
+	0: "url",
+	1: "name",
+	2: "description",
+	3: "supported_components",
+}
+
+// Decode decodes DiscoveredCalendar from json.
+func (s *DiscoveredCalendar) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode DiscoveredCalendar to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "url":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Str()
+				s.URL = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"url\"")
+			}
+		case "name":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.Str()
+				s.Name = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"name\"")
+			}
+		case "description":
+			if err := func() error {
+				s.Description.Reset()
+				if err := s.Description.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"description\"")
+			}
+		case "supported_components":
+			if err := func() error {
+				s.SupportedComponents = make([]string, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem string
+					v, err := d.Str()
+					elem = string(v)
+					if err != nil {
+						return err
+					}
+					s.SupportedComponents = append(s.SupportedComponents, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"supported_components\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode DiscoveredCalendar")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000011,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfDiscoveredCalendar) {
+					name = jsonFieldsNameOfDiscoveredCalendar[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *DiscoveredCalendar) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *DiscoveredCalendar) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *Error) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *Error) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("message")
+		e.Str(s.Message)
+	}
+	{
+		if s.FieldErrors != nil {
+			e.FieldStart("field_errors")
+			e.ArrStart()
+			for _, elem := range s.FieldErrors {
+				elem.Encode(e)
+			}
+			e.ArrEnd()
+		}
+	}
+}
+
+var jsonFieldsNameOfError = [2]string{
+	0: "message",
+	1: "field_errors",
+}
+
+// Decode decodes Error from json.
+func (s *Error) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode Error to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "message":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Str()
+				s.Message = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"message\"")
+			}
+		case "field_errors":
+			if err := func() error {
+				s.FieldErrors = make([]FieldError, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem FieldError
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.FieldErrors = append(s.FieldErrors, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"field_errors\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode Error")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000001,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfError) {
+					name = jsonFieldsNameOfError[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *Error) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *Error) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *EventTemplate) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *EventTemplate) encodeFields(e *jx.Encoder) {
+	{
+		if s.TitleTemplate.Set {
+			e.FieldStart("title_template")
+			s.TitleTemplate.Encode(e)
+		}
+	}
+	{
+		if s.DescriptionTemplate.Set {
+			e.FieldStart("description_template")
+			s.DescriptionTemplate.Encode(e)
+		}
+	}
+	{
+		if s.Location.Set {
+			e.FieldStart("location")
+			s.Location.Encode(e)
+		}
+	}
+}
+
+var jsonFieldsNameOfEventTemplate = [3]string{
+	0: "title_template",
+	1: "description_template",
+	2: "location",
+}
+
+// Decode decodes EventTemplate from json.
+func (s *EventTemplate) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode EventTemplate to nil")
+	}
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "title_template":
+			if err := func() error {
+				s.TitleTemplate.Reset()
+				if err := s.TitleTemplate.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"title_template\"")
+			}
+		case "description_template":
+			if err := func() error {
+				s.DescriptionTemplate.Reset()
+				if err := s.DescriptionTemplate.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"description_template\"")
+			}
+		case "location":
+			if err := func() error {
+				s.Location.Reset()
+				if err := s.Location.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"location\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode EventTemplate")
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *EventTemplate) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *EventTemplate) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *FieldError) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *FieldError) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("field")
+		e.Str(s.Field)
+	}
+	{
+		e.FieldStart("message")
+		e.Str(s.Message)
+	}
+}
+
+var jsonFieldsNameOfFieldError = [2]string{
+	0: "field",
+	1: "message",
+}
+
+// Decode decodes FieldError from json.
+func (s *FieldError) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode FieldError to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "field":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Str()
+				s.Field = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"field\"")
+			}
+		case "message":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.Str()
+				s.Message = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"message\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode FieldError")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000011,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfFieldError) {
+					name = jsonFieldsNameOfFieldError[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *FieldError) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *FieldError) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *GeneratePollOptionsOK) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *GeneratePollOptionsOK) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("candidates")
+		e.ArrStart()
+		for _, elem := range s.Candidates {
+			elem.Encode(e)
+		}
+		e.ArrEnd()
+	}
+	{
+		e.FieldStart("skipped")
+		e.Int(s.Skipped)
+	}
+	{
+		if s.Added != nil {
+			e.FieldStart("added")
+			e.ArrStart()
+			for _, elem := range s.Added {
+				elem.Encode(e)
+			}
+			e.ArrEnd()
+		}
+	}
+}
+
+var jsonFieldsNameOfGeneratePollOptionsOK = [3]string{
+	0: "candidates",
+	1: "skipped",
+	2: "added",
+}
+
+// Decode decodes GeneratePollOptionsOK from json.
+func (s *GeneratePollOptionsOK) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode GeneratePollOptionsOK to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "candidates":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				s.Candidates = make([]PollOptionCandidate, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem PollOptionCandidate
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Candidates = append(s.Candidates, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"candidates\"")
+			}
+		case "skipped":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.Int()
+				s.Skipped = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"skipped\"")
+			}
+		case "added":
+			if err := func() error {
+				s.Added = make([]PollOption, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem PollOption
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Added = append(s.Added, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"added\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode GeneratePollOptionsOK")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000011,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfGeneratePollOptionsOK) {
+					name = jsonFieldsNameOfGeneratePollOptionsOK[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *GeneratePollOptionsOK) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *GeneratePollOptionsOK) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *GeneratePollOptionsReq) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *GeneratePollOptionsReq) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("type")
+		s.Type.Encode(e)
+	}
+	{
+		e.FieldStart("start_date")
+		json.EncodeDate(e, s.StartDate)
+	}
+	{
+		e.FieldStart("end_date")
+		json.EncodeDate(e, s.EndDate)
+	}
+	{
+		if s.DaysOfWeek != nil {
+			e.FieldStart("days_of_week")
+			e.ArrStart()
+			for _, elem := range s.DaysOfWeek {
+				e.Int(elem)
+			}
+			e.ArrEnd()
+		}
+	}
+	{
+		if s.Times != nil {
+			e.FieldStart("times")
+			e.ArrStart()
+			for _, elem := range s.Times {
+				e.Str(elem)
+			}
+			e.ArrEnd()
+		}
+	}
+	{
+		if s.DurationMinutes.Set {
+			e.FieldStart("duration_minutes")
+			s.DurationMinutes.Encode(e)
+		}
+	}
+	{
+		if s.SpanDays.Set {
+			e.FieldStart("span_days")
+			s.SpanDays.Encode(e)
+		}
+	}
+	{
+		if s.TimeZone.Set {
+			e.FieldStart("time_zone")
+			s.TimeZone.Encode(e)
+		}
+	}
+	{
+		if s.Add.Set {
+			e.FieldStart("add")
+			s.Add.Encode(e)
+		}
+	}
+}
+
+var jsonFieldsNameOfGeneratePollOptionsReq = [9]string{
+	0: "type",
+	1: "start_date",
+	2: "end_date",
+	3: "days_of_week",
+	4: "times",
+	5: "duration_minutes",
+	6: "span_days",
+	7: "time_zone",
+	8: "add",
+}
+
+// Decode decodes GeneratePollOptionsReq from json.
+func (s *GeneratePollOptionsReq) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode GeneratePollOptionsReq to nil")
+	}
+	var requiredBitSet [2]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "type":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				if err := s.Type.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"type\"")
+			}
+		case "start_date":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := json.DecodeDate(d)
+				s.StartDate = v
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"start_date\"")
+			}
+		case "end_date":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				v, err := json.DecodeDate(d)
+				s.EndDate = v
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"end_date\"")
+			}
+		case "days_of_week":
+			if err := func() error {
+				s.DaysOfWeek = make([]int, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem int
+					v, err := d.Int()
+					elem = int(v)
+					if err != nil {
+						return err
+					}
+					s.DaysOfWeek = append(s.DaysOfWeek, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"days_of_week\"")
+			}
+		case "times":
+			if err := func() error {
+				s.Times = make([]string, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem string
+					v, err := d.Str()
+					elem = string(v)
+					if err != nil {
+						return err
+					}
+					s.Times = append(s.Times, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"times\"")
+			}
+		case "duration_minutes":
+			if err := func() error {
+				s.DurationMinutes.Reset()
+				if err := s.DurationMinutes.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"duration_minutes\"")
+			}
+		case "span_days":
+			if err := func() error {
+				s.SpanDays.Reset()
+				if err := s.SpanDays.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"span_days\"")
+			}
+		case "time_zone":
+			if err := func() error {
+				s.TimeZone.Reset()
+				if err := s.TimeZone.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"time_zone\"")
+			}
+		case "add":
+			if err := func() error {
+				s.Add.Reset()
+				if err := s.Add.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"add\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode GeneratePollOptionsReq")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [2]uint8{
+		0b00000111,
+		0b00000000,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfGeneratePollOptionsReq) {
+					name = jsonFieldsNameOfGeneratePollOptionsReq[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *GeneratePollOptionsReq) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *GeneratePollOptionsReq) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *GetBookingAvailabilityOK) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *GetBookingAvailabilityOK) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("slots")
+		e.ArrStart()
+		for _, elem := range s.Slots {
+			elem.Encode(e)
+		}
+		e.ArrEnd()
+	}
+}
+
+var jsonFieldsNameOfGetBookingAvailabilityOK = [1]string{
+	0: "slots",
+}
+
+// Decode decodes GetBookingAvailabilityOK from json.
+func (s *GetBookingAvailabilityOK) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode GetBookingAvailabilityOK to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "slots":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				s.Slots = make([]Slot, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem Slot
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Slots = append(s.Slots, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"slots\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode GetBookingAvailabilityOK")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000001,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfGetBookingAvailabilityOK) {
+					name = jsonFieldsNameOfGetBookingAvailabilityOK[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *GetBookingAvailabilityOK) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *GetBookingAvailabilityOK) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *GetPollResultsOK) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *GetPollResultsOK) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("tally")
+		e.ArrStart()
+		for _, elem := range s.Tally {
+			elem.Encode(e)
+		}
+		e.ArrEnd()
+	}
+	{
+		if s.RecommendedOptionID.Set {
+			e.FieldStart("recommended_option_id")
+			s.RecommendedOptionID.Encode(e)
+		}
+	}
+	{
+		if s.Votes != nil {
+			e.FieldStart("votes")
+			e.ArrStart()
+			for _, elem := range s.Votes {
+				elem.Encode(e)
+			}
+			e.ArrEnd()
+		}
+	}
+}
+
+var jsonFieldsNameOfGetPollResultsOK = [3]string{
+	0: "tally",
+	1: "recommended_option_id",
+	2: "votes",
+}
+
+// Decode decodes GetPollResultsOK from json.
+func (s *GetPollResultsOK) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode GetPollResultsOK to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "tally":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				s.Tally = make([]VoteTally, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem VoteTally
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Tally = append(s.Tally, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"tally\"")
+			}
+		case "recommended_option_id":
+			if err := func() error {
+				s.RecommendedOptionID.Reset()
+				if err := s.RecommendedOptionID.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"recommended_option_id\"")
+			}
+		case "votes":
+			if err := func() error {
+				s.Votes = make([]Vote, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem Vote
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Votes = append(s.Votes, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"votes\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode GetPollResultsOK")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000001,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfGetPollResultsOK) {
+					name = jsonFieldsNameOfGetPollResultsOK[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *GetPollResultsOK) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *GetPollResultsOK) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *GetPublicBookingLinkOK) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *GetPublicBookingLinkOK) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("name")
+		e.Str(s.Name)
+	}
+	{
+		if s.Description.Set {
+			e.FieldStart("description")
+			s.Description.Encode(e)
+		}
+	}
+	{
+		if s.CustomFields != nil {
+			e.FieldStart("custom_fields")
+			e.ArrStart()
+			for _, elem := range s.CustomFields {
+				elem.Encode(e)
+			}
+			e.ArrEnd()
+		}
+	}
+	{
+		if s.RequireEmail.Set {
+			e.FieldStart("require_email")
+			s.RequireEmail.Encode(e)
+		}
+	}
+	{
+		if s.SlotDurationsMinutes != nil {
+			e.FieldStart("slot_durations_minutes")
+			e.ArrStart()
+			for _, elem := range s.SlotDurationsMinutes {
+				e.Int(elem)
+			}
+			e.ArrEnd()
+		}
+	}
+	{
+		if s.OrganizerName.Set {
+			e.FieldStart("organizer_name")
+			s.OrganizerName.Encode(e)
+		}
+	}
+	{
+		if s.OrganizerAvatarURL.Set {
+			e.FieldStart("organizer_avatar_url")
+			s.OrganizerAvatarURL.Encode(e)
+		}
+	}
+	{
+		if s.Branding.Set {
+			e.FieldStart("branding")
+			s.Branding.Encode(e)
+		}
+	}
+}
+
+var jsonFieldsNameOfGetPublicBookingLinkOK = [8]string{
+	0: "name",
+	1: "description",
+	2: "custom_fields",
+	3: "require_email",
+	4: "slot_durations_minutes",
+	5: "organizer_name",
+	6: "organizer_avatar_url",
+	7: "branding",
+}
+
+// Decode decodes GetPublicBookingLinkOK from json.
+func (s *GetPublicBookingLinkOK) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode GetPublicBookingLinkOK to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "name":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Str()
+				s.Name = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"name\"")
+			}
+		case "description":
+			if err := func() error {
+				s.Description.Reset()
+				if err := s.Description.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"description\"")
+			}
+		case "custom_fields":
+			if err := func() error {
+				s.CustomFields = make([]CustomField, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem CustomField
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.CustomFields = append(s.CustomFields, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"custom_fields\"")
+			}
+		case "require_email":
+			if err := func() error {
+				s.RequireEmail.Reset()
+				if err := s.RequireEmail.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"require_email\"")
+			}
+		case "slot_durations_minutes":
+			if err := func() error {
+				s.SlotDurationsMinutes = make([]int, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem int
+					v, err := d.Int()
+					elem = int(v)
+					if err != nil {
+						return err
+					}
+					s.SlotDurationsMinutes = append(s.SlotDurationsMinutes, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"slot_durations_minutes\"")
+			}
+		case "organizer_name":
+			if err := func() error {
+				s.OrganizerName.Reset()
+				if err := s.OrganizerName.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"organizer_name\"")
+			}
+		case "organizer_avatar_url":
+			if err := func() error {
+				s.OrganizerAvatarURL.Reset()
+				if err := s.OrganizerAvatarURL.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"organizer_avatar_url\"")
+			}
+		case "branding":
+			if err := func() error {
+				s.Branding.Reset()
+				if err := s.Branding.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"branding\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode GetPublicBookingLinkOK")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000001,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfGetPublicBookingLinkOK) {
+					name = jsonFieldsNameOfGetPublicBookingLinkOK[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *GetPublicBookingLinkOK) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *GetPublicBookingLinkOK) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes GetPublicPollCommentsOKApplicationJSON as json.
+func (s GetPublicPollCommentsOKApplicationJSON) Encode(e *jx.Encoder) {
+	unwrapped := []PollComment(s)
+
+	e.ArrStart()
+	for _, elem := range unwrapped {
+		elem.Encode(e)
+	}
+	e.ArrEnd()
+}
+
+// Decode decodes GetPublicPollCommentsOKApplicationJSON from json.
+func (s *GetPublicPollCommentsOKApplicationJSON) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode GetPublicPollCommentsOKApplicationJSON to nil")
+	}
+	var unwrapped []PollComment
+	if err := func() error {
+		unwrapped = make([]PollComment, 0)
+		if err := d.Arr(func(d *jx.Decoder) error {
+			var elem PollComment
+			if err := elem.Decode(d); err != nil {
+				return err
+			}
+			unwrapped = append(unwrapped, elem)
+			return nil
+		}); err != nil {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return errors.Wrap(err, "alias")
+	}
+	*s = GetPublicPollCommentsOKApplicationJSON(unwrapped)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s GetPublicPollCommentsOKApplicationJSON) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *GetPublicPollCommentsOKApplicationJSON) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *GetPublicPollOK) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *GetPublicPollOK) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("name")
+		e.Str(s.Name)
+	}
+	{
+		if s.Description.Set {
+			e.FieldStart("description")
+			s.Description.Encode(e)
+		}
+	}
+	{
+		if s.CustomFields != nil {
+			e.FieldStart("custom_fields")
+			e.ArrStart()
+			for _, elem := range s.CustomFields {
+				elem.Encode(e)
+			}
+			e.ArrEnd()
+		}
+	}
+	{
+		e.FieldStart("options")
+		e.ArrStart()
+		for _, elem := range s.Options {
+			elem.Encode(e)
+		}
+		e.ArrEnd()
+	}
+	{
+		if s.ShowResults.Set {
+			e.FieldStart("show_results")
+			s.ShowResults.Encode(e)
+		}
+	}
+	{
+		if s.ResultsVisibility.Set {
+			e.FieldStart("results_visibility")
+			s.ResultsVisibility.Encode(e)
+		}
+	}
+	{
+		if s.ResultsTiming.Set {
+			e.FieldStart("results_timing")
+			s.ResultsTiming.Encode(e)
+		}
+	}
+	{
+		if s.RequireEmail.Set {
+			e.FieldStart("require_email")
+			s.RequireEmail.Encode(e)
+		}
+	}
+	{
+		if s.Mode.Set {
+			e.FieldStart("mode")
+			s.Mode.Encode(e)
+		}
+	}
+	{
+		if s.MaxChoices.Set {
+			e.FieldStart("max_choices")
+			s.MaxChoices.Encode(e)
+		}
+	}
+	{
+		if s.OptionProposals.Set {
+			e.FieldStart("option_proposals")
+			s.OptionProposals.Encode(e)
+		}
+	}
+	{
+		if s.OrganizerName.Set {
+			e.FieldStart("organizer_name")
+			s.OrganizerName.Encode(e)
+		}
+	}
+	{
+		if s.OrganizerAvatarURL.Set {
+			e.FieldStart("organizer_avatar_url")
+			s.OrganizerAvatarURL.Encode(e)
+		}
+	}
+	{
+		if s.Branding.Set {
+			e.FieldStart("branding")
+			s.Branding.Encode(e)
+		}
+	}
+	{
+		if s.Deadline.Set {
+			e.FieldStart("deadline")
+			s.Deadline.Encode(e, json.EncodeDateTime)
+		}
+	}
+	{
+		if s.Closed.Set {
+			e.FieldStart("closed")
+			s.Closed.Encode(e)
+		}
+	}
+	{
+		if s.WinnerOptionID.Set {
+			e.FieldStart("winner_option_id")
+			s.WinnerOptionID.Encode(e)
+		}
+	}
+	{
+		if s.Invitee.Set {
+			e.FieldStart("invitee")
+			s.Invitee.Encode(e)
+		}
+	}
+}
+
+var jsonFieldsNameOfGetPublicPollOK = [18]string{
+	0:  "name",
+	1:  "description",
+	2:  "custom_fields",
+	3:  "options",
+	4:  "show_results",
+	5:  "results_visibility",
+	6:  "results_timing",
+	7:  "require_email",
+	8:  "mode",
+	9:  "max_choices",
+	10: "option_proposals",
+	11: "organizer_name",
+	12: "organizer_avatar_url",
+	13: "branding",
+	14: "deadline",
+	15: "closed",
+	16: "winner_option_id",
+	17: "invitee",
+}
+
+// Decode decodes GetPublicPollOK from json.
+func (s *GetPublicPollOK) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode GetPublicPollOK to nil")
+	}
+	var requiredBitSet [3]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "name":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Str()
+				s.Name = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"name\"")
+			}
+		case "description":
+			if err := func() error {
+				s.Description.Reset()
+				if err := s.Description.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"description\"")
+			}
+		case "custom_fields":
+			if err := func() error {
+				s.CustomFields = make([]CustomField, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem CustomField
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.CustomFields = append(s.CustomFields, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"custom_fields\"")
+			}
+		case "options":
+			requiredBitSet[0] |= 1 << 3
+			if err := func() error {
+				s.Options = make([]PollOption, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem PollOption
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Options = append(s.Options, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"options\"")
+			}
+		case "show_results":
+			if err := func() error {
+				s.ShowResults.Reset()
+				if err := s.ShowResults.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"show_results\"")
+			}
+		case "results_visibility":
+			if err := func() error {
+				s.ResultsVisibility.Reset()
+				if err := s.ResultsVisibility.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"results_visibility\"")
+			}
+		case "results_timing":
+			if err := func() error {
+				s.ResultsTiming.Reset()
+				if err := s.ResultsTiming.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"results_timing\"")
+			}
+		case "require_email":
+			if err := func() error {
+				s.RequireEmail.Reset()
+				if err := s.RequireEmail.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"require_email\"")
+			}
+		case "mode":
+			if err := func() error {
+				s.Mode.Reset()
+				if err := s.Mode.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"mode\"")
+			}
+		case "max_choices":
+			if err := func() error {
+				s.MaxChoices.Reset()
+				if err := s.MaxChoices.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"max_choices\"")
+			}
+		case "option_proposals":
+			if err := func() error {
+				s.OptionProposals.Reset()
+				if err := s.OptionProposals.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"option_proposals\"")
+			}
+		case "organizer_name":
+			if err := func() error {
+				s.OrganizerName.Reset()
+				if err := s.OrganizerName.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"organizer_name\"")
+			}
+		case "organizer_avatar_url":
+			if err := func() error {
+				s.OrganizerAvatarURL.Reset()
+				if err := s.OrganizerAvatarURL.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"organizer_avatar_url\"")
+			}
+		case "branding":
+			if err := func() error {
+				s.Branding.Reset()
+				if err := s.Branding.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"branding\"")
+			}
+		case "deadline":
+			if err := func() error {
+				s.Deadline.Reset()
+				if err := s.Deadline.Decode(d, json.DecodeDateTime); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"deadline\"")
+			}
+		case "closed":
+			if err := func() error {
+				s.Closed.Reset()
+				if err := s.Closed.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"closed\"")
+			}
+		case "winner_option_id":
+			if err := func() error {
+				s.WinnerOptionID.Reset()
+				if err := s.WinnerOptionID.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"winner_option_id\"")
+			}
+		case "invitee":
+			if err := func() error {
+				s.Invitee.Reset()
+				if err := s.Invitee.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"invitee\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode GetPublicPollOK")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [3]uint8{
+		0b00001001,
+		0b00000000,
+		0b00000000,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfGetPublicPollOK) {
+					name = jsonFieldsNameOfGetPublicPollOK[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *GetPublicPollOK) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *GetPublicPollOK) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *GetPublicPollOKInvitee) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *GetPublicPollOKInvitee) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("email")
+		e.Str(s.Email)
+	}
+	{
+		if s.Name.Set {
+			e.FieldStart("name")
+			s.Name.Encode(e)
+		}
+	}
+	{
+		if s.EditToken.Set {
+			e.FieldStart("edit_token")
+			s.EditToken.Encode(e)
+		}
+	}
+}
+
+var jsonFieldsNameOfGetPublicPollOKInvitee = [3]string{
+	0: "email",
+	1: "name",
+	2: "edit_token",
+}
+
+// Decode decodes GetPublicPollOKInvitee from json.
+func (s *GetPublicPollOKInvitee) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode GetPublicPollOKInvitee to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "email":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Str()
+				s.Email = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"email\"")
+			}
+		case "name":
+			if err := func() error {
+				s.Name.Reset()
+				if err := s.Name.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"name\"")
+			}
+		case "edit_token":
+			if err := func() error {
+				s.EditToken.Reset()
+				if err := s.EditToken.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"edit_token\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode GetPublicPollOKInvitee")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000001,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfGetPublicPollOKInvitee) {
+					name = jsonFieldsNameOfGetPublicPollOKInvitee[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *GetPublicPollOKInvitee) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *GetPublicPollOKInvitee) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *Invitee) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *Invitee) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("email")
+		e.Str(s.Email)
+	}
+	{
+		if s.Name.Set {
+			e.FieldStart("name")
+			s.Name.Encode(e)
+		}
+	}
+	{
+		if s.Required.Set {
+			e.FieldStart("required")
+			s.Required.Encode(e)
+		}
+	}
+}
+
+var jsonFieldsNameOfInvitee = [3]string{
+	0: "email",
+	1: "name",
+	2: "required",
+}
+
+// Decode decodes Invitee from json.
+func (s *Invitee) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode Invitee to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "email":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Str()
+				s.Email = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"email\"")
+			}
+		case "name":
+			if err := func() error {
+				s.Name.Reset()
+				if err := s.Name.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"name\"")
+			}
+		case "required":
+			if err := func() error {
+				s.Required.Reset()
+				if err := s.Required.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"required\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode Invitee")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000001,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfInvitee) {
+					name = jsonFieldsNameOfInvitee[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *Invitee) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *Invitee) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes LinkStatus as json.
+func (s LinkStatus) Encode(e *jx.Encoder) {
+	e.Int(int(s))
+}
+
+// Decode decodes LinkStatus from json.
+func (s *LinkStatus) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode LinkStatus to nil")
+	}
+	v, err := d.Int()
+	if err != nil {
+		return err
+	}
+	*s = LinkStatus(v)
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s LinkStatus) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *LinkStatus) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *ModeratePollCommentReq) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *ModeratePollCommentReq) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("hidden")
+		e.Bool(s.Hidden)
+	}
+}
+
+var jsonFieldsNameOfModeratePollCommentReq = [1]string{
+	0: "hidden",
+}
+
+// Decode decodes ModeratePollCommentReq from json.
+func (s *ModeratePollCommentReq) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode ModeratePollCommentReq to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "hidden":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Bool()
+				s.Hidden = bool(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"hidden\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode ModeratePollCommentReq")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000001,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfModeratePollCommentReq) {
+					name = jsonFieldsNameOfModeratePollCommentReq[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *ModeratePollCommentReq) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *ModeratePollCommentReq) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes ApprovalTimeoutAction as json.
+func (o OptApprovalTimeoutAction) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	e.Int(int(o.Value))
+}
+
+// Decode decodes ApprovalTimeoutAction from json.
+func (o *OptApprovalTimeoutAction) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptApprovalTimeoutAction to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptApprovalTimeoutAction) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptApprovalTimeoutAction) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes AutoConfirmRules as json.
+func (o OptAutoConfirmRules) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	o.Value.Encode(e)
+}
+
+// Decode decodes AutoConfirmRules from json.
+func (o *OptAutoConfirmRules) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptAutoConfirmRules to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptAutoConfirmRules) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptAutoConfirmRules) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes BookingCustomFields as json.
+func (o OptBookingCustomFields) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	o.Value.Encode(e)
+}
+
+// Decode decodes BookingCustomFields from json.
+func (o *OptBookingCustomFields) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptBookingCustomFields to nil")
+	}
+	o.Set = true
+	o.Value = make(BookingCustomFields)
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptBookingCustomFields) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptBookingCustomFields) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes bool as json.
+func (o OptBool) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	e.Bool(bool(o.Value))
+}
+
+// Decode decodes bool from json.
+func (o *OptBool) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptBool to nil")
+	}
+	o.Set = true
+	v, err := d.Bool()
+	if err != nil {
+		return err
+	}
+	o.Value = bool(v)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptBool) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptBool) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes Branding as json.
+func (o OptBranding) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	o.Value.Encode(e)
+}
+
+// Decode decodes Branding from json.
+func (o *OptBranding) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptBranding to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptBranding) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptBranding) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes CreateBookingReqCustomFields as json.
+func (o OptCreateBookingReqCustomFields) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	o.Value.Encode(e)
+}
+
+// Decode decodes CreateBookingReqCustomFields from json.
+func (o *OptCreateBookingReqCustomFields) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptCreateBookingReqCustomFields to nil")
+	}
+	o.Set = true
+	o.Value = make(CreateBookingReqCustomFields)
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptCreateBookingReqCustomFields) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptCreateBookingReqCustomFields) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes time.Time as json.
+func (o OptDateTime) Encode(e *jx.Encoder, format func(*jx.Encoder, time.Time)) {
+	if !o.Set {
+		return
+	}
+	format(e, o.Value)
+}
+
+// Decode decodes time.Time from json.
+func (o *OptDateTime) Decode(d *jx.Decoder, format func(*jx.Decoder) (time.Time, error)) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptDateTime to nil")
+	}
+	o.Set = true
+	v, err := format(d)
+	if err != nil {
+		return err
+	}
+	o.Value = v
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptDateTime) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e, json.EncodeDateTime)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptDateTime) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d, json.DecodeDateTime)
+}
+
+// Encode encodes EventTemplate as json.
+func (o OptEventTemplate) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	o.Value.Encode(e)
+}
+
+// Decode decodes EventTemplate from json.
+func (o *OptEventTemplate) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptEventTemplate to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptEventTemplate) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptEventTemplate) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes GetPublicPollOKInvitee as json.
+func (o OptGetPublicPollOKInvitee) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	o.Value.Encode(e)
+}
+
+// Decode decodes GetPublicPollOKInvitee from json.
+func (o *OptGetPublicPollOKInvitee) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptGetPublicPollOKInvitee to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptGetPublicPollOKInvitee) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptGetPublicPollOKInvitee) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes int as json.
+func (o OptInt) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	e.Int(int(o.Value))
+}
+
+// Decode decodes int from json.
+func (o *OptInt) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptInt to nil")
+	}
+	o.Set = true
+	v, err := d.Int()
+	if err != nil {
+		return err
+	}
+	o.Value = int(v)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptInt) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptInt) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes LinkStatus as json.
+func (o OptLinkStatus) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	e.Int(int(o.Value))
+}
+
+// Decode decodes LinkStatus from json.
+func (o *OptLinkStatus) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptLinkStatus to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptLinkStatus) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptLinkStatus) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes time.Time as json.
+func (o OptNilDateTime) Encode(e *jx.Encoder, format func(*jx.Encoder, time.Time)) {
+	if !o.Set {
+		return
+	}
+	if o.Null {
+		e.Null()
+		return
+	}
+	format(e, o.Value)
+}
+
+// Decode decodes time.Time from json.
+func (o *OptNilDateTime) Decode(d *jx.Decoder, format func(*jx.Decoder) (time.Time, error)) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptNilDateTime to nil")
+	}
+	if d.Next() == jx.Null {
+		if err := d.Null(); err != nil {
+			return err
+		}
+
+		var v time.Time
+		o.Value = v
+		o.Set = true
+		o.Null = true
+		return nil
+	}
+	o.Set = true
+	o.Null = false
+	v, err := format(d)
+	if err != nil {
+		return err
+	}
+	o.Value = v
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptNilDateTime) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e, json.EncodeDateTime)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptNilDateTime) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d, json.DecodeDateTime)
+}
+
+// Encode encodes PollMode as json.
+func (o OptPollMode) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	e.Int(int(o.Value))
+}
+
+// Decode decodes PollMode from json.
+func (o *OptPollMode) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptPollMode to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptPollMode) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptPollMode) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes PollOption as json.
+func (o OptPollOption) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	o.Value.Encode(e)
+}
+
+// Decode decodes PollOption from json.
+func (o *OptPollOption) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptPollOption to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptPollOption) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptPollOption) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes PollScoring as json.
+func (o OptPollScoring) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	o.Value.Encode(e)
+}
+
+// Decode decodes PollScoring from json.
+func (o *OptPollScoring) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptPollScoring to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptPollScoring) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptPollScoring) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes ProposalMode as json.
+func (o OptProposalMode) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	e.Int(int(o.Value))
+}
+
+// Decode decodes ProposalMode from json.
+func (o *OptProposalMode) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptProposalMode to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptProposalMode) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptProposalMode) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes ResultsTiming as json.
+func (o OptResultsTiming) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	e.Int(int(o.Value))
+}
+
+// Decode decodes ResultsTiming from json.
+func (o *OptResultsTiming) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptResultsTiming to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptResultsTiming) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptResultsTiming) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes ResultsVisibility as json.
+func (o OptResultsVisibility) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	e.Int(int(o.Value))
+}
+
+// Decode decodes ResultsVisibility from json.
+func (o *OptResultsVisibility) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptResultsVisibility to nil")
+	}
+	o.Set = true
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptResultsVisibility) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptResultsVisibility) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes string as json.
+func (o OptString) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	e.Str(string(o.Value))
+}
+
+// Decode decodes string from json.
+func (o *OptString) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptString to nil")
+	}
+	o.Set = true
+	v, err := d.Str()
+	if err != nil {
+		return err
+	}
+	o.Value = string(v)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptString) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptString) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes SubmitVoteReqCustomFields as json.
+func (o OptSubmitVoteReqCustomFields) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	o.Value.Encode(e)
+}
+
+// Decode decodes SubmitVoteReqCustomFields from json.
+func (o *OptSubmitVoteReqCustomFields) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptSubmitVoteReqCustomFields to nil")
+	}
+	o.Set = true
+	o.Value = make(SubmitVoteReqCustomFields)
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptSubmitVoteReqCustomFields) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptSubmitVoteReqCustomFields) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes UpdateOwnVoteReqCustomFields as json.
+func (o OptUpdateOwnVoteReqCustomFields) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	o.Value.Encode(e)
+}
+
+// Decode decodes UpdateOwnVoteReqCustomFields from json.
+func (o *OptUpdateOwnVoteReqCustomFields) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptUpdateOwnVoteReqCustomFields to nil")
+	}
+	o.Set = true
+	o.Value = make(UpdateOwnVoteReqCustomFields)
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptUpdateOwnVoteReqCustomFields) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptUpdateOwnVoteReqCustomFields) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes VoteCustomFields as json.
+func (o OptVoteCustomFields) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	o.Value.Encode(e)
+}
+
+// Decode decodes VoteCustomFields from json.
+func (o *OptVoteCustomFields) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptVoteCustomFields to nil")
+	}
+	o.Set = true
+	o.Value = make(VoteCustomFields)
+	if err := o.Value.Decode(d); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptVoteCustomFields) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptVoteCustomFields) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *PickPollWinnerReq) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *PickPollWinnerReq) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("option_id")
+		e.Int(s.OptionID)
+	}
+}
+
+var jsonFieldsNameOfPickPollWinnerReq = [1]string{
+	0: "option_id",
+}
+
+// Decode decodes PickPollWinnerReq from json.
+func (s *PickPollWinnerReq) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode PickPollWinnerReq to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "option_id":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Int()
+				s.OptionID = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"option_id\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode PickPollWinnerReq")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000001,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfPickPollWinnerReq) {
+					name = jsonFieldsNameOfPickPollWinnerReq[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *PickPollWinnerReq) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *PickPollWinnerReq) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *Poll) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *Poll) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("id")
+		e.Int(s.ID)
+	}
+	{
+		e.FieldStart("slug")
+		e.Str(s.Slug)
+	}
+	{
+		e.FieldStart("name")
+		e.Str(s.Name)
+	}
+	{
+		if s.Description.Set {
+			e.FieldStart("description")
+			s.Description.Encode(e)
+		}
+	}
+	{
+		e.FieldStart("status")
+		s.Status.Encode(e)
+	}
+	{
+		if s.Mode.Set {
+			e.FieldStart("mode")
+			s.Mode.Encode(e)
+		}
+	}
+	{
+		if s.MaxChoices.Set {
+			e.FieldStart("max_choices")
+			s.MaxChoices.Encode(e)
+		}
+	}
+	{
+		if s.ShowResults.Set {
+			e.FieldStart("show_results")
+			s.ShowResults.Encode(e)
+		}
+	}
+	{
+		if s.ResultsVisibility.Set {
+			e.FieldStart("results_visibility")
+			s.ResultsVisibility.Encode(e)
+		}
+	}
+	{
+		if s.ResultsTiming.Set {
+			e.FieldStart("results_timing")
+			s.ResultsTiming.Encode(e)
+		}
+	}
+	{
+		if s.RequireEmail.Set {
+			e.FieldStart("require_email")
+			s.RequireEmail.Encode(e)
+		}
+	}
+	{
+		if s.CustomFields != nil {
+			e.FieldStart("custom_fields")
+			e.ArrStart()
+			for _, elem := range s.CustomFields {
+				elem.Encode(e)
+			}
+			e.ArrEnd()
+		}
+	}
+	{
+		if s.Branding.Set {
+			e.FieldStart("branding")
+			s.Branding.Encode(e)
+		}
+	}
+	{
+		if s.Deadline.Set {
+			e.FieldStart("deadline")
+			s.Deadline.Encode(e, json.EncodeDateTime)
+		}
+	}
+	{
+		if s.EventTemplate.Set {
+			e.FieldStart("event_template")
+			s.EventTemplate.Encode(e)
+		}
+	}
+	{
+		if s.WinnerOptionID.Set {
+			e.FieldStart("winner_option_id")
+			s.WinnerOptionID.Encode(e)
+		}
+	}
+	{
+		if s.ReminderHours.Set {
+			e.FieldStart("reminder_hours")
+			s.ReminderHours.Encode(e)
+		}
+	}
+	{
+		if s.Scoring.Set {
+			e.FieldStart("scoring")
+			s.Scoring.Encode(e)
+		}
+	}
+	{
+		if s.AutoPickOnClose.Set {
+			e.FieldStart("auto_pick_on_close")
+			s.AutoPickOnClose.Encode(e)
+		}
+	}
+	{
+		if s.AutoPickWhenAllYes.Set {
+			e.FieldStart("auto_pick_when_all_yes")
+			s.AutoPickWhenAllYes.Encode(e)
+		}
+	}
+	{
+		if s.HoldTimes.Set {
+			e.FieldStart("hold_times")
+			s.HoldTimes.Encode(e)
+		}
+	}
+	{
+		if s.OptionProposals.Set {
+			e.FieldStart("option_proposals")
+			s.OptionProposals.Encode(e)
+		}
+	}
+	{
+		if s.CreatedAt.Set {
+			e.FieldStart("created_at")
+			s.CreatedAt.Encode(e, json.EncodeDateTime)
+		}
+	}
+}
+
+var jsonFieldsNameOfPoll = [23]string{
+	0:  "id",
+	1:  "slug",
+	2:  "name",
+	3:  "description",
+	4:  "status",
+	5:  "mode",
+	6:  "max_choices",
+	7:  "show_results",
+	8:  "results_visibility",
+	9:  "results_timing",
+	10: "require_email",
+	11: "custom_fields",
+	12: "branding",
+	13: "deadline",
+	14: "event_template",
+	15: "winner_option_id",
+	16: "reminder_hours",
+	17: "scoring",
+	18: "auto_pick_on_close",
+	19: "auto_pick_when_all_yes",
+	20: "hold_times",
+	21: "option_proposals",
+	22: "created_at",
+}
+
+// Decode decodes Poll from json.
+func (s *Poll) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode Poll to nil")
+	}
+	var requiredBitSet [3]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "id":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Int()
+				s.ID = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"id\"")
+			}
+		case "slug":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.Str()
+				s.Slug = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"slug\"")
+			}
+		case "name":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				v, err := d.Str()
+				s.Name = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"name\"")
+			}
+		case "description":
+			if err := func() error {
+				s.Description.Reset()
+				if err := s.Description.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"description\"")
+			}
+		case "status":
+			requiredBitSet[0] |= 1 << 4
+			if err := func() error {
+				if err := s.Status.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"status\"")
+			}
+		case "mode":
+			if err := func() error {
+				s.Mode.Reset()
+				if err := s.Mode.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"mode\"")
+			}
+		case "max_choices":
+			if err := func() error {
+				s.MaxChoices.Reset()
+				if err := s.MaxChoices.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"max_choices\"")
+			}
+		case "show_results":
+			if err := func() error {
+				s.ShowResults.Reset()
+				if err := s.ShowResults.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"show_results\"")
+			}
+		case "results_visibility":
+			if err := func() error {
+				s.ResultsVisibility.Reset()
+				if err := s.ResultsVisibility.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"results_visibility\"")
+			}
+		case "results_timing":
+			if err := func() error {
+				s.ResultsTiming.Reset()
+				if err := s.ResultsTiming.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"results_timing\"")
+			}
+		case "require_email":
+			if err := func() error {
+				s.RequireEmail.Reset()
+				if err := s.RequireEmail.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"require_email\"")
+			}
+		case "custom_fields":
+			if err := func() error {
+				s.CustomFields = make([]CustomField, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem CustomField
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.CustomFields = append(s.CustomFields, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"custom_fields\"")
+			}
+		case "branding":
+			if err := func() error {
+				s.Branding.Reset()
+				if err := s.Branding.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"branding\"")
+			}
+		case "deadline":
+			if err := func() error {
+				s.Deadline.Reset()
+				if err := s.Deadline.Decode(d, json.DecodeDateTime); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"deadline\"")
+			}
+		case "event_template":
+			if err := func() error {
+				s.EventTemplate.Reset()
+				if err := s.EventTemplate.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"event_template\"")
+			}
+		case "winner_option_id":
+			if err := func() error {
+				s.WinnerOptionID.Reset()
+				if err := s.WinnerOptionID.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"winner_option_id\"")
+			}
+		case "reminder_hours":
+			if err := func() error {
+				s.ReminderHours.Reset()
+				if err := s.ReminderHours.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"reminder_hours\"")
+			}
+		case "scoring":
+			if err := func() error {
+				s.Scoring.Reset()
+				if err := s.Scoring.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"scoring\"")
+			}
+		case "auto_pick_on_close":
+			if err := func() error {
+				s.AutoPickOnClose.Reset()
+				if err := s.AutoPickOnClose.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"auto_pick_on_close\"")
+			}
+		case "auto_pick_when_all_yes":
+			if err := func() error {
+				s.AutoPickWhenAllYes.Reset()
+				if err := s.AutoPickWhenAllYes.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"auto_pick_when_all_yes\"")
+			}
+		case "hold_times":
+			if err := func() error {
+				s.HoldTimes.Reset()
+				if err := s.HoldTimes.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"hold_times\"")
+			}
+		case "option_proposals":
+			if err := func() error {
+				s.OptionProposals.Reset()
+				if err := s.OptionProposals.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"option_proposals\"")
+			}
+		case "created_at":
+			if err := func() error {
+				s.CreatedAt.Reset()
+				if err := s.CreatedAt.Decode(d, json.DecodeDateTime); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"created_at\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode Poll")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [3]uint8{
+		0b00010111,
+		0b00000000,
+		0b00000000,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfPoll) {
+					name = jsonFieldsNameOfPoll[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
 		}
 	}
 	if len(failures) > 0 {
@@ -3214,176 +8053,386 @@ func (s *Error) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *Error) MarshalJSON() ([]byte, error) {
+func (s *Poll) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *Error) UnmarshalJSON(data []byte) error {
+func (s *Poll) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *EventTemplate) Encode(e *jx.Encoder) {
+func (s *PollComment) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *EventTemplate) encodeFields(e *jx.Encoder) {
+func (s *PollComment) encodeFields(e *jx.Encoder) {
 	{
-		if s.TitleTemplate.Set {
-			e.FieldStart("title_template")
-			s.TitleTemplate.Encode(e)
+		e.FieldStart("id")
+		e.Int(s.ID)
+	}
+	{
+		if s.OptionID.Set {
+			e.FieldStart("option_id")
+			s.OptionID.Encode(e)
 		}
 	}
 	{
-		if s.DescriptionTemplate.Set {
-			e.FieldStart("description_template")
-			s.DescriptionTemplate.Encode(e)
+		e.FieldStart("author_name")
+		e.Str(s.AuthorName)
+	}
+	{
+		if s.AuthorEmail.Set {
+			e.FieldStart("author_email")
+			s.AuthorEmail.Encode(e)
 		}
 	}
 	{
-		if s.Location.Set {
-			e.FieldStart("location")
-			s.Location.Encode(e)
+		e.FieldStart("body")
+		e.Str(s.Body)
+	}
+	{
+		if s.Hidden.Set {
+			e.FieldStart("hidden")
+			s.Hidden.Encode(e)
+		}
+	}
+	{
+		if s.CreatedAt.Set {
+			e.FieldStart("created_at")
+			s.CreatedAt.Encode(e, json.EncodeDateTime)
 		}
 	}
 }
 
-var jsonFieldsNameOfEventTemplate = [3]string{
-	0: "title_template",
-	1: "description_template",
-	2: "location",
+var jsonFieldsNameOfPollComment = [7]string{
+	0: "id",
+	1: "option_id",
+	2: "author_name",
+	3: "author_email",
+	4: "body",
+	5: "hidden",
+	6: "created_at",
 }
 
-// Decode decodes EventTemplate from json.
-func (s *EventTemplate) Decode(d *jx.Decoder) error {
+// Decode decodes PollComment from json.
+func (s *PollComment) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode EventTemplate to nil")
+		return errors.New("invalid: unable to decode PollComment to nil")
 	}
+	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "title_template":
+		case "id":
+			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				s.TitleTemplate.Reset()
-				if err := s.TitleTemplate.Decode(d); err != nil {
+				v, err := d.Int()
+				s.ID = int(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"title_template\"")
+				return errors.Wrap(err, "decode field \"id\"")
 			}
-		case "description_template":
+		case "option_id":
 			if err := func() error {
-				s.DescriptionTemplate.Reset()
-				if err := s.DescriptionTemplate.Decode(d); err != nil {
+				s.OptionID.Reset()
+				if err := s.OptionID.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"description_template\"")
+				return errors.Wrap(err, "decode field \"option_id\"")
 			}
-		case "location":
+		case "author_name":
+			requiredBitSet[0] |= 1 << 2
 			if err := func() error {
-				s.Location.Reset()
-				if err := s.Location.Decode(d); err != nil {
+				v, err := d.Str()
+				s.AuthorName = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"author_name\"")
+			}
+		case "author_email":
+			if err := func() error {
+				s.AuthorEmail.Reset()
+				if err := s.AuthorEmail.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"author_email\"")
+			}
+		case "body":
+			requiredBitSet[0] |= 1 << 4
+			if err := func() error {
+				v, err := d.Str()
+				s.Body = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"body\"")
+			}
+		case "hidden":
+			if err := func() error {
+				s.Hidden.Reset()
+				if err := s.Hidden.Decode(d); err != nil {
 					return err
 				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"location\"")
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"hidden\"")
+			}
+		case "created_at":
+			if err := func() error {
+				s.CreatedAt.Reset()
+				if err := s.CreatedAt.Decode(d, json.DecodeDateTime); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"created_at\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode PollComment")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00010101,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfPollComment) {
+					name = jsonFieldsNameOfPollComment[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
 			}
-		default:
-			return d.Skip()
 		}
-		return nil
-	}); err != nil {
-		return errors.Wrap(err, "decode EventTemplate")
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *EventTemplate) MarshalJSON() ([]byte, error) {
+func (s *PollComment) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *EventTemplate) UnmarshalJSON(data []byte) error {
+func (s *PollComment) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *GetBookingAvailabilityOK) Encode(e *jx.Encoder) {
+func (s *PollInvite) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *GetBookingAvailabilityOK) encodeFields(e *jx.Encoder) {
+func (s *PollInvite) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("slots")
-		e.ArrStart()
-		for _, elem := range s.Slots {
-			elem.Encode(e)
+		e.FieldStart("id")
+		e.Int(s.ID)
+	}
+	{
+		e.FieldStart("email")
+		e.Str(s.Email)
+	}
+	{
+		if s.Name.Set {
+			e.FieldStart("name")
+			s.Name.Encode(e)
+		}
+	}
+	{
+		e.FieldStart("voted")
+		e.Bool(s.Voted)
+	}
+	{
+		if s.VotedAt.Set {
+			e.FieldStart("voted_at")
+			s.VotedAt.Encode(e, json.EncodeDateTime)
+		}
+	}
+	{
+		if s.Required.Set {
+			e.FieldStart("required")
+			s.Required.Encode(e)
+		}
+	}
+	{
+		if s.InvitedAt.Set {
+			e.FieldStart("invited_at")
+			s.InvitedAt.Encode(e, json.EncodeDateTime)
+		}
+	}
+	{
+		if s.RemindedAt.Set {
+			e.FieldStart("reminded_at")
+			s.RemindedAt.Encode(e, json.EncodeDateTime)
 		}
-		e.ArrEnd()
 	}
 }
 
-var jsonFieldsNameOfGetBookingAvailabilityOK = [1]string{
-	0: "slots",
+var jsonFieldsNameOfPollInvite = [8]string{
+	0: "id",
+	1: "email",
+	2: "name",
+	3: "voted",
+	4: "voted_at",
+	5: "required",
+	6: "invited_at",
+	7: "reminded_at",
 }
 
-// Decode decodes GetBookingAvailabilityOK from json.
-func (s *GetBookingAvailabilityOK) Decode(d *jx.Decoder) error {
+// Decode decodes PollInvite from json.
+func (s *PollInvite) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode GetBookingAvailabilityOK to nil")
+		return errors.New("invalid: unable to decode PollInvite to nil")
 	}
 	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "slots":
+		case "id":
 			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				s.Slots = make([]Slot, 0)
-				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem Slot
-					if err := elem.Decode(d); err != nil {
-						return err
-					}
-					s.Slots = append(s.Slots, elem)
-					return nil
-				}); err != nil {
+				v, err := d.Int()
+				s.ID = int(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"slots\"")
+				return errors.Wrap(err, "decode field \"id\"")
+			}
+		case "email":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.Str()
+				s.Email = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"email\"")
+			}
+		case "name":
+			if err := func() error {
+				s.Name.Reset()
+				if err := s.Name.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"name\"")
+			}
+		case "voted":
+			requiredBitSet[0] |= 1 << 3
+			if err := func() error {
+				v, err := d.Bool()
+				s.Voted = bool(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"voted\"")
+			}
+		case "voted_at":
+			if err := func() error {
+				s.VotedAt.Reset()
+				if err := s.VotedAt.Decode(d, json.DecodeDateTime); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"voted_at\"")
+			}
+		case "required":
+			if err := func() error {
+				s.Required.Reset()
+				if err := s.Required.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"required\"")
+			}
+		case "invited_at":
+			if err := func() error {
+				s.InvitedAt.Reset()
+				if err := s.InvitedAt.Decode(d, json.DecodeDateTime); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"invited_at\"")
+			}
+		case "reminded_at":
+			if err := func() error {
+				s.RemindedAt.Reset()
+				if err := s.RemindedAt.Decode(d, json.DecodeDateTime); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"reminded_at\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode GetBookingAvailabilityOK")
+		return errors.Wrap(err, "decode PollInvite")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
 	for i, mask := range [1]uint8{
-		0b00000001,
+		0b00001011,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -3395,8 +8444,8 @@ func (s *GetBookingAvailabilityOK) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfGetBookingAvailabilityOK) {
-					name = jsonFieldsNameOfGetBookingAvailabilityOK[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfPollInvite) {
+					name = jsonFieldsNameOfPollInvite[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -3417,107 +8466,218 @@ func (s *GetBookingAvailabilityOK) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *GetBookingAvailabilityOK) MarshalJSON() ([]byte, error) {
+func (s *PollInvite) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *GetBookingAvailabilityOK) UnmarshalJSON(data []byte) error {
+func (s *PollInvite) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes PollMode as json.
+func (s PollMode) Encode(e *jx.Encoder) {
+	e.Int(int(s))
+}
+
+// Decode decodes PollMode from json.
+func (s *PollMode) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode PollMode to nil")
+	}
+	v, err := d.Int()
+	if err != nil {
+		return err
+	}
+	*s = PollMode(v)
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s PollMode) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *PollMode) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *GetPollResultsOK) Encode(e *jx.Encoder) {
+func (s *PollOption) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *GetPollResultsOK) encodeFields(e *jx.Encoder) {
+func (s *PollOption) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("tally")
-		e.ArrStart()
-		for _, elem := range s.Tally {
-			elem.Encode(e)
+		e.FieldStart("id")
+		e.Int(s.ID)
+	}
+	{
+		e.FieldStart("type")
+		s.Type.Encode(e)
+	}
+	{
+		e.FieldStart("start_time")
+		json.EncodeDateTime(e, s.StartTime)
+	}
+	{
+		e.FieldStart("end_time")
+		json.EncodeDateTime(e, s.EndTime)
+	}
+	{
+		if s.Capacity.Set {
+			e.FieldStart("capacity")
+			s.Capacity.Encode(e)
 		}
-		e.ArrEnd()
 	}
 	{
-		if s.Votes != nil {
-			e.FieldStart("votes")
-			e.ArrStart()
-			for _, elem := range s.Votes {
-				elem.Encode(e)
+		if s.Signups.Set {
+			e.FieldStart("signups")
+			s.Signups.Encode(e)
+		}
+	}
+	{
+		if s.Full.Set {
+			e.FieldStart("full")
+			s.Full.Encode(e)
+		}
+	}
+	{
+		if s.ProposedBy.Set {
+			e.FieldStart("proposed_by")
+			s.ProposedBy.Encode(e)
+		}
+	}
+}
+
+var jsonFieldsNameOfPollOption = [8]string{
+	0: "id",
+	1: "type",
+	2: "start_time",
+	3: "end_time",
+	4: "capacity",
+	5: "signups",
+	6: "full",
+	7: "proposed_by",
+}
+
+// Decode decodes PollOption from json.
+func (s *PollOption) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode PollOption to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "id":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Int()
+				s.ID = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"id\"")
+			}
+		case "type":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				if err := s.Type.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"type\"")
+			}
+		case "start_time":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				v, err := json.DecodeDateTime(d)
+				s.StartTime = v
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"start_time\"")
+			}
+		case "end_time":
+			requiredBitSet[0] |= 1 << 3
+			if err := func() error {
+				v, err := json.DecodeDateTime(d)
+				s.EndTime = v
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"end_time\"")
 			}
-			e.ArrEnd()
-		}
-	}
-}
-
-var jsonFieldsNameOfGetPollResultsOK = [2]string{
-	0: "tally",
-	1: "votes",
-}
-
-// Decode decodes GetPollResultsOK from json.
-func (s *GetPollResultsOK) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode GetPollResultsOK to nil")
-	}
-	var requiredBitSet [1]uint8
-
-	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		switch string(k) {
-		case "tally":
-			requiredBitSet[0] |= 1 << 0
+		case "capacity":
 			if err := func() error {
-				s.Tally = make([]VoteTally, 0)
-				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem VoteTally
-					if err := elem.Decode(d); err != nil {
-						return err
-					}
-					s.Tally = append(s.Tally, elem)
-					return nil
-				}); err != nil {
+				s.Capacity.Reset()
+				if err := s.Capacity.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"tally\"")
+				return errors.Wrap(err, "decode field \"capacity\"")
 			}
-		case "votes":
+		case "signups":
 			if err := func() error {
-				s.Votes = make([]Vote, 0)
-				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem Vote
-					if err := elem.Decode(d); err != nil {
-						return err
-					}
-					s.Votes = append(s.Votes, elem)
-					return nil
-				}); err != nil {
+				s.Signups.Reset()
+				if err := s.Signups.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"votes\"")
+				return errors.Wrap(err, "decode field \"signups\"")
+			}
+		case "full":
+			if err := func() error {
+				s.Full.Reset()
+				if err := s.Full.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"full\"")
+			}
+		case "proposed_by":
+			if err := func() error {
+				s.ProposedBy.Reset()
+				if err := s.ProposedBy.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"proposed_by\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode GetPollResultsOK")
+		return errors.Wrap(err, "decode PollOption")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
 	for i, mask := range [1]uint8{
-		0b00000001,
+		0b00001111,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -3529,8 +8689,8 @@ func (s *GetPollResultsOK) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfGetPollResultsOK) {
-					name = jsonFieldsNameOfGetPollResultsOK[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfPollOption) {
+					name = jsonFieldsNameOfPollOption[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -3551,195 +8711,101 @@ func (s *GetPollResultsOK) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *GetPollResultsOK) MarshalJSON() ([]byte, error) {
+func (s *PollOption) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *GetPollResultsOK) UnmarshalJSON(data []byte) error {
+func (s *PollOption) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *GetPublicBookingLinkOK) Encode(e *jx.Encoder) {
+func (s *PollOptionCandidate) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *GetPublicBookingLinkOK) encodeFields(e *jx.Encoder) {
-	{
-		e.FieldStart("name")
-		e.Str(s.Name)
-	}
-	{
-		if s.Description.Set {
-			e.FieldStart("description")
-			s.Description.Encode(e)
-		}
-	}
-	{
-		if s.CustomFields != nil {
-			e.FieldStart("custom_fields")
-			e.ArrStart()
-			for _, elem := range s.CustomFields {
-				elem.Encode(e)
-			}
-			e.ArrEnd()
-		}
-	}
+func (s *PollOptionCandidate) encodeFields(e *jx.Encoder) {
 	{
-		if s.RequireEmail.Set {
-			e.FieldStart("require_email")
-			s.RequireEmail.Encode(e)
-		}
-	}
-	{
-		if s.SlotDurationsMinutes != nil {
-			e.FieldStart("slot_durations_minutes")
-			e.ArrStart()
-			for _, elem := range s.SlotDurationsMinutes {
-				e.Int(elem)
-			}
-			e.ArrEnd()
-		}
+		e.FieldStart("type")
+		s.Type.Encode(e)
 	}
 	{
-		if s.OrganizerName.Set {
-			e.FieldStart("organizer_name")
-			s.OrganizerName.Encode(e)
-		}
+		e.FieldStart("start_time")
+		json.EncodeDateTime(e, s.StartTime)
 	}
 	{
-		if s.OrganizerAvatarURL.Set {
-			e.FieldStart("organizer_avatar_url")
-			s.OrganizerAvatarURL.Encode(e)
-		}
+		e.FieldStart("end_time")
+		json.EncodeDateTime(e, s.EndTime)
 	}
 }
 
-var jsonFieldsNameOfGetPublicBookingLinkOK = [7]string{
-	0: "name",
-	1: "description",
-	2: "custom_fields",
-	3: "require_email",
-	4: "slot_durations_minutes",
-	5: "organizer_name",
-	6: "organizer_avatar_url",
+var jsonFieldsNameOfPollOptionCandidate = [3]string{
+	0: "type",
+	1: "start_time",
+	2: "end_time",
 }
 
-// Decode decodes GetPublicBookingLinkOK from json.
-func (s *GetPublicBookingLinkOK) Decode(d *jx.Decoder) error {
+// Decode decodes PollOptionCandidate from json.
+func (s *PollOptionCandidate) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode GetPublicBookingLinkOK to nil")
+		return errors.New("invalid: unable to decode PollOptionCandidate to nil")
 	}
 	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "name":
+		case "type":
 			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				v, err := d.Str()
-				s.Name = string(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"name\"")
-			}
-		case "description":
-			if err := func() error {
-				s.Description.Reset()
-				if err := s.Description.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"description\"")
-			}
-		case "custom_fields":
-			if err := func() error {
-				s.CustomFields = make([]CustomField, 0)
-				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem CustomField
-					if err := elem.Decode(d); err != nil {
-						return err
-					}
-					s.CustomFields = append(s.CustomFields, elem)
-					return nil
-				}); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"custom_fields\"")
-			}
-		case "require_email":
-			if err := func() error {
-				s.RequireEmail.Reset()
-				if err := s.RequireEmail.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"require_email\"")
-			}
-		case "slot_durations_minutes":
-			if err := func() error {
-				s.SlotDurationsMinutes = make([]int, 0)
-				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem int
-					v, err := d.Int()
-					elem = int(v)
-					if err != nil {
-						return err
-					}
-					s.SlotDurationsMinutes = append(s.SlotDurationsMinutes, elem)
-					return nil
-				}); err != nil {
+				if err := s.Type.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"slot_durations_minutes\"")
+				return errors.Wrap(err, "decode field \"type\"")
 			}
-		case "organizer_name":
+		case "start_time":
+			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
-				s.OrganizerName.Reset()
-				if err := s.OrganizerName.Decode(d); err != nil {
+				v, err := json.DecodeDateTime(d)
+				s.StartTime = v
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"organizer_name\"")
+				return errors.Wrap(err, "decode field \"start_time\"")
 			}
-		case "organizer_avatar_url":
+		case "end_time":
+			requiredBitSet[0] |= 1 << 2
 			if err := func() error {
-				s.OrganizerAvatarURL.Reset()
-				if err := s.OrganizerAvatarURL.Decode(d); err != nil {
+				v, err := json.DecodeDateTime(d)
+				s.EndTime = v
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"organizer_avatar_url\"")
+				return errors.Wrap(err, "decode field \"end_time\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode GetPublicBookingLinkOK")
+		return errors.Wrap(err, "decode PollOptionCandidate")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
 	for i, mask := range [1]uint8{
-		0b00000001,
+		0b00000111,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -3751,8 +8817,8 @@ func (s *GetPublicBookingLinkOK) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfGetPublicBookingLinkOK) {
-					name = jsonFieldsNameOfGetPublicBookingLinkOK[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfPollOptionCandidate) {
+					name = jsonFieldsNameOfPollOptionCandidate[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -3773,209 +8839,169 @@ func (s *GetPublicBookingLinkOK) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *GetPublicBookingLinkOK) MarshalJSON() ([]byte, error) {
+func (s *PollOptionCandidate) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *GetPublicBookingLinkOK) UnmarshalJSON(data []byte) error {
+func (s *PollOptionCandidate) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *GetPublicPollOK) Encode(e *jx.Encoder) {
+func (s *PollProposal) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *GetPublicPollOK) encodeFields(e *jx.Encoder) {
-	{
-		e.FieldStart("name")
-		e.Str(s.Name)
-	}
+func (s *PollProposal) encodeFields(e *jx.Encoder) {
 	{
-		if s.Description.Set {
-			e.FieldStart("description")
-			s.Description.Encode(e)
-		}
+		e.FieldStart("id")
+		e.Int(s.ID)
 	}
 	{
-		if s.CustomFields != nil {
-			e.FieldStart("custom_fields")
-			e.ArrStart()
-			for _, elem := range s.CustomFields {
-				elem.Encode(e)
-			}
-			e.ArrEnd()
-		}
+		e.FieldStart("type")
+		s.Type.Encode(e)
 	}
 	{
-		e.FieldStart("options")
-		e.ArrStart()
-		for _, elem := range s.Options {
-			elem.Encode(e)
-		}
-		e.ArrEnd()
+		e.FieldStart("start_time")
+		json.EncodeDateTime(e, s.StartTime)
 	}
 	{
-		if s.ShowResults.Set {
-			e.FieldStart("show_results")
-			s.ShowResults.Encode(e)
-		}
+		e.FieldStart("end_time")
+		json.EncodeDateTime(e, s.EndTime)
 	}
 	{
-		if s.RequireEmail.Set {
-			e.FieldStart("require_email")
-			s.RequireEmail.Encode(e)
-		}
+		e.FieldStart("name")
+		e.Str(s.Name)
 	}
 	{
-		if s.OrganizerName.Set {
-			e.FieldStart("organizer_name")
-			s.OrganizerName.Encode(e)
+		if s.Email.Set {
+			e.FieldStart("email")
+			s.Email.Encode(e)
 		}
 	}
 	{
-		if s.OrganizerAvatarURL.Set {
-			e.FieldStart("organizer_avatar_url")
-			s.OrganizerAvatarURL.Encode(e)
+		if s.CreatedAt.Set {
+			e.FieldStart("created_at")
+			s.CreatedAt.Encode(e, json.EncodeDateTime)
 		}
 	}
 }
 
-var jsonFieldsNameOfGetPublicPollOK = [8]string{
-	0: "name",
-	1: "description",
-	2: "custom_fields",
-	3: "options",
-	4: "show_results",
-	5: "require_email",
-	6: "organizer_name",
-	7: "organizer_avatar_url",
+var jsonFieldsNameOfPollProposal = [7]string{
+	0: "id",
+	1: "type",
+	2: "start_time",
+	3: "end_time",
+	4: "name",
+	5: "email",
+	6: "created_at",
 }
 
-// Decode decodes GetPublicPollOK from json.
-func (s *GetPublicPollOK) Decode(d *jx.Decoder) error {
+// Decode decodes PollProposal from json.
+func (s *PollProposal) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode GetPublicPollOK to nil")
+		return errors.New("invalid: unable to decode PollProposal to nil")
 	}
 	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "name":
+		case "id":
 			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				v, err := d.Str()
-				s.Name = string(v)
+				v, err := d.Int()
+				s.ID = int(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"name\"")
+				return errors.Wrap(err, "decode field \"id\"")
 			}
-		case "description":
+		case "type":
+			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
-				s.Description.Reset()
-				if err := s.Description.Decode(d); err != nil {
+				if err := s.Type.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"description\"")
+				return errors.Wrap(err, "decode field \"type\"")
 			}
-		case "custom_fields":
+		case "start_time":
+			requiredBitSet[0] |= 1 << 2
 			if err := func() error {
-				s.CustomFields = make([]CustomField, 0)
-				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem CustomField
-					if err := elem.Decode(d); err != nil {
-						return err
-					}
-					s.CustomFields = append(s.CustomFields, elem)
-					return nil
-				}); err != nil {
+				v, err := json.DecodeDateTime(d)
+				s.StartTime = v
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"custom_fields\"")
+				return errors.Wrap(err, "decode field \"start_time\"")
 			}
-		case "options":
+		case "end_time":
 			requiredBitSet[0] |= 1 << 3
 			if err := func() error {
-				s.Options = make([]PollOption, 0)
-				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem PollOption
-					if err := elem.Decode(d); err != nil {
-						return err
-					}
-					s.Options = append(s.Options, elem)
-					return nil
-				}); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"options\"")
-			}
-		case "show_results":
-			if err := func() error {
-				s.ShowResults.Reset()
-				if err := s.ShowResults.Decode(d); err != nil {
+				v, err := json.DecodeDateTime(d)
+				s.EndTime = v
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"show_results\"")
+				return errors.Wrap(err, "decode field \"end_time\"")
 			}
-		case "require_email":
+		case "name":
+			requiredBitSet[0] |= 1 << 4
 			if err := func() error {
-				s.RequireEmail.Reset()
-				if err := s.RequireEmail.Decode(d); err != nil {
+				v, err := d.Str()
+				s.Name = string(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"require_email\"")
+				return errors.Wrap(err, "decode field \"name\"")
 			}
-		case "organizer_name":
+		case "email":
 			if err := func() error {
-				s.OrganizerName.Reset()
-				if err := s.OrganizerName.Decode(d); err != nil {
+				s.Email.Reset()
+				if err := s.Email.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"organizer_name\"")
+				return errors.Wrap(err, "decode field \"email\"")
 			}
-		case "organizer_avatar_url":
+		case "created_at":
 			if err := func() error {
-				s.OrganizerAvatarURL.Reset()
-				if err := s.OrganizerAvatarURL.Decode(d); err != nil {
+				s.CreatedAt.Reset()
+				if err := s.CreatedAt.Decode(d, json.DecodeDateTime); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"organizer_avatar_url\"")
+				return errors.Wrap(err, "decode field \"created_at\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode GetPublicPollOK")
+		return errors.Wrap(err, "decode PollProposal")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
 	for i, mask := range [1]uint8{
-		0b00001001,
+		0b00011111,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -3987,8 +9013,8 @@ func (s *GetPublicPollOK) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfGetPublicPollOK) {
-					name = jsonFieldsNameOfGetPublicPollOK[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfPollProposal) {
+					name = jsonFieldsNameOfPollProposal[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -4009,438 +9035,607 @@ func (s *GetPublicPollOK) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *GetPublicPollOK) MarshalJSON() ([]byte, error) {
+func (s *PollProposal) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *GetPublicPollOK) UnmarshalJSON(data []byte) error {
+func (s *PollProposal) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes LinkStatus as json.
-func (s LinkStatus) Encode(e *jx.Encoder) {
-	e.Int(int(s))
+// Encode implements json.Marshaler.
+func (s *PollResults) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
 }
 
-// Decode decodes LinkStatus from json.
-func (s *LinkStatus) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode LinkStatus to nil")
+// encodeFields encodes fields.
+func (s *PollResults) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("tally")
+		e.ArrStart()
+		for _, elem := range s.Tally {
+			elem.Encode(e)
+		}
+		e.ArrEnd()
 	}
-	v, err := d.Int()
-	if err != nil {
-		return err
+	{
+		if s.RecommendedOptionID.Set {
+			e.FieldStart("recommended_option_id")
+			s.RecommendedOptionID.Encode(e)
+		}
 	}
-	*s = LinkStatus(v)
-
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s LinkStatus) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *LinkStatus) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
 }
 
-// Encode encodes BookingCustomFields as json.
-func (o OptBookingCustomFields) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
-	}
-	o.Value.Encode(e)
+var jsonFieldsNameOfPollResults = [2]string{
+	0: "tally",
+	1: "recommended_option_id",
 }
 
-// Decode decodes BookingCustomFields from json.
-func (o *OptBookingCustomFields) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptBookingCustomFields to nil")
-	}
-	o.Set = true
-	o.Value = make(BookingCustomFields)
-	if err := o.Value.Decode(d); err != nil {
-		return err
+// Decode decodes PollResults from json.
+func (s *PollResults) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode PollResults to nil")
 	}
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s OptBookingCustomFields) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptBookingCustomFields) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
+	var requiredBitSet [1]uint8
 
-// Encode encodes bool as json.
-func (o OptBool) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "tally":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				s.Tally = make([]VoteTally, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem VoteTally
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Tally = append(s.Tally, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"tally\"")
+			}
+		case "recommended_option_id":
+			if err := func() error {
+				s.RecommendedOptionID.Reset()
+				if err := s.RecommendedOptionID.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"recommended_option_id\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode PollResults")
 	}
-	e.Bool(bool(o.Value))
-}
-
-// Decode decodes bool from json.
-func (o *OptBool) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptBool to nil")
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000001,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfPollResults) {
+					name = jsonFieldsNameOfPollResults[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
 	}
-	o.Set = true
-	v, err := d.Bool()
-	if err != nil {
-		return err
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
 	}
-	o.Value = bool(v)
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s OptBool) MarshalJSON() ([]byte, error) {
+func (s *PollResults) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptBool) UnmarshalJSON(data []byte) error {
+func (s *PollResults) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes CreateBookingReqCustomFields as json.
-func (o OptCreateBookingReqCustomFields) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
-	}
-	o.Value.Encode(e)
+// Encode implements json.Marshaler.
+func (s *PollScoring) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
 }
 
-// Decode decodes CreateBookingReqCustomFields from json.
-func (o *OptCreateBookingReqCustomFields) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptCreateBookingReqCustomFields to nil")
+// encodeFields encodes fields.
+func (s *PollScoring) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("yes_points")
+		e.Int(s.YesPoints)
 	}
-	o.Set = true
-	o.Value = make(CreateBookingReqCustomFields)
-	if err := o.Value.Decode(d); err != nil {
-		return err
+	{
+		e.FieldStart("maybe_points")
+		e.Int(s.MaybePoints)
 	}
-	return nil
 }
 
-// MarshalJSON implements stdjson.Marshaler.
-func (s OptCreateBookingReqCustomFields) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptCreateBookingReqCustomFields) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
+var jsonFieldsNameOfPollScoring = [2]string{
+	0: "yes_points",
+	1: "maybe_points",
 }
 
-// Encode encodes time.Time as json.
-func (o OptDateTime) Encode(e *jx.Encoder, format func(*jx.Encoder, time.Time)) {
-	if !o.Set {
-		return
+// Decode decodes PollScoring from json.
+func (s *PollScoring) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode PollScoring to nil")
 	}
-	format(e, o.Value)
-}
+	var requiredBitSet [1]uint8
 
-// Decode decodes time.Time from json.
-func (o *OptDateTime) Decode(d *jx.Decoder, format func(*jx.Decoder) (time.Time, error)) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptDateTime to nil")
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "yes_points":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Int()
+				s.YesPoints = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"yes_points\"")
+			}
+		case "maybe_points":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.Int()
+				s.MaybePoints = int(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"maybe_points\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode PollScoring")
 	}
-	o.Set = true
-	v, err := format(d)
-	if err != nil {
-		return err
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000011,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfPollScoring) {
+					name = jsonFieldsNameOfPollScoring[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
 	}
-	o.Value = v
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s OptDateTime) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e, json.EncodeDateTime)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptDateTime) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d, json.DecodeDateTime)
-}
-
-// Encode encodes EventTemplate as json.
-func (o OptEventTemplate) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
 	}
-	o.Value.Encode(e)
-}
 
-// Decode decodes EventTemplate from json.
-func (o *OptEventTemplate) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptEventTemplate to nil")
-	}
-	o.Set = true
-	if err := o.Value.Decode(d); err != nil {
-		return err
-	}
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s OptEventTemplate) MarshalJSON() ([]byte, error) {
+func (s *PollScoring) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptEventTemplate) UnmarshalJSON(data []byte) error {
+func (s *PollScoring) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes int as json.
-func (o OptInt) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
-	}
-	e.Int(int(o.Value))
+// Encode encodes ProposalMode as json.
+func (s ProposalMode) Encode(e *jx.Encoder) {
+	e.Int(int(s))
 }
 
-// Decode decodes int from json.
-func (o *OptInt) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptInt to nil")
+// Decode decodes ProposalMode from json.
+func (s *ProposalMode) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode ProposalMode to nil")
 	}
-	o.Set = true
 	v, err := d.Int()
 	if err != nil {
 		return err
 	}
-	o.Value = int(v)
+	*s = ProposalMode(v)
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s OptInt) MarshalJSON() ([]byte, error) {
+func (s ProposalMode) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptInt) UnmarshalJSON(data []byte) error {
+func (s *ProposalMode) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes LinkStatus as json.
-func (o OptLinkStatus) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
-	}
-	e.Int(int(o.Value))
+// Encode implements json.Marshaler.
+func (s *ProposePollOptionCreated) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
 }
 
-// Decode decodes LinkStatus from json.
-func (o *OptLinkStatus) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptLinkStatus to nil")
+// encodeFields encodes fields.
+func (s *ProposePollOptionCreated) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("pending")
+		e.Bool(s.Pending)
 	}
-	o.Set = true
-	if err := o.Value.Decode(d); err != nil {
-		return err
+	{
+		if s.Option.Set {
+			e.FieldStart("option")
+			s.Option.Encode(e)
+		}
 	}
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s OptLinkStatus) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
 }
 
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptLinkStatus) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
+var jsonFieldsNameOfProposePollOptionCreated = [2]string{
+	0: "pending",
+	1: "option",
 }
 
-// Encode encodes string as json.
-func (o OptString) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
+// Decode decodes ProposePollOptionCreated from json.
+func (s *ProposePollOptionCreated) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode ProposePollOptionCreated to nil")
 	}
-	e.Str(string(o.Value))
-}
+	var requiredBitSet [1]uint8
 
-// Decode decodes string from json.
-func (o *OptString) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptString to nil")
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "pending":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := d.Bool()
+				s.Pending = bool(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"pending\"")
+			}
+		case "option":
+			if err := func() error {
+				s.Option.Reset()
+				if err := s.Option.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"option\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode ProposePollOptionCreated")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000001,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfProposePollOptionCreated) {
+					name = jsonFieldsNameOfProposePollOptionCreated[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
 	}
-	o.Set = true
-	v, err := d.Str()
-	if err != nil {
-		return err
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
 	}
-	o.Value = string(v)
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s OptString) MarshalJSON() ([]byte, error) {
+func (s *ProposePollOptionCreated) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptString) UnmarshalJSON(data []byte) error {
+func (s *ProposePollOptionCreated) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode encodes SubmitVoteReqCustomFields as json.
-func (o OptSubmitVoteReqCustomFields) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
-	}
-	o.Value.Encode(e)
+// Encode implements json.Marshaler.
+func (s *ProposePollOptionReq) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
 }
 
-// Decode decodes SubmitVoteReqCustomFields from json.
-func (o *OptSubmitVoteReqCustomFields) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptSubmitVoteReqCustomFields to nil")
+// encodeFields encodes fields.
+func (s *ProposePollOptionReq) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("type")
+		s.Type.Encode(e)
 	}
-	o.Set = true
-	o.Value = make(SubmitVoteReqCustomFields)
-	if err := o.Value.Decode(d); err != nil {
-		return err
+	{
+		e.FieldStart("start_time")
+		json.EncodeDateTime(e, s.StartTime)
+	}
+	{
+		e.FieldStart("end_time")
+		json.EncodeDateTime(e, s.EndTime)
+	}
+	{
+		e.FieldStart("name")
+		e.Str(s.Name)
+	}
+	{
+		if s.Email.Set {
+			e.FieldStart("email")
+			s.Email.Encode(e)
+		}
 	}
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s OptSubmitVoteReqCustomFields) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
 }
 
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptSubmitVoteReqCustomFields) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
+var jsonFieldsNameOfProposePollOptionReq = [5]string{
+	0: "type",
+	1: "start_time",
+	2: "end_time",
+	3: "name",
+	4: "email",
 }
 
-// Encode encodes VoteCustomFields as json.
-func (o OptVoteCustomFields) Encode(e *jx.Encoder) {
-	if !o.Set {
-		return
+// Decode decodes ProposePollOptionReq from json.
+func (s *ProposePollOptionReq) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode ProposePollOptionReq to nil")
 	}
-	o.Value.Encode(e)
-}
+	var requiredBitSet [1]uint8
 
-// Decode decodes VoteCustomFields from json.
-func (o *OptVoteCustomFields) Decode(d *jx.Decoder) error {
-	if o == nil {
-		return errors.New("invalid: unable to decode OptVoteCustomFields to nil")
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "type":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				if err := s.Type.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"type\"")
+			}
+		case "start_time":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := json.DecodeDateTime(d)
+				s.StartTime = v
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"start_time\"")
+			}
+		case "end_time":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				v, err := json.DecodeDateTime(d)
+				s.EndTime = v
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"end_time\"")
+			}
+		case "name":
+			requiredBitSet[0] |= 1 << 3
+			if err := func() error {
+				v, err := d.Str()
+				s.Name = string(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"name\"")
+			}
+		case "email":
+			if err := func() error {
+				s.Email.Reset()
+				if err := s.Email.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"email\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode ProposePollOptionReq")
 	}
-	o.Set = true
-	o.Value = make(VoteCustomFields)
-	if err := o.Value.Decode(d); err != nil {
-		return err
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00001111,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfProposePollOptionReq) {
+					name = jsonFieldsNameOfProposePollOptionReq[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
 	}
+
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s OptVoteCustomFields) MarshalJSON() ([]byte, error) {
+func (s *ProposePollOptionReq) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *OptVoteCustomFields) UnmarshalJSON(data []byte) error {
+func (s *ProposePollOptionReq) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *PickPollWinnerReq) Encode(e *jx.Encoder) {
+func (s *RemindPollInvitesOK) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *PickPollWinnerReq) encodeFields(e *jx.Encoder) {
+func (s *RemindPollInvitesOK) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("option_id")
-		e.Int(s.OptionID)
+		e.FieldStart("sent")
+		e.Int(s.Sent)
 	}
 }
 
-var jsonFieldsNameOfPickPollWinnerReq = [1]string{
-	0: "option_id",
+var jsonFieldsNameOfRemindPollInvitesOK = [1]string{
+	0: "sent",
 }
 
-// Decode decodes PickPollWinnerReq from json.
-func (s *PickPollWinnerReq) Decode(d *jx.Decoder) error {
+// Decode decodes RemindPollInvitesOK from json.
+func (s *RemindPollInvitesOK) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode PickPollWinnerReq to nil")
+		return errors.New("invalid: unable to decode RemindPollInvitesOK to nil")
 	}
 	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "option_id":
+		case "sent":
 			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
 				v, err := d.Int()
-				s.OptionID = int(v)
+				s.Sent = int(v)
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"option_id\"")
+				return errors.Wrap(err, "decode field \"sent\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode PickPollWinnerReq")
+		return errors.Wrap(err, "decode RemindPollInvitesOK")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
@@ -4457,8 +9652,8 @@ func (s *PickPollWinnerReq) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfPickPollWinnerReq) {
-					name = jsonFieldsNameOfPickPollWinnerReq[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfRemindPollInvitesOK) {
+					name = jsonFieldsNameOfRemindPollInvitesOK[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -4479,97 +9674,122 @@ func (s *PickPollWinnerReq) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *PickPollWinnerReq) MarshalJSON() ([]byte, error) {
+func (s *RemindPollInvitesOK) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *PickPollWinnerReq) UnmarshalJSON(data []byte) error {
+func (s *RemindPollInvitesOK) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
-// Encode implements json.Marshaler.
-func (s *Poll) Encode(e *jx.Encoder) {
-	e.ObjStart()
-	s.encodeFields(e)
-	e.ObjEnd()
+// Encode encodes ResultsTiming as json.
+func (s ResultsTiming) Encode(e *jx.Encoder) {
+	e.Int(int(s))
 }
 
-// encodeFields encodes fields.
-func (s *Poll) encodeFields(e *jx.Encoder) {
-	{
-		e.FieldStart("id")
-		e.Int(s.ID)
-	}
-	{
-		e.FieldStart("slug")
-		e.Str(s.Slug)
+// Decode decodes ResultsTiming from json.
+func (s *ResultsTiming) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode ResultsTiming to nil")
 	}
-	{
-		e.FieldStart("name")
-		e.Str(s.Name)
+	v, err := d.Int()
+	if err != nil {
+		return err
 	}
-	{
-		if s.Description.Set {
-			e.FieldStart("description")
-			s.Description.Encode(e)
-		}
+	*s = ResultsTiming(v)
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s ResultsTiming) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *ResultsTiming) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes ResultsVisibility as json.
+func (s ResultsVisibility) Encode(e *jx.Encoder) {
+	e.Int(int(s))
+}
+
+// Decode decodes ResultsVisibility from json.
+func (s *ResultsVisibility) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode ResultsVisibility to nil")
 	}
-	{
-		e.FieldStart("status")
-		s.Status.Encode(e)
+	v, err := d.Int()
+	if err != nil {
+		return err
 	}
+	*s = ResultsVisibility(v)
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s ResultsVisibility) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *ResultsVisibility) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *Slot) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *Slot) encodeFields(e *jx.Encoder) {
 	{
-		if s.ShowResults.Set {
-			e.FieldStart("show_results")
-			s.ShowResults.Encode(e)
-		}
+		e.FieldStart("id")
+		e.Int(s.ID)
 	}
 	{
-		if s.RequireEmail.Set {
-			e.FieldStart("require_email")
-			s.RequireEmail.Encode(e)
-		}
+		e.FieldStart("type")
+		s.Type.Encode(e)
 	}
 	{
-		if s.CustomFields != nil {
-			e.FieldStart("custom_fields")
-			e.ArrStart()
-			for _, elem := range s.CustomFields {
-				elem.Encode(e)
-			}
-			e.ArrEnd()
-		}
+		e.FieldStart("start_time")
+		json.EncodeDateTime(e, s.StartTime)
 	}
 	{
-		if s.CreatedAt.Set {
-			e.FieldStart("created_at")
-			s.CreatedAt.Encode(e, json.EncodeDateTime)
-		}
+		e.FieldStart("end_time")
+		json.EncodeDateTime(e, s.EndTime)
 	}
 }
 
-var jsonFieldsNameOfPoll = [9]string{
+var jsonFieldsNameOfSlot = [4]string{
 	0: "id",
-	1: "slug",
-	2: "name",
-	3: "description",
-	4: "status",
-	5: "show_results",
-	6: "require_email",
-	7: "custom_fields",
-	8: "created_at",
+	1: "type",
+	2: "start_time",
+	3: "end_time",
 }
 
-// Decode decodes Poll from json.
-func (s *Poll) Decode(d *jx.Decoder) error {
+// Decode decodes Slot from json.
+func (s *Slot) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode Poll to nil")
+		return errors.New("invalid: unable to decode Slot to nil")
 	}
-	var requiredBitSet [2]uint8
+	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
@@ -4585,109 +9805,51 @@ func (s *Poll) Decode(d *jx.Decoder) error {
 			}(); err != nil {
 				return errors.Wrap(err, "decode field \"id\"")
 			}
-		case "slug":
+		case "type":
 			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
-				v, err := d.Str()
-				s.Slug = string(v)
-				if err != nil {
+				if err := s.Type.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"slug\"")
+				return errors.Wrap(err, "decode field \"type\"")
 			}
-		case "name":
+		case "start_time":
 			requiredBitSet[0] |= 1 << 2
 			if err := func() error {
-				v, err := d.Str()
-				s.Name = string(v)
+				v, err := json.DecodeDateTime(d)
+				s.StartTime = v
 				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"name\"")
-			}
-		case "description":
-			if err := func() error {
-				s.Description.Reset()
-				if err := s.Description.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"description\"")
-			}
-		case "status":
-			requiredBitSet[0] |= 1 << 4
-			if err := func() error {
-				if err := s.Status.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"status\"")
-			}
-		case "show_results":
-			if err := func() error {
-				s.ShowResults.Reset()
-				if err := s.ShowResults.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"show_results\"")
-			}
-		case "require_email":
-			if err := func() error {
-				s.RequireEmail.Reset()
-				if err := s.RequireEmail.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"require_email\"")
-			}
-		case "custom_fields":
-			if err := func() error {
-				s.CustomFields = make([]CustomField, 0)
-				if err := d.Arr(func(d *jx.Decoder) error {
-					var elem CustomField
-					if err := elem.Decode(d); err != nil {
-						return err
-					}
-					s.CustomFields = append(s.CustomFields, elem)
-					return nil
-				}); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"custom_fields\"")
+				return errors.Wrap(err, "decode field \"start_time\"")
 			}
-		case "created_at":
+		case "end_time":
+			requiredBitSet[0] |= 1 << 3
 			if err := func() error {
-				s.CreatedAt.Reset()
-				if err := s.CreatedAt.Decode(d, json.DecodeDateTime); err != nil {
+				v, err := json.DecodeDateTime(d)
+				s.EndTime = v
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"created_at\"")
+				return errors.Wrap(err, "decode field \"end_time\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode Poll")
+		return errors.Wrap(err, "decode Slot")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
-	for i, mask := range [2]uint8{
-		0b00010111,
-		0b00000000,
+	for i, mask := range [1]uint8{
+		0b00001111,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -4699,8 +9861,8 @@ func (s *Poll) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfPoll) {
-					name = jsonFieldsNameOfPoll[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfSlot) {
+					name = jsonFieldsNameOfSlot[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -4721,118 +9883,167 @@ func (s *Poll) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *Poll) MarshalJSON() ([]byte, error) {
+func (s *Slot) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *Poll) UnmarshalJSON(data []byte) error {
+func (s *Slot) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes SlotType as json.
+func (s SlotType) Encode(e *jx.Encoder) {
+	e.Int(int(s))
+}
+
+// Decode decodes SlotType from json.
+func (s *SlotType) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode SlotType to nil")
+	}
+	v, err := d.Int()
+	if err != nil {
+		return err
+	}
+	*s = SlotType(v)
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s SlotType) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *SlotType) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *PollOption) Encode(e *jx.Encoder) {
+func (s *SubmitVoteReq) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *PollOption) encodeFields(e *jx.Encoder) {
+func (s *SubmitVoteReq) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("id")
-		e.Int(s.ID)
+		if s.GuestName.Set {
+			e.FieldStart("guest_name")
+			s.GuestName.Encode(e)
+		}
 	}
 	{
-		e.FieldStart("type")
-		s.Type.Encode(e)
+		if s.GuestEmail.Set {
+			e.FieldStart("guest_email")
+			s.GuestEmail.Encode(e)
+		}
 	}
 	{
-		e.FieldStart("start_time")
-		json.EncodeDateTime(e, s.StartTime)
+		e.FieldStart("responses")
+		s.Responses.Encode(e)
 	}
 	{
-		e.FieldStart("end_time")
-		json.EncodeDateTime(e, s.EndTime)
+		if s.CustomFields.Set {
+			e.FieldStart("custom_fields")
+			s.CustomFields.Encode(e)
+		}
+	}
+	{
+		if s.InviteToken.Set {
+			e.FieldStart("invite_token")
+			s.InviteToken.Encode(e)
+		}
 	}
 }
 
-var jsonFieldsNameOfPollOption = [4]string{
-	0: "id",
-	1: "type",
-	2: "start_time",
-	3: "end_time",
+var jsonFieldsNameOfSubmitVoteReq = [5]string{
+	0: "guest_name",
+	1: "guest_email",
+	2: "responses",
+	3: "custom_fields",
+	4: "invite_token",
 }
 
-// Decode decodes PollOption from json.
-func (s *PollOption) Decode(d *jx.Decoder) error {
+// Decode decodes SubmitVoteReq from json.
+func (s *SubmitVoteReq) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode PollOption to nil")
+		return errors.New("invalid: unable to decode SubmitVoteReq to nil")
 	}
 	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "id":
-			requiredBitSet[0] |= 1 << 0
+		case "guest_name":
 			if err := func() error {
-				v, err := d.Int()
-				s.ID = int(v)
-				if err != nil {
+				s.GuestName.Reset()
+				if err := s.GuestName.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"id\"")
+				return errors.Wrap(err, "decode field \"guest_name\"")
 			}
-		case "type":
-			requiredBitSet[0] |= 1 << 1
+		case "guest_email":
+			if err := func() error {
+				s.GuestEmail.Reset()
+				if err := s.GuestEmail.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"guest_email\"")
+			}
+		case "responses":
+			requiredBitSet[0] |= 1 << 2
 			if err := func() error {
-				if err := s.Type.Decode(d); err != nil {
+				if err := s.Responses.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"type\"")
+				return errors.Wrap(err, "decode field \"responses\"")
 			}
-		case "start_time":
-			requiredBitSet[0] |= 1 << 2
+		case "custom_fields":
 			if err := func() error {
-				v, err := json.DecodeDateTime(d)
-				s.StartTime = v
-				if err != nil {
+				s.CustomFields.Reset()
+				if err := s.CustomFields.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"start_time\"")
+				return errors.Wrap(err, "decode field \"custom_fields\"")
 			}
-		case "end_time":
-			requiredBitSet[0] |= 1 << 3
+		case "invite_token":
 			if err := func() error {
-				v, err := json.DecodeDateTime(d)
-				s.EndTime = v
-				if err != nil {
+				s.InviteToken.Reset()
+				if err := s.InviteToken.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"end_time\"")
+				return errors.Wrap(err, "decode field \"invite_token\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode PollOption")
+		return errors.Wrap(err, "decode SubmitVoteReq")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
 	for i, mask := range [1]uint8{
-		0b00001111,
+		0b00000100,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -4844,8 +10055,8 @@ func (s *PollOption) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfPollOption) {
-					name = jsonFieldsNameOfPollOption[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfSubmitVoteReq) {
+					name = jsonFieldsNameOfSubmitVoteReq[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -4866,118 +10077,217 @@ func (s *PollOption) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *PollOption) MarshalJSON() ([]byte, error) {
+func (s *SubmitVoteReq) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *PollOption) UnmarshalJSON(data []byte) error {
+func (s *SubmitVoteReq) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *Slot) Encode(e *jx.Encoder) {
+func (s SubmitVoteReqCustomFields) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
-// encodeFields encodes fields.
-func (s *Slot) encodeFields(e *jx.Encoder) {
-	{
-		e.FieldStart("id")
-		e.Int(s.ID)
+// encodeFields implements json.Marshaler.
+func (s SubmitVoteReqCustomFields) encodeFields(e *jx.Encoder) {
+	for k, elem := range s {
+		e.FieldStart(k)
+
+		e.Str(elem)
 	}
-	{
-		e.FieldStart("type")
-		s.Type.Encode(e)
+}
+
+// Decode decodes SubmitVoteReqCustomFields from json.
+func (s *SubmitVoteReqCustomFields) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode SubmitVoteReqCustomFields to nil")
+	}
+	m := s.init()
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		var elem string
+		if err := func() error {
+			v, err := d.Str()
+			elem = string(v)
+			if err != nil {
+				return err
+			}
+			return nil
+		}(); err != nil {
+			return errors.Wrapf(err, "decode field %q", k)
+		}
+		m[string(k)] = elem
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode SubmitVoteReqCustomFields")
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s SubmitVoteReqCustomFields) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *SubmitVoteReqCustomFields) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s SubmitVoteReqResponses) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields implements json.Marshaler.
+func (s SubmitVoteReqResponses) encodeFields(e *jx.Encoder) {
+	for k, elem := range s {
+		e.FieldStart(k)
+
+		elem.Encode(e)
+	}
+}
+
+// Decode decodes SubmitVoteReqResponses from json.
+func (s *SubmitVoteReqResponses) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode SubmitVoteReqResponses to nil")
+	}
+	m := s.init()
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		var elem VoteResponse
+		if err := func() error {
+			if err := elem.Decode(d); err != nil {
+				return err
+			}
+			return nil
+		}(); err != nil {
+			return errors.Wrapf(err, "decode field %q", k)
+		}
+		m[string(k)] = elem
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode SubmitVoteReqResponses")
 	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s SubmitVoteReqResponses) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *SubmitVoteReqResponses) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *SuggestPollOptionsOK) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *SuggestPollOptionsOK) encodeFields(e *jx.Encoder) {
 	{
-		e.FieldStart("start_time")
-		json.EncodeDateTime(e, s.StartTime)
+		e.FieldStart("candidates")
+		e.ArrStart()
+		for _, elem := range s.Candidates {
+			elem.Encode(e)
+		}
+		e.ArrEnd()
 	}
 	{
-		e.FieldStart("end_time")
-		json.EncodeDateTime(e, s.EndTime)
+		if s.Added != nil {
+			e.FieldStart("added")
+			e.ArrStart()
+			for _, elem := range s.Added {
+				elem.Encode(e)
+			}
+			e.ArrEnd()
+		}
 	}
 }
 
-var jsonFieldsNameOfSlot = [4]string{
-	0: "id",
-	1: "type",
-	2: "start_time",
-	3: "end_time",
+var jsonFieldsNameOfSuggestPollOptionsOK = [2]string{
+	0: "candidates",
+	1: "added",
 }
 
-// Decode decodes Slot from json.
-func (s *Slot) Decode(d *jx.Decoder) error {
+// Decode decodes SuggestPollOptionsOK from json.
+func (s *SuggestPollOptionsOK) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode Slot to nil")
+		return errors.New("invalid: unable to decode SuggestPollOptionsOK to nil")
 	}
 	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "id":
+		case "candidates":
 			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				v, err := d.Int()
-				s.ID = int(v)
-				if err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"id\"")
-			}
-		case "type":
-			requiredBitSet[0] |= 1 << 1
-			if err := func() error {
-				if err := s.Type.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"type\"")
-			}
-		case "start_time":
-			requiredBitSet[0] |= 1 << 2
-			if err := func() error {
-				v, err := json.DecodeDateTime(d)
-				s.StartTime = v
-				if err != nil {
+				s.Candidates = make([]PollOptionCandidate, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem PollOptionCandidate
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Candidates = append(s.Candidates, elem)
+					return nil
+				}); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"start_time\"")
+				return errors.Wrap(err, "decode field \"candidates\"")
 			}
-		case "end_time":
-			requiredBitSet[0] |= 1 << 3
+		case "added":
 			if err := func() error {
-				v, err := json.DecodeDateTime(d)
-				s.EndTime = v
-				if err != nil {
+				s.Added = make([]PollOption, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem PollOption
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.Added = append(s.Added, elem)
+					return nil
+				}); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"end_time\"")
+				return errors.Wrap(err, "decode field \"added\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode Slot")
+		return errors.Wrap(err, "decode SuggestPollOptionsOK")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
 	for i, mask := range [1]uint8{
-		0b00001111,
+		0b00000001,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -4989,8 +10299,8 @@ func (s *Slot) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfSlot) {
-					name = jsonFieldsNameOfSlot[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfSuggestPollOptionsOK) {
+					name = jsonFieldsNameOfSuggestPollOptionsOK[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -4998,163 +10308,212 @@ func (s *Slot) Decode(d *jx.Decoder) error {
 					Name:  name,
 					Error: validate.ErrFieldRequired,
 				})
-				// Reset bit.
-				result &^= 1 << bitIdx
-			}
-		}
-	}
-	if len(failures) > 0 {
-		return &validate.Error{Fields: failures}
-	}
-
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s *Slot) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *Slot) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
-
-// Encode encodes SlotType as json.
-func (s SlotType) Encode(e *jx.Encoder) {
-	e.Int(int(s))
-}
-
-// Decode decodes SlotType from json.
-func (s *SlotType) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode SlotType to nil")
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
 	}
-	v, err := d.Int()
-	if err != nil {
-		return err
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
 	}
-	*s = SlotType(v)
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s SlotType) MarshalJSON() ([]byte, error) {
+func (s *SuggestPollOptionsOK) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *SlotType) UnmarshalJSON(data []byte) error {
+func (s *SuggestPollOptionsOK) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
 
 // Encode implements json.Marshaler.
-func (s *SubmitVoteReq) Encode(e *jx.Encoder) {
+func (s *SuggestPollOptionsReq) Encode(e *jx.Encoder) {
 	e.ObjStart()
 	s.encodeFields(e)
 	e.ObjEnd()
 }
 
 // encodeFields encodes fields.
-func (s *SubmitVoteReq) encodeFields(e *jx.Encoder) {
+func (s *SuggestPollOptionsReq) encodeFields(e *jx.Encoder) {
 	{
-		if s.GuestName.Set {
-			e.FieldStart("guest_name")
-			s.GuestName.Encode(e)
+		e.FieldStart("start")
+		json.EncodeDateTime(e, s.Start)
+	}
+	{
+		e.FieldStart("end")
+		json.EncodeDateTime(e, s.End)
+	}
+	{
+		e.FieldStart("duration_minutes")
+		e.Int(s.DurationMinutes)
+	}
+	{
+		if s.BufferMinutes.Set {
+			e.FieldStart("buffer_minutes")
+			s.BufferMinutes.Encode(e)
 		}
 	}
 	{
-		if s.GuestEmail.Set {
-			e.FieldStart("guest_email")
-			s.GuestEmail.Encode(e)
+		if s.AvailabilityRules != nil {
+			e.FieldStart("availability_rules")
+			e.ArrStart()
+			for _, elem := range s.AvailabilityRules {
+				elem.Encode(e)
+			}
+			e.ArrEnd()
 		}
 	}
 	{
-		e.FieldStart("responses")
-		s.Responses.Encode(e)
+		if s.BookingLinkID.Set {
+			e.FieldStart("booking_link_id")
+			s.BookingLinkID.Encode(e)
+		}
 	}
 	{
-		if s.CustomFields.Set {
-			e.FieldStart("custom_fields")
-			s.CustomFields.Encode(e)
+		if s.Limit.Set {
+			e.FieldStart("limit")
+			s.Limit.Encode(e)
+		}
+	}
+	{
+		if s.Add.Set {
+			e.FieldStart("add")
+			s.Add.Encode(e)
 		}
 	}
 }
 
-var jsonFieldsNameOfSubmitVoteReq = [4]string{
-	0: "guest_name",
-	1: "guest_email",
-	2: "responses",
-	3: "custom_fields",
+var jsonFieldsNameOfSuggestPollOptionsReq = [8]string{
+	0: "start",
+	1: "end",
+	2: "duration_minutes",
+	3: "buffer_minutes",
+	4: "availability_rules",
+	5: "booking_link_id",
+	6: "limit",
+	7: "add",
 }
 
-// Decode decodes SubmitVoteReq from json.
-func (s *SubmitVoteReq) Decode(d *jx.Decoder) error {
+// Decode decodes SuggestPollOptionsReq from json.
+func (s *SuggestPollOptionsReq) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode SubmitVoteReq to nil")
+		return errors.New("invalid: unable to decode SuggestPollOptionsReq to nil")
 	}
 	var requiredBitSet [1]uint8
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
 		switch string(k) {
-		case "guest_name":
+		case "start":
+			requiredBitSet[0] |= 1 << 0
 			if err := func() error {
-				s.GuestName.Reset()
-				if err := s.GuestName.Decode(d); err != nil {
+				v, err := json.DecodeDateTime(d)
+				s.Start = v
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"guest_name\"")
+				return errors.Wrap(err, "decode field \"start\"")
 			}
-		case "guest_email":
+		case "end":
+			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
-				s.GuestEmail.Reset()
-				if err := s.GuestEmail.Decode(d); err != nil {
+				v, err := json.DecodeDateTime(d)
+				s.End = v
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"guest_email\"")
+				return errors.Wrap(err, "decode field \"end\"")
 			}
-		case "responses":
+		case "duration_minutes":
 			requiredBitSet[0] |= 1 << 2
 			if err := func() error {
-				if err := s.Responses.Decode(d); err != nil {
+				v, err := d.Int()
+				s.DurationMinutes = int(v)
+				if err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"responses\"")
+				return errors.Wrap(err, "decode field \"duration_minutes\"")
 			}
-		case "custom_fields":
+		case "buffer_minutes":
 			if err := func() error {
-				s.CustomFields.Reset()
-				if err := s.CustomFields.Decode(d); err != nil {
+				s.BufferMinutes.Reset()
+				if err := s.BufferMinutes.Decode(d); err != nil {
 					return err
 				}
 				return nil
 			}(); err != nil {
-				return errors.Wrap(err, "decode field \"custom_fields\"")
+				return errors.Wrap(err, "decode field \"buffer_minutes\"")
+			}
+		case "availability_rules":
+			if err := func() error {
+				s.AvailabilityRules = make([]AvailabilityRule, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem AvailabilityRule
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.AvailabilityRules = append(s.AvailabilityRules, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"availability_rules\"")
+			}
+		case "booking_link_id":
+			if err := func() error {
+				s.BookingLinkID.Reset()
+				if err := s.BookingLinkID.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"booking_link_id\"")
+			}
+		case "limit":
+			if err := func() error {
+				s.Limit.Reset()
+				if err := s.Limit.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"limit\"")
+			}
+		case "add":
+			if err := func() error {
+				s.Add.Reset()
+				if err := s.Add.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"add\"")
 			}
 		default:
 			return d.Skip()
 		}
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode SubmitVoteReq")
+		return errors.Wrap(err, "decode SuggestPollOptionsReq")
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
 	for i, mask := range [1]uint8{
-		0b00000100,
+		0b00000111,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -5166,8 +10525,8 @@ func (s *SubmitVoteReq) Decode(d *jx.Decoder) error {
 				bitIdx := bits.TrailingZeros8(result)
 				fieldIdx := i*8 + bitIdx
 				var name string
-				if fieldIdx < len(jsonFieldsNameOfSubmitVoteReq) {
-					name = jsonFieldsNameOfSubmitVoteReq[fieldIdx]
+				if fieldIdx < len(jsonFieldsNameOfSuggestPollOptionsReq) {
+					name = jsonFieldsNameOfSuggestPollOptionsReq[fieldIdx]
 				} else {
 					name = strconv.Itoa(fieldIdx)
 				}
@@ -5188,124 +10547,14 @@ func (s *SubmitVoteReq) Decode(d *jx.Decoder) error {
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *SubmitVoteReq) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *SubmitVoteReq) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
-
-// Encode implements json.Marshaler.
-func (s SubmitVoteReqCustomFields) Encode(e *jx.Encoder) {
-	e.ObjStart()
-	s.encodeFields(e)
-	e.ObjEnd()
-}
-
-// encodeFields implements json.Marshaler.
-func (s SubmitVoteReqCustomFields) encodeFields(e *jx.Encoder) {
-	for k, elem := range s {
-		e.FieldStart(k)
-
-		e.Str(elem)
-	}
-}
-
-// Decode decodes SubmitVoteReqCustomFields from json.
-func (s *SubmitVoteReqCustomFields) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode SubmitVoteReqCustomFields to nil")
-	}
-	m := s.init()
-	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		var elem string
-		if err := func() error {
-			v, err := d.Str()
-			elem = string(v)
-			if err != nil {
-				return err
-			}
-			return nil
-		}(); err != nil {
-			return errors.Wrapf(err, "decode field %q", k)
-		}
-		m[string(k)] = elem
-		return nil
-	}); err != nil {
-		return errors.Wrap(err, "decode SubmitVoteReqCustomFields")
-	}
-
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s SubmitVoteReqCustomFields) MarshalJSON() ([]byte, error) {
-	e := jx.Encoder{}
-	s.Encode(&e)
-	return e.Bytes(), nil
-}
-
-// UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *SubmitVoteReqCustomFields) UnmarshalJSON(data []byte) error {
-	d := jx.DecodeBytes(data)
-	return s.Decode(d)
-}
-
-// Encode implements json.Marshaler.
-func (s SubmitVoteReqResponses) Encode(e *jx.Encoder) {
-	e.ObjStart()
-	s.encodeFields(e)
-	e.ObjEnd()
-}
-
-// encodeFields implements json.Marshaler.
-func (s SubmitVoteReqResponses) encodeFields(e *jx.Encoder) {
-	for k, elem := range s {
-		e.FieldStart(k)
-
-		elem.Encode(e)
-	}
-}
-
-// Decode decodes SubmitVoteReqResponses from json.
-func (s *SubmitVoteReqResponses) Decode(d *jx.Decoder) error {
-	if s == nil {
-		return errors.New("invalid: unable to decode SubmitVoteReqResponses to nil")
-	}
-	m := s.init()
-	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		var elem VoteResponse
-		if err := func() error {
-			if err := elem.Decode(d); err != nil {
-				return err
-			}
-			return nil
-		}(); err != nil {
-			return errors.Wrapf(err, "decode field %q", k)
-		}
-		m[string(k)] = elem
-		return nil
-	}); err != nil {
-		return errors.Wrap(err, "decode SubmitVoteReqResponses")
-	}
-
-	return nil
-}
-
-// MarshalJSON implements stdjson.Marshaler.
-func (s SubmitVoteReqResponses) MarshalJSON() ([]byte, error) {
+func (s *SuggestPollOptionsReq) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *SubmitVoteReqResponses) UnmarshalJSON(data []byte) error {
+func (s *SuggestPollOptionsReq) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
@@ -5343,6 +10592,24 @@ func (s *UpdateBookingLinkReq) encodeFields(e *jx.Encoder) {
 			s.AutoConfirm.Encode(e)
 		}
 	}
+	{
+		if s.AutoConfirmRules.Set {
+			e.FieldStart("auto_confirm_rules")
+			s.AutoConfirmRules.Encode(e)
+		}
+	}
+	{
+		if s.ApprovalTimeoutHours.Set {
+			e.FieldStart("approval_timeout_hours")
+			s.ApprovalTimeoutHours.Encode(e)
+		}
+	}
+	{
+		if s.ApprovalTimeoutAction.Set {
+			e.FieldStart("approval_timeout_action")
+			s.ApprovalTimeoutAction.Encode(e)
+		}
+	}
 	{
 		if s.SlotDurationMinutes.Set {
 			e.FieldStart("slot_duration_minutes")
@@ -5377,6 +10644,12 @@ func (s *UpdateBookingLinkReq) encodeFields(e *jx.Encoder) {
 			s.MeetingLink.Encode(e)
 		}
 	}
+	{
+		if s.MeetingProvider.Set {
+			e.FieldStart("meeting_provider")
+			s.MeetingProvider.Encode(e)
+		}
+	}
 	{
 		if s.AvailabilityRules != nil {
 			e.FieldStart("availability_rules")
@@ -5403,21 +10676,32 @@ func (s *UpdateBookingLinkReq) encodeFields(e *jx.Encoder) {
 			s.EventTemplate.Encode(e)
 		}
 	}
+	{
+		if s.Branding.Set {
+			e.FieldStart("branding")
+			s.Branding.Encode(e)
+		}
+	}
 }
 
-var jsonFieldsNameOfUpdateBookingLinkReq = [12]string{
+var jsonFieldsNameOfUpdateBookingLinkReq = [17]string{
 	0:  "name",
 	1:  "description",
 	2:  "status",
 	3:  "auto_confirm",
-	4:  "slot_duration_minutes",
-	5:  "slot_durations_minutes",
-	6:  "buffer_minutes",
-	7:  "require_email",
-	8:  "meeting_link",
-	9:  "availability_rules",
-	10: "custom_fields",
-	11: "event_template",
+	4:  "auto_confirm_rules",
+	5:  "approval_timeout_hours",
+	6:  "approval_timeout_action",
+	7:  "slot_duration_minutes",
+	8:  "slot_durations_minutes",
+	9:  "buffer_minutes",
+	10: "require_email",
+	11: "meeting_link",
+	12: "meeting_provider",
+	13: "availability_rules",
+	14: "custom_fields",
+	15: "event_template",
+	16: "branding",
 }
 
 // Decode decodes UpdateBookingLinkReq from json.
@@ -5468,6 +10752,36 @@ func (s *UpdateBookingLinkReq) Decode(d *jx.Decoder) error {
 			}(); err != nil {
 				return errors.Wrap(err, "decode field \"auto_confirm\"")
 			}
+		case "auto_confirm_rules":
+			if err := func() error {
+				s.AutoConfirmRules.Reset()
+				if err := s.AutoConfirmRules.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"auto_confirm_rules\"")
+			}
+		case "approval_timeout_hours":
+			if err := func() error {
+				s.ApprovalTimeoutHours.Reset()
+				if err := s.ApprovalTimeoutHours.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"approval_timeout_hours\"")
+			}
+		case "approval_timeout_action":
+			if err := func() error {
+				s.ApprovalTimeoutAction.Reset()
+				if err := s.ApprovalTimeoutAction.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"approval_timeout_action\"")
+			}
 		case "slot_duration_minutes":
 			if err := func() error {
 				s.SlotDurationMinutes.Reset()
@@ -5527,6 +10841,16 @@ func (s *UpdateBookingLinkReq) Decode(d *jx.Decoder) error {
 			}(); err != nil {
 				return errors.Wrap(err, "decode field \"meeting_link\"")
 			}
+		case "meeting_provider":
+			if err := func() error {
+				s.MeetingProvider.Reset()
+				if err := s.MeetingProvider.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"meeting_provider\"")
+			}
 		case "availability_rules":
 			if err := func() error {
 				s.AvailabilityRules = make([]AvailabilityRule, 0)
@@ -5571,6 +10895,16 @@ func (s *UpdateBookingLinkReq) Decode(d *jx.Decoder) error {
 			}(); err != nil {
 				return errors.Wrap(err, "decode field \"event_template\"")
 			}
+		case "branding":
+			if err := func() error {
+				s.Branding.Reset()
+				if err := s.Branding.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"branding\"")
+			}
 		default:
 			return d.Skip()
 		}
@@ -5610,50 +10944,305 @@ func (s *UpdateCurrentUserReq) encodeFields(e *jx.Encoder) {
 			s.Name.Encode(e)
 		}
 	}
+	{
+		if s.Branding.Set {
+			e.FieldStart("branding")
+			s.Branding.Encode(e)
+		}
+	}
+}
+
+var jsonFieldsNameOfUpdateCurrentUserReq = [2]string{
+	0: "name",
+	1: "branding",
+}
+
+// Decode decodes UpdateCurrentUserReq from json.
+func (s *UpdateCurrentUserReq) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode UpdateCurrentUserReq to nil")
+	}
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "name":
+			if err := func() error {
+				s.Name.Reset()
+				if err := s.Name.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"name\"")
+			}
+		case "branding":
+			if err := func() error {
+				s.Branding.Reset()
+				if err := s.Branding.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"branding\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode UpdateCurrentUserReq")
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *UpdateCurrentUserReq) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *UpdateCurrentUserReq) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s *UpdateOwnVoteReq) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *UpdateOwnVoteReq) encodeFields(e *jx.Encoder) {
+	{
+		if s.GuestName.Set {
+			e.FieldStart("guest_name")
+			s.GuestName.Encode(e)
+		}
+	}
+	{
+		e.FieldStart("responses")
+		s.Responses.Encode(e)
+	}
+	{
+		if s.CustomFields.Set {
+			e.FieldStart("custom_fields")
+			s.CustomFields.Encode(e)
+		}
+	}
+}
+
+var jsonFieldsNameOfUpdateOwnVoteReq = [3]string{
+	0: "guest_name",
+	1: "responses",
+	2: "custom_fields",
+}
+
+// Decode decodes UpdateOwnVoteReq from json.
+func (s *UpdateOwnVoteReq) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode UpdateOwnVoteReq to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "guest_name":
+			if err := func() error {
+				s.GuestName.Reset()
+				if err := s.GuestName.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"guest_name\"")
+			}
+		case "responses":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				if err := s.Responses.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"responses\"")
+			}
+		case "custom_fields":
+			if err := func() error {
+				s.CustomFields.Reset()
+				if err := s.CustomFields.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"custom_fields\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode UpdateOwnVoteReq")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000010,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfUpdateOwnVoteReq) {
+					name = jsonFieldsNameOfUpdateOwnVoteReq[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *UpdateOwnVoteReq) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *UpdateOwnVoteReq) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s UpdateOwnVoteReqCustomFields) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields implements json.Marshaler.
+func (s UpdateOwnVoteReqCustomFields) encodeFields(e *jx.Encoder) {
+	for k, elem := range s {
+		e.FieldStart(k)
+
+		e.Str(elem)
+	}
+}
+
+// Decode decodes UpdateOwnVoteReqCustomFields from json.
+func (s *UpdateOwnVoteReqCustomFields) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode UpdateOwnVoteReqCustomFields to nil")
+	}
+	m := s.init()
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		var elem string
+		if err := func() error {
+			v, err := d.Str()
+			elem = string(v)
+			if err != nil {
+				return err
+			}
+			return nil
+		}(); err != nil {
+			return errors.Wrapf(err, "decode field %q", k)
+		}
+		m[string(k)] = elem
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode UpdateOwnVoteReqCustomFields")
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s UpdateOwnVoteReqCustomFields) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *UpdateOwnVoteReqCustomFields) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode implements json.Marshaler.
+func (s UpdateOwnVoteReqResponses) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
 }
 
-var jsonFieldsNameOfUpdateCurrentUserReq = [1]string{
-	0: "name",
+// encodeFields implements json.Marshaler.
+func (s UpdateOwnVoteReqResponses) encodeFields(e *jx.Encoder) {
+	for k, elem := range s {
+		e.FieldStart(k)
+
+		elem.Encode(e)
+	}
 }
 
-// Decode decodes UpdateCurrentUserReq from json.
-func (s *UpdateCurrentUserReq) Decode(d *jx.Decoder) error {
+// Decode decodes UpdateOwnVoteReqResponses from json.
+func (s *UpdateOwnVoteReqResponses) Decode(d *jx.Decoder) error {
 	if s == nil {
-		return errors.New("invalid: unable to decode UpdateCurrentUserReq to nil")
+		return errors.New("invalid: unable to decode UpdateOwnVoteReqResponses to nil")
 	}
-
+	m := s.init()
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
-		switch string(k) {
-		case "name":
-			if err := func() error {
-				s.Name.Reset()
-				if err := s.Name.Decode(d); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return errors.Wrap(err, "decode field \"name\"")
+		var elem VoteResponse
+		if err := func() error {
+			if err := elem.Decode(d); err != nil {
+				return err
 			}
-		default:
-			return d.Skip()
+			return nil
+		}(); err != nil {
+			return errors.Wrapf(err, "decode field %q", k)
 		}
+		m[string(k)] = elem
 		return nil
 	}); err != nil {
-		return errors.Wrap(err, "decode UpdateCurrentUserReq")
+		return errors.Wrap(err, "decode UpdateOwnVoteReqResponses")
 	}
 
 	return nil
 }
 
 // MarshalJSON implements stdjson.Marshaler.
-func (s *UpdateCurrentUserReq) MarshalJSON() ([]byte, error) {
+func (s UpdateOwnVoteReqResponses) MarshalJSON() ([]byte, error) {
 	e := jx.Encoder{}
 	s.Encode(&e)
 	return e.Bytes(), nil
 }
 
 // UnmarshalJSON implements stdjson.Unmarshaler.
-func (s *UpdateCurrentUserReq) UnmarshalJSON(data []byte) error {
+func (s *UpdateOwnVoteReqResponses) UnmarshalJSON(data []byte) error {
 	d := jx.DecodeBytes(data)
 	return s.Decode(d)
 }
@@ -5691,12 +11280,36 @@ func (s *UpdatePollReq) encodeFields(e *jx.Encoder) {
 			s.ShowResults.Encode(e)
 		}
 	}
+	{
+		if s.ResultsVisibility.Set {
+			e.FieldStart("results_visibility")
+			s.ResultsVisibility.Encode(e)
+		}
+	}
+	{
+		if s.ResultsTiming.Set {
+			e.FieldStart("results_timing")
+			s.ResultsTiming.Encode(e)
+		}
+	}
 	{
 		if s.RequireEmail.Set {
 			e.FieldStart("require_email")
 			s.RequireEmail.Encode(e)
 		}
 	}
+	{
+		if s.Mode.Set {
+			e.FieldStart("mode")
+			s.Mode.Encode(e)
+		}
+	}
+	{
+		if s.MaxChoices.Set {
+			e.FieldStart("max_choices")
+			s.MaxChoices.Encode(e)
+		}
+	}
 	{
 		if s.CustomFields != nil {
 			e.FieldStart("custom_fields")
@@ -5707,15 +11320,82 @@ func (s *UpdatePollReq) encodeFields(e *jx.Encoder) {
 			e.ArrEnd()
 		}
 	}
+	{
+		if s.Branding.Set {
+			e.FieldStart("branding")
+			s.Branding.Encode(e)
+		}
+	}
+	{
+		if s.Deadline.Set {
+			e.FieldStart("deadline")
+			s.Deadline.Encode(e, json.EncodeDateTime)
+		}
+	}
+	{
+		if s.EventTemplate.Set {
+			e.FieldStart("event_template")
+			s.EventTemplate.Encode(e)
+		}
+	}
+	{
+		if s.ReminderHours.Set {
+			e.FieldStart("reminder_hours")
+			s.ReminderHours.Encode(e)
+		}
+	}
+	{
+		if s.Scoring.Set {
+			e.FieldStart("scoring")
+			s.Scoring.Encode(e)
+		}
+	}
+	{
+		if s.AutoPickOnClose.Set {
+			e.FieldStart("auto_pick_on_close")
+			s.AutoPickOnClose.Encode(e)
+		}
+	}
+	{
+		if s.AutoPickWhenAllYes.Set {
+			e.FieldStart("auto_pick_when_all_yes")
+			s.AutoPickWhenAllYes.Encode(e)
+		}
+	}
+	{
+		if s.HoldTimes.Set {
+			e.FieldStart("hold_times")
+			s.HoldTimes.Encode(e)
+		}
+	}
+	{
+		if s.OptionProposals.Set {
+			e.FieldStart("option_proposals")
+			s.OptionProposals.Encode(e)
+		}
+	}
 }
 
-var jsonFieldsNameOfUpdatePollReq = [6]string{
-	0: "name",
-	1: "description",
-	2: "status",
-	3: "show_results",
-	4: "require_email",
-	5: "custom_fields",
+var jsonFieldsNameOfUpdatePollReq = [19]string{
+	0:  "name",
+	1:  "description",
+	2:  "status",
+	3:  "show_results",
+	4:  "results_visibility",
+	5:  "results_timing",
+	6:  "require_email",
+	7:  "mode",
+	8:  "max_choices",
+	9:  "custom_fields",
+	10: "branding",
+	11: "deadline",
+	12: "event_template",
+	13: "reminder_hours",
+	14: "scoring",
+	15: "auto_pick_on_close",
+	16: "auto_pick_when_all_yes",
+	17: "hold_times",
+	18: "option_proposals",
 }
 
 // Decode decodes UpdatePollReq from json.
@@ -5766,6 +11446,26 @@ func (s *UpdatePollReq) Decode(d *jx.Decoder) error {
 			}(); err != nil {
 				return errors.Wrap(err, "decode field \"show_results\"")
 			}
+		case "results_visibility":
+			if err := func() error {
+				s.ResultsVisibility.Reset()
+				if err := s.ResultsVisibility.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"results_visibility\"")
+			}
+		case "results_timing":
+			if err := func() error {
+				s.ResultsTiming.Reset()
+				if err := s.ResultsTiming.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"results_timing\"")
+			}
 		case "require_email":
 			if err := func() error {
 				s.RequireEmail.Reset()
@@ -5776,6 +11476,26 @@ func (s *UpdatePollReq) Decode(d *jx.Decoder) error {
 			}(); err != nil {
 				return errors.Wrap(err, "decode field \"require_email\"")
 			}
+		case "mode":
+			if err := func() error {
+				s.Mode.Reset()
+				if err := s.Mode.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"mode\"")
+			}
+		case "max_choices":
+			if err := func() error {
+				s.MaxChoices.Reset()
+				if err := s.MaxChoices.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"max_choices\"")
+			}
 		case "custom_fields":
 			if err := func() error {
 				s.CustomFields = make([]CustomField, 0)
@@ -5793,6 +11513,96 @@ func (s *UpdatePollReq) Decode(d *jx.Decoder) error {
 			}(); err != nil {
 				return errors.Wrap(err, "decode field \"custom_fields\"")
 			}
+		case "branding":
+			if err := func() error {
+				s.Branding.Reset()
+				if err := s.Branding.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"branding\"")
+			}
+		case "deadline":
+			if err := func() error {
+				s.Deadline.Reset()
+				if err := s.Deadline.Decode(d, json.DecodeDateTime); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"deadline\"")
+			}
+		case "event_template":
+			if err := func() error {
+				s.EventTemplate.Reset()
+				if err := s.EventTemplate.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"event_template\"")
+			}
+		case "reminder_hours":
+			if err := func() error {
+				s.ReminderHours.Reset()
+				if err := s.ReminderHours.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"reminder_hours\"")
+			}
+		case "scoring":
+			if err := func() error {
+				s.Scoring.Reset()
+				if err := s.Scoring.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"scoring\"")
+			}
+		case "auto_pick_on_close":
+			if err := func() error {
+				s.AutoPickOnClose.Reset()
+				if err := s.AutoPickOnClose.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"auto_pick_on_close\"")
+			}
+		case "auto_pick_when_all_yes":
+			if err := func() error {
+				s.AutoPickWhenAllYes.Reset()
+				if err := s.AutoPickWhenAllYes.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"auto_pick_when_all_yes\"")
+			}
+		case "hold_times":
+			if err := func() error {
+				s.HoldTimes.Reset()
+				if err := s.HoldTimes.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"hold_times\"")
+			}
+		case "option_proposals":
+			if err := func() error {
+				s.OptionProposals.Reset()
+				if err := s.OptionProposals.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"option_proposals\"")
+			}
 		default:
 			return d.Skip()
 		}
@@ -5846,13 +11656,20 @@ func (s *User) encodeFields(e *jx.Encoder) {
 			s.AvatarURL.Encode(e)
 		}
 	}
+	{
+		if s.Branding.Set {
+			e.FieldStart("branding")
+			s.Branding.Encode(e)
+		}
+	}
 }
 
-var jsonFieldsNameOfUser = [4]string{
+var jsonFieldsNameOfUser = [5]string{
 	0: "id",
 	1: "email",
 	2: "name",
 	3: "avatar_url",
+	4: "branding",
 }
 
 // Decode decodes User from json.
@@ -5908,6 +11725,16 @@ func (s *User) Decode(d *jx.Decoder) error {
 			}(); err != nil {
 				return errors.Wrap(err, "decode field \"avatar_url\"")
 			}
+		case "branding":
+			if err := func() error {
+				s.Branding.Reset()
+				if err := s.Branding.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"branding\"")
+			}
 		default:
 			return d.Skip()
 		}
@@ -5964,6 +11791,69 @@ func (s *User) UnmarshalJSON(data []byte) error {
 	return s.Decode(d)
 }
 
+// Encode implements json.Marshaler.
+func (s *VerifyBookingEmailOK) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *VerifyBookingEmailOK) encodeFields(e *jx.Encoder) {
+	{
+		if s.Message.Set {
+			e.FieldStart("message")
+			s.Message.Encode(e)
+		}
+	}
+}
+
+var jsonFieldsNameOfVerifyBookingEmailOK = [1]string{
+	0: "message",
+}
+
+// Decode decodes VerifyBookingEmailOK from json.
+func (s *VerifyBookingEmailOK) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode VerifyBookingEmailOK to nil")
+	}
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "message":
+			if err := func() error {
+				s.Message.Reset()
+				if err := s.Message.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"message\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode VerifyBookingEmailOK")
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *VerifyBookingEmailOK) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *VerifyBookingEmailOK) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
 // Encode implements json.Marshaler.
 func (s *Vote) Encode(e *jx.Encoder) {
 	e.ObjStart()
@@ -5999,6 +11889,12 @@ func (s *Vote) encodeFields(e *jx.Encoder) {
 			s.CustomFields.Encode(e)
 		}
 	}
+	{
+		if s.EditToken.Set {
+			e.FieldStart("edit_token")
+			s.EditToken.Encode(e)
+		}
+	}
 	{
 		if s.CreatedAt.Set {
 			e.FieldStart("created_at")
@@ -6007,13 +11903,14 @@ func (s *Vote) encodeFields(e *jx.Encoder) {
 	}
 }
 
-var jsonFieldsNameOfVote = [6]string{
+var jsonFieldsNameOfVote = [7]string{
 	0: "id",
 	1: "guest_name",
 	2: "guest_email",
 	3: "responses",
 	4: "custom_fields",
-	5: "created_at",
+	5: "edit_token",
+	6: "created_at",
 }
 
 // Decode decodes Vote from json.
@@ -6077,6 +11974,16 @@ func (s *Vote) Decode(d *jx.Decoder) error {
 			}(); err != nil {
 				return errors.Wrap(err, "decode field \"custom_fields\"")
 			}
+		case "edit_token":
+			if err := func() error {
+				s.EditToken.Reset()
+				if err := s.EditToken.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"edit_token\"")
+			}
 		case "created_at":
 			if err := func() error {
 				s.CreatedAt.Reset()
@@ -6310,13 +12217,27 @@ func (s *VoteTally) encodeFields(e *jx.Encoder) {
 		e.FieldStart("maybe_count")
 		e.Int(s.MaybeCount)
 	}
+	{
+		if s.Score.Set {
+			e.FieldStart("score")
+			s.Score.Encode(e)
+		}
+	}
+	{
+		if s.RequiredNoCount.Set {
+			e.FieldStart("required_no_count")
+			s.RequiredNoCount.Encode(e)
+		}
+	}
 }
 
-var jsonFieldsNameOfVoteTally = [4]string{
+var jsonFieldsNameOfVoteTally = [6]string{
 	0: "option_id",
 	1: "yes_count",
 	2: "no_count",
 	3: "maybe_count",
+	4: "score",
+	5: "required_no_count",
 }
 
 // Decode decodes VoteTally from json.
@@ -6376,6 +12297,26 @@ func (s *VoteTally) Decode(d *jx.Decoder) error {
 			}(); err != nil {
 				return errors.Wrap(err, "decode field \"maybe_count\"")
 			}
+		case "score":
+			if err := func() error {
+				s.Score.Reset()
+				if err := s.Score.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"score\"")
+			}
+		case "required_no_count":
+			if err := func() error {
+				s.RequiredNoCount.Reset()
+				if err := s.RequiredNoCount.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"required_no_count\"")
+			}
 		default:
 			return d.Skip()
 		}
@@ -7,39 +7,61 @@ type OperationName = string
 
 const (
 	AddCalendarOperation            OperationName = "AddCalendar"
+	AddPollCommentOperation         OperationName = "AddPollComment"
+	AddPollInvitesOperation         OperationName = "AddPollInvites"
 	AddPollOptionOperation          OperationName = "AddPollOption"
 	ApproveBookingOperation         OperationName = "ApproveBooking"
+	ApprovePollProposalOperation    OperationName = "ApprovePollProposal"
 	ApproveViaEmailOperation        OperationName = "ApproveViaEmail"
 	AuthCallbackOperation           OperationName = "AuthCallback"
+	AutofillVoteOperation           OperationName = "AutofillVote"
 	CreateBookingOperation          OperationName = "CreateBooking"
 	CreateBookingLinkOperation      OperationName = "CreateBookingLink"
 	CreatePollOperation             OperationName = "CreatePoll"
 	DeclineBookingOperation         OperationName = "DeclineBooking"
+	DeclinePollProposalOperation    OperationName = "DeclinePollProposal"
 	DeclineViaEmailOperation        OperationName = "DeclineViaEmail"
 	DeleteBookingLinkOperation      OperationName = "DeleteBookingLink"
+	DeleteOwnVoteOperation          OperationName = "DeleteOwnVote"
 	DeletePollOperation             OperationName = "DeletePoll"
+	DeletePollCommentOperation      OperationName = "DeletePollComment"
+	DeletePollInviteOperation       OperationName = "DeletePollInvite"
 	DeletePollOptionOperation       OperationName = "DeletePollOption"
 	DiscoverCalendarsOperation      OperationName = "DiscoverCalendars"
+	GeneratePollOptionsOperation    OperationName = "GeneratePollOptions"
 	GetBookingAvailabilityOperation OperationName = "GetBookingAvailability"
 	GetBookingLinkOperation         OperationName = "GetBookingLink"
 	GetBookingLinkBookingsOperation OperationName = "GetBookingLinkBookings"
 	GetCurrentUserOperation         OperationName = "GetCurrentUser"
+	GetOwnVoteOperation             OperationName = "GetOwnVote"
 	GetPollOperation                OperationName = "GetPoll"
 	GetPollOptionsOperation         OperationName = "GetPollOptions"
+	GetPollRankingOperation         OperationName = "GetPollRanking"
 	GetPollResultsOperation         OperationName = "GetPollResults"
 	GetPollVotesOperation           OperationName = "GetPollVotes"
 	GetPublicBookingLinkOperation   OperationName = "GetPublicBookingLink"
 	GetPublicPollOperation          OperationName = "GetPublicPoll"
+	GetPublicPollCommentsOperation  OperationName = "GetPublicPollComments"
 	InitiateLoginOperation          OperationName = "InitiateLogin"
 	ListBookingLinksOperation       OperationName = "ListBookingLinks"
 	ListCalendarsOperation          OperationName = "ListCalendars"
+	ListMeetingProvidersOperation   OperationName = "ListMeetingProviders"
+	ListPollCommentsOperation       OperationName = "ListPollComments"
+	ListPollInvitesOperation        OperationName = "ListPollInvites"
+	ListPollProposalsOperation      OperationName = "ListPollProposals"
 	ListPollsOperation              OperationName = "ListPolls"
 	LogoutOperation                 OperationName = "Logout"
+	ModeratePollCommentOperation    OperationName = "ModeratePollComment"
 	PickPollWinnerOperation         OperationName = "PickPollWinner"
+	ProposePollOptionOperation      OperationName = "ProposePollOption"
+	RemindPollInvitesOperation      OperationName = "RemindPollInvites"
 	RemoveCalendarOperation         OperationName = "RemoveCalendar"
 	SubmitVoteOperation             OperationName = "SubmitVote"
+	SuggestPollOptionsOperation     OperationName = "SuggestPollOptions"
 	TestCalendarOperation           OperationName = "TestCalendar"
 	UpdateBookingLinkOperation      OperationName = "UpdateBookingLink"
 	UpdateCurrentUserOperation      OperationName = "UpdateCurrentUser"
+	UpdateOwnVoteOperation          OperationName = "UpdateOwnVote"
 	UpdatePollOperation             OperationName = "UpdatePoll"
+	VerifyBookingEmailOperation     OperationName = "VerifyBookingEmail"
 )
@@ -15,24 +15,24 @@ import (
 	"github.com/ogen-go/ogen/validate"
 )
 
-// AddPollOptionParams is parameters of addPollOption operation.
-type AddPollOptionParams struct {
-	ID int
+// AddPollCommentParams is parameters of addPollComment operation.
+type AddPollCommentParams struct {
+	Slug string
 }
 
-func unpackAddPollOptionParams(packed middleware.Parameters) (params AddPollOptionParams) {
+func unpackAddPollCommentParams(packed middleware.Parameters) (params AddPollCommentParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "id",
+			Name: "slug",
 			In:   "path",
 		}
-		params.ID = packed[key].(int)
+		params.Slug = packed[key].(string)
 	}
 	return params
 }
 
-func decodeAddPollOptionParams(args [1]string, argsEscaped bool, r *http.Request) (params AddPollOptionParams, _ error) {
-	// Decode path: id.
+func decodeAddPollCommentParams(args [1]string, argsEscaped bool, r *http.Request) (params AddPollCommentParams, _ error) {
+	// Decode path: slug.
 	if err := func() error {
 		param := args[0]
 		if argsEscaped {
@@ -44,7 +44,7 @@ func decodeAddPollOptionParams(args [1]string, argsEscaped bool, r *http.Request
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "id",
+				Param:   "slug",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -56,12 +56,12 @@ func decodeAddPollOptionParams(args [1]string, argsEscaped bool, r *http.Request
 					return err
 				}
 
-				c, err := conv.ToInt(val)
+				c, err := conv.ToString(val)
 				if err != nil {
 					return err
 				}
 
-				params.ID = c
+				params.Slug = c
 				return nil
 			}(); err != nil {
 				return err
@@ -72,7 +72,7 @@ func decodeAddPollOptionParams(args [1]string, argsEscaped bool, r *http.Request
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "id",
+			Name: "slug",
 			In:   "path",
 			Err:  err,
 		}
@@ -80,12 +80,12 @@ func decodeAddPollOptionParams(args [1]string, argsEscaped bool, r *http.Request
 	return params, nil
 }
 
-// ApproveBookingParams is parameters of approveBooking operation.
-type ApproveBookingParams struct {
+// AddPollInvitesParams is parameters of addPollInvites operation.
+type AddPollInvitesParams struct {
 	ID int
 }
 
-func unpackApproveBookingParams(packed middleware.Parameters) (params ApproveBookingParams) {
+func unpackAddPollInvitesParams(packed middleware.Parameters) (params AddPollInvitesParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "id",
@@ -96,7 +96,7 @@ func unpackApproveBookingParams(packed middleware.Parameters) (params ApproveBoo
 	return params
 }
 
-func decodeApproveBookingParams(args [1]string, argsEscaped bool, r *http.Request) (params ApproveBookingParams, _ error) {
+func decodeAddPollInvitesParams(args [1]string, argsEscaped bool, r *http.Request) (params AddPollInvitesParams, _ error) {
 	// Decode path: id.
 	if err := func() error {
 		param := args[0]
@@ -145,125 +145,24 @@ func decodeApproveBookingParams(args [1]string, argsEscaped bool, r *http.Reques
 	return params, nil
 }
 
-// AuthCallbackParams is parameters of authCallback operation.
-type AuthCallbackParams struct {
-	Code  string
-	State string
-}
-
-func unpackAuthCallbackParams(packed middleware.Parameters) (params AuthCallbackParams) {
-	{
-		key := middleware.ParameterKey{
-			Name: "code",
-			In:   "query",
-		}
-		params.Code = packed[key].(string)
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "state",
-			In:   "query",
-		}
-		params.State = packed[key].(string)
-	}
-	return params
-}
-
-func decodeAuthCallbackParams(args [0]string, argsEscaped bool, r *http.Request) (params AuthCallbackParams, _ error) {
-	q := uri.NewQueryDecoder(r.URL.Query())
-	// Decode query: code.
-	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "code",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				val, err := d.DecodeValue()
-				if err != nil {
-					return err
-				}
-
-				c, err := conv.ToString(val)
-				if err != nil {
-					return err
-				}
-
-				params.Code = c
-				return nil
-			}); err != nil {
-				return err
-			}
-		} else {
-			return err
-		}
-		return nil
-	}(); err != nil {
-		return params, &ogenerrors.DecodeParamError{
-			Name: "code",
-			In:   "query",
-			Err:  err,
-		}
-	}
-	// Decode query: state.
-	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "state",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				val, err := d.DecodeValue()
-				if err != nil {
-					return err
-				}
-
-				c, err := conv.ToString(val)
-				if err != nil {
-					return err
-				}
-
-				params.State = c
-				return nil
-			}); err != nil {
-				return err
-			}
-		} else {
-			return err
-		}
-		return nil
-	}(); err != nil {
-		return params, &ogenerrors.DecodeParamError{
-			Name: "state",
-			In:   "query",
-			Err:  err,
-		}
-	}
-	return params, nil
-}
-
-// CreateBookingParams is parameters of createBooking operation.
-type CreateBookingParams struct {
-	Slug string
+// AddPollOptionParams is parameters of addPollOption operation.
+type AddPollOptionParams struct {
+	ID int
 }
 
-func unpackCreateBookingParams(packed middleware.Parameters) (params CreateBookingParams) {
+func unpackAddPollOptionParams(packed middleware.Parameters) (params AddPollOptionParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "slug",
+			Name: "id",
 			In:   "path",
 		}
-		params.Slug = packed[key].(string)
+		params.ID = packed[key].(int)
 	}
 	return params
 }
 
-func decodeCreateBookingParams(args [1]string, argsEscaped bool, r *http.Request) (params CreateBookingParams, _ error) {
-	// Decode path: slug.
+func decodeAddPollOptionParams(args [1]string, argsEscaped bool, r *http.Request) (params AddPollOptionParams, _ error) {
+	// Decode path: id.
 	if err := func() error {
 		param := args[0]
 		if argsEscaped {
@@ -275,7 +174,7 @@ func decodeCreateBookingParams(args [1]string, argsEscaped bool, r *http.Request
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "slug",
+				Param:   "id",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -287,12 +186,12 @@ func decodeCreateBookingParams(args [1]string, argsEscaped bool, r *http.Request
 					return err
 				}
 
-				c, err := conv.ToString(val)
+				c, err := conv.ToInt(val)
 				if err != nil {
 					return err
 				}
 
-				params.Slug = c
+				params.ID = c
 				return nil
 			}(); err != nil {
 				return err
@@ -303,7 +202,7 @@ func decodeCreateBookingParams(args [1]string, argsEscaped bool, r *http.Request
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "slug",
+			Name: "id",
 			In:   "path",
 			Err:  err,
 		}
@@ -311,12 +210,12 @@ func decodeCreateBookingParams(args [1]string, argsEscaped bool, r *http.Request
 	return params, nil
 }
 
-// DeclineBookingParams is parameters of declineBooking operation.
-type DeclineBookingParams struct {
+// ApproveBookingParams is parameters of approveBooking operation.
+type ApproveBookingParams struct {
 	ID int
 }
 
-func unpackDeclineBookingParams(packed middleware.Parameters) (params DeclineBookingParams) {
+func unpackApproveBookingParams(packed middleware.Parameters) (params ApproveBookingParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "id",
@@ -327,7 +226,7 @@ func unpackDeclineBookingParams(packed middleware.Parameters) (params DeclineBoo
 	return params
 }
 
-func decodeDeclineBookingParams(args [1]string, argsEscaped bool, r *http.Request) (params DeclineBookingParams, _ error) {
+func decodeApproveBookingParams(args [1]string, argsEscaped bool, r *http.Request) (params ApproveBookingParams, _ error) {
 	// Decode path: id.
 	if err := func() error {
 		param := args[0]
@@ -376,12 +275,13 @@ func decodeDeclineBookingParams(args [1]string, argsEscaped bool, r *http.Reques
 	return params, nil
 }
 
-// DeleteBookingLinkParams is parameters of deleteBookingLink operation.
-type DeleteBookingLinkParams struct {
-	ID int
+// ApprovePollProposalParams is parameters of approvePollProposal operation.
+type ApprovePollProposalParams struct {
+	ID         int
+	ProposalId int
 }
 
-func unpackDeleteBookingLinkParams(packed middleware.Parameters) (params DeleteBookingLinkParams) {
+func unpackApprovePollProposalParams(packed middleware.Parameters) (params ApprovePollProposalParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "id",
@@ -389,10 +289,17 @@ func unpackDeleteBookingLinkParams(packed middleware.Parameters) (params DeleteB
 		}
 		params.ID = packed[key].(int)
 	}
+	{
+		key := middleware.ParameterKey{
+			Name: "proposalId",
+			In:   "path",
+		}
+		params.ProposalId = packed[key].(int)
+	}
 	return params
 }
 
-func decodeDeleteBookingLinkParams(args [1]string, argsEscaped bool, r *http.Request) (params DeleteBookingLinkParams, _ error) {
+func decodeApprovePollProposalParams(args [2]string, argsEscaped bool, r *http.Request) (params ApprovePollProposalParams, _ error) {
 	// Decode path: id.
 	if err := func() error {
 		param := args[0]
@@ -438,31 +345,11 @@ func decodeDeleteBookingLinkParams(args [1]string, argsEscaped bool, r *http.Req
 			Err:  err,
 		}
 	}
-	return params, nil
-}
-
-// DeletePollParams is parameters of deletePoll operation.
-type DeletePollParams struct {
-	ID int
-}
-
-func unpackDeletePollParams(packed middleware.Parameters) (params DeletePollParams) {
-	{
-		key := middleware.ParameterKey{
-			Name: "id",
-			In:   "path",
-		}
-		params.ID = packed[key].(int)
-	}
-	return params
-}
-
-func decodeDeletePollParams(args [1]string, argsEscaped bool, r *http.Request) (params DeletePollParams, _ error) {
-	// Decode path: id.
+	// Decode path: proposalId.
 	if err := func() error {
-		param := args[0]
+		param := args[1]
 		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[0])
+			unescaped, err := url.PathUnescape(args[1])
 			if err != nil {
 				return errors.Wrap(err, "unescape path")
 			}
@@ -470,7 +357,7 @@ func decodeDeletePollParams(args [1]string, argsEscaped bool, r *http.Request) (
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "id",
+				Param:   "proposalId",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -487,7 +374,7 @@ func decodeDeletePollParams(args [1]string, argsEscaped bool, r *http.Request) (
 					return err
 				}
 
-				params.ID = c
+				params.ProposalId = c
 				return nil
 			}(); err != nil {
 				return err
@@ -498,7 +385,7 @@ func decodeDeletePollParams(args [1]string, argsEscaped bool, r *http.Request) (
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "id",
+			Name: "proposalId",
 			In:   "path",
 			Err:  err,
 		}
@@ -506,81 +393,1901 @@ func decodeDeletePollParams(args [1]string, argsEscaped bool, r *http.Request) (
 	return params, nil
 }
 
-// DeletePollOptionParams is parameters of deletePollOption operation.
-type DeletePollOptionParams struct {
-	ID       int
-	OptionId int
+// AuthCallbackParams is parameters of authCallback operation.
+type AuthCallbackParams struct {
+	Code  string
+	State string
 }
 
-func unpackDeletePollOptionParams(packed middleware.Parameters) (params DeletePollOptionParams) {
+func unpackAuthCallbackParams(packed middleware.Parameters) (params AuthCallbackParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "id",
-			In:   "path",
+			Name: "code",
+			In:   "query",
 		}
-		params.ID = packed[key].(int)
+		params.Code = packed[key].(string)
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "optionId",
-			In:   "path",
+			Name: "state",
+			In:   "query",
 		}
-		params.OptionId = packed[key].(int)
+		params.State = packed[key].(string)
 	}
 	return params
 }
 
-func decodeDeletePollOptionParams(args [2]string, argsEscaped bool, r *http.Request) (params DeletePollOptionParams, _ error) {
-	// Decode path: id.
+func decodeAuthCallbackParams(args [0]string, argsEscaped bool, r *http.Request) (params AuthCallbackParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode query: code.
 	if err := func() error {
-		param := args[0]
-		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[0])
-			if err != nil {
-				return errors.Wrap(err, "unescape path")
-			}
-			param = unescaped
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "code",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
 		}
-		if len(param) > 0 {
-			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "id",
-				Value:   param,
-				Style:   uri.PathStyleSimple,
-				Explode: false,
-			})
 
-			if err := func() error {
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
 				val, err := d.DecodeValue()
 				if err != nil {
 					return err
 				}
 
-				c, err := conv.ToInt(val)
+				c, err := conv.ToString(val)
 				if err != nil {
 					return err
 				}
 
-				params.ID = c
+				params.Code = c
 				return nil
-			}(); err != nil {
+			}); err != nil {
 				return err
 			}
 		} else {
-			return validate.ErrFieldRequired
+			return err
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "id",
-			In:   "path",
+			Name: "code",
+			In:   "query",
 			Err:  err,
 		}
 	}
-	// Decode path: optionId.
+	// Decode query: state.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "state",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.State = c
+				return nil
+			}); err != nil {
+				return err
+			}
+		} else {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "state",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// AutofillVoteParams is parameters of autofillVote operation.
+type AutofillVoteParams struct {
+	Slug string
+}
+
+func unpackAutofillVoteParams(packed middleware.Parameters) (params AutofillVoteParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "slug",
+			In:   "path",
+		}
+		params.Slug = packed[key].(string)
+	}
+	return params
+}
+
+func decodeAutofillVoteParams(args [1]string, argsEscaped bool, r *http.Request) (params AutofillVoteParams, _ error) {
+	// Decode path: slug.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "slug",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.Slug = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "slug",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// CreateBookingParams is parameters of createBooking operation.
+type CreateBookingParams struct {
+	Slug string
+}
+
+func unpackCreateBookingParams(packed middleware.Parameters) (params CreateBookingParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "slug",
+			In:   "path",
+		}
+		params.Slug = packed[key].(string)
+	}
+	return params
+}
+
+func decodeCreateBookingParams(args [1]string, argsEscaped bool, r *http.Request) (params CreateBookingParams, _ error) {
+	// Decode path: slug.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "slug",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.Slug = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "slug",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// DeclineBookingParams is parameters of declineBooking operation.
+type DeclineBookingParams struct {
+	ID int
+}
+
+func unpackDeclineBookingParams(packed middleware.Parameters) (params DeclineBookingParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(int)
+	}
+	return params
+}
+
+func decodeDeclineBookingParams(args [1]string, argsEscaped bool, r *http.Request) (params DeclineBookingParams, _ error) {
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToInt(val)
+				if err != nil {
+					return err
+				}
+
+				params.ID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// DeclinePollProposalParams is parameters of declinePollProposal operation.
+type DeclinePollProposalParams struct {
+	ID         int
+	ProposalId int
+}
+
+func unpackDeclinePollProposalParams(packed middleware.Parameters) (params DeclinePollProposalParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(int)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "proposalId",
+			In:   "path",
+		}
+		params.ProposalId = packed[key].(int)
+	}
+	return params
+}
+
+func decodeDeclinePollProposalParams(args [2]string, argsEscaped bool, r *http.Request) (params DeclinePollProposalParams, _ error) {
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToInt(val)
+				if err != nil {
+					return err
+				}
+
+				params.ID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Decode path: proposalId.
+	if err := func() error {
+		param := args[1]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[1])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "proposalId",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToInt(val)
+				if err != nil {
+					return err
+				}
+
+				params.ProposalId = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "proposalId",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// DeleteBookingLinkParams is parameters of deleteBookingLink operation.
+type DeleteBookingLinkParams struct {
+	ID int
+}
+
+func unpackDeleteBookingLinkParams(packed middleware.Parameters) (params DeleteBookingLinkParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(int)
+	}
+	return params
+}
+
+func decodeDeleteBookingLinkParams(args [1]string, argsEscaped bool, r *http.Request) (params DeleteBookingLinkParams, _ error) {
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToInt(val)
+				if err != nil {
+					return err
+				}
+
+				params.ID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// DeleteOwnVoteParams is parameters of deleteOwnVote operation.
+type DeleteOwnVoteParams struct {
+	Slug  string
+	Token string
+}
+
+func unpackDeleteOwnVoteParams(packed middleware.Parameters) (params DeleteOwnVoteParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "slug",
+			In:   "path",
+		}
+		params.Slug = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "token",
+			In:   "path",
+		}
+		params.Token = packed[key].(string)
+	}
+	return params
+}
+
+func decodeDeleteOwnVoteParams(args [2]string, argsEscaped bool, r *http.Request) (params DeleteOwnVoteParams, _ error) {
+	// Decode path: slug.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "slug",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.Slug = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "slug",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Decode path: token.
+	if err := func() error {
+		param := args[1]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[1])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "token",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.Token = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "token",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// DeletePollParams is parameters of deletePoll operation.
+type DeletePollParams struct {
+	ID int
+}
+
+func unpackDeletePollParams(packed middleware.Parameters) (params DeletePollParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(int)
+	}
+	return params
+}
+
+func decodeDeletePollParams(args [1]string, argsEscaped bool, r *http.Request) (params DeletePollParams, _ error) {
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToInt(val)
+				if err != nil {
+					return err
+				}
+
+				params.ID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// DeletePollCommentParams is parameters of deletePollComment operation.
+type DeletePollCommentParams struct {
+	ID        int
+	CommentId int
+}
+
+func unpackDeletePollCommentParams(packed middleware.Parameters) (params DeletePollCommentParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(int)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "commentId",
+			In:   "path",
+		}
+		params.CommentId = packed[key].(int)
+	}
+	return params
+}
+
+func decodeDeletePollCommentParams(args [2]string, argsEscaped bool, r *http.Request) (params DeletePollCommentParams, _ error) {
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToInt(val)
+				if err != nil {
+					return err
+				}
+
+				params.ID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Decode path: commentId.
+	if err := func() error {
+		param := args[1]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[1])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "commentId",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToInt(val)
+				if err != nil {
+					return err
+				}
+
+				params.CommentId = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "commentId",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// DeletePollInviteParams is parameters of deletePollInvite operation.
+type DeletePollInviteParams struct {
+	ID       int
+	InviteId int
+}
+
+func unpackDeletePollInviteParams(packed middleware.Parameters) (params DeletePollInviteParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(int)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "inviteId",
+			In:   "path",
+		}
+		params.InviteId = packed[key].(int)
+	}
+	return params
+}
+
+func decodeDeletePollInviteParams(args [2]string, argsEscaped bool, r *http.Request) (params DeletePollInviteParams, _ error) {
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToInt(val)
+				if err != nil {
+					return err
+				}
+
+				params.ID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Decode path: inviteId.
+	if err := func() error {
+		param := args[1]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[1])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "inviteId",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToInt(val)
+				if err != nil {
+					return err
+				}
+
+				params.InviteId = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "inviteId",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// DeletePollOptionParams is parameters of deletePollOption operation.
+type DeletePollOptionParams struct {
+	ID       int
+	OptionId int
+}
+
+func unpackDeletePollOptionParams(packed middleware.Parameters) (params DeletePollOptionParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(int)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "optionId",
+			In:   "path",
+		}
+		params.OptionId = packed[key].(int)
+	}
+	return params
+}
+
+func decodeDeletePollOptionParams(args [2]string, argsEscaped bool, r *http.Request) (params DeletePollOptionParams, _ error) {
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToInt(val)
+				if err != nil {
+					return err
+				}
+
+				params.ID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Decode path: optionId.
+	if err := func() error {
+		param := args[1]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[1])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "optionId",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToInt(val)
+				if err != nil {
+					return err
+				}
+
+				params.OptionId = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "optionId",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GeneratePollOptionsParams is parameters of generatePollOptions operation.
+type GeneratePollOptionsParams struct {
+	ID int
+}
+
+func unpackGeneratePollOptionsParams(packed middleware.Parameters) (params GeneratePollOptionsParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(int)
+	}
+	return params
+}
+
+func decodeGeneratePollOptionsParams(args [1]string, argsEscaped bool, r *http.Request) (params GeneratePollOptionsParams, _ error) {
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToInt(val)
+				if err != nil {
+					return err
+				}
+
+				params.ID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetBookingAvailabilityParams is parameters of getBookingAvailability operation.
+type GetBookingAvailabilityParams struct {
+	Slug  string
+	Start time.Time
+	End   time.Time
+	// Filter availability by slot duration in minutes.
+	Duration OptInt `json:",omitempty,omitzero"`
+}
+
+func unpackGetBookingAvailabilityParams(packed middleware.Parameters) (params GetBookingAvailabilityParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "slug",
+			In:   "path",
+		}
+		params.Slug = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "start",
+			In:   "query",
+		}
+		params.Start = packed[key].(time.Time)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "end",
+			In:   "query",
+		}
+		params.End = packed[key].(time.Time)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "duration",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Duration = v.(OptInt)
+		}
+	}
+	return params
+}
+
+func decodeGetBookingAvailabilityParams(args [1]string, argsEscaped bool, r *http.Request) (params GetBookingAvailabilityParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode path: slug.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "slug",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.Slug = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "slug",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Decode query: start.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "start",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToDateTime(val)
+				if err != nil {
+					return err
+				}
+
+				params.Start = c
+				return nil
+			}); err != nil {
+				return err
+			}
+		} else {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "start",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: end.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "end",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToDateTime(val)
+				if err != nil {
+					return err
+				}
+
+				params.End = c
+				return nil
+			}); err != nil {
+				return err
+			}
+		} else {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "end",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: duration.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "duration",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotDurationVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotDurationVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Duration.SetTo(paramsDotDurationVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Duration.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           5,
+							MaxSet:        true,
+							Max:           480,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "duration",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetBookingLinkParams is parameters of getBookingLink operation.
+type GetBookingLinkParams struct {
+	ID int
+}
+
+func unpackGetBookingLinkParams(packed middleware.Parameters) (params GetBookingLinkParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(int)
+	}
+	return params
+}
+
+func decodeGetBookingLinkParams(args [1]string, argsEscaped bool, r *http.Request) (params GetBookingLinkParams, _ error) {
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToInt(val)
+				if err != nil {
+					return err
+				}
+
+				params.ID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetBookingLinkBookingsParams is parameters of getBookingLinkBookings operation.
+type GetBookingLinkBookingsParams struct {
+	ID int
+}
+
+func unpackGetBookingLinkBookingsParams(packed middleware.Parameters) (params GetBookingLinkBookingsParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(int)
+	}
+	return params
+}
+
+func decodeGetBookingLinkBookingsParams(args [1]string, argsEscaped bool, r *http.Request) (params GetBookingLinkBookingsParams, _ error) {
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToInt(val)
+				if err != nil {
+					return err
+				}
+
+				params.ID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetOwnVoteParams is parameters of getOwnVote operation.
+type GetOwnVoteParams struct {
+	Slug  string
+	Token string
+}
+
+func unpackGetOwnVoteParams(packed middleware.Parameters) (params GetOwnVoteParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "slug",
+			In:   "path",
+		}
+		params.Slug = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "token",
+			In:   "path",
+		}
+		params.Token = packed[key].(string)
+	}
+	return params
+}
+
+func decodeGetOwnVoteParams(args [2]string, argsEscaped bool, r *http.Request) (params GetOwnVoteParams, _ error) {
+	// Decode path: slug.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "slug",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.Slug = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "slug",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Decode path: token.
+	if err := func() error {
+		param := args[1]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[1])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "token",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.Token = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "token",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetPollParams is parameters of getPoll operation.
+type GetPollParams struct {
+	ID int
+}
+
+func unpackGetPollParams(packed middleware.Parameters) (params GetPollParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(int)
+	}
+	return params
+}
+
+func decodeGetPollParams(args [1]string, argsEscaped bool, r *http.Request) (params GetPollParams, _ error) {
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToInt(val)
+				if err != nil {
+					return err
+				}
+
+				params.ID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetPollOptionsParams is parameters of getPollOptions operation.
+type GetPollOptionsParams struct {
+	ID int
+}
+
+func unpackGetPollOptionsParams(packed middleware.Parameters) (params GetPollOptionsParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(int)
+	}
+	return params
+}
+
+func decodeGetPollOptionsParams(args [1]string, argsEscaped bool, r *http.Request) (params GetPollOptionsParams, _ error) {
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToInt(val)
+				if err != nil {
+					return err
+				}
+
+				params.ID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetPollRankingParams is parameters of getPollRanking operation.
+type GetPollRankingParams struct {
+	ID int
+}
+
+func unpackGetPollRankingParams(packed middleware.Parameters) (params GetPollRankingParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(int)
+	}
+	return params
+}
+
+func decodeGetPollRankingParams(args [1]string, argsEscaped bool, r *http.Request) (params GetPollRankingParams, _ error) {
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToInt(val)
+				if err != nil {
+					return err
+				}
+
+				params.ID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetPollResultsParams is parameters of getPollResults operation.
+type GetPollResultsParams struct {
+	Slug string
+	// Edit token of the visitor's vote, required for results shown after voting.
+	Token OptString `json:",omitempty,omitzero"`
+}
+
+func unpackGetPollResultsParams(packed middleware.Parameters) (params GetPollResultsParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "slug",
+			In:   "path",
+		}
+		params.Slug = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "token",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Token = v.(OptString)
+		}
+	}
+	return params
+}
+
+func decodeGetPollResultsParams(args [1]string, argsEscaped bool, r *http.Request) (params GetPollResultsParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode path: slug.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "slug",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.Slug = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "slug",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Decode query: token.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "token",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotTokenVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotTokenVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Token.SetTo(paramsDotTokenVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "token",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetPollVotesParams is parameters of getPollVotes operation.
+type GetPollVotesParams struct {
+	ID int
+}
+
+func unpackGetPollVotesParams(packed middleware.Parameters) (params GetPollVotesParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(int)
+	}
+	return params
+}
+
+func decodeGetPollVotesParams(args [1]string, argsEscaped bool, r *http.Request) (params GetPollVotesParams, _ error) {
+	// Decode path: id.
 	if err := func() error {
-		param := args[1]
+		param := args[0]
 		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[1])
+			unescaped, err := url.PathUnescape(args[0])
 			if err != nil {
 				return errors.Wrap(err, "unescape path")
 			}
@@ -588,7 +2295,7 @@ func decodeDeletePollOptionParams(args [2]string, argsEscaped bool, r *http.Requ
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "optionId",
+				Param:   "id",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -605,7 +2312,7 @@ func decodeDeletePollOptionParams(args [2]string, argsEscaped bool, r *http.Requ
 					return err
 				}
 
-				params.OptionId = c
+				params.ID = c
 				return nil
 			}(); err != nil {
 				return err
@@ -616,7 +2323,7 @@ func decodeDeletePollOptionParams(args [2]string, argsEscaped bool, r *http.Requ
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "optionId",
+			Name: "id",
 			In:   "path",
 			Err:  err,
 		}
@@ -624,16 +2331,12 @@ func decodeDeletePollOptionParams(args [2]string, argsEscaped bool, r *http.Requ
 	return params, nil
 }
 
-// GetBookingAvailabilityParams is parameters of getBookingAvailability operation.
-type GetBookingAvailabilityParams struct {
-	Slug  string
-	Start time.Time
-	End   time.Time
-	// Filter availability by slot duration in minutes.
-	Duration OptInt `json:",omitempty,omitzero"`
+// GetPublicBookingLinkParams is parameters of getPublicBookingLink operation.
+type GetPublicBookingLinkParams struct {
+	Slug string
 }
 
-func unpackGetBookingAvailabilityParams(packed middleware.Parameters) (params GetBookingAvailabilityParams) {
+func unpackGetPublicBookingLinkParams(packed middleware.Parameters) (params GetPublicBookingLinkParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "slug",
@@ -641,33 +2344,86 @@ func unpackGetBookingAvailabilityParams(packed middleware.Parameters) (params Ge
 		}
 		params.Slug = packed[key].(string)
 	}
-	{
-		key := middleware.ParameterKey{
-			Name: "start",
-			In:   "query",
+	return params
+}
+
+func decodeGetPublicBookingLinkParams(args [1]string, argsEscaped bool, r *http.Request) (params GetPublicBookingLinkParams, _ error) {
+	// Decode path: slug.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "slug",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.Slug = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "slug",
+			In:   "path",
+			Err:  err,
 		}
-		params.Start = packed[key].(time.Time)
 	}
+	return params, nil
+}
+
+// GetPublicPollParams is parameters of getPublicPoll operation.
+type GetPublicPollParams struct {
+	Slug string
+	// Token of a personal invite link.
+	Invite OptString `json:",omitempty,omitzero"`
+}
+
+func unpackGetPublicPollParams(packed middleware.Parameters) (params GetPublicPollParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "end",
-			In:   "query",
+			Name: "slug",
+			In:   "path",
 		}
-		params.End = packed[key].(time.Time)
+		params.Slug = packed[key].(string)
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "duration",
+			Name: "invite",
 			In:   "query",
 		}
 		if v, ok := packed[key]; ok {
-			params.Duration = v.(OptInt)
+			params.Invite = v.(OptString)
 		}
 	}
 	return params
 }
 
-func decodeGetBookingAvailabilityParams(args [1]string, argsEscaped bool, r *http.Request) (params GetBookingAvailabilityParams, _ error) {
+func decodeGetPublicPollParams(args [1]string, argsEscaped bool, r *http.Request) (params GetPublicPollParams, _ error) {
 	q := uri.NewQueryDecoder(r.URL.Query())
 	// Decode path: slug.
 	if err := func() error {
@@ -714,153 +2470,186 @@ func decodeGetBookingAvailabilityParams(args [1]string, argsEscaped bool, r *htt
 			Err:  err,
 		}
 	}
-	// Decode query: start.
+	// Decode query: invite.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "start",
+			Name:    "invite",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				val, err := d.DecodeValue()
-				if err != nil {
-					return err
-				}
+				var paramsDotInviteVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
 
-				c, err := conv.ToDateTime(val)
-				if err != nil {
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotInviteVal = c
+					return nil
+				}(); err != nil {
 					return err
 				}
-
-				params.Start = c
+				params.Invite.SetTo(paramsDotInviteVal)
 				return nil
 			}); err != nil {
 				return err
 			}
-		} else {
-			return err
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "start",
+			Name: "invite",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	// Decode query: end.
+	return params, nil
+}
+
+// GetPublicPollCommentsParams is parameters of getPublicPollComments operation.
+type GetPublicPollCommentsParams struct {
+	Slug string
+}
+
+func unpackGetPublicPollCommentsParams(packed middleware.Parameters) (params GetPublicPollCommentsParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "slug",
+			In:   "path",
+		}
+		params.Slug = packed[key].(string)
+	}
+	return params
+}
+
+func decodeGetPublicPollCommentsParams(args [1]string, argsEscaped bool, r *http.Request) (params GetPublicPollCommentsParams, _ error) {
+	// Decode path: slug.
 	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "end",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
 		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "slug",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
 
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+			if err := func() error {
 				val, err := d.DecodeValue()
 				if err != nil {
 					return err
 				}
 
-				c, err := conv.ToDateTime(val)
+				c, err := conv.ToString(val)
 				if err != nil {
 					return err
 				}
 
-				params.End = c
+				params.Slug = c
 				return nil
-			}); err != nil {
+			}(); err != nil {
 				return err
 			}
 		} else {
-			return err
+			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "end",
-			In:   "query",
+			Name: "slug",
+			In:   "path",
 			Err:  err,
 		}
 	}
-	// Decode query: duration.
-	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "duration",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
+	return params, nil
+}
 
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotDurationVal int
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
+// ListPollCommentsParams is parameters of listPollComments operation.
+type ListPollCommentsParams struct {
+	ID int
+}
 
-					c, err := conv.ToInt(val)
-					if err != nil {
-						return err
-					}
+func unpackListPollCommentsParams(packed middleware.Parameters) (params ListPollCommentsParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
+			In:   "path",
+		}
+		params.ID = packed[key].(int)
+	}
+	return params
+}
 
-					paramsDotDurationVal = c
-					return nil
-				}(); err != nil {
-					return err
-				}
-				params.Duration.SetTo(paramsDotDurationVal)
-				return nil
-			}); err != nil {
-				return err
+func decodeListPollCommentsParams(args [1]string, argsEscaped bool, r *http.Request) (params ListPollCommentsParams, _ error) {
+	// Decode path: id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
 			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
 			if err := func() error {
-				if value, ok := params.Duration.Get(); ok {
-					if err := func() error {
-						if err := (validate.Int{
-							MinSet:        true,
-							Min:           5,
-							MaxSet:        true,
-							Max:           480,
-							MinExclusive:  false,
-							MaxExclusive:  false,
-							MultipleOfSet: false,
-							MultipleOf:    0,
-							Pattern:       nil,
-						}).Validate(int64(value)); err != nil {
-							return errors.Wrap(err, "int")
-						}
-						return nil
-					}(); err != nil {
-						return err
-					}
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToInt(val)
+				if err != nil {
+					return err
 				}
+
+				params.ID = c
 				return nil
 			}(); err != nil {
 				return err
 			}
+		} else {
+			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "duration",
-			In:   "query",
+			Name: "id",
+			In:   "path",
 			Err:  err,
 		}
 	}
 	return params, nil
 }
 
-// GetBookingLinkParams is parameters of getBookingLink operation.
-type GetBookingLinkParams struct {
+// ListPollInvitesParams is parameters of listPollInvites operation.
+type ListPollInvitesParams struct {
 	ID int
 }
 
-func unpackGetBookingLinkParams(packed middleware.Parameters) (params GetBookingLinkParams) {
+func unpackListPollInvitesParams(packed middleware.Parameters) (params ListPollInvitesParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "id",
@@ -871,7 +2660,7 @@ func unpackGetBookingLinkParams(packed middleware.Parameters) (params GetBooking
 	return params
 }
 
-func decodeGetBookingLinkParams(args [1]string, argsEscaped bool, r *http.Request) (params GetBookingLinkParams, _ error) {
+func decodeListPollInvitesParams(args [1]string, argsEscaped bool, r *http.Request) (params ListPollInvitesParams, _ error) {
 	// Decode path: id.
 	if err := func() error {
 		param := args[0]
@@ -920,12 +2709,12 @@ func decodeGetBookingLinkParams(args [1]string, argsEscaped bool, r *http.Reques
 	return params, nil
 }
 
-// GetBookingLinkBookingsParams is parameters of getBookingLinkBookings operation.
-type GetBookingLinkBookingsParams struct {
+// ListPollProposalsParams is parameters of listPollProposals operation.
+type ListPollProposalsParams struct {
 	ID int
 }
 
-func unpackGetBookingLinkBookingsParams(packed middleware.Parameters) (params GetBookingLinkBookingsParams) {
+func unpackListPollProposalsParams(packed middleware.Parameters) (params ListPollProposalsParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "id",
@@ -936,7 +2725,7 @@ func unpackGetBookingLinkBookingsParams(packed middleware.Parameters) (params Ge
 	return params
 }
 
-func decodeGetBookingLinkBookingsParams(args [1]string, argsEscaped bool, r *http.Request) (params GetBookingLinkBookingsParams, _ error) {
+func decodeListPollProposalsParams(args [1]string, argsEscaped bool, r *http.Request) (params ListPollProposalsParams, _ error) {
 	// Decode path: id.
 	if err := func() error {
 		param := args[0]
@@ -985,12 +2774,13 @@ func decodeGetBookingLinkBookingsParams(args [1]string, argsEscaped bool, r *htt
 	return params, nil
 }
 
-// GetPollParams is parameters of getPoll operation.
-type GetPollParams struct {
-	ID int
+// ModeratePollCommentParams is parameters of moderatePollComment operation.
+type ModeratePollCommentParams struct {
+	ID        int
+	CommentId int
 }
 
-func unpackGetPollParams(packed middleware.Parameters) (params GetPollParams) {
+func unpackModeratePollCommentParams(packed middleware.Parameters) (params ModeratePollCommentParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "id",
@@ -998,10 +2788,17 @@ func unpackGetPollParams(packed middleware.Parameters) (params GetPollParams) {
 		}
 		params.ID = packed[key].(int)
 	}
+	{
+		key := middleware.ParameterKey{
+			Name: "commentId",
+			In:   "path",
+		}
+		params.CommentId = packed[key].(int)
+	}
 	return params
 }
 
-func decodeGetPollParams(args [1]string, argsEscaped bool, r *http.Request) (params GetPollParams, _ error) {
+func decodeModeratePollCommentParams(args [2]string, argsEscaped bool, r *http.Request) (params ModeratePollCommentParams, _ error) {
 	// Decode path: id.
 	if err := func() error {
 		param := args[0]
@@ -1047,15 +2844,60 @@ func decodeGetPollParams(args [1]string, argsEscaped bool, r *http.Request) (par
 			Err:  err,
 		}
 	}
+	// Decode path: commentId.
+	if err := func() error {
+		param := args[1]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[1])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "commentId",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToInt(val)
+				if err != nil {
+					return err
+				}
+
+				params.CommentId = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "commentId",
+			In:   "path",
+			Err:  err,
+		}
+	}
 	return params, nil
 }
 
-// GetPollOptionsParams is parameters of getPollOptions operation.
-type GetPollOptionsParams struct {
+// PickPollWinnerParams is parameters of pickPollWinner operation.
+type PickPollWinnerParams struct {
 	ID int
 }
 
-func unpackGetPollOptionsParams(packed middleware.Parameters) (params GetPollOptionsParams) {
+func unpackPickPollWinnerParams(packed middleware.Parameters) (params PickPollWinnerParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "id",
@@ -1066,7 +2908,7 @@ func unpackGetPollOptionsParams(packed middleware.Parameters) (params GetPollOpt
 	return params
 }
 
-func decodeGetPollOptionsParams(args [1]string, argsEscaped bool, r *http.Request) (params GetPollOptionsParams, _ error) {
+func decodePickPollWinnerParams(args [1]string, argsEscaped bool, r *http.Request) (params PickPollWinnerParams, _ error) {
 	// Decode path: id.
 	if err := func() error {
 		param := args[0]
@@ -1115,12 +2957,12 @@ func decodeGetPollOptionsParams(args [1]string, argsEscaped bool, r *http.Reques
 	return params, nil
 }
 
-// GetPollResultsParams is parameters of getPollResults operation.
-type GetPollResultsParams struct {
+// ProposePollOptionParams is parameters of proposePollOption operation.
+type ProposePollOptionParams struct {
 	Slug string
 }
 
-func unpackGetPollResultsParams(packed middleware.Parameters) (params GetPollResultsParams) {
+func unpackProposePollOptionParams(packed middleware.Parameters) (params ProposePollOptionParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "slug",
@@ -1131,7 +2973,7 @@ func unpackGetPollResultsParams(packed middleware.Parameters) (params GetPollRes
 	return params
 }
 
-func decodeGetPollResultsParams(args [1]string, argsEscaped bool, r *http.Request) (params GetPollResultsParams, _ error) {
+func decodeProposePollOptionParams(args [1]string, argsEscaped bool, r *http.Request) (params ProposePollOptionParams, _ error) {
 	// Decode path: slug.
 	if err := func() error {
 		param := args[0]
@@ -1180,12 +3022,12 @@ func decodeGetPollResultsParams(args [1]string, argsEscaped bool, r *http.Reques
 	return params, nil
 }
 
-// GetPollVotesParams is parameters of getPollVotes operation.
-type GetPollVotesParams struct {
+// RemindPollInvitesParams is parameters of remindPollInvites operation.
+type RemindPollInvitesParams struct {
 	ID int
 }
 
-func unpackGetPollVotesParams(packed middleware.Parameters) (params GetPollVotesParams) {
+func unpackRemindPollInvitesParams(packed middleware.Parameters) (params RemindPollInvitesParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "id",
@@ -1196,7 +3038,7 @@ func unpackGetPollVotesParams(packed middleware.Parameters) (params GetPollVotes
 	return params
 }
 
-func decodeGetPollVotesParams(args [1]string, argsEscaped bool, r *http.Request) (params GetPollVotesParams, _ error) {
+func decodeRemindPollInvitesParams(args [1]string, argsEscaped bool, r *http.Request) (params RemindPollInvitesParams, _ error) {
 	// Decode path: id.
 	if err := func() error {
 		param := args[0]
@@ -1245,24 +3087,24 @@ func decodeGetPollVotesParams(args [1]string, argsEscaped bool, r *http.Request)
 	return params, nil
 }
 
-// GetPublicBookingLinkParams is parameters of getPublicBookingLink operation.
-type GetPublicBookingLinkParams struct {
-	Slug string
+// RemoveCalendarParams is parameters of removeCalendar operation.
+type RemoveCalendarParams struct {
+	ID int
 }
 
-func unpackGetPublicBookingLinkParams(packed middleware.Parameters) (params GetPublicBookingLinkParams) {
+func unpackRemoveCalendarParams(packed middleware.Parameters) (params RemoveCalendarParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "slug",
+			Name: "id",
 			In:   "path",
 		}
-		params.Slug = packed[key].(string)
+		params.ID = packed[key].(int)
 	}
 	return params
 }
 
-func decodeGetPublicBookingLinkParams(args [1]string, argsEscaped bool, r *http.Request) (params GetPublicBookingLinkParams, _ error) {
-	// Decode path: slug.
+func decodeRemoveCalendarParams(args [1]string, argsEscaped bool, r *http.Request) (params RemoveCalendarParams, _ error) {
+	// Decode path: id.
 	if err := func() error {
 		param := args[0]
 		if argsEscaped {
@@ -1274,7 +3116,7 @@ func decodeGetPublicBookingLinkParams(args [1]string, argsEscaped bool, r *http.
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "slug",
+				Param:   "id",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -1286,12 +3128,12 @@ func decodeGetPublicBookingLinkParams(args [1]string, argsEscaped bool, r *http.
 					return err
 				}
 
-				c, err := conv.ToString(val)
+				c, err := conv.ToInt(val)
 				if err != nil {
 					return err
 				}
 
-				params.Slug = c
+				params.ID = c
 				return nil
 			}(); err != nil {
 				return err
@@ -1302,7 +3144,7 @@ func decodeGetPublicBookingLinkParams(args [1]string, argsEscaped bool, r *http.
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "slug",
+			Name: "id",
 			In:   "path",
 			Err:  err,
 		}
@@ -1310,12 +3152,12 @@ func decodeGetPublicBookingLinkParams(args [1]string, argsEscaped bool, r *http.
 	return params, nil
 }
 
-// GetPublicPollParams is parameters of getPublicPoll operation.
-type GetPublicPollParams struct {
+// SubmitVoteParams is parameters of submitVote operation.
+type SubmitVoteParams struct {
 	Slug string
 }
 
-func unpackGetPublicPollParams(packed middleware.Parameters) (params GetPublicPollParams) {
+func unpackSubmitVoteParams(packed middleware.Parameters) (params SubmitVoteParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "slug",
@@ -1326,7 +3168,7 @@ func unpackGetPublicPollParams(packed middleware.Parameters) (params GetPublicPo
 	return params
 }
 
-func decodeGetPublicPollParams(args [1]string, argsEscaped bool, r *http.Request) (params GetPublicPollParams, _ error) {
+func decodeSubmitVoteParams(args [1]string, argsEscaped bool, r *http.Request) (params SubmitVoteParams, _ error) {
 	// Decode path: slug.
 	if err := func() error {
 		param := args[0]
@@ -1375,12 +3217,12 @@ func decodeGetPublicPollParams(args [1]string, argsEscaped bool, r *http.Request
 	return params, nil
 }
 
-// PickPollWinnerParams is parameters of pickPollWinner operation.
-type PickPollWinnerParams struct {
+// SuggestPollOptionsParams is parameters of suggestPollOptions operation.
+type SuggestPollOptionsParams struct {
 	ID int
 }
 
-func unpackPickPollWinnerParams(packed middleware.Parameters) (params PickPollWinnerParams) {
+func unpackSuggestPollOptionsParams(packed middleware.Parameters) (params SuggestPollOptionsParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "id",
@@ -1391,7 +3233,7 @@ func unpackPickPollWinnerParams(packed middleware.Parameters) (params PickPollWi
 	return params
 }
 
-func decodePickPollWinnerParams(args [1]string, argsEscaped bool, r *http.Request) (params PickPollWinnerParams, _ error) {
+func decodeSuggestPollOptionsParams(args [1]string, argsEscaped bool, r *http.Request) (params SuggestPollOptionsParams, _ error) {
 	// Decode path: id.
 	if err := func() error {
 		param := args[0]
@@ -1440,12 +3282,12 @@ func decodePickPollWinnerParams(args [1]string, argsEscaped bool, r *http.Reques
 	return params, nil
 }
 
-// RemoveCalendarParams is parameters of removeCalendar operation.
-type RemoveCalendarParams struct {
+// TestCalendarParams is parameters of testCalendar operation.
+type TestCalendarParams struct {
 	ID int
 }
 
-func unpackRemoveCalendarParams(packed middleware.Parameters) (params RemoveCalendarParams) {
+func unpackTestCalendarParams(packed middleware.Parameters) (params TestCalendarParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "id",
@@ -1456,7 +3298,7 @@ func unpackRemoveCalendarParams(packed middleware.Parameters) (params RemoveCale
 	return params
 }
 
-func decodeRemoveCalendarParams(args [1]string, argsEscaped bool, r *http.Request) (params RemoveCalendarParams, _ error) {
+func decodeTestCalendarParams(args [1]string, argsEscaped bool, r *http.Request) (params TestCalendarParams, _ error) {
 	// Decode path: id.
 	if err := func() error {
 		param := args[0]
@@ -1505,24 +3347,24 @@ func decodeRemoveCalendarParams(args [1]string, argsEscaped bool, r *http.Reques
 	return params, nil
 }
 
-// SubmitVoteParams is parameters of submitVote operation.
-type SubmitVoteParams struct {
-	Slug string
+// UpdateBookingLinkParams is parameters of updateBookingLink operation.
+type UpdateBookingLinkParams struct {
+	ID int
 }
 
-func unpackSubmitVoteParams(packed middleware.Parameters) (params SubmitVoteParams) {
+func unpackUpdateBookingLinkParams(packed middleware.Parameters) (params UpdateBookingLinkParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "slug",
+			Name: "id",
 			In:   "path",
 		}
-		params.Slug = packed[key].(string)
+		params.ID = packed[key].(int)
 	}
 	return params
 }
 
-func decodeSubmitVoteParams(args [1]string, argsEscaped bool, r *http.Request) (params SubmitVoteParams, _ error) {
-	// Decode path: slug.
+func decodeUpdateBookingLinkParams(args [1]string, argsEscaped bool, r *http.Request) (params UpdateBookingLinkParams, _ error) {
+	// Decode path: id.
 	if err := func() error {
 		param := args[0]
 		if argsEscaped {
@@ -1534,7 +3376,7 @@ func decodeSubmitVoteParams(args [1]string, argsEscaped bool, r *http.Request) (
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "slug",
+				Param:   "id",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -1546,12 +3388,12 @@ func decodeSubmitVoteParams(args [1]string, argsEscaped bool, r *http.Request) (
 					return err
 				}
 
-				c, err := conv.ToString(val)
+				c, err := conv.ToInt(val)
 				if err != nil {
 					return err
 				}
 
-				params.Slug = c
+				params.ID = c
 				return nil
 			}(); err != nil {
 				return err
@@ -1562,7 +3404,7 @@ func decodeSubmitVoteParams(args [1]string, argsEscaped bool, r *http.Request) (
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "slug",
+			Name: "id",
 			In:   "path",
 			Err:  err,
 		}
@@ -1570,24 +3412,32 @@ func decodeSubmitVoteParams(args [1]string, argsEscaped bool, r *http.Request) (
 	return params, nil
 }
 
-// TestCalendarParams is parameters of testCalendar operation.
-type TestCalendarParams struct {
-	ID int
+// UpdateOwnVoteParams is parameters of updateOwnVote operation.
+type UpdateOwnVoteParams struct {
+	Slug  string
+	Token string
 }
 
-func unpackTestCalendarParams(packed middleware.Parameters) (params TestCalendarParams) {
+func unpackUpdateOwnVoteParams(packed middleware.Parameters) (params UpdateOwnVoteParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "id",
+			Name: "slug",
 			In:   "path",
 		}
-		params.ID = packed[key].(int)
+		params.Slug = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "token",
+			In:   "path",
+		}
+		params.Token = packed[key].(string)
 	}
 	return params
 }
 
-func decodeTestCalendarParams(args [1]string, argsEscaped bool, r *http.Request) (params TestCalendarParams, _ error) {
-	// Decode path: id.
+func decodeUpdateOwnVoteParams(args [2]string, argsEscaped bool, r *http.Request) (params UpdateOwnVoteParams, _ error) {
+	// Decode path: slug.
 	if err := func() error {
 		param := args[0]
 		if argsEscaped {
@@ -1599,7 +3449,7 @@ func decodeTestCalendarParams(args [1]string, argsEscaped bool, r *http.Request)
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "id",
+				Param:   "slug",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -1611,12 +3461,12 @@ func decodeTestCalendarParams(args [1]string, argsEscaped bool, r *http.Request)
 					return err
 				}
 
-				c, err := conv.ToInt(val)
+				c, err := conv.ToString(val)
 				if err != nil {
 					return err
 				}
 
-				params.ID = c
+				params.Slug = c
 				return nil
 			}(); err != nil {
 				return err
@@ -1627,36 +3477,16 @@ func decodeTestCalendarParams(args [1]string, argsEscaped bool, r *http.Request)
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "id",
+			Name: "slug",
 			In:   "path",
 			Err:  err,
 		}
 	}
-	return params, nil
-}
-
-// UpdateBookingLinkParams is parameters of updateBookingLink operation.
-type UpdateBookingLinkParams struct {
-	ID int
-}
-
-func unpackUpdateBookingLinkParams(packed middleware.Parameters) (params UpdateBookingLinkParams) {
-	{
-		key := middleware.ParameterKey{
-			Name: "id",
-			In:   "path",
-		}
-		params.ID = packed[key].(int)
-	}
-	return params
-}
-
-func decodeUpdateBookingLinkParams(args [1]string, argsEscaped bool, r *http.Request) (params UpdateBookingLinkParams, _ error) {
-	// Decode path: id.
+	// Decode path: token.
 	if err := func() error {
-		param := args[0]
+		param := args[1]
 		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[0])
+			unescaped, err := url.PathUnescape(args[1])
 			if err != nil {
 				return errors.Wrap(err, "unescape path")
 			}
@@ -1664,7 +3494,7 @@ func decodeUpdateBookingLinkParams(args [1]string, argsEscaped bool, r *http.Req
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "id",
+				Param:   "token",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -1676,12 +3506,12 @@ func decodeUpdateBookingLinkParams(args [1]string, argsEscaped bool, r *http.Req
 					return err
 				}
 
-				c, err := conv.ToInt(val)
+				c, err := conv.ToString(val)
 				if err != nil {
 					return err
 				}
 
-				params.ID = c
+				params.Token = c
 				return nil
 			}(); err != nil {
 				return err
@@ -1692,7 +3522,7 @@ func decodeUpdateBookingLinkParams(args [1]string, argsEscaped bool, r *http.Req
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "id",
+			Name: "token",
 			In:   "path",
 			Err:  err,
 		}
@@ -1764,3 +3594,60 @@ func decodeUpdatePollParams(args [1]string, argsEscaped bool, r *http.Request) (
 	}
 	return params, nil
 }
+
+// VerifyBookingEmailParams is parameters of verifyBookingEmail operation.
+type VerifyBookingEmailParams struct {
+	Token string
+}
+
+func unpackVerifyBookingEmailParams(packed middleware.Parameters) (params VerifyBookingEmailParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "token",
+			In:   "query",
+		}
+		params.Token = packed[key].(string)
+	}
+	return params
+}
+
+func decodeVerifyBookingEmailParams(args [0]string, argsEscaped bool, r *http.Request) (params VerifyBookingEmailParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode query: token.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "token",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.Token = c
+				return nil
+			}); err != nil {
+				return err
+			}
+		} else {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "token",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
@@ -24,6 +24,34 @@ func encodeAddCalendarRequest(
 	return nil
 }
 
+func encodeAddPollCommentRequest(
+	req *AddPollCommentReq,
+	r *http.Request,
+) error {
+	const contentType = "application/json"
+	e := new(jx.Encoder)
+	{
+		req.Encode(e)
+	}
+	encoded := e.Bytes()
+	ht.SetBody(r, bytes.NewReader(encoded), contentType)
+	return nil
+}
+
+func encodeAddPollInvitesRequest(
+	req *AddPollInvitesReq,
+	r *http.Request,
+) error {
+	const contentType = "application/json"
+	e := new(jx.Encoder)
+	{
+		req.Encode(e)
+	}
+	encoded := e.Bytes()
+	ht.SetBody(r, bytes.NewReader(encoded), contentType)
+	return nil
+}
+
 func encodeAddPollOptionRequest(
 	req *AddPollOptionReq,
 	r *http.Request,
@@ -38,6 +66,20 @@ func encodeAddPollOptionRequest(
 	return nil
 }
 
+func encodeAutofillVoteRequest(
+	req *AutofillVoteReq,
+	r *http.Request,
+) error {
+	const contentType = "application/json"
+	e := new(jx.Encoder)
+	{
+		req.Encode(e)
+	}
+	encoded := e.Bytes()
+	ht.SetBody(r, bytes.NewReader(encoded), contentType)
+	return nil
+}
+
 func encodeCreateBookingRequest(
 	req *CreateBookingReq,
 	r *http.Request,
@@ -94,6 +136,34 @@ func encodeDiscoverCalendarsRequest(
 	return nil
 }
 
+func encodeGeneratePollOptionsRequest(
+	req *GeneratePollOptionsReq,
+	r *http.Request,
+) error {
+	const contentType = "application/json"
+	e := new(jx.Encoder)
+	{
+		req.Encode(e)
+	}
+	encoded := e.Bytes()
+	ht.SetBody(r, bytes.NewReader(encoded), contentType)
+	return nil
+}
+
+func encodeModeratePollCommentRequest(
+	req *ModeratePollCommentReq,
+	r *http.Request,
+) error {
+	const contentType = "application/json"
+	e := new(jx.Encoder)
+	{
+		req.Encode(e)
+	}
+	encoded := e.Bytes()
+	ht.SetBody(r, bytes.NewReader(encoded), contentType)
+	return nil
+}
+
 func encodePickPollWinnerRequest(
 	req *PickPollWinnerReq,
 	r *http.Request,
@@ -108,6 +178,20 @@ func encodePickPollWinnerRequest(
 	return nil
 }
 
+func encodeProposePollOptionRequest(
+	req *ProposePollOptionReq,
+	r *http.Request,
+) error {
+	const contentType = "application/json"
+	e := new(jx.Encoder)
+	{
+		req.Encode(e)
+	}
+	encoded := e.Bytes()
+	ht.SetBody(r, bytes.NewReader(encoded), contentType)
+	return nil
+}
+
 func encodeSubmitVoteRequest(
 	req *SubmitVoteReq,
 	r *http.Request,
@@ -122,6 +206,20 @@ func encodeSubmitVoteRequest(
 	return nil
 }
 
+func encodeSuggestPollOptionsRequest(
+	req *SuggestPollOptionsReq,
+	r *http.Request,
+) error {
+	const contentType = "application/json"
+	e := new(jx.Encoder)
+	{
+		req.Encode(e)
+	}
+	encoded := e.Bytes()
+	ht.SetBody(r, bytes.NewReader(encoded), contentType)
+	return nil
+}
+
 func encodeUpdateBookingLinkRequest(
 	req *UpdateBookingLinkReq,
 	r *http.Request,
@@ -150,6 +248,20 @@ func encodeUpdateCurrentUserRequest(
 	return nil
 }
 
+func encodeUpdateOwnVoteRequest(
+	req *UpdateOwnVoteReq,
+	r *http.Request,
+) error {
+	const contentType = "application/json"
+	e := new(jx.Encoder)
+	{
+		req.Encode(e)
+	}
+	encoded := e.Bytes()
+	ht.SetBody(r, bytes.NewReader(encoded), contentType)
+	return nil
+}
+
 func encodeUpdatePollRequest(
 	req *UpdatePollReq,
 	r *http.Request,
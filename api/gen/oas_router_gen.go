@@ -125,6 +125,26 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 							return
 						}
 
+					case 'v': // Prefix: "verify-email"
+
+						if l := len("verify-email"); len(elem) >= l && elem[0:l] == "verify-email" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch r.Method {
+							case "GET":
+								s.handleVerifyBookingEmailRequest([0]string{}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "GET")
+							}
+
+							return
+						}
+
 					}
 
 				case 'u': // Prefix: "uth/"
@@ -522,6 +542,26 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 				}
 
+			case 'm': // Prefix: "meeting-providers"
+
+				if l := len("meeting-providers"); len(elem) >= l && elem[0:l] == "meeting-providers" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					// Leaf node.
+					switch r.Method {
+					case "GET":
+						s.handleListMeetingProvidersRequest([0]string{}, elemIsEscaped, w, r)
+					default:
+						s.notAllowed(w, r, "GET")
+					}
+
+					return
+				}
+
 			case 'p': // Prefix: "p"
 
 				if l := len("p"); len(elem) >= l && elem[0:l] == "p" {
@@ -678,6 +718,76 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 								break
 							}
 							switch elem[0] {
+							case 'a': // Prefix: "autofill"
+
+								if l := len("autofill"); len(elem) >= l && elem[0:l] == "autofill" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "POST":
+										s.handleAutofillVoteRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "POST")
+									}
+
+									return
+								}
+
+							case 'c': // Prefix: "comments"
+
+								if l := len("comments"); len(elem) >= l && elem[0:l] == "comments" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "GET":
+										s.handleGetPublicPollCommentsRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									case "POST":
+										s.handleAddPollCommentRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "GET,POST")
+									}
+
+									return
+								}
+
+							case 'p': // Prefix: "proposals"
+
+								if l := len("proposals"); len(elem) >= l && elem[0:l] == "proposals" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "POST":
+										s.handleProposePollOptionRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "POST")
+									}
+
+									return
+								}
+
 							case 'r': // Prefix: "results"
 
 								if l := len("results"); len(elem) >= l && elem[0:l] == "results" {
@@ -709,7 +819,6 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 								}
 
 								if len(elem) == 0 {
-									// Leaf node.
 									switch r.Method {
 									case "POST":
 										s.handleSubmitVoteRequest([1]string{
@@ -721,6 +830,50 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 									return
 								}
+								switch elem[0] {
+								case '/': // Prefix: "/"
+
+									if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									// Param: "token"
+									// Leaf parameter, slashes are prohibited
+									idx := strings.IndexByte(elem, '/')
+									if idx >= 0 {
+										break
+									}
+									args[1] = elem
+									elem = ""
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch r.Method {
+										case "DELETE":
+											s.handleDeleteOwnVoteRequest([2]string{
+												args[0],
+												args[1],
+											}, elemIsEscaped, w, r)
+										case "GET":
+											s.handleGetOwnVoteRequest([2]string{
+												args[0],
+												args[1],
+											}, elemIsEscaped, w, r)
+										case "PUT":
+											s.handleUpdateOwnVoteRequest([2]string{
+												args[0],
+												args[1],
+											}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "DELETE,GET,PUT")
+										}
+
+										return
+									}
+
+								}
 
 							}
 
@@ -799,9 +952,9 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 								break
 							}
 							switch elem[0] {
-							case 'o': // Prefix: "options"
+							case 'c': // Prefix: "comments"
 
-								if l := len("options"); len(elem) >= l && elem[0:l] == "options" {
+								if l := len("comments"); len(elem) >= l && elem[0:l] == "comments" {
 									elem = elem[l:]
 								} else {
 									break
@@ -810,15 +963,11 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 								if len(elem) == 0 {
 									switch r.Method {
 									case "GET":
-										s.handleGetPollOptionsRequest([1]string{
-											args[0],
-										}, elemIsEscaped, w, r)
-									case "POST":
-										s.handleAddPollOptionRequest([1]string{
+										s.handleListPollCommentsRequest([1]string{
 											args[0],
 										}, elemIsEscaped, w, r)
 									default:
-										s.notAllowed(w, r, "GET,POST")
+										s.notAllowed(w, r, "GET")
 									}
 
 									return
@@ -832,7 +981,7 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 										break
 									}
 
-									// Param: "optionId"
+									// Param: "commentId"
 									// Leaf parameter, slashes are prohibited
 									idx := strings.IndexByte(elem, '/')
 									if idx >= 0 {
@@ -845,12 +994,17 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 										// Leaf node.
 										switch r.Method {
 										case "DELETE":
-											s.handleDeletePollOptionRequest([2]string{
+											s.handleDeletePollCommentRequest([2]string{
+												args[0],
+												args[1],
+											}, elemIsEscaped, w, r)
+										case "PUT":
+											s.handleModeratePollCommentRequest([2]string{
 												args[0],
 												args[1],
 											}, elemIsEscaped, w, r)
 										default:
-											s.notAllowed(w, r, "DELETE")
+											s.notAllowed(w, r, "DELETE,PUT")
 										}
 
 										return
@@ -858,82 +1012,394 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 								}
 
-							case 'p': // Prefix: "pick-winner"
+							case 'i': // Prefix: "invites"
 
-								if l := len("pick-winner"); len(elem) >= l && elem[0:l] == "pick-winner" {
+								if l := len("invites"); len(elem) >= l && elem[0:l] == "invites" {
 									elem = elem[l:]
 								} else {
 									break
 								}
 
 								if len(elem) == 0 {
-									// Leaf node.
 									switch r.Method {
+									case "GET":
+										s.handleListPollInvitesRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
 									case "POST":
-										s.handlePickPollWinnerRequest([1]string{
+										s.handleAddPollInvitesRequest([1]string{
 											args[0],
 										}, elemIsEscaped, w, r)
 									default:
-										s.notAllowed(w, r, "POST")
+										s.notAllowed(w, r, "GET,POST")
 									}
 
 									return
 								}
+								switch elem[0] {
+								case '/': // Prefix: "/"
 
-							case 'v': // Prefix: "votes"
+									if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+										elem = elem[l:]
+									} else {
+										break
+									}
 
-								if l := len("votes"); len(elem) >= l && elem[0:l] == "votes" {
+									if len(elem) == 0 {
+										break
+									}
+									switch elem[0] {
+									case 'r': // Prefix: "remind"
+										origElem := elem
+										if l := len("remind"); len(elem) >= l && elem[0:l] == "remind" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											// Leaf node.
+											switch r.Method {
+											case "POST":
+												s.handleRemindPollInvitesRequest([1]string{
+													args[0],
+												}, elemIsEscaped, w, r)
+											default:
+												s.notAllowed(w, r, "POST")
+											}
+
+											return
+										}
+
+										elem = origElem
+									}
+									// Param: "inviteId"
+									// Leaf parameter, slashes are prohibited
+									idx := strings.IndexByte(elem, '/')
+									if idx >= 0 {
+										break
+									}
+									args[1] = elem
+									elem = ""
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch r.Method {
+										case "DELETE":
+											s.handleDeletePollInviteRequest([2]string{
+												args[0],
+												args[1],
+											}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "DELETE")
+										}
+
+										return
+									}
+
+								}
+
+							case 'o': // Prefix: "options"
+
+								if l := len("options"); len(elem) >= l && elem[0:l] == "options" {
 									elem = elem[l:]
 								} else {
 									break
 								}
 
 								if len(elem) == 0 {
-									// Leaf node.
 									switch r.Method {
 									case "GET":
-										s.handleGetPollVotesRequest([1]string{
+										s.handleGetPollOptionsRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									case "POST":
+										s.handleAddPollOptionRequest([1]string{
 											args[0],
 										}, elemIsEscaped, w, r)
 									default:
-										s.notAllowed(w, r, "GET")
+										s.notAllowed(w, r, "GET,POST")
 									}
 
 									return
 								}
+								switch elem[0] {
+								case '/': // Prefix: "/"
 
-							}
+									if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+										elem = elem[l:]
+									} else {
+										break
+									}
 
-						}
+									if len(elem) == 0 {
+										break
+									}
+									switch elem[0] {
+									case 'g': // Prefix: "generate"
+										origElem := elem
+										if l := len("generate"); len(elem) >= l && elem[0:l] == "generate" {
+											elem = elem[l:]
+										} else {
+											break
+										}
 
-					}
+										if len(elem) == 0 {
+											// Leaf node.
+											switch r.Method {
+											case "POST":
+												s.handleGeneratePollOptionsRequest([1]string{
+													args[0],
+												}, elemIsEscaped, w, r)
+											default:
+												s.notAllowed(w, r, "POST")
+											}
 
-				}
+											return
+										}
 
-			}
+										elem = origElem
+									case 's': // Prefix: "suggest"
+										origElem := elem
+										if l := len("suggest"); len(elem) >= l && elem[0:l] == "suggest" {
+											elem = elem[l:]
+										} else {
+											break
+										}
 
-		}
-	}
-	s.notFound(w, r)
-}
+										if len(elem) == 0 {
+											// Leaf node.
+											switch r.Method {
+											case "POST":
+												s.handleSuggestPollOptionsRequest([1]string{
+													args[0],
+												}, elemIsEscaped, w, r)
+											default:
+												s.notAllowed(w, r, "POST")
+											}
 
-// Route is route object.
-type Route struct {
-	name           string
-	summary        string
-	operationID    string
-	operationGroup string
-	pathPattern    string
-	count          int
-	args           [2]string
-}
+											return
+										}
 
-// Name returns ogen operation name.
-//
-// It is guaranteed to be unique and not empty.
-func (r Route) Name() string {
-	return r.name
-}
+										elem = origElem
+									}
+									// Param: "optionId"
+									// Leaf parameter, slashes are prohibited
+									idx := strings.IndexByte(elem, '/')
+									if idx >= 0 {
+										break
+									}
+									args[1] = elem
+									elem = ""
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch r.Method {
+										case "DELETE":
+											s.handleDeletePollOptionRequest([2]string{
+												args[0],
+												args[1],
+											}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "DELETE")
+										}
+
+										return
+									}
+
+								}
+
+							case 'p': // Prefix: "p"
+
+								if l := len("p"); len(elem) >= l && elem[0:l] == "p" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									break
+								}
+								switch elem[0] {
+								case 'i': // Prefix: "ick-winner"
+
+									if l := len("ick-winner"); len(elem) >= l && elem[0:l] == "ick-winner" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch r.Method {
+										case "POST":
+											s.handlePickPollWinnerRequest([1]string{
+												args[0],
+											}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "POST")
+										}
+
+										return
+									}
+
+								case 'r': // Prefix: "roposals"
+
+									if l := len("roposals"); len(elem) >= l && elem[0:l] == "roposals" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										switch r.Method {
+										case "GET":
+											s.handleListPollProposalsRequest([1]string{
+												args[0],
+											}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "GET")
+										}
+
+										return
+									}
+									switch elem[0] {
+									case '/': // Prefix: "/"
+
+										if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										// Param: "proposalId"
+										// Match until "/"
+										idx := strings.IndexByte(elem, '/')
+										if idx < 0 {
+											idx = len(elem)
+										}
+										args[1] = elem[:idx]
+										elem = elem[idx:]
+
+										if len(elem) == 0 {
+											switch r.Method {
+											case "DELETE":
+												s.handleDeclinePollProposalRequest([2]string{
+													args[0],
+													args[1],
+												}, elemIsEscaped, w, r)
+											default:
+												s.notAllowed(w, r, "DELETE")
+											}
+
+											return
+										}
+										switch elem[0] {
+										case '/': // Prefix: "/approve"
+
+											if l := len("/approve"); len(elem) >= l && elem[0:l] == "/approve" {
+												elem = elem[l:]
+											} else {
+												break
+											}
+
+											if len(elem) == 0 {
+												// Leaf node.
+												switch r.Method {
+												case "POST":
+													s.handleApprovePollProposalRequest([2]string{
+														args[0],
+														args[1],
+													}, elemIsEscaped, w, r)
+												default:
+													s.notAllowed(w, r, "POST")
+												}
+
+												return
+											}
+
+										}
+
+									}
+
+								}
+
+							case 'r': // Prefix: "results"
+
+								if l := len("results"); len(elem) >= l && elem[0:l] == "results" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "GET":
+										s.handleGetPollRankingRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "GET")
+									}
+
+									return
+								}
+
+							case 'v': // Prefix: "votes"
+
+								if l := len("votes"); len(elem) >= l && elem[0:l] == "votes" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "GET":
+										s.handleGetPollVotesRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "GET")
+									}
+
+									return
+								}
+
+							}
+
+						}
+
+					}
+
+				}
+
+			}
+
+		}
+	}
+	s.notFound(w, r)
+}
+
+// Route is route object.
+type Route struct {
+	name           string
+	summary        string
+	operationID    string
+	operationGroup string
+	pathPattern    string
+	count          int
+	args           [2]string
+}
+
+// Name returns ogen operation name.
+//
+// It is guaranteed to be unique and not empty.
+func (r Route) Name() string {
+	return r.name
+}
 
 // Summary returns OpenAPI summary.
 func (r Route) Summary() string {
@@ -1084,6 +1550,31 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 							}
 						}
 
+					case 'v': // Prefix: "verify-email"
+
+						if l := len("verify-email"); len(elem) >= l && elem[0:l] == "verify-email" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch method {
+							case "GET":
+								r.name = VerifyBookingEmailOperation
+								r.summary = "Confirm a guest's email address via email link"
+								r.operationID = "verifyBookingEmail"
+								r.operationGroup = ""
+								r.pathPattern = "/actions/verify-email"
+								r.args = args
+								r.count = 0
+								return r, true
+							default:
+								return
+							}
+						}
+
 					}
 
 				case 'u': // Prefix: "uth/"
@@ -1565,6 +2056,31 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 
 				}
 
+			case 'm': // Prefix: "meeting-providers"
+
+				if l := len("meeting-providers"); len(elem) >= l && elem[0:l] == "meeting-providers" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					// Leaf node.
+					switch method {
+					case "GET":
+						r.name = ListMeetingProvidersOperation
+						r.summary = "List enabled meeting link providers"
+						r.operationID = "listMeetingProviders"
+						r.operationGroup = ""
+						r.pathPattern = "/meeting-providers"
+						r.args = args
+						r.count = 0
+						return r, true
+					default:
+						return
+					}
+				}
+
 			case 'p': // Prefix: "p"
 
 				if l := len("p"); len(elem) >= l && elem[0:l] == "p" {
@@ -1733,6 +2249,90 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 								break
 							}
 							switch elem[0] {
+							case 'a': // Prefix: "autofill"
+
+								if l := len("autofill"); len(elem) >= l && elem[0:l] == "autofill" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch method {
+									case "POST":
+										r.name = AutofillVoteOperation
+										r.summary = "Suggest answers from the voter's own calendar"
+										r.operationID = "autofillVote"
+										r.operationGroup = ""
+										r.pathPattern = "/p/poll/{slug}/autofill"
+										r.args = args
+										r.count = 1
+										return r, true
+									default:
+										return
+									}
+								}
+
+							case 'c': // Prefix: "comments"
+
+								if l := len("comments"); len(elem) >= l && elem[0:l] == "comments" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch method {
+									case "GET":
+										r.name = GetPublicPollCommentsOperation
+										r.summary = "List the visible comments of a poll"
+										r.operationID = "getPublicPollComments"
+										r.operationGroup = ""
+										r.pathPattern = "/p/poll/{slug}/comments"
+										r.args = args
+										r.count = 1
+										return r, true
+									case "POST":
+										r.name = AddPollCommentOperation
+										r.summary = "Comment on a poll or one of its options"
+										r.operationID = "addPollComment"
+										r.operationGroup = ""
+										r.pathPattern = "/p/poll/{slug}/comments"
+										r.args = args
+										r.count = 1
+										return r, true
+									default:
+										return
+									}
+								}
+
+							case 'p': // Prefix: "proposals"
+
+								if l := len("proposals"); len(elem) >= l && elem[0:l] == "proposals" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch method {
+									case "POST":
+										r.name = ProposePollOptionOperation
+										r.summary = "Propose an option for a poll"
+										r.operationID = "proposePollOption"
+										r.operationGroup = ""
+										r.pathPattern = "/p/poll/{slug}/proposals"
+										r.args = args
+										r.count = 1
+										return r, true
+									default:
+										return
+									}
+								}
+
 							case 'r': // Prefix: "results"
 
 								if l := len("results"); len(elem) >= l && elem[0:l] == "results" {
@@ -1767,7 +2367,6 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 								}
 
 								if len(elem) == 0 {
-									// Leaf node.
 									switch method {
 									case "POST":
 										r.name = SubmitVoteOperation
@@ -1782,6 +2381,60 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 										return
 									}
 								}
+								switch elem[0] {
+								case '/': // Prefix: "/"
+
+									if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									// Param: "token"
+									// Leaf parameter, slashes are prohibited
+									idx := strings.IndexByte(elem, '/')
+									if idx >= 0 {
+										break
+									}
+									args[1] = elem
+									elem = ""
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch method {
+										case "DELETE":
+											r.name = DeleteOwnVoteOperation
+											r.summary = "Delete own vote by edit token"
+											r.operationID = "deleteOwnVote"
+											r.operationGroup = ""
+											r.pathPattern = "/p/poll/{slug}/vote/{token}"
+											r.args = args
+											r.count = 2
+											return r, true
+										case "GET":
+											r.name = GetOwnVoteOperation
+											r.summary = "Get own vote by edit token"
+											r.operationID = "getOwnVote"
+											r.operationGroup = ""
+											r.pathPattern = "/p/poll/{slug}/vote/{token}"
+											r.args = args
+											r.count = 2
+											return r, true
+										case "PUT":
+											r.name = UpdateOwnVoteOperation
+											r.summary = "Update own vote by edit token"
+											r.operationID = "updateOwnVote"
+											r.operationGroup = ""
+											r.pathPattern = "/p/poll/{slug}/vote/{token}"
+											r.args = args
+											r.count = 2
+											return r, true
+										default:
+											return
+										}
+									}
+
+								}
 
 							}
 
@@ -1885,6 +2538,175 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 								break
 							}
 							switch elem[0] {
+							case 'c': // Prefix: "comments"
+
+								if l := len("comments"); len(elem) >= l && elem[0:l] == "comments" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									switch method {
+									case "GET":
+										r.name = ListPollCommentsOperation
+										r.summary = "List all comments of a poll, including hidden ones"
+										r.operationID = "listPollComments"
+										r.operationGroup = ""
+										r.pathPattern = "/polls/{id}/comments"
+										r.args = args
+										r.count = 1
+										return r, true
+									default:
+										return
+									}
+								}
+								switch elem[0] {
+								case '/': // Prefix: "/"
+
+									if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									// Param: "commentId"
+									// Leaf parameter, slashes are prohibited
+									idx := strings.IndexByte(elem, '/')
+									if idx >= 0 {
+										break
+									}
+									args[1] = elem
+									elem = ""
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch method {
+										case "DELETE":
+											r.name = DeletePollCommentOperation
+											r.summary = "Delete a comment"
+											r.operationID = "deletePollComment"
+											r.operationGroup = ""
+											r.pathPattern = "/polls/{id}/comments/{commentId}"
+											r.args = args
+											r.count = 2
+											return r, true
+										case "PUT":
+											r.name = ModeratePollCommentOperation
+											r.summary = "Hide or show a comment"
+											r.operationID = "moderatePollComment"
+											r.operationGroup = ""
+											r.pathPattern = "/polls/{id}/comments/{commentId}"
+											r.args = args
+											r.count = 2
+											return r, true
+										default:
+											return
+										}
+									}
+
+								}
+
+							case 'i': // Prefix: "invites"
+
+								if l := len("invites"); len(elem) >= l && elem[0:l] == "invites" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									switch method {
+									case "GET":
+										r.name = ListPollInvitesOperation
+										r.summary = "List invitees of a poll and whether they voted"
+										r.operationID = "listPollInvites"
+										r.operationGroup = ""
+										r.pathPattern = "/polls/{id}/invites"
+										r.args = args
+										r.count = 1
+										return r, true
+									case "POST":
+										r.name = AddPollInvitesOperation
+										r.summary = "Invite participants and email them a personal link"
+										r.operationID = "addPollInvites"
+										r.operationGroup = ""
+										r.pathPattern = "/polls/{id}/invites"
+										r.args = args
+										r.count = 1
+										return r, true
+									default:
+										return
+									}
+								}
+								switch elem[0] {
+								case '/': // Prefix: "/"
+
+									if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										break
+									}
+									switch elem[0] {
+									case 'r': // Prefix: "remind"
+										origElem := elem
+										if l := len("remind"); len(elem) >= l && elem[0:l] == "remind" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											// Leaf node.
+											switch method {
+											case "POST":
+												r.name = RemindPollInvitesOperation
+												r.summary = "Send a reminder to all invitees who haven't voted yet"
+												r.operationID = "remindPollInvites"
+												r.operationGroup = ""
+												r.pathPattern = "/polls/{id}/invites/remind"
+												r.args = args
+												r.count = 1
+												return r, true
+											default:
+												return
+											}
+										}
+
+										elem = origElem
+									}
+									// Param: "inviteId"
+									// Leaf parameter, slashes are prohibited
+									idx := strings.IndexByte(elem, '/')
+									if idx >= 0 {
+										break
+									}
+									args[1] = elem
+									elem = ""
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch method {
+										case "DELETE":
+											r.name = DeletePollInviteOperation
+											r.summary = "Remove an invitee from a poll"
+											r.operationID = "deletePollInvite"
+											r.operationGroup = ""
+											r.pathPattern = "/polls/{id}/invites/{inviteId}"
+											r.args = args
+											r.count = 2
+											return r, true
+										default:
+											return
+										}
+									}
+
+								}
+
 							case 'o': // Prefix: "options"
 
 								if l := len("options"); len(elem) >= l && elem[0:l] == "options" {
@@ -1926,6 +2748,63 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 										break
 									}
 
+									if len(elem) == 0 {
+										break
+									}
+									switch elem[0] {
+									case 'g': // Prefix: "generate"
+										origElem := elem
+										if l := len("generate"); len(elem) >= l && elem[0:l] == "generate" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											// Leaf node.
+											switch method {
+											case "POST":
+												r.name = GeneratePollOptionsOperation
+												r.summary = "Generate poll options from a recurrence pattern"
+												r.operationID = "generatePollOptions"
+												r.operationGroup = ""
+												r.pathPattern = "/polls/{id}/options/generate"
+												r.args = args
+												r.count = 1
+												return r, true
+											default:
+												return
+											}
+										}
+
+										elem = origElem
+									case 's': // Prefix: "suggest"
+										origElem := elem
+										if l := len("suggest"); len(elem) >= l && elem[0:l] == "suggest" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											// Leaf node.
+											switch method {
+											case "POST":
+												r.name = SuggestPollOptionsOperation
+												r.summary = "Suggest poll options from the organizer's free time"
+												r.operationID = "suggestPollOptions"
+												r.operationGroup = ""
+												r.pathPattern = "/polls/{id}/options/suggest"
+												r.args = args
+												r.count = 1
+												return r, true
+											default:
+												return
+											}
+										}
+
+										elem = origElem
+									}
 									// Param: "optionId"
 									// Leaf parameter, slashes are prohibited
 									idx := strings.IndexByte(elem, '/')
@@ -1954,9 +2833,134 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 
 								}
 
-							case 'p': // Prefix: "pick-winner"
+							case 'p': // Prefix: "p"
 
-								if l := len("pick-winner"); len(elem) >= l && elem[0:l] == "pick-winner" {
+								if l := len("p"); len(elem) >= l && elem[0:l] == "p" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									break
+								}
+								switch elem[0] {
+								case 'i': // Prefix: "ick-winner"
+
+									if l := len("ick-winner"); len(elem) >= l && elem[0:l] == "ick-winner" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch method {
+										case "POST":
+											r.name = PickPollWinnerOperation
+											r.summary = "Pick winning option for poll"
+											r.operationID = "pickPollWinner"
+											r.operationGroup = ""
+											r.pathPattern = "/polls/{id}/pick-winner"
+											r.args = args
+											r.count = 1
+											return r, true
+										default:
+											return
+										}
+									}
+
+								case 'r': // Prefix: "roposals"
+
+									if l := len("roposals"); len(elem) >= l && elem[0:l] == "roposals" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										switch method {
+										case "GET":
+											r.name = ListPollProposalsOperation
+											r.summary = "List the option proposals waiting for approval"
+											r.operationID = "listPollProposals"
+											r.operationGroup = ""
+											r.pathPattern = "/polls/{id}/proposals"
+											r.args = args
+											r.count = 1
+											return r, true
+										default:
+											return
+										}
+									}
+									switch elem[0] {
+									case '/': // Prefix: "/"
+
+										if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										// Param: "proposalId"
+										// Match until "/"
+										idx := strings.IndexByte(elem, '/')
+										if idx < 0 {
+											idx = len(elem)
+										}
+										args[1] = elem[:idx]
+										elem = elem[idx:]
+
+										if len(elem) == 0 {
+											switch method {
+											case "DELETE":
+												r.name = DeclinePollProposalOperation
+												r.summary = "Decline an option proposal"
+												r.operationID = "declinePollProposal"
+												r.operationGroup = ""
+												r.pathPattern = "/polls/{id}/proposals/{proposalId}"
+												r.args = args
+												r.count = 2
+												return r, true
+											default:
+												return
+											}
+										}
+										switch elem[0] {
+										case '/': // Prefix: "/approve"
+
+											if l := len("/approve"); len(elem) >= l && elem[0:l] == "/approve" {
+												elem = elem[l:]
+											} else {
+												break
+											}
+
+											if len(elem) == 0 {
+												// Leaf node.
+												switch method {
+												case "POST":
+													r.name = ApprovePollProposalOperation
+													r.summary = "Add a proposal to the options of the poll"
+													r.operationID = "approvePollProposal"
+													r.operationGroup = ""
+													r.pathPattern = "/polls/{id}/proposals/{proposalId}/approve"
+													r.args = args
+													r.count = 2
+													return r, true
+												default:
+													return
+												}
+											}
+
+										}
+
+									}
+
+								}
+
+							case 'r': // Prefix: "results"
+
+								if l := len("results"); len(elem) >= l && elem[0:l] == "results" {
 									elem = elem[l:]
 								} else {
 									break
@@ -1965,12 +2969,12 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 								if len(elem) == 0 {
 									// Leaf node.
 									switch method {
-									case "POST":
-										r.name = PickPollWinnerOperation
-										r.summary = "Pick winning option for poll"
-										r.operationID = "pickPollWinner"
+									case "GET":
+										r.name = GetPollRankingOperation
+										r.summary = "Get ranked results of a poll"
+										r.operationID = "getPollRanking"
 										r.operationGroup = ""
-										r.pathPattern = "/polls/{id}/pick-winner"
+										r.pathPattern = "/polls/{id}/results"
 										r.args = args
 										r.count = 1
 										return r, true
@@ -89,10 +89,76 @@ func (s *AddCalendarReq) SetWriteURL(val OptString) {
 	s.WriteURL = val
 }
 
+type AddPollCommentReq struct {
+	AuthorName  string    `json:"author_name"`
+	AuthorEmail OptString `json:"author_email"`
+	Body        string    `json:"body"`
+	OptionID    OptInt    `json:"option_id"`
+}
+
+// GetAuthorName returns the value of AuthorName.
+func (s *AddPollCommentReq) GetAuthorName() string {
+	return s.AuthorName
+}
+
+// GetAuthorEmail returns the value of AuthorEmail.
+func (s *AddPollCommentReq) GetAuthorEmail() OptString {
+	return s.AuthorEmail
+}
+
+// GetBody returns the value of Body.
+func (s *AddPollCommentReq) GetBody() string {
+	return s.Body
+}
+
+// GetOptionID returns the value of OptionID.
+func (s *AddPollCommentReq) GetOptionID() OptInt {
+	return s.OptionID
+}
+
+// SetAuthorName sets the value of AuthorName.
+func (s *AddPollCommentReq) SetAuthorName(val string) {
+	s.AuthorName = val
+}
+
+// SetAuthorEmail sets the value of AuthorEmail.
+func (s *AddPollCommentReq) SetAuthorEmail(val OptString) {
+	s.AuthorEmail = val
+}
+
+// SetBody sets the value of Body.
+func (s *AddPollCommentReq) SetBody(val string) {
+	s.Body = val
+}
+
+// SetOptionID sets the value of OptionID.
+func (s *AddPollCommentReq) SetOptionID(val OptInt) {
+	s.OptionID = val
+}
+
+type AddPollInvitesCreatedApplicationJSON []PollInvite
+
+func (*AddPollInvitesCreatedApplicationJSON) addPollInvitesRes() {}
+
+type AddPollInvitesReq struct {
+	Invitees []Invitee `json:"invitees"`
+}
+
+// GetInvitees returns the value of Invitees.
+func (s *AddPollInvitesReq) GetInvitees() []Invitee {
+	return s.Invitees
+}
+
+// SetInvitees sets the value of Invitees.
+func (s *AddPollInvitesReq) SetInvitees(val []Invitee) {
+	s.Invitees = val
+}
+
 type AddPollOptionReq struct {
 	Type      SlotType  `json:"type"`
 	StartTime time.Time `json:"start_time"`
 	EndTime   time.Time `json:"end_time"`
+	Capacity  OptInt    `json:"capacity"`
 }
 
 // GetType returns the value of Type.
@@ -110,6 +176,11 @@ func (s *AddPollOptionReq) GetEndTime() time.Time {
 	return s.EndTime
 }
 
+// GetCapacity returns the value of Capacity.
+func (s *AddPollOptionReq) GetCapacity() OptInt {
+	return s.Capacity
+}
+
 // SetType sets the value of Type.
 func (s *AddPollOptionReq) SetType(val SlotType) {
 	s.Type = val
@@ -125,6 +196,28 @@ func (s *AddPollOptionReq) SetEndTime(val time.Time) {
 	s.EndTime = val
 }
 
+// SetCapacity sets the value of Capacity.
+func (s *AddPollOptionReq) SetCapacity(val OptInt) {
+	s.Capacity = val
+}
+
+// 1=decline, 2=confirm.
+// Ref: #/components/schemas/ApprovalTimeoutAction
+type ApprovalTimeoutAction int
+
+const (
+	ApprovalTimeoutAction1 ApprovalTimeoutAction = 1
+	ApprovalTimeoutAction2 ApprovalTimeoutAction = 2
+)
+
+// AllValues returns all ApprovalTimeoutAction values.
+func (ApprovalTimeoutAction) AllValues() []ApprovalTimeoutAction {
+	return []ApprovalTimeoutAction{
+		ApprovalTimeoutAction1,
+		ApprovalTimeoutAction2,
+	}
+}
+
 type ApproveViaEmailOK struct {
 	Message OptString `json:"message"`
 }
@@ -169,6 +262,107 @@ func (s *AuthCallbackFound) SetSetCookie(val OptString) {
 
 func (*AuthCallbackFound) authCallbackRes() {}
 
+// Bookings matching any rule are confirmed, all others wait for approval. Only used when
+// auto_confirm is off. Guests type in their email address, so bookings matching known_guests or
+// email_domains wait for approval until the guest confirms the address through a link sent to it.
+// Ref: #/components/schemas/AutoConfirmRules
+type AutoConfirmRules struct {
+	// Confirm guests who already have a confirmed booking with the organizer, once they confirmed their
+	// email address.
+	KnownGuests OptBool `json:"known_guests"`
+	// Confirm guests whose email address belongs to one of these domains, once they confirmed their
+	// email address.
+	EmailDomains []string `json:"email_domains"`
+	// Confirm bookings whose slot lies within these hours right away.
+	Hours []AvailabilityRule `json:"hours"`
+}
+
+// GetKnownGuests returns the value of KnownGuests.
+func (s *AutoConfirmRules) GetKnownGuests() OptBool {
+	return s.KnownGuests
+}
+
+// GetEmailDomains returns the value of EmailDomains.
+func (s *AutoConfirmRules) GetEmailDomains() []string {
+	return s.EmailDomains
+}
+
+// GetHours returns the value of Hours.
+func (s *AutoConfirmRules) GetHours() []AvailabilityRule {
+	return s.Hours
+}
+
+// SetKnownGuests sets the value of KnownGuests.
+func (s *AutoConfirmRules) SetKnownGuests(val OptBool) {
+	s.KnownGuests = val
+}
+
+// SetEmailDomains sets the value of EmailDomains.
+func (s *AutoConfirmRules) SetEmailDomains(val []string) {
+	s.EmailDomains = val
+}
+
+// SetHours sets the value of Hours.
+func (s *AutoConfirmRules) SetHours(val []AvailabilityRule) {
+	s.Hours = val
+}
+
+type AutofillVoteOK struct {
+	// Suggested answer per option ID.
+	Responses AutofillVoteOKResponses `json:"responses"`
+}
+
+// GetResponses returns the value of Responses.
+func (s *AutofillVoteOK) GetResponses() AutofillVoteOKResponses {
+	return s.Responses
+}
+
+// SetResponses sets the value of Responses.
+func (s *AutofillVoteOK) SetResponses(val AutofillVoteOKResponses) {
+	s.Responses = val
+}
+
+func (*AutofillVoteOK) autofillVoteRes() {}
+
+// Suggested answer per option ID.
+type AutofillVoteOKResponses map[string]VoteResponse
+
+func (s *AutofillVoteOKResponses) init() AutofillVoteOKResponses {
+	m := *s
+	if m == nil {
+		m = map[string]VoteResponse{}
+		*s = m
+	}
+	return m
+}
+
+type AutofillVoteReq struct {
+	// Http(s) or webcal URL of the calendar.
+	URL OptString `json:"url"`
+	// Contents of an .ics file.
+	Ics OptString `json:"ics"`
+}
+
+// GetURL returns the value of URL.
+func (s *AutofillVoteReq) GetURL() OptString {
+	return s.URL
+}
+
+// GetIcs returns the value of Ics.
+func (s *AutofillVoteReq) GetIcs() OptString {
+	return s.Ics
+}
+
+// SetURL sets the value of URL.
+func (s *AutofillVoteReq) SetURL(val OptString) {
+	s.URL = val
+}
+
+// SetIcs sets the value of Ics.
+func (s *AutofillVoteReq) SetIcs(val OptString) {
+	s.Ics = val
+}
+
 // Ref: #/components/schemas/AvailabilityRule
 type AvailabilityRule struct {
 	DaysOfWeek []int  `json:"days_of_week"`
@@ -208,13 +402,17 @@ func (s *AvailabilityRule) SetEndTime(val string) {
 
 // Ref: #/components/schemas/Booking
 type Booking struct {
-	ID           int                    `json:"id"`
-	Slot         Slot                   `json:"slot"`
-	GuestEmail   string                 `json:"guest_email"`
-	GuestName    OptString              `json:"guest_name"`
-	Status       BookingStatus          `json:"status"`
+	ID         int           `json:"id"`
+	Slot       Slot          `json:"slot"`
+	GuestEmail string        `json:"guest_email"`
+	GuestName  OptString     `json:"guest_name"`
+	Status     BookingStatus `json:"status"`
+	// Meeting link generated for this booking.
+	MeetingLink  OptString              `json:"meeting_link"`
 	CustomFields OptBookingCustomFields `json:"custom_fields"`
-	CreatedAt    OptDateTime            `json:"created_at"`
+	// Files the guest uploaded for file fields.
+	Attachments []BookingAttachment `json:"attachments"`
+	CreatedAt   OptDateTime         `json:"created_at"`
 }
 
 // GetID returns the value of ID.
@@ -242,11 +440,21 @@ func (s *Booking) GetStatus() BookingStatus {
 	return s.Status
 }
 
+// GetMeetingLink returns the value of MeetingLink.
+func (s *Booking) GetMeetingLink() OptString {
+	return s.MeetingLink
+}
+
 // GetCustomFields returns the value of CustomFields.
 func (s *Booking) GetCustomFields() OptBookingCustomFields {
 	return s.CustomFields
 }
 
+// GetAttachments returns the value of Attachments.
+func (s *Booking) GetAttachments() []BookingAttachment {
+	return s.Attachments
+}
+
 // GetCreatedAt returns the value of CreatedAt.
 func (s *Booking) GetCreatedAt() OptDateTime {
 	return s.CreatedAt
@@ -277,16 +485,98 @@ func (s *Booking) SetStatus(val BookingStatus) {
 	s.Status = val
 }
 
+// SetMeetingLink sets the value of MeetingLink.
+func (s *Booking) SetMeetingLink(val OptString) {
+	s.MeetingLink = val
+}
+
 // SetCustomFields sets the value of CustomFields.
 func (s *Booking) SetCustomFields(val OptBookingCustomFields) {
 	s.CustomFields = val
 }
 
+// SetAttachments sets the value of Attachments.
+func (s *Booking) SetAttachments(val []BookingAttachment) {
+	s.Attachments = val
+}
+
 // SetCreatedAt sets the value of CreatedAt.
 func (s *Booking) SetCreatedAt(val OptDateTime) {
 	s.CreatedAt = val
 }
 
+// Ref: #/components/schemas/BookingAttachment
+type BookingAttachment struct {
+	ID int `json:"id"`
+	// Name of the custom field.
+	Field       string    `json:"field"`
+	Filename    string    `json:"filename"`
+	ContentType OptString `json:"content_type"`
+	Size        int64     `json:"size"`
+	// Download URL, requires the organizer's session.
+	URL string `json:"url"`
+}
+
+// GetID returns the value of ID.
+func (s *BookingAttachment) GetID() int {
+	return s.ID
+}
+
+// GetField returns the value of Field.
+func (s *BookingAttachment) GetField() string {
+	return s.Field
+}
+
+// GetFilename returns the value of Filename.
+func (s *BookingAttachment) GetFilename() string {
+	return s.Filename
+}
+
+// GetContentType returns the value of ContentType.
+func (s *BookingAttachment) GetContentType() OptString {
+	return s.ContentType
+}
+
+// GetSize returns the value of Size.
+func (s *BookingAttachment) GetSize() int64 {
+	return s.Size
+}
+
+// GetURL returns the value of URL.
+func (s *BookingAttachment) GetURL() string {
+	return s.URL
+}
+
+// SetID sets the value of ID.
+func (s *BookingAttachment) SetID(val int) {
+	s.ID = val
+}
+
+// SetField sets the value of Field.
+func (s *BookingAttachment) SetField(val string) {
+	s.Field = val
+}
+
+// SetFilename sets the value of Filename.
+func (s *BookingAttachment) SetFilename(val string) {
+	s.Filename = val
+}
+
+// SetContentType sets the value of ContentType.
+func (s *BookingAttachment) SetContentType(val OptString) {
+	s.ContentType = val
+}
+
+// SetSize sets the value of Size.
+func (s *BookingAttachment) SetSize(val int64) {
+	s.Size = val
+}
+
+// SetURL sets the value of URL.
+func (s *BookingAttachment) SetURL(val string) {
+	s.URL = val
+}
+
 type BookingCustomFields map[string]string
 
 func (s *BookingCustomFields) init() BookingCustomFields {
@@ -300,12 +590,17 @@ func (s *BookingCustomFields) init() BookingCustomFields {
 
 // Ref: #/components/schemas/BookingLink
 type BookingLink struct {
-	ID          int        `json:"id"`
-	Slug        string     `json:"slug"`
-	Name        string     `json:"name"`
-	Description OptString  `json:"description"`
-	Status      LinkStatus `json:"status"`
-	AutoConfirm OptBool    `json:"auto_confirm"`
+	ID               int                 `json:"id"`
+	Slug             string              `json:"slug"`
+	Name             string              `json:"name"`
+	Description      OptString           `json:"description"`
+	Status           LinkStatus          `json:"status"`
+	AutoConfirm      OptBool             `json:"auto_confirm"`
+	AutoConfirmRules OptAutoConfirmRules `json:"auto_confirm_rules"`
+	// Hours after which bookings that still wait for approval are resolved with approval_timeout_action.
+	// 0 waits until the organizer reacts.
+	ApprovalTimeoutHours  OptInt                   `json:"approval_timeout_hours"`
+	ApprovalTimeoutAction OptApprovalTimeoutAction `json:"approval_timeout_action"`
 	// Duration of each bookable slot in minutes (deprecated, use slot_durations_minutes).
 	SlotDurationMinutes OptInt `json:"slot_duration_minutes"`
 	// Available slot durations in minutes. If empty, slot_duration_minutes is used.
@@ -314,10 +609,14 @@ type BookingLink struct {
 	BufferMinutes OptInt  `json:"buffer_minutes"`
 	RequireEmail  OptBool `json:"require_email"`
 	// Video meeting link (Zoom, Google Meet, etc.) to include in calendar events.
-	MeetingLink       OptString          `json:"meeting_link"`
+	MeetingLink OptString `json:"meeting_link"`
+	// Provider generating a meeting link per booking (e.g. jitsi, bigbluebutton). Empty or "static" uses
+	// meeting_link for every booking.
+	MeetingProvider   OptString          `json:"meeting_provider"`
 	AvailabilityRules []AvailabilityRule `json:"availability_rules"`
 	CustomFields      []CustomField      `json:"custom_fields"`
 	EventTemplate     OptEventTemplate   `json:"event_template"`
+	Branding          OptBranding        `json:"branding"`
 	CreatedAt         OptDateTime        `json:"created_at"`
 }
 
@@ -351,6 +650,21 @@ func (s *BookingLink) GetAutoConfirm() OptBool {
 	return s.AutoConfirm
 }
 
+// GetAutoConfirmRules returns the value of AutoConfirmRules.
+func (s *BookingLink) GetAutoConfirmRules() OptAutoConfirmRules {
+	return s.AutoConfirmRules
+}
+
+// GetApprovalTimeoutHours returns the value of ApprovalTimeoutHours.
+func (s *BookingLink) GetApprovalTimeoutHours() OptInt {
+	return s.ApprovalTimeoutHours
+}
+
+// GetApprovalTimeoutAction returns the value of ApprovalTimeoutAction.
+func (s *BookingLink) GetApprovalTimeoutAction() OptApprovalTimeoutAction {
+	return s.ApprovalTimeoutAction
+}
+
 // GetSlotDurationMinutes returns the value of SlotDurationMinutes.
 func (s *BookingLink) GetSlotDurationMinutes() OptInt {
 	return s.SlotDurationMinutes
@@ -376,6 +690,11 @@ func (s *BookingLink) GetMeetingLink() OptString {
 	return s.MeetingLink
 }
 
+// GetMeetingProvider returns the value of MeetingProvider.
+func (s *BookingLink) GetMeetingProvider() OptString {
+	return s.MeetingProvider
+}
+
 // GetAvailabilityRules returns the value of AvailabilityRules.
 func (s *BookingLink) GetAvailabilityRules() []AvailabilityRule {
 	return s.AvailabilityRules
@@ -391,6 +710,11 @@ func (s *BookingLink) GetEventTemplate() OptEventTemplate {
 	return s.EventTemplate
 }
 
+// GetBranding returns the value of Branding.
+func (s *BookingLink) GetBranding() OptBranding {
+	return s.Branding
+}
+
 // GetCreatedAt returns the value of CreatedAt.
 func (s *BookingLink) GetCreatedAt() OptDateTime {
 	return s.CreatedAt
@@ -426,6 +750,21 @@ func (s *BookingLink) SetAutoConfirm(val OptBool) {
 	s.AutoConfirm = val
 }
 
+// SetAutoConfirmRules sets the value of AutoConfirmRules.
+func (s *BookingLink) SetAutoConfirmRules(val OptAutoConfirmRules) {
+	s.AutoConfirmRules = val
+}
+
+// SetApprovalTimeoutHours sets the value of ApprovalTimeoutHours.
+func (s *BookingLink) SetApprovalTimeoutHours(val OptInt) {
+	s.ApprovalTimeoutHours = val
+}
+
+// SetApprovalTimeoutAction sets the value of ApprovalTimeoutAction.
+func (s *BookingLink) SetApprovalTimeoutAction(val OptApprovalTimeoutAction) {
+	s.ApprovalTimeoutAction = val
+}
+
 // SetSlotDurationMinutes sets the value of SlotDurationMinutes.
 func (s *BookingLink) SetSlotDurationMinutes(val OptInt) {
 	s.SlotDurationMinutes = val
@@ -451,6 +790,11 @@ func (s *BookingLink) SetMeetingLink(val OptString) {
 	s.MeetingLink = val
 }
 
+// SetMeetingProvider sets the value of MeetingProvider.
+func (s *BookingLink) SetMeetingProvider(val OptString) {
+	s.MeetingProvider = val
+}
+
 // SetAvailabilityRules sets the value of AvailabilityRules.
 func (s *BookingLink) SetAvailabilityRules(val []AvailabilityRule) {
 	s.AvailabilityRules = val
@@ -466,11 +810,19 @@ func (s *BookingLink) SetEventTemplate(val OptEventTemplate) {
 	s.EventTemplate = val
 }
 
+// SetBranding sets the value of Branding.
+func (s *BookingLink) SetBranding(val OptBranding) {
+	s.Branding = val
+}
+
 // SetCreatedAt sets the value of CreatedAt.
 func (s *BookingLink) SetCreatedAt(val OptDateTime) {
 	s.CreatedAt = val
 }
 
+func (*BookingLink) createBookingLinkRes() {}
+func (*BookingLink) updateBookingLinkRes() {}
+
 // 1=pending, 2=confirmed, 3=declined.
 // Ref: #/components/schemas/BookingStatus
 type BookingStatus int
@@ -490,6 +842,56 @@ func (BookingStatus) AllValues() []BookingStatus {
 	}
 }
 
+// Theme colors and images for public pages and emails. Logo and cover images are uploaded via
+// /api/branding; their URLs are ignored in update requests.
+// Ref: #/components/schemas/Branding
+type Branding struct {
+	PrimaryColor OptString `json:"primary_color"`
+	AccentColor  OptString `json:"accent_color"`
+	LogoURL      OptString `json:"logo_url"`
+	CoverURL     OptString `json:"cover_url"`
+}
+
+// GetPrimaryColor returns the value of PrimaryColor.
+func (s *Branding) GetPrimaryColor() OptString {
+	return s.PrimaryColor
+}
+
+// GetAccentColor returns the value of AccentColor.
+func (s *Branding) GetAccentColor() OptString {
+	return s.AccentColor
+}
+
+// GetLogoURL returns the value of LogoURL.
+func (s *Branding) GetLogoURL() OptString {
+	return s.LogoURL
+}
+
+// GetCoverURL returns the value of CoverURL.
+func (s *Branding) GetCoverURL() OptString {
+	return s.CoverURL
+}
+
+// SetPrimaryColor sets the value of PrimaryColor.
+func (s *Branding) SetPrimaryColor(val OptString) {
+	s.PrimaryColor = val
+}
+
+// SetAccentColor sets the value of AccentColor.
+func (s *Branding) SetAccentColor(val OptString) {
+	s.AccentColor = val
+}
+
+// SetLogoURL sets the value of LogoURL.
+func (s *Branding) SetLogoURL(val OptString) {
+	s.LogoURL = val
+}
+
+// SetCoverURL sets the value of CoverURL.
+func (s *Branding) SetCoverURL(val OptString) {
+	s.CoverURL = val
+}
+
 // Ref: #/components/schemas/CalendarConnection
 type CalendarConnection struct {
 	ID           int       `json:"id"`
@@ -689,6 +1091,8 @@ func (s *CookieAuth) SetRoles(val []string) {
 type CreateBookingCreated struct {
 	Status  BookingStatus `json:"status"`
 	Message OptString     `json:"message"`
+	// The booking is confirmed once the guest confirms their email address through the link sent to it.
+	VerifyEmail OptBool `json:"verify_email"`
 }
 
 // GetStatus returns the value of Status.
@@ -701,6 +1105,11 @@ func (s *CreateBookingCreated) GetMessage() OptString {
 	return s.Message
 }
 
+// GetVerifyEmail returns the value of VerifyEmail.
+func (s *CreateBookingCreated) GetVerifyEmail() OptBool {
+	return s.VerifyEmail
+}
+
 // SetStatus sets the value of Status.
 func (s *CreateBookingCreated) SetStatus(val BookingStatus) {
 	s.Status = val
@@ -711,22 +1120,33 @@ func (s *CreateBookingCreated) SetMessage(val OptString) {
 	s.Message = val
 }
 
+// SetVerifyEmail sets the value of VerifyEmail.
+func (s *CreateBookingCreated) SetVerifyEmail(val OptBool) {
+	s.VerifyEmail = val
+}
+
 func (*CreateBookingCreated) createBookingRes() {}
 
 type CreateBookingLinkReq struct {
-	Name                string    `json:"name"`
-	Description         OptString `json:"description"`
-	AutoConfirm         OptBool   `json:"auto_confirm"`
-	SlotDurationMinutes OptInt    `json:"slot_duration_minutes"`
+	Name                  string                   `json:"name"`
+	Description           OptString                `json:"description"`
+	AutoConfirm           OptBool                  `json:"auto_confirm"`
+	AutoConfirmRules      OptAutoConfirmRules      `json:"auto_confirm_rules"`
+	ApprovalTimeoutHours  OptInt                   `json:"approval_timeout_hours"`
+	ApprovalTimeoutAction OptApprovalTimeoutAction `json:"approval_timeout_action"`
+	SlotDurationMinutes   OptInt                   `json:"slot_duration_minutes"`
 	// Available slot durations in minutes.
 	SlotDurationsMinutes []int   `json:"slot_durations_minutes"`
 	BufferMinutes        OptInt  `json:"buffer_minutes"`
 	RequireEmail         OptBool `json:"require_email"`
 	// Video meeting link (Zoom, Google Meet, etc.).
-	MeetingLink       OptString          `json:"meeting_link"`
+	MeetingLink OptString `json:"meeting_link"`
+	// Provider generating a meeting link per booking.
+	MeetingProvider   OptString          `json:"meeting_provider"`
 	AvailabilityRules []AvailabilityRule `json:"availability_rules"`
 	CustomFields      []CustomField      `json:"custom_fields"`
 	EventTemplate     OptEventTemplate   `json:"event_template"`
+	Branding          OptBranding        `json:"branding"`
 }
 
 // GetName returns the value of Name.
@@ -744,6 +1164,21 @@ func (s *CreateBookingLinkReq) GetAutoConfirm() OptBool {
 	return s.AutoConfirm
 }
 
+// GetAutoConfirmRules returns the value of AutoConfirmRules.
+func (s *CreateBookingLinkReq) GetAutoConfirmRules() OptAutoConfirmRules {
+	return s.AutoConfirmRules
+}
+
+// GetApprovalTimeoutHours returns the value of ApprovalTimeoutHours.
+func (s *CreateBookingLinkReq) GetApprovalTimeoutHours() OptInt {
+	return s.ApprovalTimeoutHours
+}
+
+// GetApprovalTimeoutAction returns the value of ApprovalTimeoutAction.
+func (s *CreateBookingLinkReq) GetApprovalTimeoutAction() OptApprovalTimeoutAction {
+	return s.ApprovalTimeoutAction
+}
+
 // GetSlotDurationMinutes returns the value of SlotDurationMinutes.
 func (s *CreateBookingLinkReq) GetSlotDurationMinutes() OptInt {
 	return s.SlotDurationMinutes
@@ -769,6 +1204,11 @@ func (s *CreateBookingLinkReq) GetMeetingLink() OptString {
 	return s.MeetingLink
 }
 
+// GetMeetingProvider returns the value of MeetingProvider.
+func (s *CreateBookingLinkReq) GetMeetingProvider() OptString {
+	return s.MeetingProvider
+}
+
 // GetAvailabilityRules returns the value of AvailabilityRules.
 func (s *CreateBookingLinkReq) GetAvailabilityRules() []AvailabilityRule {
 	return s.AvailabilityRules
@@ -784,6 +1224,11 @@ func (s *CreateBookingLinkReq) GetEventTemplate() OptEventTemplate {
 	return s.EventTemplate
 }
 
+// GetBranding returns the value of Branding.
+func (s *CreateBookingLinkReq) GetBranding() OptBranding {
+	return s.Branding
+}
+
 // SetName sets the value of Name.
 func (s *CreateBookingLinkReq) SetName(val string) {
 	s.Name = val
@@ -799,6 +1244,21 @@ func (s *CreateBookingLinkReq) SetAutoConfirm(val OptBool) {
 	s.AutoConfirm = val
 }
 
+// SetAutoConfirmRules sets the value of AutoConfirmRules.
+func (s *CreateBookingLinkReq) SetAutoConfirmRules(val OptAutoConfirmRules) {
+	s.AutoConfirmRules = val
+}
+
+// SetApprovalTimeoutHours sets the value of ApprovalTimeoutHours.
+func (s *CreateBookingLinkReq) SetApprovalTimeoutHours(val OptInt) {
+	s.ApprovalTimeoutHours = val
+}
+
+// SetApprovalTimeoutAction sets the value of ApprovalTimeoutAction.
+func (s *CreateBookingLinkReq) SetApprovalTimeoutAction(val OptApprovalTimeoutAction) {
+	s.ApprovalTimeoutAction = val
+}
+
 // SetSlotDurationMinutes sets the value of SlotDurationMinutes.
 func (s *CreateBookingLinkReq) SetSlotDurationMinutes(val OptInt) {
 	s.SlotDurationMinutes = val
@@ -824,6 +1284,11 @@ func (s *CreateBookingLinkReq) SetMeetingLink(val OptString) {
 	s.MeetingLink = val
 }
 
+// SetMeetingProvider sets the value of MeetingProvider.
+func (s *CreateBookingLinkReq) SetMeetingProvider(val OptString) {
+	s.MeetingProvider = val
+}
+
 // SetAvailabilityRules sets the value of AvailabilityRules.
 func (s *CreateBookingLinkReq) SetAvailabilityRules(val []AvailabilityRule) {
 	s.AvailabilityRules = val
@@ -839,6 +1304,11 @@ func (s *CreateBookingLinkReq) SetEventTemplate(val OptEventTemplate) {
 	s.EventTemplate = val
 }
 
+// SetBranding sets the value of Branding.
+func (s *CreateBookingLinkReq) SetBranding(val OptBranding) {
+	s.Branding = val
+}
+
 type CreateBookingReq struct {
 	GuestEmail string    `json:"guest_email"`
 	GuestName  OptString `json:"guest_name"`
@@ -911,11 +1381,25 @@ func (s *CreateBookingReqCustomFields) init() CreateBookingReqCustomFields {
 }
 
 type CreatePollReq struct {
-	Name         string        `json:"name"`
-	Description  OptString     `json:"description"`
-	ShowResults  OptBool       `json:"show_results"`
-	RequireEmail OptBool       `json:"require_email"`
-	CustomFields []CustomField `json:"custom_fields"`
+	Name              string               `json:"name"`
+	Description       OptString            `json:"description"`
+	ShowResults       OptBool              `json:"show_results"`
+	ResultsVisibility OptResultsVisibility `json:"results_visibility"`
+	ResultsTiming     OptResultsTiming     `json:"results_timing"`
+	RequireEmail      OptBool              `json:"require_email"`
+	Mode              OptPollMode          `json:"mode"`
+	MaxChoices        OptInt               `json:"max_choices"`
+	CustomFields      []CustomField        `json:"custom_fields"`
+	Branding          OptBranding          `json:"branding"`
+	Deadline          OptDateTime          `json:"deadline"`
+	EventTemplate     OptEventTemplate     `json:"event_template"`
+	// Defaults to 24.
+	ReminderHours      OptInt          `json:"reminder_hours"`
+	Scoring            OptPollScoring  `json:"scoring"`
+	AutoPickOnClose    OptBool         `json:"auto_pick_on_close"`
+	AutoPickWhenAllYes OptBool         `json:"auto_pick_when_all_yes"`
+	HoldTimes          OptBool         `json:"hold_times"`
+	OptionProposals    OptProposalMode `json:"option_proposals"`
 }
 
 // GetName returns the value of Name.
@@ -933,16 +1417,81 @@ func (s *CreatePollReq) GetShowResults() OptBool {
 	return s.ShowResults
 }
 
+// GetResultsVisibility returns the value of ResultsVisibility.
+func (s *CreatePollReq) GetResultsVisibility() OptResultsVisibility {
+	return s.ResultsVisibility
+}
+
+// GetResultsTiming returns the value of ResultsTiming.
+func (s *CreatePollReq) GetResultsTiming() OptResultsTiming {
+	return s.ResultsTiming
+}
+
 // GetRequireEmail returns the value of RequireEmail.
 func (s *CreatePollReq) GetRequireEmail() OptBool {
 	return s.RequireEmail
 }
 
+// GetMode returns the value of Mode.
+func (s *CreatePollReq) GetMode() OptPollMode {
+	return s.Mode
+}
+
+// GetMaxChoices returns the value of MaxChoices.
+func (s *CreatePollReq) GetMaxChoices() OptInt {
+	return s.MaxChoices
+}
+
 // GetCustomFields returns the value of CustomFields.
 func (s *CreatePollReq) GetCustomFields() []CustomField {
 	return s.CustomFields
 }
 
+// GetBranding returns the value of Branding.
+func (s *CreatePollReq) GetBranding() OptBranding {
+	return s.Branding
+}
+
+// GetDeadline returns the value of Deadline.
+func (s *CreatePollReq) GetDeadline() OptDateTime {
+	return s.Deadline
+}
+
+// GetEventTemplate returns the value of EventTemplate.
+func (s *CreatePollReq) GetEventTemplate() OptEventTemplate {
+	return s.EventTemplate
+}
+
+// GetReminderHours returns the value of ReminderHours.
+func (s *CreatePollReq) GetReminderHours() OptInt {
+	return s.ReminderHours
+}
+
+// GetScoring returns the value of Scoring.
+func (s *CreatePollReq) GetScoring() OptPollScoring {
+	return s.Scoring
+}
+
+// GetAutoPickOnClose returns the value of AutoPickOnClose.
+func (s *CreatePollReq) GetAutoPickOnClose() OptBool {
+	return s.AutoPickOnClose
+}
+
+// GetAutoPickWhenAllYes returns the value of AutoPickWhenAllYes.
+func (s *CreatePollReq) GetAutoPickWhenAllYes() OptBool {
+	return s.AutoPickWhenAllYes
+}
+
+// GetHoldTimes returns the value of HoldTimes.
+func (s *CreatePollReq) GetHoldTimes() OptBool {
+	return s.HoldTimes
+}
+
+// GetOptionProposals returns the value of OptionProposals.
+func (s *CreatePollReq) GetOptionProposals() OptProposalMode {
+	return s.OptionProposals
+}
+
 // SetName sets the value of Name.
 func (s *CreatePollReq) SetName(val string) {
 	s.Name = val
@@ -958,37 +1507,109 @@ func (s *CreatePollReq) SetShowResults(val OptBool) {
 	s.ShowResults = val
 }
 
+// SetResultsVisibility sets the value of ResultsVisibility.
+func (s *CreatePollReq) SetResultsVisibility(val OptResultsVisibility) {
+	s.ResultsVisibility = val
+}
+
+// SetResultsTiming sets the value of ResultsTiming.
+func (s *CreatePollReq) SetResultsTiming(val OptResultsTiming) {
+	s.ResultsTiming = val
+}
+
 // SetRequireEmail sets the value of RequireEmail.
 func (s *CreatePollReq) SetRequireEmail(val OptBool) {
 	s.RequireEmail = val
 }
 
+// SetMode sets the value of Mode.
+func (s *CreatePollReq) SetMode(val OptPollMode) {
+	s.Mode = val
+}
+
+// SetMaxChoices sets the value of MaxChoices.
+func (s *CreatePollReq) SetMaxChoices(val OptInt) {
+	s.MaxChoices = val
+}
+
 // SetCustomFields sets the value of CustomFields.
 func (s *CreatePollReq) SetCustomFields(val []CustomField) {
 	s.CustomFields = val
 }
 
-// Ref: #/components/schemas/CustomField
-type CustomField struct {
-	Name     string          `json:"name"`
-	Label    string          `json:"label"`
-	Type     CustomFieldType `json:"type"`
-	Required bool            `json:"required"`
-	Options  []string        `json:"options"`
+// SetBranding sets the value of Branding.
+func (s *CreatePollReq) SetBranding(val OptBranding) {
+	s.Branding = val
 }
 
-// GetName returns the value of Name.
-func (s *CustomField) GetName() string {
-	return s.Name
+// SetDeadline sets the value of Deadline.
+func (s *CreatePollReq) SetDeadline(val OptDateTime) {
+	s.Deadline = val
 }
 
-// GetLabel returns the value of Label.
-func (s *CustomField) GetLabel() string {
-	return s.Label
+// SetEventTemplate sets the value of EventTemplate.
+func (s *CreatePollReq) SetEventTemplate(val OptEventTemplate) {
+	s.EventTemplate = val
 }
 
-// GetType returns the value of Type.
-func (s *CustomField) GetType() CustomFieldType {
+// SetReminderHours sets the value of ReminderHours.
+func (s *CreatePollReq) SetReminderHours(val OptInt) {
+	s.ReminderHours = val
+}
+
+// SetScoring sets the value of Scoring.
+func (s *CreatePollReq) SetScoring(val OptPollScoring) {
+	s.Scoring = val
+}
+
+// SetAutoPickOnClose sets the value of AutoPickOnClose.
+func (s *CreatePollReq) SetAutoPickOnClose(val OptBool) {
+	s.AutoPickOnClose = val
+}
+
+// SetAutoPickWhenAllYes sets the value of AutoPickWhenAllYes.
+func (s *CreatePollReq) SetAutoPickWhenAllYes(val OptBool) {
+	s.AutoPickWhenAllYes = val
+}
+
+// SetHoldTimes sets the value of HoldTimes.
+func (s *CreatePollReq) SetHoldTimes(val OptBool) {
+	s.HoldTimes = val
+}
+
+// SetOptionProposals sets the value of OptionProposals.
+func (s *CreatePollReq) SetOptionProposals(val OptProposalMode) {
+	s.OptionProposals = val
+}
+
+// Ref: #/components/schemas/CustomField
+type CustomField struct {
+	Name     string          `json:"name"`
+	Label    string          `json:"label"`
+	Type     CustomFieldType `json:"type"`
+	Required bool            `json:"required"`
+	Options  []string        `json:"options"`
+	// Regular expression text and textarea answers must match completely.
+	Pattern OptString `json:"pattern"`
+	// Largest upload accepted by a file field, 10MB if not set.
+	MaxFileSizeMB OptInt `json:"max_file_size_mb"`
+	// File types accepted by a file field as extensions like ".pdf" or MIME types like "image/*". All
+	// types are accepted if empty.
+	FileTypes []string `json:"file_types"`
+}
+
+// GetName returns the value of Name.
+func (s *CustomField) GetName() string {
+	return s.Name
+}
+
+// GetLabel returns the value of Label.
+func (s *CustomField) GetLabel() string {
+	return s.Label
+}
+
+// GetType returns the value of Type.
+func (s *CustomField) GetType() CustomFieldType {
 	return s.Type
 }
 
@@ -1002,6 +1623,21 @@ func (s *CustomField) GetOptions() []string {
 	return s.Options
 }
 
+// GetPattern returns the value of Pattern.
+func (s *CustomField) GetPattern() OptString {
+	return s.Pattern
+}
+
+// GetMaxFileSizeMB returns the value of MaxFileSizeMB.
+func (s *CustomField) GetMaxFileSizeMB() OptInt {
+	return s.MaxFileSizeMB
+}
+
+// GetFileTypes returns the value of FileTypes.
+func (s *CustomField) GetFileTypes() []string {
+	return s.FileTypes
+}
+
 // SetName sets the value of Name.
 func (s *CustomField) SetName(val string) {
 	s.Name = val
@@ -1027,16 +1663,40 @@ func (s *CustomField) SetOptions(val []string) {
 	s.Options = val
 }
 
-// 1=text, 2=email, 3=phone, 4=select, 5=textarea.
+// SetPattern sets the value of Pattern.
+func (s *CustomField) SetPattern(val OptString) {
+	s.Pattern = val
+}
+
+// SetMaxFileSizeMB sets the value of MaxFileSizeMB.
+func (s *CustomField) SetMaxFileSizeMB(val OptInt) {
+	s.MaxFileSizeMB = val
+}
+
+// SetFileTypes sets the value of FileTypes.
+func (s *CustomField) SetFileTypes(val []string) {
+	s.FileTypes = val
+}
+
+// 1=text, 2=email, 3=phone, 4=select, 5=textarea, 6=checkbox, 7=number, 8=date, 9=multi_select,
+// 10=file. Checkbox answers are "true" or "false", dates are YYYY-MM-DD and multi-select answers are
+// the chosen options separated by commas. File fields are only available on booking links: the guest
+// uploads the file to POST /api/attachments?booking_link={slug}&field={name} first and answers with
+// the returned token.
 // Ref: #/components/schemas/CustomFieldType
 type CustomFieldType int
 
 const (
-	CustomFieldType1 CustomFieldType = 1
-	CustomFieldType2 CustomFieldType = 2
-	CustomFieldType3 CustomFieldType = 3
-	CustomFieldType4 CustomFieldType = 4
-	CustomFieldType5 CustomFieldType = 5
+	CustomFieldType1  CustomFieldType = 1
+	CustomFieldType2  CustomFieldType = 2
+	CustomFieldType3  CustomFieldType = 3
+	CustomFieldType4  CustomFieldType = 4
+	CustomFieldType5  CustomFieldType = 5
+	CustomFieldType6  CustomFieldType = 6
+	CustomFieldType7  CustomFieldType = 7
+	CustomFieldType8  CustomFieldType = 8
+	CustomFieldType9  CustomFieldType = 9
+	CustomFieldType10 CustomFieldType = 10
 )
 
 // AllValues returns all CustomFieldType values.
@@ -1047,9 +1707,17 @@ func (CustomFieldType) AllValues() []CustomFieldType {
 		CustomFieldType3,
 		CustomFieldType4,
 		CustomFieldType5,
+		CustomFieldType6,
+		CustomFieldType7,
+		CustomFieldType8,
+		CustomFieldType9,
+		CustomFieldType10,
 	}
 }
 
+// DeclinePollProposalNoContent is response for DeclinePollProposal operation.
+type DeclinePollProposalNoContent struct{}
+
 type DeclineViaEmailOK struct {
 	Message OptString `json:"message"`
 }
@@ -1069,6 +1737,17 @@ func (*DeclineViaEmailOK) declineViaEmailRes() {}
 // DeleteBookingLinkNoContent is response for DeleteBookingLink operation.
 type DeleteBookingLinkNoContent struct{}
 
+// DeleteOwnVoteNoContent is response for DeleteOwnVote operation.
+type DeleteOwnVoteNoContent struct{}
+
+func (*DeleteOwnVoteNoContent) deleteOwnVoteRes() {}
+
+// DeletePollCommentNoContent is response for DeletePollComment operation.
+type DeletePollCommentNoContent struct{}
+
+// DeletePollInviteNoContent is response for DeletePollInvite operation.
+type DeletePollInviteNoContent struct{}
+
 // DeletePollNoContent is response for DeletePoll operation.
 type DeletePollNoContent struct{}
 
@@ -1165,6 +1844,8 @@ func (s *DiscoveredCalendar) SetSupportedComponents(val []string) {
 // Ref: #/components/schemas/Error
 type Error struct {
 	Message string `json:"message"`
+	// Problems with individual custom field answers.
+	FieldErrors []FieldError `json:"field_errors"`
 }
 
 // GetMessage returns the value of Message.
@@ -1172,22 +1853,56 @@ func (s *Error) GetMessage() string {
 	return s.Message
 }
 
+// GetFieldErrors returns the value of FieldErrors.
+func (s *Error) GetFieldErrors() []FieldError {
+	return s.FieldErrors
+}
+
 // SetMessage sets the value of Message.
 func (s *Error) SetMessage(val string) {
 	s.Message = val
 }
 
-func (*Error) approveViaEmailRes()      {}
-func (*Error) authCallbackRes()         {}
-func (*Error) createBookingRes()        {}
-func (*Error) declineViaEmailRes()      {}
-func (*Error) getCurrentUserRes()       {}
-func (*Error) getPollResultsRes()       {}
-func (*Error) getPublicBookingLinkRes() {}
-func (*Error) getPublicPollRes()        {}
-func (*Error) testCalendarRes()         {}
-func (*Error) updateCurrentUserRes()    {}
-
+// SetFieldErrors sets the value of FieldErrors.
+func (s *Error) SetFieldErrors(val []FieldError) {
+	s.FieldErrors = val
+}
+
+func (*Error) addPollCommentRes()        {}
+func (*Error) addPollInvitesRes()        {}
+func (*Error) approvePollProposalRes()   {}
+func (*Error) approveViaEmailRes()       {}
+func (*Error) authCallbackRes()          {}
+func (*Error) autofillVoteRes()          {}
+func (*Error) createBookingLinkRes()     {}
+func (*Error) createBookingRes()         {}
+func (*Error) createPollRes()            {}
+func (*Error) declineViaEmailRes()       {}
+func (*Error) deleteOwnVoteRes()         {}
+func (*Error) generatePollOptionsRes()   {}
+func (*Error) getCurrentUserRes()        {}
+func (*Error) getOwnVoteRes()            {}
+func (*Error) getPollResultsRes()        {}
+func (*Error) getPublicBookingLinkRes()  {}
+func (*Error) getPublicPollCommentsRes() {}
+func (*Error) getPublicPollRes()         {}
+func (*Error) pickPollWinnerRes()        {}
+func (*Error) proposePollOptionRes()     {}
+func (*Error) submitVoteRes()            {}
+func (*Error) suggestPollOptionsRes()    {}
+func (*Error) testCalendarRes()          {}
+func (*Error) updateBookingLinkRes()     {}
+func (*Error) updateCurrentUserRes()     {}
+func (*Error) updateOwnVoteRes()         {}
+func (*Error) updatePollRes()            {}
+func (*Error) verifyBookingEmailRes()    {}
+
+// Templates use Go text/template syntax. Available values: {{guest_name}}, {{guest_email}},
+// {{meeting_link}}, {{link_name}}, {{organizer_name}}, {{organizer_email}}, {{start_date}},
+// {{start_time}}, {{start_datetime}}, {{start_iso}}, {{end_date}}, {{end_time}}, {{end_datetime}},
+// {{end_iso}}, {{duration_minutes}}, {{field "name"}} for custom field answers and {{format start_at
+// "2006-01-02 15:04"}} for custom date formats. For polls the guest values and custom fields are
+// empty and {{link_name}} is the poll name.
 // Ref: #/components/schemas/EventTemplate
 type EventTemplate struct {
 	TitleTemplate       OptString `json:"title_template"`
@@ -1225,6 +1940,182 @@ func (s *EventTemplate) SetLocation(val OptString) {
 	s.Location = val
 }
 
+// Ref: #/components/schemas/FieldError
+type FieldError struct {
+	// Name of the custom field.
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+// GetField returns the value of Field.
+func (s *FieldError) GetField() string {
+	return s.Field
+}
+
+// GetMessage returns the value of Message.
+func (s *FieldError) GetMessage() string {
+	return s.Message
+}
+
+// SetField sets the value of Field.
+func (s *FieldError) SetField(val string) {
+	s.Field = val
+}
+
+// SetMessage sets the value of Message.
+func (s *FieldError) SetMessage(val string) {
+	s.Message = val
+}
+
+type GeneratePollOptionsOK struct {
+	Candidates []PollOptionCandidate `json:"candidates"`
+	// Number of options the poll already offers.
+	Skipped int `json:"skipped"`
+	// Options created when add is set.
+	Added []PollOption `json:"added"`
+}
+
+// GetCandidates returns the value of Candidates.
+func (s *GeneratePollOptionsOK) GetCandidates() []PollOptionCandidate {
+	return s.Candidates
+}
+
+// GetSkipped returns the value of Skipped.
+func (s *GeneratePollOptionsOK) GetSkipped() int {
+	return s.Skipped
+}
+
+// GetAdded returns the value of Added.
+func (s *GeneratePollOptionsOK) GetAdded() []PollOption {
+	return s.Added
+}
+
+// SetCandidates sets the value of Candidates.
+func (s *GeneratePollOptionsOK) SetCandidates(val []PollOptionCandidate) {
+	s.Candidates = val
+}
+
+// SetSkipped sets the value of Skipped.
+func (s *GeneratePollOptionsOK) SetSkipped(val int) {
+	s.Skipped = val
+}
+
+// SetAdded sets the value of Added.
+func (s *GeneratePollOptionsOK) SetAdded(val []PollOption) {
+	s.Added = val
+}
+
+func (*GeneratePollOptionsOK) generatePollOptionsRes() {}
+
+type GeneratePollOptionsReq struct {
+	Type      SlotType  `json:"type"`
+	StartDate time.Time `json:"start_date"`
+	// Last day an option may start on.
+	EndDate time.Time `json:"end_date"`
+	// 0=Sunday ... 6=Saturday, defaults to every day.
+	DaysOfWeek []int `json:"days_of_week"`
+	// Start times of time options.
+	Times []string `json:"times"`
+	// Length of time options.
+	DurationMinutes OptInt `json:"duration_minutes"`
+	// Number of days of multi-day options.
+	SpanDays OptInt `json:"span_days"`
+	// IANA time zone of the times, e.g. Europe/Berlin. Defaults to UTC.
+	TimeZone OptString `json:"time_zone"`
+	// Add the generated options to the poll.
+	Add OptBool `json:"add"`
+}
+
+// GetType returns the value of Type.
+func (s *GeneratePollOptionsReq) GetType() SlotType {
+	return s.Type
+}
+
+// GetStartDate returns the value of StartDate.
+func (s *GeneratePollOptionsReq) GetStartDate() time.Time {
+	return s.StartDate
+}
+
+// GetEndDate returns the value of EndDate.
+func (s *GeneratePollOptionsReq) GetEndDate() time.Time {
+	return s.EndDate
+}
+
+// GetDaysOfWeek returns the value of DaysOfWeek.
+func (s *GeneratePollOptionsReq) GetDaysOfWeek() []int {
+	return s.DaysOfWeek
+}
+
+// GetTimes returns the value of Times.
+func (s *GeneratePollOptionsReq) GetTimes() []string {
+	return s.Times
+}
+
+// GetDurationMinutes returns the value of DurationMinutes.
+func (s *GeneratePollOptionsReq) GetDurationMinutes() OptInt {
+	return s.DurationMinutes
+}
+
+// GetSpanDays returns the value of SpanDays.
+func (s *GeneratePollOptionsReq) GetSpanDays() OptInt {
+	return s.SpanDays
+}
+
+// GetTimeZone returns the value of TimeZone.
+func (s *GeneratePollOptionsReq) GetTimeZone() OptString {
+	return s.TimeZone
+}
+
+// GetAdd returns the value of Add.
+func (s *GeneratePollOptionsReq) GetAdd() OptBool {
+	return s.Add
+}
+
+// SetType sets the value of Type.
+func (s *GeneratePollOptionsReq) SetType(val SlotType) {
+	s.Type = val
+}
+
+// SetStartDate sets the value of StartDate.
+func (s *GeneratePollOptionsReq) SetStartDate(val time.Time) {
+	s.StartDate = val
+}
+
+// SetEndDate sets the value of EndDate.
+func (s *GeneratePollOptionsReq) SetEndDate(val time.Time) {
+	s.EndDate = val
+}
+
+// SetDaysOfWeek sets the value of DaysOfWeek.
+func (s *GeneratePollOptionsReq) SetDaysOfWeek(val []int) {
+	s.DaysOfWeek = val
+}
+
+// SetTimes sets the value of Times.
+func (s *GeneratePollOptionsReq) SetTimes(val []string) {
+	s.Times = val
+}
+
+// SetDurationMinutes sets the value of DurationMinutes.
+func (s *GeneratePollOptionsReq) SetDurationMinutes(val OptInt) {
+	s.DurationMinutes = val
+}
+
+// SetSpanDays sets the value of SpanDays.
+func (s *GeneratePollOptionsReq) SetSpanDays(val OptInt) {
+	s.SpanDays = val
+}
+
+// SetTimeZone sets the value of TimeZone.
+func (s *GeneratePollOptionsReq) SetTimeZone(val OptString) {
+	s.TimeZone = val
+}
+
+// SetAdd sets the value of Add.
+func (s *GeneratePollOptionsReq) SetAdd(val OptBool) {
+	s.Add = val
+}
+
 type GetBookingAvailabilityOK struct {
 	Slots []Slot `json:"slots"`
 }
@@ -1240,8 +2131,11 @@ func (s *GetBookingAvailabilityOK) SetSlots(val []Slot) {
 }
 
 type GetPollResultsOK struct {
-	Tally []VoteTally `json:"tally"`
-	Votes []Vote      `json:"votes"`
+	// Options ranked from best to worst.
+	Tally               []VoteTally `json:"tally"`
+	RecommendedOptionID OptInt      `json:"recommended_option_id"`
+	// Omitted when only aggregate results are shown.
+	Votes []Vote `json:"votes"`
 }
 
 // GetTally returns the value of Tally.
@@ -1249,6 +2143,11 @@ func (s *GetPollResultsOK) GetTally() []VoteTally {
 	return s.Tally
 }
 
+// GetRecommendedOptionID returns the value of RecommendedOptionID.
+func (s *GetPollResultsOK) GetRecommendedOptionID() OptInt {
+	return s.RecommendedOptionID
+}
+
 // GetVotes returns the value of Votes.
 func (s *GetPollResultsOK) GetVotes() []Vote {
 	return s.Votes
@@ -1259,6 +2158,11 @@ func (s *GetPollResultsOK) SetTally(val []VoteTally) {
 	s.Tally = val
 }
 
+// SetRecommendedOptionID sets the value of RecommendedOptionID.
+func (s *GetPollResultsOK) SetRecommendedOptionID(val OptInt) {
+	s.RecommendedOptionID = val
+}
+
 // SetVotes sets the value of Votes.
 func (s *GetPollResultsOK) SetVotes(val []Vote) {
 	s.Votes = val
@@ -1276,7 +2180,8 @@ type GetPublicBookingLinkOK struct {
 	// Display name of the organizer.
 	OrganizerName OptString `json:"organizer_name"`
 	// URL to the organizer's avatar image.
-	OrganizerAvatarURL OptString `json:"organizer_avatar_url"`
+	OrganizerAvatarURL OptString   `json:"organizer_avatar_url"`
+	Branding           OptBranding `json:"branding"`
 }
 
 // GetName returns the value of Name.
@@ -1314,6 +2219,11 @@ func (s *GetPublicBookingLinkOK) GetOrganizerAvatarURL() OptString {
 	return s.OrganizerAvatarURL
 }
 
+// GetBranding returns the value of Branding.
+func (s *GetPublicBookingLinkOK) GetBranding() OptBranding {
+	return s.Branding
+}
+
 // SetName sets the value of Name.
 func (s *GetPublicBookingLinkOK) SetName(val string) {
 	s.Name = val
@@ -1349,19 +2259,43 @@ func (s *GetPublicBookingLinkOK) SetOrganizerAvatarURL(val OptString) {
 	s.OrganizerAvatarURL = val
 }
 
+// SetBranding sets the value of Branding.
+func (s *GetPublicBookingLinkOK) SetBranding(val OptBranding) {
+	s.Branding = val
+}
+
 func (*GetPublicBookingLinkOK) getPublicBookingLinkRes() {}
 
+type GetPublicPollCommentsOKApplicationJSON []PollComment
+
+func (*GetPublicPollCommentsOKApplicationJSON) getPublicPollCommentsRes() {}
+
 type GetPublicPollOK struct {
-	Name         string        `json:"name"`
-	Description  OptString     `json:"description"`
-	CustomFields []CustomField `json:"custom_fields"`
-	Options      []PollOption  `json:"options"`
-	ShowResults  OptBool       `json:"show_results"`
-	RequireEmail OptBool       `json:"require_email"`
+	Name              string               `json:"name"`
+	Description       OptString            `json:"description"`
+	CustomFields      []CustomField        `json:"custom_fields"`
+	Options           []PollOption         `json:"options"`
+	ShowResults       OptBool              `json:"show_results"`
+	ResultsVisibility OptResultsVisibility `json:"results_visibility"`
+	ResultsTiming     OptResultsTiming     `json:"results_timing"`
+	RequireEmail      OptBool              `json:"require_email"`
+	Mode              OptPollMode          `json:"mode"`
+	MaxChoices        OptInt               `json:"max_choices"`
+	OptionProposals   OptProposalMode      `json:"option_proposals"`
 	// Display name of the organizer.
 	OrganizerName OptString `json:"organizer_name"`
 	// URL to the organizer's avatar image.
-	OrganizerAvatarURL OptString `json:"organizer_avatar_url"`
+	OrganizerAvatarURL OptString   `json:"organizer_avatar_url"`
+	Branding           OptBranding `json:"branding"`
+	// Voting closes at this time.
+	Deadline OptDateTime `json:"deadline"`
+	// Voting has closed, at the deadline or by the organizer. The poll can still be viewed but no longer
+	// takes votes, comments or proposals.
+	Closed OptBool `json:"closed"`
+	// Option picked as winner, set once the poll is decided.
+	WinnerOptionID OptInt `json:"winner_option_id"`
+	// The invited participant, when opened through a personal link.
+	Invitee OptGetPublicPollOKInvitee `json:"invitee"`
 }
 
 // GetName returns the value of Name.
@@ -1389,11 +2323,36 @@ func (s *GetPublicPollOK) GetShowResults() OptBool {
 	return s.ShowResults
 }
 
+// GetResultsVisibility returns the value of ResultsVisibility.
+func (s *GetPublicPollOK) GetResultsVisibility() OptResultsVisibility {
+	return s.ResultsVisibility
+}
+
+// GetResultsTiming returns the value of ResultsTiming.
+func (s *GetPublicPollOK) GetResultsTiming() OptResultsTiming {
+	return s.ResultsTiming
+}
+
 // GetRequireEmail returns the value of RequireEmail.
 func (s *GetPublicPollOK) GetRequireEmail() OptBool {
 	return s.RequireEmail
 }
 
+// GetMode returns the value of Mode.
+func (s *GetPublicPollOK) GetMode() OptPollMode {
+	return s.Mode
+}
+
+// GetMaxChoices returns the value of MaxChoices.
+func (s *GetPublicPollOK) GetMaxChoices() OptInt {
+	return s.MaxChoices
+}
+
+// GetOptionProposals returns the value of OptionProposals.
+func (s *GetPublicPollOK) GetOptionProposals() OptProposalMode {
+	return s.OptionProposals
+}
+
 // GetOrganizerName returns the value of OrganizerName.
 func (s *GetPublicPollOK) GetOrganizerName() OptString {
 	return s.OrganizerName
@@ -1404,6 +2363,31 @@ func (s *GetPublicPollOK) GetOrganizerAvatarURL() OptString {
 	return s.OrganizerAvatarURL
 }
 
+// GetBranding returns the value of Branding.
+func (s *GetPublicPollOK) GetBranding() OptBranding {
+	return s.Branding
+}
+
+// GetDeadline returns the value of Deadline.
+func (s *GetPublicPollOK) GetDeadline() OptDateTime {
+	return s.Deadline
+}
+
+// GetClosed returns the value of Closed.
+func (s *GetPublicPollOK) GetClosed() OptBool {
+	return s.Closed
+}
+
+// GetWinnerOptionID returns the value of WinnerOptionID.
+func (s *GetPublicPollOK) GetWinnerOptionID() OptInt {
+	return s.WinnerOptionID
+}
+
+// GetInvitee returns the value of Invitee.
+func (s *GetPublicPollOK) GetInvitee() OptGetPublicPollOKInvitee {
+	return s.Invitee
+}
+
 // SetName sets the value of Name.
 func (s *GetPublicPollOK) SetName(val string) {
 	s.Name = val
@@ -1429,11 +2413,36 @@ func (s *GetPublicPollOK) SetShowResults(val OptBool) {
 	s.ShowResults = val
 }
 
+// SetResultsVisibility sets the value of ResultsVisibility.
+func (s *GetPublicPollOK) SetResultsVisibility(val OptResultsVisibility) {
+	s.ResultsVisibility = val
+}
+
+// SetResultsTiming sets the value of ResultsTiming.
+func (s *GetPublicPollOK) SetResultsTiming(val OptResultsTiming) {
+	s.ResultsTiming = val
+}
+
 // SetRequireEmail sets the value of RequireEmail.
 func (s *GetPublicPollOK) SetRequireEmail(val OptBool) {
 	s.RequireEmail = val
 }
 
+// SetMode sets the value of Mode.
+func (s *GetPublicPollOK) SetMode(val OptPollMode) {
+	s.Mode = val
+}
+
+// SetMaxChoices sets the value of MaxChoices.
+func (s *GetPublicPollOK) SetMaxChoices(val OptInt) {
+	s.MaxChoices = val
+}
+
+// SetOptionProposals sets the value of OptionProposals.
+func (s *GetPublicPollOK) SetOptionProposals(val OptProposalMode) {
+	s.OptionProposals = val
+}
+
 // SetOrganizerName sets the value of OrganizerName.
 func (s *GetPublicPollOK) SetOrganizerName(val OptString) {
 	s.OrganizerName = val
@@ -1444,8 +2453,71 @@ func (s *GetPublicPollOK) SetOrganizerAvatarURL(val OptString) {
 	s.OrganizerAvatarURL = val
 }
 
+// SetBranding sets the value of Branding.
+func (s *GetPublicPollOK) SetBranding(val OptBranding) {
+	s.Branding = val
+}
+
+// SetDeadline sets the value of Deadline.
+func (s *GetPublicPollOK) SetDeadline(val OptDateTime) {
+	s.Deadline = val
+}
+
+// SetClosed sets the value of Closed.
+func (s *GetPublicPollOK) SetClosed(val OptBool) {
+	s.Closed = val
+}
+
+// SetWinnerOptionID sets the value of WinnerOptionID.
+func (s *GetPublicPollOK) SetWinnerOptionID(val OptInt) {
+	s.WinnerOptionID = val
+}
+
+// SetInvitee sets the value of Invitee.
+func (s *GetPublicPollOK) SetInvitee(val OptGetPublicPollOKInvitee) {
+	s.Invitee = val
+}
+
 func (*GetPublicPollOK) getPublicPollRes() {}
 
+// The invited participant, when opened through a personal link.
+type GetPublicPollOKInvitee struct {
+	Email string    `json:"email"`
+	Name  OptString `json:"name"`
+	// Edit token of the invitee's vote, if they already voted.
+	EditToken OptString `json:"edit_token"`
+}
+
+// GetEmail returns the value of Email.
+func (s *GetPublicPollOKInvitee) GetEmail() string {
+	return s.Email
+}
+
+// GetName returns the value of Name.
+func (s *GetPublicPollOKInvitee) GetName() OptString {
+	return s.Name
+}
+
+// GetEditToken returns the value of EditToken.
+func (s *GetPublicPollOKInvitee) GetEditToken() OptString {
+	return s.EditToken
+}
+
+// SetEmail sets the value of Email.
+func (s *GetPublicPollOKInvitee) SetEmail(val string) {
+	s.Email = val
+}
+
+// SetName sets the value of Name.
+func (s *GetPublicPollOKInvitee) SetName(val OptString) {
+	s.Name = val
+}
+
+// SetEditToken sets the value of EditToken.
+func (s *GetPublicPollOKInvitee) SetEditToken(val OptString) {
+	s.EditToken = val
+}
+
 // InitiateLoginFound is response for InitiateLogin operation.
 type InitiateLoginFound struct {
 	Location  OptString
@@ -1472,6 +2544,43 @@ func (s *InitiateLoginFound) SetSetCookie(val OptString) {
 	s.SetCookie = val
 }
 
+// Ref: #/components/schemas/Invitee
+type Invitee struct {
+	Email    string    `json:"email"`
+	Name     OptString `json:"name"`
+	Required OptBool   `json:"required"`
+}
+
+// GetEmail returns the value of Email.
+func (s *Invitee) GetEmail() string {
+	return s.Email
+}
+
+// GetName returns the value of Name.
+func (s *Invitee) GetName() OptString {
+	return s.Name
+}
+
+// GetRequired returns the value of Required.
+func (s *Invitee) GetRequired() OptBool {
+	return s.Required
+}
+
+// SetEmail sets the value of Email.
+func (s *Invitee) SetEmail(val string) {
+	s.Email = val
+}
+
+// SetName sets the value of Name.
+func (s *Invitee) SetName(val OptString) {
+	s.Name = val
+}
+
+// SetRequired sets the value of Required.
+func (s *Invitee) SetRequired(val OptBool) {
+	s.Required = val
+}
+
 // 1=active, 2=closed.
 // Ref: #/components/schemas/LinkStatus
 type LinkStatus int
@@ -1492,38 +2601,52 @@ func (LinkStatus) AllValues() []LinkStatus {
 // LogoutOK is response for Logout operation.
 type LogoutOK struct{}
 
-// NewOptBookingCustomFields returns new OptBookingCustomFields with value set to v.
-func NewOptBookingCustomFields(v BookingCustomFields) OptBookingCustomFields {
-	return OptBookingCustomFields{
+type ModeratePollCommentReq struct {
+	Hidden bool `json:"hidden"`
+}
+
+// GetHidden returns the value of Hidden.
+func (s *ModeratePollCommentReq) GetHidden() bool {
+	return s.Hidden
+}
+
+// SetHidden sets the value of Hidden.
+func (s *ModeratePollCommentReq) SetHidden(val bool) {
+	s.Hidden = val
+}
+
+// NewOptApprovalTimeoutAction returns new OptApprovalTimeoutAction with value set to v.
+func NewOptApprovalTimeoutAction(v ApprovalTimeoutAction) OptApprovalTimeoutAction {
+	return OptApprovalTimeoutAction{
 		Value: v,
 		Set:   true,
 	}
 }
 
-// OptBookingCustomFields is optional BookingCustomFields.
-type OptBookingCustomFields struct {
-	Value BookingCustomFields
+// OptApprovalTimeoutAction is optional ApprovalTimeoutAction.
+type OptApprovalTimeoutAction struct {
+	Value ApprovalTimeoutAction
 	Set   bool
 }
 
-// IsSet returns true if OptBookingCustomFields was set.
-func (o OptBookingCustomFields) IsSet() bool { return o.Set }
+// IsSet returns true if OptApprovalTimeoutAction was set.
+func (o OptApprovalTimeoutAction) IsSet() bool { return o.Set }
 
 // Reset unsets value.
-func (o *OptBookingCustomFields) Reset() {
-	var v BookingCustomFields
+func (o *OptApprovalTimeoutAction) Reset() {
+	var v ApprovalTimeoutAction
 	o.Value = v
 	o.Set = false
 }
 
 // SetTo sets value to v.
-func (o *OptBookingCustomFields) SetTo(v BookingCustomFields) {
+func (o *OptApprovalTimeoutAction) SetTo(v ApprovalTimeoutAction) {
 	o.Set = true
 	o.Value = v
 }
 
 // Get returns value and boolean that denotes whether value was set.
-func (o OptBookingCustomFields) Get() (v BookingCustomFields, ok bool) {
+func (o OptApprovalTimeoutAction) Get() (v ApprovalTimeoutAction, ok bool) {
 	if !o.Set {
 		return v, false
 	}
@@ -1531,15 +2654,107 @@ func (o OptBookingCustomFields) Get() (v BookingCustomFields, ok bool) {
 }
 
 // Or returns value if set, or given parameter if does not.
-func (o OptBookingCustomFields) Or(d BookingCustomFields) BookingCustomFields {
+func (o OptApprovalTimeoutAction) Or(d ApprovalTimeoutAction) ApprovalTimeoutAction {
 	if v, ok := o.Get(); ok {
 		return v
 	}
 	return d
 }
 
-// NewOptBool returns new OptBool with value set to v.
-func NewOptBool(v bool) OptBool {
+// NewOptAutoConfirmRules returns new OptAutoConfirmRules with value set to v.
+func NewOptAutoConfirmRules(v AutoConfirmRules) OptAutoConfirmRules {
+	return OptAutoConfirmRules{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptAutoConfirmRules is optional AutoConfirmRules.
+type OptAutoConfirmRules struct {
+	Value AutoConfirmRules
+	Set   bool
+}
+
+// IsSet returns true if OptAutoConfirmRules was set.
+func (o OptAutoConfirmRules) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptAutoConfirmRules) Reset() {
+	var v AutoConfirmRules
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptAutoConfirmRules) SetTo(v AutoConfirmRules) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptAutoConfirmRules) Get() (v AutoConfirmRules, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptAutoConfirmRules) Or(d AutoConfirmRules) AutoConfirmRules {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptBookingCustomFields returns new OptBookingCustomFields with value set to v.
+func NewOptBookingCustomFields(v BookingCustomFields) OptBookingCustomFields {
+	return OptBookingCustomFields{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptBookingCustomFields is optional BookingCustomFields.
+type OptBookingCustomFields struct {
+	Value BookingCustomFields
+	Set   bool
+}
+
+// IsSet returns true if OptBookingCustomFields was set.
+func (o OptBookingCustomFields) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptBookingCustomFields) Reset() {
+	var v BookingCustomFields
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptBookingCustomFields) SetTo(v BookingCustomFields) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptBookingCustomFields) Get() (v BookingCustomFields, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptBookingCustomFields) Or(d BookingCustomFields) BookingCustomFields {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptBool returns new OptBool with value set to v.
+func NewOptBool(v bool) OptBool {
 	return OptBool{
 		Value: v,
 		Set:   true,
@@ -1584,6 +2799,52 @@ func (o OptBool) Or(d bool) bool {
 	return d
 }
 
+// NewOptBranding returns new OptBranding with value set to v.
+func NewOptBranding(v Branding) OptBranding {
+	return OptBranding{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptBranding is optional Branding.
+type OptBranding struct {
+	Value Branding
+	Set   bool
+}
+
+// IsSet returns true if OptBranding was set.
+func (o OptBranding) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptBranding) Reset() {
+	var v Branding
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptBranding) SetTo(v Branding) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptBranding) Get() (v Branding, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptBranding) Or(d Branding) Branding {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
 // NewOptCreateBookingReqCustomFields returns new OptCreateBookingReqCustomFields with value set to v.
 func NewOptCreateBookingReqCustomFields(v CreateBookingReqCustomFields) OptCreateBookingReqCustomFields {
 	return OptCreateBookingReqCustomFields{
@@ -1722,6 +2983,52 @@ func (o OptEventTemplate) Or(d EventTemplate) EventTemplate {
 	return d
 }
 
+// NewOptGetPublicPollOKInvitee returns new OptGetPublicPollOKInvitee with value set to v.
+func NewOptGetPublicPollOKInvitee(v GetPublicPollOKInvitee) OptGetPublicPollOKInvitee {
+	return OptGetPublicPollOKInvitee{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptGetPublicPollOKInvitee is optional GetPublicPollOKInvitee.
+type OptGetPublicPollOKInvitee struct {
+	Value GetPublicPollOKInvitee
+	Set   bool
+}
+
+// IsSet returns true if OptGetPublicPollOKInvitee was set.
+func (o OptGetPublicPollOKInvitee) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptGetPublicPollOKInvitee) Reset() {
+	var v GetPublicPollOKInvitee
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptGetPublicPollOKInvitee) SetTo(v GetPublicPollOKInvitee) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptGetPublicPollOKInvitee) Get() (v GetPublicPollOKInvitee, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptGetPublicPollOKInvitee) Or(d GetPublicPollOKInvitee) GetPublicPollOKInvitee {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
 // NewOptInt returns new OptInt with value set to v.
 func NewOptInt(v int) OptInt {
 	return OptInt{
@@ -1814,38 +3121,55 @@ func (o OptLinkStatus) Or(d LinkStatus) LinkStatus {
 	return d
 }
 
-// NewOptString returns new OptString with value set to v.
-func NewOptString(v string) OptString {
-	return OptString{
+// NewOptNilDateTime returns new OptNilDateTime with value set to v.
+func NewOptNilDateTime(v time.Time) OptNilDateTime {
+	return OptNilDateTime{
 		Value: v,
 		Set:   true,
 	}
 }
 
-// OptString is optional string.
-type OptString struct {
-	Value string
+// OptNilDateTime is optional nullable time.Time.
+type OptNilDateTime struct {
+	Value time.Time
 	Set   bool
+	Null  bool
 }
 
-// IsSet returns true if OptString was set.
-func (o OptString) IsSet() bool { return o.Set }
+// IsSet returns true if OptNilDateTime was set.
+func (o OptNilDateTime) IsSet() bool { return o.Set }
 
 // Reset unsets value.
-func (o *OptString) Reset() {
-	var v string
+func (o *OptNilDateTime) Reset() {
+	var v time.Time
 	o.Value = v
 	o.Set = false
+	o.Null = false
 }
 
 // SetTo sets value to v.
-func (o *OptString) SetTo(v string) {
+func (o *OptNilDateTime) SetTo(v time.Time) {
 	o.Set = true
+	o.Null = false
+	o.Value = v
+}
+
+// IsNull returns true if value is Null.
+func (o OptNilDateTime) IsNull() bool { return o.Null }
+
+// SetToNull sets value to null.
+func (o *OptNilDateTime) SetToNull() {
+	o.Set = true
+	o.Null = true
+	var v time.Time
 	o.Value = v
 }
 
 // Get returns value and boolean that denotes whether value was set.
-func (o OptString) Get() (v string, ok bool) {
+func (o OptNilDateTime) Get() (v time.Time, ok bool) {
+	if o.Null {
+		return v, false
+	}
 	if !o.Set {
 		return v, false
 	}
@@ -1853,45 +3177,45 @@ func (o OptString) Get() (v string, ok bool) {
 }
 
 // Or returns value if set, or given parameter if does not.
-func (o OptString) Or(d string) string {
+func (o OptNilDateTime) Or(d time.Time) time.Time {
 	if v, ok := o.Get(); ok {
 		return v
 	}
 	return d
 }
 
-// NewOptSubmitVoteReqCustomFields returns new OptSubmitVoteReqCustomFields with value set to v.
-func NewOptSubmitVoteReqCustomFields(v SubmitVoteReqCustomFields) OptSubmitVoteReqCustomFields {
-	return OptSubmitVoteReqCustomFields{
+// NewOptPollMode returns new OptPollMode with value set to v.
+func NewOptPollMode(v PollMode) OptPollMode {
+	return OptPollMode{
 		Value: v,
 		Set:   true,
 	}
 }
 
-// OptSubmitVoteReqCustomFields is optional SubmitVoteReqCustomFields.
-type OptSubmitVoteReqCustomFields struct {
-	Value SubmitVoteReqCustomFields
+// OptPollMode is optional PollMode.
+type OptPollMode struct {
+	Value PollMode
 	Set   bool
 }
 
-// IsSet returns true if OptSubmitVoteReqCustomFields was set.
-func (o OptSubmitVoteReqCustomFields) IsSet() bool { return o.Set }
+// IsSet returns true if OptPollMode was set.
+func (o OptPollMode) IsSet() bool { return o.Set }
 
 // Reset unsets value.
-func (o *OptSubmitVoteReqCustomFields) Reset() {
-	var v SubmitVoteReqCustomFields
+func (o *OptPollMode) Reset() {
+	var v PollMode
 	o.Value = v
 	o.Set = false
 }
 
 // SetTo sets value to v.
-func (o *OptSubmitVoteReqCustomFields) SetTo(v SubmitVoteReqCustomFields) {
+func (o *OptPollMode) SetTo(v PollMode) {
 	o.Set = true
 	o.Value = v
 }
 
 // Get returns value and boolean that denotes whether value was set.
-func (o OptSubmitVoteReqCustomFields) Get() (v SubmitVoteReqCustomFields, ok bool) {
+func (o OptPollMode) Get() (v PollMode, ok bool) {
 	if !o.Set {
 		return v, false
 	}
@@ -1899,45 +3223,45 @@ func (o OptSubmitVoteReqCustomFields) Get() (v SubmitVoteReqCustomFields, ok boo
 }
 
 // Or returns value if set, or given parameter if does not.
-func (o OptSubmitVoteReqCustomFields) Or(d SubmitVoteReqCustomFields) SubmitVoteReqCustomFields {
+func (o OptPollMode) Or(d PollMode) PollMode {
 	if v, ok := o.Get(); ok {
 		return v
 	}
 	return d
 }
 
-// NewOptVoteCustomFields returns new OptVoteCustomFields with value set to v.
-func NewOptVoteCustomFields(v VoteCustomFields) OptVoteCustomFields {
-	return OptVoteCustomFields{
+// NewOptPollOption returns new OptPollOption with value set to v.
+func NewOptPollOption(v PollOption) OptPollOption {
+	return OptPollOption{
 		Value: v,
 		Set:   true,
 	}
 }
 
-// OptVoteCustomFields is optional VoteCustomFields.
-type OptVoteCustomFields struct {
-	Value VoteCustomFields
+// OptPollOption is optional PollOption.
+type OptPollOption struct {
+	Value PollOption
 	Set   bool
 }
 
-// IsSet returns true if OptVoteCustomFields was set.
-func (o OptVoteCustomFields) IsSet() bool { return o.Set }
+// IsSet returns true if OptPollOption was set.
+func (o OptPollOption) IsSet() bool { return o.Set }
 
 // Reset unsets value.
-func (o *OptVoteCustomFields) Reset() {
-	var v VoteCustomFields
+func (o *OptPollOption) Reset() {
+	var v PollOption
 	o.Value = v
 	o.Set = false
 }
 
 // SetTo sets value to v.
-func (o *OptVoteCustomFields) SetTo(v VoteCustomFields) {
+func (o *OptPollOption) SetTo(v PollOption) {
 	o.Set = true
 	o.Value = v
 }
 
 // Get returns value and boolean that denotes whether value was set.
-func (o OptVoteCustomFields) Get() (v VoteCustomFields, ok bool) {
+func (o OptPollOption) Get() (v PollOption, ok bool) {
 	if !o.Set {
 		return v, false
 	}
@@ -1945,131 +3269,863 @@ func (o OptVoteCustomFields) Get() (v VoteCustomFields, ok bool) {
 }
 
 // Or returns value if set, or given parameter if does not.
-func (o OptVoteCustomFields) Or(d VoteCustomFields) VoteCustomFields {
+func (o OptPollOption) Or(d PollOption) PollOption {
 	if v, ok := o.Get(); ok {
 		return v
 	}
 	return d
 }
 
-// PickPollWinnerOK is response for PickPollWinner operation.
-type PickPollWinnerOK struct{}
-
-type PickPollWinnerReq struct {
-	OptionID int `json:"option_id"`
+// NewOptPollScoring returns new OptPollScoring with value set to v.
+func NewOptPollScoring(v PollScoring) OptPollScoring {
+	return OptPollScoring{
+		Value: v,
+		Set:   true,
+	}
 }
 
-// GetOptionID returns the value of OptionID.
-func (s *PickPollWinnerReq) GetOptionID() int {
-	return s.OptionID
+// OptPollScoring is optional PollScoring.
+type OptPollScoring struct {
+	Value PollScoring
+	Set   bool
 }
 
-// SetOptionID sets the value of OptionID.
-func (s *PickPollWinnerReq) SetOptionID(val int) {
-	s.OptionID = val
-}
+// IsSet returns true if OptPollScoring was set.
+func (o OptPollScoring) IsSet() bool { return o.Set }
 
-// Ref: #/components/schemas/Poll
-type Poll struct {
-	ID           int           `json:"id"`
-	Slug         string        `json:"slug"`
-	Name         string        `json:"name"`
-	Description  OptString     `json:"description"`
-	Status       LinkStatus    `json:"status"`
-	ShowResults  OptBool       `json:"show_results"`
-	RequireEmail OptBool       `json:"require_email"`
-	CustomFields []CustomField `json:"custom_fields"`
-	CreatedAt    OptDateTime   `json:"created_at"`
+// Reset unsets value.
+func (o *OptPollScoring) Reset() {
+	var v PollScoring
+	o.Value = v
+	o.Set = false
 }
 
-// GetID returns the value of ID.
-func (s *Poll) GetID() int {
-	return s.ID
+// SetTo sets value to v.
+func (o *OptPollScoring) SetTo(v PollScoring) {
+	o.Set = true
+	o.Value = v
 }
 
-// GetSlug returns the value of Slug.
-func (s *Poll) GetSlug() string {
-	return s.Slug
+// Get returns value and boolean that denotes whether value was set.
+func (o OptPollScoring) Get() (v PollScoring, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
 }
 
-// GetName returns the value of Name.
-func (s *Poll) GetName() string {
-	return s.Name
+// Or returns value if set, or given parameter if does not.
+func (o OptPollScoring) Or(d PollScoring) PollScoring {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
 }
 
-// GetDescription returns the value of Description.
-func (s *Poll) GetDescription() OptString {
-	return s.Description
+// NewOptProposalMode returns new OptProposalMode with value set to v.
+func NewOptProposalMode(v ProposalMode) OptProposalMode {
+	return OptProposalMode{
+		Value: v,
+		Set:   true,
+	}
 }
 
-// GetStatus returns the value of Status.
-func (s *Poll) GetStatus() LinkStatus {
-	return s.Status
+// OptProposalMode is optional ProposalMode.
+type OptProposalMode struct {
+	Value ProposalMode
+	Set   bool
 }
 
-// GetShowResults returns the value of ShowResults.
-func (s *Poll) GetShowResults() OptBool {
-	return s.ShowResults
+// IsSet returns true if OptProposalMode was set.
+func (o OptProposalMode) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptProposalMode) Reset() {
+	var v ProposalMode
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptProposalMode) SetTo(v ProposalMode) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptProposalMode) Get() (v ProposalMode, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptProposalMode) Or(d ProposalMode) ProposalMode {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptResultsTiming returns new OptResultsTiming with value set to v.
+func NewOptResultsTiming(v ResultsTiming) OptResultsTiming {
+	return OptResultsTiming{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptResultsTiming is optional ResultsTiming.
+type OptResultsTiming struct {
+	Value ResultsTiming
+	Set   bool
+}
+
+// IsSet returns true if OptResultsTiming was set.
+func (o OptResultsTiming) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptResultsTiming) Reset() {
+	var v ResultsTiming
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptResultsTiming) SetTo(v ResultsTiming) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptResultsTiming) Get() (v ResultsTiming, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptResultsTiming) Or(d ResultsTiming) ResultsTiming {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptResultsVisibility returns new OptResultsVisibility with value set to v.
+func NewOptResultsVisibility(v ResultsVisibility) OptResultsVisibility {
+	return OptResultsVisibility{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptResultsVisibility is optional ResultsVisibility.
+type OptResultsVisibility struct {
+	Value ResultsVisibility
+	Set   bool
+}
+
+// IsSet returns true if OptResultsVisibility was set.
+func (o OptResultsVisibility) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptResultsVisibility) Reset() {
+	var v ResultsVisibility
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptResultsVisibility) SetTo(v ResultsVisibility) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptResultsVisibility) Get() (v ResultsVisibility, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptResultsVisibility) Or(d ResultsVisibility) ResultsVisibility {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptString returns new OptString with value set to v.
+func NewOptString(v string) OptString {
+	return OptString{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptString is optional string.
+type OptString struct {
+	Value string
+	Set   bool
+}
+
+// IsSet returns true if OptString was set.
+func (o OptString) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptString) Reset() {
+	var v string
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptString) SetTo(v string) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptString) Get() (v string, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptString) Or(d string) string {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptSubmitVoteReqCustomFields returns new OptSubmitVoteReqCustomFields with value set to v.
+func NewOptSubmitVoteReqCustomFields(v SubmitVoteReqCustomFields) OptSubmitVoteReqCustomFields {
+	return OptSubmitVoteReqCustomFields{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptSubmitVoteReqCustomFields is optional SubmitVoteReqCustomFields.
+type OptSubmitVoteReqCustomFields struct {
+	Value SubmitVoteReqCustomFields
+	Set   bool
+}
+
+// IsSet returns true if OptSubmitVoteReqCustomFields was set.
+func (o OptSubmitVoteReqCustomFields) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptSubmitVoteReqCustomFields) Reset() {
+	var v SubmitVoteReqCustomFields
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptSubmitVoteReqCustomFields) SetTo(v SubmitVoteReqCustomFields) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptSubmitVoteReqCustomFields) Get() (v SubmitVoteReqCustomFields, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptSubmitVoteReqCustomFields) Or(d SubmitVoteReqCustomFields) SubmitVoteReqCustomFields {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptUpdateOwnVoteReqCustomFields returns new OptUpdateOwnVoteReqCustomFields with value set to v.
+func NewOptUpdateOwnVoteReqCustomFields(v UpdateOwnVoteReqCustomFields) OptUpdateOwnVoteReqCustomFields {
+	return OptUpdateOwnVoteReqCustomFields{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptUpdateOwnVoteReqCustomFields is optional UpdateOwnVoteReqCustomFields.
+type OptUpdateOwnVoteReqCustomFields struct {
+	Value UpdateOwnVoteReqCustomFields
+	Set   bool
+}
+
+// IsSet returns true if OptUpdateOwnVoteReqCustomFields was set.
+func (o OptUpdateOwnVoteReqCustomFields) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptUpdateOwnVoteReqCustomFields) Reset() {
+	var v UpdateOwnVoteReqCustomFields
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptUpdateOwnVoteReqCustomFields) SetTo(v UpdateOwnVoteReqCustomFields) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptUpdateOwnVoteReqCustomFields) Get() (v UpdateOwnVoteReqCustomFields, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptUpdateOwnVoteReqCustomFields) Or(d UpdateOwnVoteReqCustomFields) UpdateOwnVoteReqCustomFields {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptVoteCustomFields returns new OptVoteCustomFields with value set to v.
+func NewOptVoteCustomFields(v VoteCustomFields) OptVoteCustomFields {
+	return OptVoteCustomFields{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptVoteCustomFields is optional VoteCustomFields.
+type OptVoteCustomFields struct {
+	Value VoteCustomFields
+	Set   bool
+}
+
+// IsSet returns true if OptVoteCustomFields was set.
+func (o OptVoteCustomFields) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptVoteCustomFields) Reset() {
+	var v VoteCustomFields
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptVoteCustomFields) SetTo(v VoteCustomFields) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptVoteCustomFields) Get() (v VoteCustomFields, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptVoteCustomFields) Or(d VoteCustomFields) VoteCustomFields {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// PickPollWinnerOK is response for PickPollWinner operation.
+type PickPollWinnerOK struct{}
+
+func (*PickPollWinnerOK) pickPollWinnerRes() {}
+
+type PickPollWinnerReq struct {
+	OptionID int `json:"option_id"`
+}
+
+// GetOptionID returns the value of OptionID.
+func (s *PickPollWinnerReq) GetOptionID() int {
+	return s.OptionID
+}
+
+// SetOptionID sets the value of OptionID.
+func (s *PickPollWinnerReq) SetOptionID(val int) {
+	s.OptionID = val
+}
+
+// Ref: #/components/schemas/Poll
+type Poll struct {
+	ID          int         `json:"id"`
+	Slug        string      `json:"slug"`
+	Name        string      `json:"name"`
+	Description OptString   `json:"description"`
+	Status      LinkStatus  `json:"status"`
+	Mode        OptPollMode `json:"mode"`
+	// Sign-up sheets only, how many options a participant may choose (0 = unlimited).
+	MaxChoices        OptInt               `json:"max_choices"`
+	ShowResults       OptBool              `json:"show_results"`
+	ResultsVisibility OptResultsVisibility `json:"results_visibility"`
+	ResultsTiming     OptResultsTiming     `json:"results_timing"`
+	RequireEmail      OptBool              `json:"require_email"`
+	CustomFields      []CustomField        `json:"custom_fields"`
+	Branding          OptBranding          `json:"branding"`
+	// Voting closes automatically at this time.
+	Deadline      OptDateTime      `json:"deadline"`
+	EventTemplate OptEventTemplate `json:"event_template"`
+	// Option picked as winner, set once the poll is decided.
+	WinnerOptionID OptInt `json:"winner_option_id"`
+	// Hours before the deadline to remind invitees who haven't voted yet, 0 disables reminders.
+	ReminderHours OptInt         `json:"reminder_hours"`
+	Scoring       OptPollScoring `json:"scoring"`
+	// Pick the recommended option as winner when the poll closes.
+	AutoPickOnClose OptBool `json:"auto_pick_on_close"`
+	// Pick the winner as soon as every invitee has voted and all voters said yes to an option.
+	AutoPickWhenAllYes OptBool `json:"auto_pick_when_all_yes"`
+	// Reserve the options as tentative events in the organizer's calendar until a winner is picked or
+	// the poll closes. Held times can't be booked through booking links. Options participants add
+	// without approval are not held.
+	HoldTimes       OptBool         `json:"hold_times"`
+	OptionProposals OptProposalMode `json:"option_proposals"`
+	CreatedAt       OptDateTime     `json:"created_at"`
+}
+
+// GetID returns the value of ID.
+func (s *Poll) GetID() int {
+	return s.ID
+}
+
+// GetSlug returns the value of Slug.
+func (s *Poll) GetSlug() string {
+	return s.Slug
+}
+
+// GetName returns the value of Name.
+func (s *Poll) GetName() string {
+	return s.Name
+}
+
+// GetDescription returns the value of Description.
+func (s *Poll) GetDescription() OptString {
+	return s.Description
+}
+
+// GetStatus returns the value of Status.
+func (s *Poll) GetStatus() LinkStatus {
+	return s.Status
+}
+
+// GetMode returns the value of Mode.
+func (s *Poll) GetMode() OptPollMode {
+	return s.Mode
+}
+
+// GetMaxChoices returns the value of MaxChoices.
+func (s *Poll) GetMaxChoices() OptInt {
+	return s.MaxChoices
+}
+
+// GetShowResults returns the value of ShowResults.
+func (s *Poll) GetShowResults() OptBool {
+	return s.ShowResults
+}
+
+// GetResultsVisibility returns the value of ResultsVisibility.
+func (s *Poll) GetResultsVisibility() OptResultsVisibility {
+	return s.ResultsVisibility
+}
+
+// GetResultsTiming returns the value of ResultsTiming.
+func (s *Poll) GetResultsTiming() OptResultsTiming {
+	return s.ResultsTiming
+}
+
+// GetRequireEmail returns the value of RequireEmail.
+func (s *Poll) GetRequireEmail() OptBool {
+	return s.RequireEmail
+}
+
+// GetCustomFields returns the value of CustomFields.
+func (s *Poll) GetCustomFields() []CustomField {
+	return s.CustomFields
+}
+
+// GetBranding returns the value of Branding.
+func (s *Poll) GetBranding() OptBranding {
+	return s.Branding
+}
+
+// GetDeadline returns the value of Deadline.
+func (s *Poll) GetDeadline() OptDateTime {
+	return s.Deadline
+}
+
+// GetEventTemplate returns the value of EventTemplate.
+func (s *Poll) GetEventTemplate() OptEventTemplate {
+	return s.EventTemplate
+}
+
+// GetWinnerOptionID returns the value of WinnerOptionID.
+func (s *Poll) GetWinnerOptionID() OptInt {
+	return s.WinnerOptionID
+}
+
+// GetReminderHours returns the value of ReminderHours.
+func (s *Poll) GetReminderHours() OptInt {
+	return s.ReminderHours
+}
+
+// GetScoring returns the value of Scoring.
+func (s *Poll) GetScoring() OptPollScoring {
+	return s.Scoring
+}
+
+// GetAutoPickOnClose returns the value of AutoPickOnClose.
+func (s *Poll) GetAutoPickOnClose() OptBool {
+	return s.AutoPickOnClose
+}
+
+// GetAutoPickWhenAllYes returns the value of AutoPickWhenAllYes.
+func (s *Poll) GetAutoPickWhenAllYes() OptBool {
+	return s.AutoPickWhenAllYes
+}
+
+// GetHoldTimes returns the value of HoldTimes.
+func (s *Poll) GetHoldTimes() OptBool {
+	return s.HoldTimes
+}
+
+// GetOptionProposals returns the value of OptionProposals.
+func (s *Poll) GetOptionProposals() OptProposalMode {
+	return s.OptionProposals
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *Poll) GetCreatedAt() OptDateTime {
+	return s.CreatedAt
+}
+
+// SetID sets the value of ID.
+func (s *Poll) SetID(val int) {
+	s.ID = val
+}
+
+// SetSlug sets the value of Slug.
+func (s *Poll) SetSlug(val string) {
+	s.Slug = val
+}
+
+// SetName sets the value of Name.
+func (s *Poll) SetName(val string) {
+	s.Name = val
+}
+
+// SetDescription sets the value of Description.
+func (s *Poll) SetDescription(val OptString) {
+	s.Description = val
+}
+
+// SetStatus sets the value of Status.
+func (s *Poll) SetStatus(val LinkStatus) {
+	s.Status = val
+}
+
+// SetMode sets the value of Mode.
+func (s *Poll) SetMode(val OptPollMode) {
+	s.Mode = val
+}
+
+// SetMaxChoices sets the value of MaxChoices.
+func (s *Poll) SetMaxChoices(val OptInt) {
+	s.MaxChoices = val
+}
+
+// SetShowResults sets the value of ShowResults.
+func (s *Poll) SetShowResults(val OptBool) {
+	s.ShowResults = val
+}
+
+// SetResultsVisibility sets the value of ResultsVisibility.
+func (s *Poll) SetResultsVisibility(val OptResultsVisibility) {
+	s.ResultsVisibility = val
+}
+
+// SetResultsTiming sets the value of ResultsTiming.
+func (s *Poll) SetResultsTiming(val OptResultsTiming) {
+	s.ResultsTiming = val
+}
+
+// SetRequireEmail sets the value of RequireEmail.
+func (s *Poll) SetRequireEmail(val OptBool) {
+	s.RequireEmail = val
+}
+
+// SetCustomFields sets the value of CustomFields.
+func (s *Poll) SetCustomFields(val []CustomField) {
+	s.CustomFields = val
+}
+
+// SetBranding sets the value of Branding.
+func (s *Poll) SetBranding(val OptBranding) {
+	s.Branding = val
+}
+
+// SetDeadline sets the value of Deadline.
+func (s *Poll) SetDeadline(val OptDateTime) {
+	s.Deadline = val
+}
+
+// SetEventTemplate sets the value of EventTemplate.
+func (s *Poll) SetEventTemplate(val OptEventTemplate) {
+	s.EventTemplate = val
+}
+
+// SetWinnerOptionID sets the value of WinnerOptionID.
+func (s *Poll) SetWinnerOptionID(val OptInt) {
+	s.WinnerOptionID = val
+}
+
+// SetReminderHours sets the value of ReminderHours.
+func (s *Poll) SetReminderHours(val OptInt) {
+	s.ReminderHours = val
+}
+
+// SetScoring sets the value of Scoring.
+func (s *Poll) SetScoring(val OptPollScoring) {
+	s.Scoring = val
+}
+
+// SetAutoPickOnClose sets the value of AutoPickOnClose.
+func (s *Poll) SetAutoPickOnClose(val OptBool) {
+	s.AutoPickOnClose = val
+}
+
+// SetAutoPickWhenAllYes sets the value of AutoPickWhenAllYes.
+func (s *Poll) SetAutoPickWhenAllYes(val OptBool) {
+	s.AutoPickWhenAllYes = val
+}
+
+// SetHoldTimes sets the value of HoldTimes.
+func (s *Poll) SetHoldTimes(val OptBool) {
+	s.HoldTimes = val
+}
+
+// SetOptionProposals sets the value of OptionProposals.
+func (s *Poll) SetOptionProposals(val OptProposalMode) {
+	s.OptionProposals = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *Poll) SetCreatedAt(val OptDateTime) {
+	s.CreatedAt = val
+}
+
+func (*Poll) createPollRes() {}
+func (*Poll) updatePollRes() {}
+
+// Ref: #/components/schemas/PollComment
+type PollComment struct {
+	ID int `json:"id"`
+	// Option the comment refers to, omitted for comments on the poll.
+	OptionID   OptInt `json:"option_id"`
+	AuthorName string `json:"author_name"`
+	// Only visible to the organizer.
+	AuthorEmail OptString `json:"author_email"`
+	Body        string    `json:"body"`
+	// Hidden by the organizer, only visible to the organizer.
+	Hidden    OptBool     `json:"hidden"`
+	CreatedAt OptDateTime `json:"created_at"`
+}
+
+// GetID returns the value of ID.
+func (s *PollComment) GetID() int {
+	return s.ID
+}
+
+// GetOptionID returns the value of OptionID.
+func (s *PollComment) GetOptionID() OptInt {
+	return s.OptionID
+}
+
+// GetAuthorName returns the value of AuthorName.
+func (s *PollComment) GetAuthorName() string {
+	return s.AuthorName
+}
+
+// GetAuthorEmail returns the value of AuthorEmail.
+func (s *PollComment) GetAuthorEmail() OptString {
+	return s.AuthorEmail
+}
+
+// GetBody returns the value of Body.
+func (s *PollComment) GetBody() string {
+	return s.Body
+}
+
+// GetHidden returns the value of Hidden.
+func (s *PollComment) GetHidden() OptBool {
+	return s.Hidden
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *PollComment) GetCreatedAt() OptDateTime {
+	return s.CreatedAt
+}
+
+// SetID sets the value of ID.
+func (s *PollComment) SetID(val int) {
+	s.ID = val
+}
+
+// SetOptionID sets the value of OptionID.
+func (s *PollComment) SetOptionID(val OptInt) {
+	s.OptionID = val
+}
+
+// SetAuthorName sets the value of AuthorName.
+func (s *PollComment) SetAuthorName(val string) {
+	s.AuthorName = val
 }
 
-// GetRequireEmail returns the value of RequireEmail.
-func (s *Poll) GetRequireEmail() OptBool {
-	return s.RequireEmail
+// SetAuthorEmail sets the value of AuthorEmail.
+func (s *PollComment) SetAuthorEmail(val OptString) {
+	s.AuthorEmail = val
 }
 
-// GetCustomFields returns the value of CustomFields.
-func (s *Poll) GetCustomFields() []CustomField {
-	return s.CustomFields
+// SetBody sets the value of Body.
+func (s *PollComment) SetBody(val string) {
+	s.Body = val
 }
 
-// GetCreatedAt returns the value of CreatedAt.
-func (s *Poll) GetCreatedAt() OptDateTime {
-	return s.CreatedAt
+// SetHidden sets the value of Hidden.
+func (s *PollComment) SetHidden(val OptBool) {
+	s.Hidden = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *PollComment) SetCreatedAt(val OptDateTime) {
+	s.CreatedAt = val
+}
+
+func (*PollComment) addPollCommentRes() {}
+
+// Ref: #/components/schemas/PollInvite
+type PollInvite struct {
+	ID      int         `json:"id"`
+	Email   string      `json:"email"`
+	Name    OptString   `json:"name"`
+	Voted   bool        `json:"voted"`
+	VotedAt OptDateTime `json:"voted_at"`
+	// Options a required participant can't attend are ranked last.
+	Required   OptBool     `json:"required"`
+	InvitedAt  OptDateTime `json:"invited_at"`
+	RemindedAt OptDateTime `json:"reminded_at"`
+}
+
+// GetID returns the value of ID.
+func (s *PollInvite) GetID() int {
+	return s.ID
+}
+
+// GetEmail returns the value of Email.
+func (s *PollInvite) GetEmail() string {
+	return s.Email
+}
+
+// GetName returns the value of Name.
+func (s *PollInvite) GetName() OptString {
+	return s.Name
+}
+
+// GetVoted returns the value of Voted.
+func (s *PollInvite) GetVoted() bool {
+	return s.Voted
+}
+
+// GetVotedAt returns the value of VotedAt.
+func (s *PollInvite) GetVotedAt() OptDateTime {
+	return s.VotedAt
+}
+
+// GetRequired returns the value of Required.
+func (s *PollInvite) GetRequired() OptBool {
+	return s.Required
+}
+
+// GetInvitedAt returns the value of InvitedAt.
+func (s *PollInvite) GetInvitedAt() OptDateTime {
+	return s.InvitedAt
+}
+
+// GetRemindedAt returns the value of RemindedAt.
+func (s *PollInvite) GetRemindedAt() OptDateTime {
+	return s.RemindedAt
 }
 
 // SetID sets the value of ID.
-func (s *Poll) SetID(val int) {
+func (s *PollInvite) SetID(val int) {
 	s.ID = val
 }
 
-// SetSlug sets the value of Slug.
-func (s *Poll) SetSlug(val string) {
-	s.Slug = val
+// SetEmail sets the value of Email.
+func (s *PollInvite) SetEmail(val string) {
+	s.Email = val
 }
 
 // SetName sets the value of Name.
-func (s *Poll) SetName(val string) {
+func (s *PollInvite) SetName(val OptString) {
 	s.Name = val
 }
 
-// SetDescription sets the value of Description.
-func (s *Poll) SetDescription(val OptString) {
-	s.Description = val
+// SetVoted sets the value of Voted.
+func (s *PollInvite) SetVoted(val bool) {
+	s.Voted = val
 }
 
-// SetStatus sets the value of Status.
-func (s *Poll) SetStatus(val LinkStatus) {
-	s.Status = val
+// SetVotedAt sets the value of VotedAt.
+func (s *PollInvite) SetVotedAt(val OptDateTime) {
+	s.VotedAt = val
 }
 
-// SetShowResults sets the value of ShowResults.
-func (s *Poll) SetShowResults(val OptBool) {
-	s.ShowResults = val
+// SetRequired sets the value of Required.
+func (s *PollInvite) SetRequired(val OptBool) {
+	s.Required = val
 }
 
-// SetRequireEmail sets the value of RequireEmail.
-func (s *Poll) SetRequireEmail(val OptBool) {
-	s.RequireEmail = val
+// SetInvitedAt sets the value of InvitedAt.
+func (s *PollInvite) SetInvitedAt(val OptDateTime) {
+	s.InvitedAt = val
 }
 
-// SetCustomFields sets the value of CustomFields.
-func (s *Poll) SetCustomFields(val []CustomField) {
-	s.CustomFields = val
+// SetRemindedAt sets the value of RemindedAt.
+func (s *PollInvite) SetRemindedAt(val OptDateTime) {
+	s.RemindedAt = val
 }
 
-// SetCreatedAt sets the value of CreatedAt.
-func (s *Poll) SetCreatedAt(val OptDateTime) {
-	s.CreatedAt = val
+// 1=vote, 2=signup.
+// Ref: #/components/schemas/PollMode
+type PollMode int
+
+const (
+	PollMode1 PollMode = 1
+	PollMode2 PollMode = 2
+)
+
+// AllValues returns all PollMode values.
+func (PollMode) AllValues() []PollMode {
+	return []PollMode{
+		PollMode1,
+		PollMode2,
+	}
 }
 
 // Ref: #/components/schemas/PollOption
@@ -2078,6 +4134,14 @@ type PollOption struct {
 	Type      SlotType  `json:"type"`
 	StartTime time.Time `json:"start_time"`
 	EndTime   time.Time `json:"end_time"`
+	// Sign-up sheets only, maximum number of participants (0 = unlimited).
+	Capacity OptInt `json:"capacity"`
+	// Sign-up sheets only, number of participants who chose this option.
+	Signups OptInt `json:"signups"`
+	// Sign-up sheets only, the option has reached its capacity.
+	Full OptBool `json:"full"`
+	// Name of the participant who proposed the option.
+	ProposedBy OptString `json:"proposed_by"`
 }
 
 // GetID returns the value of ID.
@@ -2100,29 +4164,401 @@ func (s *PollOption) GetEndTime() time.Time {
 	return s.EndTime
 }
 
+// GetCapacity returns the value of Capacity.
+func (s *PollOption) GetCapacity() OptInt {
+	return s.Capacity
+}
+
+// GetSignups returns the value of Signups.
+func (s *PollOption) GetSignups() OptInt {
+	return s.Signups
+}
+
+// GetFull returns the value of Full.
+func (s *PollOption) GetFull() OptBool {
+	return s.Full
+}
+
+// GetProposedBy returns the value of ProposedBy.
+func (s *PollOption) GetProposedBy() OptString {
+	return s.ProposedBy
+}
+
 // SetID sets the value of ID.
 func (s *PollOption) SetID(val int) {
 	s.ID = val
 }
 
 // SetType sets the value of Type.
-func (s *PollOption) SetType(val SlotType) {
+func (s *PollOption) SetType(val SlotType) {
+	s.Type = val
+}
+
+// SetStartTime sets the value of StartTime.
+func (s *PollOption) SetStartTime(val time.Time) {
+	s.StartTime = val
+}
+
+// SetEndTime sets the value of EndTime.
+func (s *PollOption) SetEndTime(val time.Time) {
+	s.EndTime = val
+}
+
+// SetCapacity sets the value of Capacity.
+func (s *PollOption) SetCapacity(val OptInt) {
+	s.Capacity = val
+}
+
+// SetSignups sets the value of Signups.
+func (s *PollOption) SetSignups(val OptInt) {
+	s.Signups = val
+}
+
+// SetFull sets the value of Full.
+func (s *PollOption) SetFull(val OptBool) {
+	s.Full = val
+}
+
+// SetProposedBy sets the value of ProposedBy.
+func (s *PollOption) SetProposedBy(val OptString) {
+	s.ProposedBy = val
+}
+
+func (*PollOption) approvePollProposalRes() {}
+
+// Ref: #/components/schemas/PollOptionCandidate
+type PollOptionCandidate struct {
+	Type      SlotType  `json:"type"`
+	StartTime time.Time `json:"start_time"`
+	EndTime   time.Time `json:"end_time"`
+}
+
+// GetType returns the value of Type.
+func (s *PollOptionCandidate) GetType() SlotType {
+	return s.Type
+}
+
+// GetStartTime returns the value of StartTime.
+func (s *PollOptionCandidate) GetStartTime() time.Time {
+	return s.StartTime
+}
+
+// GetEndTime returns the value of EndTime.
+func (s *PollOptionCandidate) GetEndTime() time.Time {
+	return s.EndTime
+}
+
+// SetType sets the value of Type.
+func (s *PollOptionCandidate) SetType(val SlotType) {
+	s.Type = val
+}
+
+// SetStartTime sets the value of StartTime.
+func (s *PollOptionCandidate) SetStartTime(val time.Time) {
+	s.StartTime = val
+}
+
+// SetEndTime sets the value of EndTime.
+func (s *PollOptionCandidate) SetEndTime(val time.Time) {
+	s.EndTime = val
+}
+
+// Ref: #/components/schemas/PollProposal
+type PollProposal struct {
+	ID        int         `json:"id"`
+	Type      SlotType    `json:"type"`
+	StartTime time.Time   `json:"start_time"`
+	EndTime   time.Time   `json:"end_time"`
+	Name      string      `json:"name"`
+	Email     OptString   `json:"email"`
+	CreatedAt OptDateTime `json:"created_at"`
+}
+
+// GetID returns the value of ID.
+func (s *PollProposal) GetID() int {
+	return s.ID
+}
+
+// GetType returns the value of Type.
+func (s *PollProposal) GetType() SlotType {
+	return s.Type
+}
+
+// GetStartTime returns the value of StartTime.
+func (s *PollProposal) GetStartTime() time.Time {
+	return s.StartTime
+}
+
+// GetEndTime returns the value of EndTime.
+func (s *PollProposal) GetEndTime() time.Time {
+	return s.EndTime
+}
+
+// GetName returns the value of Name.
+func (s *PollProposal) GetName() string {
+	return s.Name
+}
+
+// GetEmail returns the value of Email.
+func (s *PollProposal) GetEmail() OptString {
+	return s.Email
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *PollProposal) GetCreatedAt() OptDateTime {
+	return s.CreatedAt
+}
+
+// SetID sets the value of ID.
+func (s *PollProposal) SetID(val int) {
+	s.ID = val
+}
+
+// SetType sets the value of Type.
+func (s *PollProposal) SetType(val SlotType) {
+	s.Type = val
+}
+
+// SetStartTime sets the value of StartTime.
+func (s *PollProposal) SetStartTime(val time.Time) {
+	s.StartTime = val
+}
+
+// SetEndTime sets the value of EndTime.
+func (s *PollProposal) SetEndTime(val time.Time) {
+	s.EndTime = val
+}
+
+// SetName sets the value of Name.
+func (s *PollProposal) SetName(val string) {
+	s.Name = val
+}
+
+// SetEmail sets the value of Email.
+func (s *PollProposal) SetEmail(val OptString) {
+	s.Email = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *PollProposal) SetCreatedAt(val OptDateTime) {
+	s.CreatedAt = val
+}
+
+// Ref: #/components/schemas/PollResults
+type PollResults struct {
+	// Options ranked from best to worst.
+	Tally []VoteTally `json:"tally"`
+	// Best ranked option, omitted while nobody is available for any option.
+	RecommendedOptionID OptInt `json:"recommended_option_id"`
+}
+
+// GetTally returns the value of Tally.
+func (s *PollResults) GetTally() []VoteTally {
+	return s.Tally
+}
+
+// GetRecommendedOptionID returns the value of RecommendedOptionID.
+func (s *PollResults) GetRecommendedOptionID() OptInt {
+	return s.RecommendedOptionID
+}
+
+// SetTally sets the value of Tally.
+func (s *PollResults) SetTally(val []VoteTally) {
+	s.Tally = val
+}
+
+// SetRecommendedOptionID sets the value of RecommendedOptionID.
+func (s *PollResults) SetRecommendedOptionID(val OptInt) {
+	s.RecommendedOptionID = val
+}
+
+// Points an option earns per response, defaults to yes=2 and maybe=1.
+// Ref: #/components/schemas/PollScoring
+type PollScoring struct {
+	YesPoints   int `json:"yes_points"`
+	MaybePoints int `json:"maybe_points"`
+}
+
+// GetYesPoints returns the value of YesPoints.
+func (s *PollScoring) GetYesPoints() int {
+	return s.YesPoints
+}
+
+// GetMaybePoints returns the value of MaybePoints.
+func (s *PollScoring) GetMaybePoints() int {
+	return s.MaybePoints
+}
+
+// SetYesPoints sets the value of YesPoints.
+func (s *PollScoring) SetYesPoints(val int) {
+	s.YesPoints = val
+}
+
+// SetMaybePoints sets the value of MaybePoints.
+func (s *PollScoring) SetMaybePoints(val int) {
+	s.MaybePoints = val
+}
+
+// 1=off, 2=approval (the organizer approves proposals), 3=auto (proposals are added right away).
+// Ref: #/components/schemas/ProposalMode
+type ProposalMode int
+
+const (
+	ProposalMode1 ProposalMode = 1
+	ProposalMode2 ProposalMode = 2
+	ProposalMode3 ProposalMode = 3
+)
+
+// AllValues returns all ProposalMode values.
+func (ProposalMode) AllValues() []ProposalMode {
+	return []ProposalMode{
+		ProposalMode1,
+		ProposalMode2,
+		ProposalMode3,
+	}
+}
+
+type ProposePollOptionCreated struct {
+	// The proposal waits for the organizer's approval.
+	Pending bool          `json:"pending"`
+	Option  OptPollOption `json:"option"`
+}
+
+// GetPending returns the value of Pending.
+func (s *ProposePollOptionCreated) GetPending() bool {
+	return s.Pending
+}
+
+// GetOption returns the value of Option.
+func (s *ProposePollOptionCreated) GetOption() OptPollOption {
+	return s.Option
+}
+
+// SetPending sets the value of Pending.
+func (s *ProposePollOptionCreated) SetPending(val bool) {
+	s.Pending = val
+}
+
+// SetOption sets the value of Option.
+func (s *ProposePollOptionCreated) SetOption(val OptPollOption) {
+	s.Option = val
+}
+
+func (*ProposePollOptionCreated) proposePollOptionRes() {}
+
+type ProposePollOptionReq struct {
+	Type      SlotType  `json:"type"`
+	StartTime time.Time `json:"start_time"`
+	EndTime   time.Time `json:"end_time"`
+	Name      string    `json:"name"`
+	Email     OptString `json:"email"`
+}
+
+// GetType returns the value of Type.
+func (s *ProposePollOptionReq) GetType() SlotType {
+	return s.Type
+}
+
+// GetStartTime returns the value of StartTime.
+func (s *ProposePollOptionReq) GetStartTime() time.Time {
+	return s.StartTime
+}
+
+// GetEndTime returns the value of EndTime.
+func (s *ProposePollOptionReq) GetEndTime() time.Time {
+	return s.EndTime
+}
+
+// GetName returns the value of Name.
+func (s *ProposePollOptionReq) GetName() string {
+	return s.Name
+}
+
+// GetEmail returns the value of Email.
+func (s *ProposePollOptionReq) GetEmail() OptString {
+	return s.Email
+}
+
+// SetType sets the value of Type.
+func (s *ProposePollOptionReq) SetType(val SlotType) {
 	s.Type = val
 }
 
 // SetStartTime sets the value of StartTime.
-func (s *PollOption) SetStartTime(val time.Time) {
+func (s *ProposePollOptionReq) SetStartTime(val time.Time) {
 	s.StartTime = val
 }
 
 // SetEndTime sets the value of EndTime.
-func (s *PollOption) SetEndTime(val time.Time) {
+func (s *ProposePollOptionReq) SetEndTime(val time.Time) {
 	s.EndTime = val
 }
 
+// SetName sets the value of Name.
+func (s *ProposePollOptionReq) SetName(val string) {
+	s.Name = val
+}
+
+// SetEmail sets the value of Email.
+func (s *ProposePollOptionReq) SetEmail(val OptString) {
+	s.Email = val
+}
+
+type RemindPollInvitesOK struct {
+	Sent int `json:"sent"`
+}
+
+// GetSent returns the value of Sent.
+func (s *RemindPollInvitesOK) GetSent() int {
+	return s.Sent
+}
+
+// SetSent sets the value of Sent.
+func (s *RemindPollInvitesOK) SetSent(val int) {
+	s.Sent = val
+}
+
 // RemoveCalendarNoContent is response for RemoveCalendar operation.
 type RemoveCalendarNoContent struct{}
 
+// 1=always, 2=after_vote (only to participants who voted), 3=after_close.
+// Ref: #/components/schemas/ResultsTiming
+type ResultsTiming int
+
+const (
+	ResultsTiming1 ResultsTiming = 1
+	ResultsTiming2 ResultsTiming = 2
+	ResultsTiming3 ResultsTiming = 3
+)
+
+// AllValues returns all ResultsTiming values.
+func (ResultsTiming) AllValues() []ResultsTiming {
+	return []ResultsTiming{
+		ResultsTiming1,
+		ResultsTiming2,
+		ResultsTiming3,
+	}
+}
+
+// 1=aggregate (counts only), 2=names (voter names without emails), 3=full.
+// Ref: #/components/schemas/ResultsVisibility
+type ResultsVisibility int
+
+const (
+	ResultsVisibility1 ResultsVisibility = 1
+	ResultsVisibility2 ResultsVisibility = 2
+	ResultsVisibility3 ResultsVisibility = 3
+)
+
+// AllValues returns all ResultsVisibility values.
+func (ResultsVisibility) AllValues() []ResultsVisibility {
+	return []ResultsVisibility{
+		ResultsVisibility1,
+		ResultsVisibility2,
+		ResultsVisibility3,
+	}
+}
+
 // Ref: #/components/schemas/Slot
 type Slot struct {
 	ID        int       `json:"id"`
@@ -2195,6 +4631,8 @@ type SubmitVoteReq struct {
 	GuestEmail   OptString                    `json:"guest_email"`
 	Responses    SubmitVoteReqResponses       `json:"responses"`
 	CustomFields OptSubmitVoteReqCustomFields `json:"custom_fields"`
+	// Token of the personal invite link the vote was cast through.
+	InviteToken OptString `json:"invite_token"`
 }
 
 // GetGuestName returns the value of GuestName.
@@ -2217,6 +4655,11 @@ func (s *SubmitVoteReq) GetCustomFields() OptSubmitVoteReqCustomFields {
 	return s.CustomFields
 }
 
+// GetInviteToken returns the value of InviteToken.
+func (s *SubmitVoteReq) GetInviteToken() OptString {
+	return s.InviteToken
+}
+
 // SetGuestName sets the value of GuestName.
 func (s *SubmitVoteReq) SetGuestName(val OptString) {
 	s.GuestName = val
@@ -2237,6 +4680,11 @@ func (s *SubmitVoteReq) SetCustomFields(val OptSubmitVoteReqCustomFields) {
 	s.CustomFields = val
 }
 
+// SetInviteToken sets the value of InviteToken.
+func (s *SubmitVoteReq) SetInviteToken(val OptString) {
+	s.InviteToken = val
+}
+
 type SubmitVoteReqCustomFields map[string]string
 
 func (s *SubmitVoteReqCustomFields) init() SubmitVoteReqCustomFields {
@@ -2259,20 +4707,150 @@ func (s *SubmitVoteReqResponses) init() SubmitVoteReqResponses {
 	return m
 }
 
+type SuggestPollOptionsOK struct {
+	Candidates []PollOptionCandidate `json:"candidates"`
+	// Options created when add is set.
+	Added []PollOption `json:"added"`
+}
+
+// GetCandidates returns the value of Candidates.
+func (s *SuggestPollOptionsOK) GetCandidates() []PollOptionCandidate {
+	return s.Candidates
+}
+
+// GetAdded returns the value of Added.
+func (s *SuggestPollOptionsOK) GetAdded() []PollOption {
+	return s.Added
+}
+
+// SetCandidates sets the value of Candidates.
+func (s *SuggestPollOptionsOK) SetCandidates(val []PollOptionCandidate) {
+	s.Candidates = val
+}
+
+// SetAdded sets the value of Added.
+func (s *SuggestPollOptionsOK) SetAdded(val []PollOption) {
+	s.Added = val
+}
+
+func (*SuggestPollOptionsOK) suggestPollOptionsRes() {}
+
+type SuggestPollOptionsReq struct {
+	Start           time.Time `json:"start"`
+	End             time.Time `json:"end"`
+	DurationMinutes int       `json:"duration_minutes"`
+	BufferMinutes   OptInt    `json:"buffer_minutes"`
+	// Rules to use, defaults to the rules of booking_link_id or of all active booking links of the
+	// organizer.
+	AvailabilityRules []AvailabilityRule `json:"availability_rules"`
+	// Use the availability rules of this booking link.
+	BookingLinkID OptInt `json:"booking_link_id"`
+	// Maximum number of suggestions, defaults to 50.
+	Limit OptInt `json:"limit"`
+	// Add the suggestions to the poll right away.
+	Add OptBool `json:"add"`
+}
+
+// GetStart returns the value of Start.
+func (s *SuggestPollOptionsReq) GetStart() time.Time {
+	return s.Start
+}
+
+// GetEnd returns the value of End.
+func (s *SuggestPollOptionsReq) GetEnd() time.Time {
+	return s.End
+}
+
+// GetDurationMinutes returns the value of DurationMinutes.
+func (s *SuggestPollOptionsReq) GetDurationMinutes() int {
+	return s.DurationMinutes
+}
+
+// GetBufferMinutes returns the value of BufferMinutes.
+func (s *SuggestPollOptionsReq) GetBufferMinutes() OptInt {
+	return s.BufferMinutes
+}
+
+// GetAvailabilityRules returns the value of AvailabilityRules.
+func (s *SuggestPollOptionsReq) GetAvailabilityRules() []AvailabilityRule {
+	return s.AvailabilityRules
+}
+
+// GetBookingLinkID returns the value of BookingLinkID.
+func (s *SuggestPollOptionsReq) GetBookingLinkID() OptInt {
+	return s.BookingLinkID
+}
+
+// GetLimit returns the value of Limit.
+func (s *SuggestPollOptionsReq) GetLimit() OptInt {
+	return s.Limit
+}
+
+// GetAdd returns the value of Add.
+func (s *SuggestPollOptionsReq) GetAdd() OptBool {
+	return s.Add
+}
+
+// SetStart sets the value of Start.
+func (s *SuggestPollOptionsReq) SetStart(val time.Time) {
+	s.Start = val
+}
+
+// SetEnd sets the value of End.
+func (s *SuggestPollOptionsReq) SetEnd(val time.Time) {
+	s.End = val
+}
+
+// SetDurationMinutes sets the value of DurationMinutes.
+func (s *SuggestPollOptionsReq) SetDurationMinutes(val int) {
+	s.DurationMinutes = val
+}
+
+// SetBufferMinutes sets the value of BufferMinutes.
+func (s *SuggestPollOptionsReq) SetBufferMinutes(val OptInt) {
+	s.BufferMinutes = val
+}
+
+// SetAvailabilityRules sets the value of AvailabilityRules.
+func (s *SuggestPollOptionsReq) SetAvailabilityRules(val []AvailabilityRule) {
+	s.AvailabilityRules = val
+}
+
+// SetBookingLinkID sets the value of BookingLinkID.
+func (s *SuggestPollOptionsReq) SetBookingLinkID(val OptInt) {
+	s.BookingLinkID = val
+}
+
+// SetLimit sets the value of Limit.
+func (s *SuggestPollOptionsReq) SetLimit(val OptInt) {
+	s.Limit = val
+}
+
+// SetAdd sets the value of Add.
+func (s *SuggestPollOptionsReq) SetAdd(val OptBool) {
+	s.Add = val
+}
+
 type UpdateBookingLinkReq struct {
-	Name                 OptString     `json:"name"`
-	Description          OptString     `json:"description"`
-	Status               OptLinkStatus `json:"status"`
-	AutoConfirm          OptBool       `json:"auto_confirm"`
-	SlotDurationMinutes  OptInt        `json:"slot_duration_minutes"`
-	SlotDurationsMinutes []int         `json:"slot_durations_minutes"`
-	BufferMinutes        OptInt        `json:"buffer_minutes"`
-	RequireEmail         OptBool       `json:"require_email"`
+	Name                  OptString                `json:"name"`
+	Description           OptString                `json:"description"`
+	Status                OptLinkStatus            `json:"status"`
+	AutoConfirm           OptBool                  `json:"auto_confirm"`
+	AutoConfirmRules      OptAutoConfirmRules      `json:"auto_confirm_rules"`
+	ApprovalTimeoutHours  OptInt                   `json:"approval_timeout_hours"`
+	ApprovalTimeoutAction OptApprovalTimeoutAction `json:"approval_timeout_action"`
+	SlotDurationMinutes   OptInt                   `json:"slot_duration_minutes"`
+	SlotDurationsMinutes  []int                    `json:"slot_durations_minutes"`
+	BufferMinutes         OptInt                   `json:"buffer_minutes"`
+	RequireEmail          OptBool                  `json:"require_email"`
 	// Video meeting link (Zoom, Google Meet, etc.).
-	MeetingLink       OptString          `json:"meeting_link"`
+	MeetingLink OptString `json:"meeting_link"`
+	// Provider generating a meeting link per booking.
+	MeetingProvider   OptString          `json:"meeting_provider"`
 	AvailabilityRules []AvailabilityRule `json:"availability_rules"`
 	CustomFields      []CustomField      `json:"custom_fields"`
 	EventTemplate     OptEventTemplate   `json:"event_template"`
+	Branding          OptBranding        `json:"branding"`
 }
 
 // GetName returns the value of Name.
@@ -2295,6 +4873,21 @@ func (s *UpdateBookingLinkReq) GetAutoConfirm() OptBool {
 	return s.AutoConfirm
 }
 
+// GetAutoConfirmRules returns the value of AutoConfirmRules.
+func (s *UpdateBookingLinkReq) GetAutoConfirmRules() OptAutoConfirmRules {
+	return s.AutoConfirmRules
+}
+
+// GetApprovalTimeoutHours returns the value of ApprovalTimeoutHours.
+func (s *UpdateBookingLinkReq) GetApprovalTimeoutHours() OptInt {
+	return s.ApprovalTimeoutHours
+}
+
+// GetApprovalTimeoutAction returns the value of ApprovalTimeoutAction.
+func (s *UpdateBookingLinkReq) GetApprovalTimeoutAction() OptApprovalTimeoutAction {
+	return s.ApprovalTimeoutAction
+}
+
 // GetSlotDurationMinutes returns the value of SlotDurationMinutes.
 func (s *UpdateBookingLinkReq) GetSlotDurationMinutes() OptInt {
 	return s.SlotDurationMinutes
@@ -2320,6 +4913,11 @@ func (s *UpdateBookingLinkReq) GetMeetingLink() OptString {
 	return s.MeetingLink
 }
 
+// GetMeetingProvider returns the value of MeetingProvider.
+func (s *UpdateBookingLinkReq) GetMeetingProvider() OptString {
+	return s.MeetingProvider
+}
+
 // GetAvailabilityRules returns the value of AvailabilityRules.
 func (s *UpdateBookingLinkReq) GetAvailabilityRules() []AvailabilityRule {
 	return s.AvailabilityRules
@@ -2335,6 +4933,11 @@ func (s *UpdateBookingLinkReq) GetEventTemplate() OptEventTemplate {
 	return s.EventTemplate
 }
 
+// GetBranding returns the value of Branding.
+func (s *UpdateBookingLinkReq) GetBranding() OptBranding {
+	return s.Branding
+}
+
 // SetName sets the value of Name.
 func (s *UpdateBookingLinkReq) SetName(val OptString) {
 	s.Name = val
@@ -2355,6 +4958,21 @@ func (s *UpdateBookingLinkReq) SetAutoConfirm(val OptBool) {
 	s.AutoConfirm = val
 }
 
+// SetAutoConfirmRules sets the value of AutoConfirmRules.
+func (s *UpdateBookingLinkReq) SetAutoConfirmRules(val OptAutoConfirmRules) {
+	s.AutoConfirmRules = val
+}
+
+// SetApprovalTimeoutHours sets the value of ApprovalTimeoutHours.
+func (s *UpdateBookingLinkReq) SetApprovalTimeoutHours(val OptInt) {
+	s.ApprovalTimeoutHours = val
+}
+
+// SetApprovalTimeoutAction sets the value of ApprovalTimeoutAction.
+func (s *UpdateBookingLinkReq) SetApprovalTimeoutAction(val OptApprovalTimeoutAction) {
+	s.ApprovalTimeoutAction = val
+}
+
 // SetSlotDurationMinutes sets the value of SlotDurationMinutes.
 func (s *UpdateBookingLinkReq) SetSlotDurationMinutes(val OptInt) {
 	s.SlotDurationMinutes = val
@@ -2380,6 +4998,11 @@ func (s *UpdateBookingLinkReq) SetMeetingLink(val OptString) {
 	s.MeetingLink = val
 }
 
+// SetMeetingProvider sets the value of MeetingProvider.
+func (s *UpdateBookingLinkReq) SetMeetingProvider(val OptString) {
+	s.MeetingProvider = val
+}
+
 // SetAvailabilityRules sets the value of AvailabilityRules.
 func (s *UpdateBookingLinkReq) SetAvailabilityRules(val []AvailabilityRule) {
 	s.AvailabilityRules = val
@@ -2395,9 +5018,15 @@ func (s *UpdateBookingLinkReq) SetEventTemplate(val OptEventTemplate) {
 	s.EventTemplate = val
 }
 
+// SetBranding sets the value of Branding.
+func (s *UpdateBookingLinkReq) SetBranding(val OptBranding) {
+	s.Branding = val
+}
+
 type UpdateCurrentUserReq struct {
 	// Display name for the organizer.
-	Name OptString `json:"name"`
+	Name     OptString   `json:"name"`
+	Branding OptBranding `json:"branding"`
 }
 
 // GetName returns the value of Name.
@@ -2405,18 +5034,100 @@ func (s *UpdateCurrentUserReq) GetName() OptString {
 	return s.Name
 }
 
+// GetBranding returns the value of Branding.
+func (s *UpdateCurrentUserReq) GetBranding() OptBranding {
+	return s.Branding
+}
+
 // SetName sets the value of Name.
 func (s *UpdateCurrentUserReq) SetName(val OptString) {
 	s.Name = val
 }
 
+// SetBranding sets the value of Branding.
+func (s *UpdateCurrentUserReq) SetBranding(val OptBranding) {
+	s.Branding = val
+}
+
+type UpdateOwnVoteReq struct {
+	GuestName    OptString                       `json:"guest_name"`
+	Responses    UpdateOwnVoteReqResponses       `json:"responses"`
+	CustomFields OptUpdateOwnVoteReqCustomFields `json:"custom_fields"`
+}
+
+// GetGuestName returns the value of GuestName.
+func (s *UpdateOwnVoteReq) GetGuestName() OptString {
+	return s.GuestName
+}
+
+// GetResponses returns the value of Responses.
+func (s *UpdateOwnVoteReq) GetResponses() UpdateOwnVoteReqResponses {
+	return s.Responses
+}
+
+// GetCustomFields returns the value of CustomFields.
+func (s *UpdateOwnVoteReq) GetCustomFields() OptUpdateOwnVoteReqCustomFields {
+	return s.CustomFields
+}
+
+// SetGuestName sets the value of GuestName.
+func (s *UpdateOwnVoteReq) SetGuestName(val OptString) {
+	s.GuestName = val
+}
+
+// SetResponses sets the value of Responses.
+func (s *UpdateOwnVoteReq) SetResponses(val UpdateOwnVoteReqResponses) {
+	s.Responses = val
+}
+
+// SetCustomFields sets the value of CustomFields.
+func (s *UpdateOwnVoteReq) SetCustomFields(val OptUpdateOwnVoteReqCustomFields) {
+	s.CustomFields = val
+}
+
+type UpdateOwnVoteReqCustomFields map[string]string
+
+func (s *UpdateOwnVoteReqCustomFields) init() UpdateOwnVoteReqCustomFields {
+	m := *s
+	if m == nil {
+		m = map[string]string{}
+		*s = m
+	}
+	return m
+}
+
+type UpdateOwnVoteReqResponses map[string]VoteResponse
+
+func (s *UpdateOwnVoteReqResponses) init() UpdateOwnVoteReqResponses {
+	m := *s
+	if m == nil {
+		m = map[string]VoteResponse{}
+		*s = m
+	}
+	return m
+}
+
 type UpdatePollReq struct {
-	Name         OptString     `json:"name"`
-	Description  OptString     `json:"description"`
-	Status       OptLinkStatus `json:"status"`
-	ShowResults  OptBool       `json:"show_results"`
-	RequireEmail OptBool       `json:"require_email"`
-	CustomFields []CustomField `json:"custom_fields"`
+	Name              OptString            `json:"name"`
+	Description       OptString            `json:"description"`
+	Status            OptLinkStatus        `json:"status"`
+	ShowResults       OptBool              `json:"show_results"`
+	ResultsVisibility OptResultsVisibility `json:"results_visibility"`
+	ResultsTiming     OptResultsTiming     `json:"results_timing"`
+	RequireEmail      OptBool              `json:"require_email"`
+	Mode              OptPollMode          `json:"mode"`
+	MaxChoices        OptInt               `json:"max_choices"`
+	CustomFields      []CustomField        `json:"custom_fields"`
+	Branding          OptBranding          `json:"branding"`
+	// Set to null to remove the deadline.
+	Deadline           OptNilDateTime   `json:"deadline"`
+	EventTemplate      OptEventTemplate `json:"event_template"`
+	ReminderHours      OptInt           `json:"reminder_hours"`
+	Scoring            OptPollScoring   `json:"scoring"`
+	AutoPickOnClose    OptBool          `json:"auto_pick_on_close"`
+	AutoPickWhenAllYes OptBool          `json:"auto_pick_when_all_yes"`
+	HoldTimes          OptBool          `json:"hold_times"`
+	OptionProposals    OptProposalMode  `json:"option_proposals"`
 }
 
 // GetName returns the value of Name.
@@ -2439,16 +5150,81 @@ func (s *UpdatePollReq) GetShowResults() OptBool {
 	return s.ShowResults
 }
 
+// GetResultsVisibility returns the value of ResultsVisibility.
+func (s *UpdatePollReq) GetResultsVisibility() OptResultsVisibility {
+	return s.ResultsVisibility
+}
+
+// GetResultsTiming returns the value of ResultsTiming.
+func (s *UpdatePollReq) GetResultsTiming() OptResultsTiming {
+	return s.ResultsTiming
+}
+
 // GetRequireEmail returns the value of RequireEmail.
 func (s *UpdatePollReq) GetRequireEmail() OptBool {
 	return s.RequireEmail
 }
 
+// GetMode returns the value of Mode.
+func (s *UpdatePollReq) GetMode() OptPollMode {
+	return s.Mode
+}
+
+// GetMaxChoices returns the value of MaxChoices.
+func (s *UpdatePollReq) GetMaxChoices() OptInt {
+	return s.MaxChoices
+}
+
 // GetCustomFields returns the value of CustomFields.
 func (s *UpdatePollReq) GetCustomFields() []CustomField {
 	return s.CustomFields
 }
 
+// GetBranding returns the value of Branding.
+func (s *UpdatePollReq) GetBranding() OptBranding {
+	return s.Branding
+}
+
+// GetDeadline returns the value of Deadline.
+func (s *UpdatePollReq) GetDeadline() OptNilDateTime {
+	return s.Deadline
+}
+
+// GetEventTemplate returns the value of EventTemplate.
+func (s *UpdatePollReq) GetEventTemplate() OptEventTemplate {
+	return s.EventTemplate
+}
+
+// GetReminderHours returns the value of ReminderHours.
+func (s *UpdatePollReq) GetReminderHours() OptInt {
+	return s.ReminderHours
+}
+
+// GetScoring returns the value of Scoring.
+func (s *UpdatePollReq) GetScoring() OptPollScoring {
+	return s.Scoring
+}
+
+// GetAutoPickOnClose returns the value of AutoPickOnClose.
+func (s *UpdatePollReq) GetAutoPickOnClose() OptBool {
+	return s.AutoPickOnClose
+}
+
+// GetAutoPickWhenAllYes returns the value of AutoPickWhenAllYes.
+func (s *UpdatePollReq) GetAutoPickWhenAllYes() OptBool {
+	return s.AutoPickWhenAllYes
+}
+
+// GetHoldTimes returns the value of HoldTimes.
+func (s *UpdatePollReq) GetHoldTimes() OptBool {
+	return s.HoldTimes
+}
+
+// GetOptionProposals returns the value of OptionProposals.
+func (s *UpdatePollReq) GetOptionProposals() OptProposalMode {
+	return s.OptionProposals
+}
+
 // SetName sets the value of Name.
 func (s *UpdatePollReq) SetName(val OptString) {
 	s.Name = val
@@ -2469,23 +5245,89 @@ func (s *UpdatePollReq) SetShowResults(val OptBool) {
 	s.ShowResults = val
 }
 
+// SetResultsVisibility sets the value of ResultsVisibility.
+func (s *UpdatePollReq) SetResultsVisibility(val OptResultsVisibility) {
+	s.ResultsVisibility = val
+}
+
+// SetResultsTiming sets the value of ResultsTiming.
+func (s *UpdatePollReq) SetResultsTiming(val OptResultsTiming) {
+	s.ResultsTiming = val
+}
+
 // SetRequireEmail sets the value of RequireEmail.
 func (s *UpdatePollReq) SetRequireEmail(val OptBool) {
 	s.RequireEmail = val
 }
 
+// SetMode sets the value of Mode.
+func (s *UpdatePollReq) SetMode(val OptPollMode) {
+	s.Mode = val
+}
+
+// SetMaxChoices sets the value of MaxChoices.
+func (s *UpdatePollReq) SetMaxChoices(val OptInt) {
+	s.MaxChoices = val
+}
+
 // SetCustomFields sets the value of CustomFields.
 func (s *UpdatePollReq) SetCustomFields(val []CustomField) {
 	s.CustomFields = val
 }
 
+// SetBranding sets the value of Branding.
+func (s *UpdatePollReq) SetBranding(val OptBranding) {
+	s.Branding = val
+}
+
+// SetDeadline sets the value of Deadline.
+func (s *UpdatePollReq) SetDeadline(val OptNilDateTime) {
+	s.Deadline = val
+}
+
+// SetEventTemplate sets the value of EventTemplate.
+func (s *UpdatePollReq) SetEventTemplate(val OptEventTemplate) {
+	s.EventTemplate = val
+}
+
+// SetReminderHours sets the value of ReminderHours.
+func (s *UpdatePollReq) SetReminderHours(val OptInt) {
+	s.ReminderHours = val
+}
+
+// SetScoring sets the value of Scoring.
+func (s *UpdatePollReq) SetScoring(val OptPollScoring) {
+	s.Scoring = val
+}
+
+// SetAutoPickOnClose sets the value of AutoPickOnClose.
+func (s *UpdatePollReq) SetAutoPickOnClose(val OptBool) {
+	s.AutoPickOnClose = val
+}
+
+// SetAutoPickWhenAllYes sets the value of AutoPickWhenAllYes.
+func (s *UpdatePollReq) SetAutoPickWhenAllYes(val OptBool) {
+	s.AutoPickWhenAllYes = val
+}
+
+// SetHoldTimes sets the value of HoldTimes.
+func (s *UpdatePollReq) SetHoldTimes(val OptBool) {
+	s.HoldTimes = val
+}
+
+// SetOptionProposals sets the value of OptionProposals.
+func (s *UpdatePollReq) SetOptionProposals(val OptProposalMode) {
+	s.OptionProposals = val
+}
+
 // Ref: #/components/schemas/User
 type User struct {
 	ID    int       `json:"id"`
 	Email string    `json:"email"`
 	Name  OptString `json:"name"`
 	// URL to the user's avatar image, or empty if no avatar is set.
-	AvatarURL OptString `json:"avatar_url"`
+	AvatarURL OptString   `json:"avatar_url"`
+	Branding  OptBranding `json:"branding"`
 }
 
 // GetID returns the value of ID.
@@ -2508,6 +5350,11 @@ func (s *User) GetAvatarURL() OptString {
 	return s.AvatarURL
 }
 
+// GetBranding returns the value of Branding.
+func (s *User) GetBranding() OptBranding {
+	return s.Branding
+}
+
 // SetID sets the value of ID.
 func (s *User) SetID(val int) {
 	s.ID = val
@@ -2528,9 +5375,30 @@ func (s *User) SetAvatarURL(val OptString) {
 	s.AvatarURL = val
 }
 
+// SetBranding sets the value of Branding.
+func (s *User) SetBranding(val OptBranding) {
+	s.Branding = val
+}
+
 func (*User) getCurrentUserRes()    {}
 func (*User) updateCurrentUserRes() {}
 
+type VerifyBookingEmailOK struct {
+	Message OptString `json:"message"`
+}
+
+// GetMessage returns the value of Message.
+func (s *VerifyBookingEmailOK) GetMessage() OptString {
+	return s.Message
+}
+
+// SetMessage sets the value of Message.
+func (s *VerifyBookingEmailOK) SetMessage(val OptString) {
+	s.Message = val
+}
+
+func (*VerifyBookingEmailOK) verifyBookingEmailRes() {}
+
 // Ref: #/components/schemas/Vote
 type Vote struct {
 	ID           int                 `json:"id"`
@@ -2538,7 +5406,9 @@ type Vote struct {
 	GuestEmail   OptString           `json:"guest_email"`
 	Responses    VoteResponses       `json:"responses"`
 	CustomFields OptVoteCustomFields `json:"custom_fields"`
-	CreatedAt    OptDateTime         `json:"created_at"`
+	// Token to edit or delete the vote, only returned to the voter.
+	EditToken OptString   `json:"edit_token"`
+	CreatedAt OptDateTime `json:"created_at"`
 }
 
 // GetID returns the value of ID.
@@ -2566,6 +5436,11 @@ func (s *Vote) GetCustomFields() OptVoteCustomFields {
 	return s.CustomFields
 }
 
+// GetEditToken returns the value of EditToken.
+func (s *Vote) GetEditToken() OptString {
+	return s.EditToken
+}
+
 // GetCreatedAt returns the value of CreatedAt.
 func (s *Vote) GetCreatedAt() OptDateTime {
 	return s.CreatedAt
@@ -2596,11 +5471,20 @@ func (s *Vote) SetCustomFields(val OptVoteCustomFields) {
 	s.CustomFields = val
 }
 
+// SetEditToken sets the value of EditToken.
+func (s *Vote) SetEditToken(val OptString) {
+	s.EditToken = val
+}
+
 // SetCreatedAt sets the value of CreatedAt.
 func (s *Vote) SetCreatedAt(val OptDateTime) {
 	s.CreatedAt = val
 }
 
+func (*Vote) getOwnVoteRes()    {}
+func (*Vote) submitVoteRes()    {}
+func (*Vote) updateOwnVoteRes() {}
+
 type VoteCustomFields map[string]string
 
 func (s *VoteCustomFields) init() VoteCustomFields {
@@ -2644,10 +5528,13 @@ func (s *VoteResponses) init() VoteResponses {
 
 // Ref: #/components/schemas/VoteTally
 type VoteTally struct {
-	OptionID   int `json:"option_id"`
-	YesCount   int `json:"yes_count"`
-	NoCount    int `json:"no_count"`
-	MaybeCount int `json:"maybe_count"`
+	OptionID   int    `json:"option_id"`
+	YesCount   int    `json:"yes_count"`
+	NoCount    int    `json:"no_count"`
+	MaybeCount int    `json:"maybe_count"`
+	Score      OptInt `json:"score"`
+	// Number of required participants who can't attend.
+	RequiredNoCount OptInt `json:"required_no_count"`
 }
 
 // GetOptionID returns the value of OptionID.
@@ -2670,6 +5557,16 @@ func (s *VoteTally) GetMaybeCount() int {
 	return s.MaybeCount
 }
 
+// GetScore returns the value of Score.
+func (s *VoteTally) GetScore() OptInt {
+	return s.Score
+}
+
+// GetRequiredNoCount returns the value of RequiredNoCount.
+func (s *VoteTally) GetRequiredNoCount() OptInt {
+	return s.RequiredNoCount
+}
+
 // SetOptionID sets the value of OptionID.
 func (s *VoteTally) SetOptionID(val int) {
 	s.OptionID = val
@@ -2689,3 +5586,13 @@ func (s *VoteTally) SetNoCount(val int) {
 func (s *VoteTally) SetMaybeCount(val int) {
 	s.MaybeCount = val
 }
+
+// SetScore sets the value of Score.
+func (s *VoteTally) SetScore(val OptInt) {
+	s.Score = val
+}
+
+// SetRequiredNoCount sets the value of RequiredNoCount.
+func (s *VoteTally) SetRequiredNoCount(val OptInt) {
+	s.RequiredNoCount = val
+}
@@ -60,27 +60,41 @@ func (s *Server) securityActionToken(ctx context.Context, operationName Operatio
 
 var operationRolesCookieAuth = map[string][]string{
 	AddCalendarOperation:            []string{},
+	AddPollInvitesOperation:         []string{},
 	AddPollOptionOperation:          []string{},
 	ApproveBookingOperation:         []string{},
+	ApprovePollProposalOperation:    []string{},
 	CreateBookingLinkOperation:      []string{},
 	CreatePollOperation:             []string{},
 	DeclineBookingOperation:         []string{},
+	DeclinePollProposalOperation:    []string{},
 	DeleteBookingLinkOperation:      []string{},
 	DeletePollOperation:             []string{},
+	DeletePollCommentOperation:      []string{},
+	DeletePollInviteOperation:       []string{},
 	DeletePollOptionOperation:       []string{},
 	DiscoverCalendarsOperation:      []string{},
+	GeneratePollOptionsOperation:    []string{},
 	GetBookingLinkOperation:         []string{},
 	GetBookingLinkBookingsOperation: []string{},
 	GetCurrentUserOperation:         []string{},
 	GetPollOperation:                []string{},
 	GetPollOptionsOperation:         []string{},
+	GetPollRankingOperation:         []string{},
 	GetPollVotesOperation:           []string{},
 	ListBookingLinksOperation:       []string{},
 	ListCalendarsOperation:          []string{},
+	ListMeetingProvidersOperation:   []string{},
+	ListPollCommentsOperation:       []string{},
+	ListPollInvitesOperation:        []string{},
+	ListPollProposalsOperation:      []string{},
 	ListPollsOperation:              []string{},
 	LogoutOperation:                 []string{},
+	ModeratePollCommentOperation:    []string{},
 	PickPollWinnerOperation:         []string{},
+	RemindPollInvitesOperation:      []string{},
 	RemoveCalendarOperation:         []string{},
+	SuggestPollOptionsOperation:     []string{},
 	TestCalendarOperation:           []string{},
 	UpdateBookingLinkOperation:      []string{},
 	UpdateCurrentUserOperation:      []string{},
@@ -14,6 +14,18 @@ type Handler interface {
 	//
 	// POST /calendars
 	AddCalendar(ctx context.Context, req *AddCalendarReq) (*CalendarConnection, error)
+	// AddPollComment implements addPollComment operation.
+	//
+	// Comment on a poll or one of its options.
+	//
+	// POST /p/poll/{slug}/comments
+	AddPollComment(ctx context.Context, req *AddPollCommentReq, params AddPollCommentParams) (AddPollCommentRes, error)
+	// AddPollInvites implements addPollInvites operation.
+	//
+	// Invite participants and email them a personal link.
+	//
+	// POST /polls/{id}/invites
+	AddPollInvites(ctx context.Context, req *AddPollInvitesReq, params AddPollInvitesParams) (AddPollInvitesRes, error)
 	// AddPollOption implements addPollOption operation.
 	//
 	// Add an option to a poll.
@@ -26,6 +38,12 @@ type Handler interface {
 	//
 	// POST /bookings/{id}/approve
 	ApproveBooking(ctx context.Context, params ApproveBookingParams) (*Booking, error)
+	// ApprovePollProposal implements approvePollProposal operation.
+	//
+	// Add a proposal to the options of the poll.
+	//
+	// POST /polls/{id}/proposals/{proposalId}/approve
+	ApprovePollProposal(ctx context.Context, params ApprovePollProposalParams) (ApprovePollProposalRes, error)
 	// ApproveViaEmail implements approveViaEmail operation.
 	//
 	// Approve booking via email link.
@@ -38,6 +56,15 @@ type Handler interface {
 	//
 	// GET /auth/callback
 	AuthCallback(ctx context.Context, params AuthCallbackParams) (AuthCallbackRes, error)
+	// AutofillVote implements autofillVote operation.
+	//
+	// Reads the busy times from a private ICS or CalDAV free-busy URL, or from the contents of an
+	// uploaded .ics file, and suggests an answer per option: no if the voter is busy, yes otherwise.
+	// Full-day and multi-day options the voter is partly busy on get maybe. The calendar is only used to
+	// compute the answers and is not stored.
+	//
+	// POST /p/poll/{slug}/autofill
+	AutofillVote(ctx context.Context, req *AutofillVoteReq, params AutofillVoteParams) (AutofillVoteRes, error)
 	// CreateBooking implements createBooking operation.
 	//
 	// Create a booking.
@@ -49,19 +76,25 @@ type Handler interface {
 	// Create a booking link.
 	//
 	// POST /booking-links
-	CreateBookingLink(ctx context.Context, req *CreateBookingLinkReq) (*BookingLink, error)
+	CreateBookingLink(ctx context.Context, req *CreateBookingLinkReq) (CreateBookingLinkRes, error)
 	// CreatePoll implements createPoll operation.
 	//
 	// Create a poll.
 	//
 	// POST /polls
-	CreatePoll(ctx context.Context, req *CreatePollReq) (*Poll, error)
+	CreatePoll(ctx context.Context, req *CreatePollReq) (CreatePollRes, error)
 	// DeclineBooking implements declineBooking operation.
 	//
 	// Decline a booking.
 	//
 	// POST /bookings/{id}/decline
 	DeclineBooking(ctx context.Context, params DeclineBookingParams) (*Booking, error)
+	// DeclinePollProposal implements declinePollProposal operation.
+	//
+	// Decline an option proposal.
+	//
+	// DELETE /polls/{id}/proposals/{proposalId}
+	DeclinePollProposal(ctx context.Context, params DeclinePollProposalParams) error
 	// DeclineViaEmail implements declineViaEmail operation.
 	//
 	// Decline booking via email link.
@@ -74,12 +107,30 @@ type Handler interface {
 	//
 	// DELETE /booking-links/{id}
 	DeleteBookingLink(ctx context.Context, params DeleteBookingLinkParams) error
+	// DeleteOwnVote implements deleteOwnVote operation.
+	//
+	// Delete own vote by edit token.
+	//
+	// DELETE /p/poll/{slug}/vote/{token}
+	DeleteOwnVote(ctx context.Context, params DeleteOwnVoteParams) (DeleteOwnVoteRes, error)
 	// DeletePoll implements deletePoll operation.
 	//
 	// Delete a poll.
 	//
 	// DELETE /polls/{id}
 	DeletePoll(ctx context.Context, params DeletePollParams) error
+	// DeletePollComment implements deletePollComment operation.
+	//
+	// Delete a comment.
+	//
+	// DELETE /polls/{id}/comments/{commentId}
+	DeletePollComment(ctx context.Context, params DeletePollCommentParams) error
+	// DeletePollInvite implements deletePollInvite operation.
+	//
+	// Remove an invitee from a poll.
+	//
+	// DELETE /polls/{id}/invites/{inviteId}
+	DeletePollInvite(ctx context.Context, params DeletePollInviteParams) error
 	// DeletePollOption implements deletePollOption operation.
 	//
 	// Delete an option from a poll.
@@ -92,6 +143,15 @@ type Handler interface {
 	//
 	// POST /calendars/discover
 	DiscoverCalendars(ctx context.Context, req *DiscoverCalendarsReq) (*CalendarDiscoveryResult, error)
+	// GeneratePollOptions implements generatePollOptions operation.
+	//
+	// Creates one option per matching day between start_date and end_date, for each of the given times.
+	// Full-day options cover the whole day, multi-day options span span_days days. Times are wall-clock
+	// times in time_zone, so they stay the same across daylight saving changes. Options the poll already
+	// offers are skipped. Without add, the options are only previewed.
+	//
+	// POST /polls/{id}/options/generate
+	GeneratePollOptions(ctx context.Context, req *GeneratePollOptionsReq, params GeneratePollOptionsParams) (GeneratePollOptionsRes, error)
 	// GetBookingAvailability implements getBookingAvailability operation.
 	//
 	// Get real-time availability for booking link.
@@ -116,6 +176,12 @@ type Handler interface {
 	//
 	// GET /auth/me
 	GetCurrentUser(ctx context.Context) (GetCurrentUserRes, error)
+	// GetOwnVote implements getOwnVote operation.
+	//
+	// Get own vote by edit token.
+	//
+	// GET /p/poll/{slug}/vote/{token}
+	GetOwnVote(ctx context.Context, params GetOwnVoteParams) (GetOwnVoteRes, error)
 	// GetPoll implements getPoll operation.
 	//
 	// Get poll details.
@@ -128,9 +194,16 @@ type Handler interface {
 	//
 	// GET /polls/{id}/options
 	GetPollOptions(ctx context.Context, params GetPollOptionsParams) ([]PollOption, error)
+	// GetPollRanking implements getPollRanking operation.
+	//
+	// Get ranked results of a poll.
+	//
+	// GET /polls/{id}/results
+	GetPollRanking(ctx context.Context, params GetPollRankingParams) (*PollResults, error)
 	// GetPollResults implements getPollResults operation.
 	//
-	// Get poll results.
+	// Results are only returned if the poll shows results and its timing allows it. Voter details are
+	// reduced to what the poll's visibility permits.
 	//
 	// GET /p/poll/{slug}/results
 	GetPollResults(ctx context.Context, params GetPollResultsParams) (GetPollResultsRes, error)
@@ -152,6 +225,12 @@ type Handler interface {
 	//
 	// GET /p/poll/{slug}
 	GetPublicPoll(ctx context.Context, params GetPublicPollParams) (GetPublicPollRes, error)
+	// GetPublicPollComments implements getPublicPollComments operation.
+	//
+	// List the visible comments of a poll.
+	//
+	// GET /p/poll/{slug}/comments
+	GetPublicPollComments(ctx context.Context, params GetPublicPollCommentsParams) (GetPublicPollCommentsRes, error)
 	// InitiateLogin implements initiateLogin operation.
 	//
 	// Redirect to OIDC provider.
@@ -170,6 +249,30 @@ type Handler interface {
 	//
 	// GET /calendars
 	ListCalendars(ctx context.Context) ([]CalendarConnection, error)
+	// ListMeetingProviders implements listMeetingProviders operation.
+	//
+	// List enabled meeting link providers.
+	//
+	// GET /meeting-providers
+	ListMeetingProviders(ctx context.Context) ([]string, error)
+	// ListPollComments implements listPollComments operation.
+	//
+	// List all comments of a poll, including hidden ones.
+	//
+	// GET /polls/{id}/comments
+	ListPollComments(ctx context.Context, params ListPollCommentsParams) ([]PollComment, error)
+	// ListPollInvites implements listPollInvites operation.
+	//
+	// List invitees of a poll and whether they voted.
+	//
+	// GET /polls/{id}/invites
+	ListPollInvites(ctx context.Context, params ListPollInvitesParams) ([]PollInvite, error)
+	// ListPollProposals implements listPollProposals operation.
+	//
+	// List the option proposals waiting for approval.
+	//
+	// GET /polls/{id}/proposals
+	ListPollProposals(ctx context.Context, params ListPollProposalsParams) ([]PollProposal, error)
 	// ListPolls implements listPolls operation.
 	//
 	// List all polls.
@@ -182,12 +285,31 @@ type Handler interface {
 	//
 	// POST /auth/logout
 	Logout(ctx context.Context) error
+	// ModeratePollComment implements moderatePollComment operation.
+	//
+	// Hide or show a comment.
+	//
+	// PUT /polls/{id}/comments/{commentId}
+	ModeratePollComment(ctx context.Context, req *ModeratePollCommentReq, params ModeratePollCommentParams) (*PollComment, error)
 	// PickPollWinner implements pickPollWinner operation.
 	//
 	// Pick winning option for poll.
 	//
 	// POST /polls/{id}/pick-winner
-	PickPollWinner(ctx context.Context, req *PickPollWinnerReq, params PickPollWinnerParams) error
+	PickPollWinner(ctx context.Context, req *PickPollWinnerReq, params PickPollWinnerParams) (PickPollWinnerRes, error)
+	// ProposePollOption implements proposePollOption operation.
+	//
+	// Only accepted if the poll allows proposals. Depending on the poll, the option is added right away
+	// or waits for the organizer's approval.
+	//
+	// POST /p/poll/{slug}/proposals
+	ProposePollOption(ctx context.Context, req *ProposePollOptionReq, params ProposePollOptionParams) (ProposePollOptionRes, error)
+	// RemindPollInvites implements remindPollInvites operation.
+	//
+	// Send a reminder to all invitees who haven't voted yet.
+	//
+	// POST /polls/{id}/invites/remind
+	RemindPollInvites(ctx context.Context, params RemindPollInvitesParams) (*RemindPollInvitesOK, error)
 	// RemoveCalendar implements removeCalendar operation.
 	//
 	// Remove calendar connection.
@@ -199,7 +321,14 @@ type Handler interface {
 	// Submit poll vote.
 	//
 	// POST /p/poll/{slug}/vote
-	SubmitVote(ctx context.Context, req *SubmitVoteReq, params SubmitVoteParams) (*Vote, error)
+	SubmitVote(ctx context.Context, req *SubmitVoteReq, params SubmitVoteParams) (SubmitVoteRes, error)
+	// SuggestPollOptions implements suggestPollOptions operation.
+	//
+	// Runs the booking availability logic for the given range: slots within the availability rules that
+	// don't overlap busy times in the connected calendars. Times the poll already offers are skipped.
+	//
+	// POST /polls/{id}/options/suggest
+	SuggestPollOptions(ctx context.Context, req *SuggestPollOptionsReq, params SuggestPollOptionsParams) (SuggestPollOptionsRes, error)
 	// TestCalendar implements testCalendar operation.
 	//
 	// Test calendar connection by fetching events.
@@ -211,19 +340,32 @@ type Handler interface {
 	// Update a booking link.
 	//
 	// PUT /booking-links/{id}
-	UpdateBookingLink(ctx context.Context, req *UpdateBookingLinkReq, params UpdateBookingLinkParams) (*BookingLink, error)
+	UpdateBookingLink(ctx context.Context, req *UpdateBookingLinkReq, params UpdateBookingLinkParams) (UpdateBookingLinkRes, error)
 	// UpdateCurrentUser implements updateCurrentUser operation.
 	//
 	// Update current user profile.
 	//
 	// PUT /auth/me
 	UpdateCurrentUser(ctx context.Context, req *UpdateCurrentUserReq) (UpdateCurrentUserRes, error)
+	// UpdateOwnVote implements updateOwnVote operation.
+	//
+	// Update own vote by edit token.
+	//
+	// PUT /p/poll/{slug}/vote/{token}
+	UpdateOwnVote(ctx context.Context, req *UpdateOwnVoteReq, params UpdateOwnVoteParams) (UpdateOwnVoteRes, error)
 	// UpdatePoll implements updatePoll operation.
 	//
 	// Update a poll.
 	//
 	// PUT /polls/{id}
-	UpdatePoll(ctx context.Context, req *UpdatePollReq, params UpdatePollParams) (*Poll, error)
+	UpdatePoll(ctx context.Context, req *UpdatePollReq, params UpdatePollParams) (UpdatePollRes, error)
+	// VerifyBookingEmail implements verifyBookingEmail operation.
+	//
+	// Confirms a pending booking that matched an email based auto-confirm rule once the guest proved
+	// they own the address.
+	//
+	// GET /actions/verify-email
+	VerifyBookingEmail(ctx context.Context, params VerifyBookingEmailParams) (VerifyBookingEmailRes, error)
 }
 
 // Server implements http server based on OpenAPI v3 specification and
@@ -22,6 +22,24 @@ func (UnimplementedHandler) AddCalendar(ctx context.Context, req *AddCalendarReq
 	return r, ht.ErrNotImplemented
 }
 
+// AddPollComment implements addPollComment operation.
+//
+// Comment on a poll or one of its options.
+//
+// POST /p/poll/{slug}/comments
+func (UnimplementedHandler) AddPollComment(ctx context.Context, req *AddPollCommentReq, params AddPollCommentParams) (r AddPollCommentRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// AddPollInvites implements addPollInvites operation.
+//
+// Invite participants and email them a personal link.
+//
+// POST /polls/{id}/invites
+func (UnimplementedHandler) AddPollInvites(ctx context.Context, req *AddPollInvitesReq, params AddPollInvitesParams) (r AddPollInvitesRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // AddPollOption implements addPollOption operation.
 //
 // Add an option to a poll.
@@ -40,6 +58,15 @@ func (UnimplementedHandler) ApproveBooking(ctx context.Context, params ApproveBo
 	return r, ht.ErrNotImplemented
 }
 
+// ApprovePollProposal implements approvePollProposal operation.
+//
+// Add a proposal to the options of the poll.
+//
+// POST /polls/{id}/proposals/{proposalId}/approve
+func (UnimplementedHandler) ApprovePollProposal(ctx context.Context, params ApprovePollProposalParams) (r ApprovePollProposalRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // ApproveViaEmail implements approveViaEmail operation.
 //
 // Approve booking via email link.
@@ -58,6 +85,18 @@ func (UnimplementedHandler) AuthCallback(ctx context.Context, params AuthCallbac
 	return r, ht.ErrNotImplemented
 }
 
+// AutofillVote implements autofillVote operation.
+//
+// Reads the busy times from a private ICS or CalDAV free-busy URL, or from the contents of an
+// uploaded .ics file, and suggests an answer per option: no if the voter is busy, yes otherwise.
+// Full-day and multi-day options the voter is partly busy on get maybe. The calendar is only used to
+// compute the answers and is not stored.
+//
+// POST /p/poll/{slug}/autofill
+func (UnimplementedHandler) AutofillVote(ctx context.Context, req *AutofillVoteReq, params AutofillVoteParams) (r AutofillVoteRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // CreateBooking implements createBooking operation.
 //
 // Create a booking.
@@ -72,7 +111,7 @@ func (UnimplementedHandler) CreateBooking(ctx context.Context, req *CreateBookin
 // Create a booking link.
 //
 // POST /booking-links
-func (UnimplementedHandler) CreateBookingLink(ctx context.Context, req *CreateBookingLinkReq) (r *BookingLink, _ error) {
+func (UnimplementedHandler) CreateBookingLink(ctx context.Context, req *CreateBookingLinkReq) (r CreateBookingLinkRes, _ error) {
 	return r, ht.ErrNotImplemented
 }
 
@@ -81,7 +120,7 @@ func (UnimplementedHandler) CreateBookingLink(ctx context.Context, req *CreateBo
 // Create a poll.
 //
 // POST /polls
-func (UnimplementedHandler) CreatePoll(ctx context.Context, req *CreatePollReq) (r *Poll, _ error) {
+func (UnimplementedHandler) CreatePoll(ctx context.Context, req *CreatePollReq) (r CreatePollRes, _ error) {
 	return r, ht.ErrNotImplemented
 }
 
@@ -94,6 +133,15 @@ func (UnimplementedHandler) DeclineBooking(ctx context.Context, params DeclineBo
 	return r, ht.ErrNotImplemented
 }
 
+// DeclinePollProposal implements declinePollProposal operation.
+//
+// Decline an option proposal.
+//
+// DELETE /polls/{id}/proposals/{proposalId}
+func (UnimplementedHandler) DeclinePollProposal(ctx context.Context, params DeclinePollProposalParams) error {
+	return ht.ErrNotImplemented
+}
+
 // DeclineViaEmail implements declineViaEmail operation.
 //
 // Decline booking via email link.
@@ -112,6 +160,15 @@ func (UnimplementedHandler) DeleteBookingLink(ctx context.Context, params Delete
 	return ht.ErrNotImplemented
 }
 
+// DeleteOwnVote implements deleteOwnVote operation.
+//
+// Delete own vote by edit token.
+//
+// DELETE /p/poll/{slug}/vote/{token}
+func (UnimplementedHandler) DeleteOwnVote(ctx context.Context, params DeleteOwnVoteParams) (r DeleteOwnVoteRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // DeletePoll implements deletePoll operation.
 //
 // Delete a poll.
@@ -121,6 +178,24 @@ func (UnimplementedHandler) DeletePoll(ctx context.Context, params DeletePollPar
 	return ht.ErrNotImplemented
 }
 
+// DeletePollComment implements deletePollComment operation.
+//
+// Delete a comment.
+//
+// DELETE /polls/{id}/comments/{commentId}
+func (UnimplementedHandler) DeletePollComment(ctx context.Context, params DeletePollCommentParams) error {
+	return ht.ErrNotImplemented
+}
+
+// DeletePollInvite implements deletePollInvite operation.
+//
+// Remove an invitee from a poll.
+//
+// DELETE /polls/{id}/invites/{inviteId}
+func (UnimplementedHandler) DeletePollInvite(ctx context.Context, params DeletePollInviteParams) error {
+	return ht.ErrNotImplemented
+}
+
 // DeletePollOption implements deletePollOption operation.
 //
 // Delete an option from a poll.
@@ -139,6 +214,18 @@ func (UnimplementedHandler) DiscoverCalendars(ctx context.Context, req *Discover
 	return r, ht.ErrNotImplemented
 }
 
+// GeneratePollOptions implements generatePollOptions operation.
+//
+// Creates one option per matching day between start_date and end_date, for each of the given times.
+// Full-day options cover the whole day, multi-day options span span_days days. Times are wall-clock
+// times in time_zone, so they stay the same across daylight saving changes. Options the poll already
+// offers are skipped. Without add, the options are only previewed.
+//
+// POST /polls/{id}/options/generate
+func (UnimplementedHandler) GeneratePollOptions(ctx context.Context, req *GeneratePollOptionsReq, params GeneratePollOptionsParams) (r GeneratePollOptionsRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // GetBookingAvailability implements getBookingAvailability operation.
 //
 // Get real-time availability for booking link.
@@ -175,6 +262,15 @@ func (UnimplementedHandler) GetCurrentUser(ctx context.Context) (r GetCurrentUse
 	return r, ht.ErrNotImplemented
 }
 
+// GetOwnVote implements getOwnVote operation.
+//
+// Get own vote by edit token.
+//
+// GET /p/poll/{slug}/vote/{token}
+func (UnimplementedHandler) GetOwnVote(ctx context.Context, params GetOwnVoteParams) (r GetOwnVoteRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // GetPoll implements getPoll operation.
 //
 // Get poll details.
@@ -193,9 +289,19 @@ func (UnimplementedHandler) GetPollOptions(ctx context.Context, params GetPollOp
 	return r, ht.ErrNotImplemented
 }
 
+// GetPollRanking implements getPollRanking operation.
+//
+// Get ranked results of a poll.
+//
+// GET /polls/{id}/results
+func (UnimplementedHandler) GetPollRanking(ctx context.Context, params GetPollRankingParams) (r *PollResults, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // GetPollResults implements getPollResults operation.
 //
-// Get poll results.
+// Results are only returned if the poll shows results and its timing allows it. Voter details are
+// reduced to what the poll's visibility permits.
 //
 // GET /p/poll/{slug}/results
 func (UnimplementedHandler) GetPollResults(ctx context.Context, params GetPollResultsParams) (r GetPollResultsRes, _ error) {
@@ -229,6 +335,15 @@ func (UnimplementedHandler) GetPublicPoll(ctx context.Context, params GetPublicP
 	return r, ht.ErrNotImplemented
 }
 
+// GetPublicPollComments implements getPublicPollComments operation.
+//
+// List the visible comments of a poll.
+//
+// GET /p/poll/{slug}/comments
+func (UnimplementedHandler) GetPublicPollComments(ctx context.Context, params GetPublicPollCommentsParams) (r GetPublicPollCommentsRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // InitiateLogin implements initiateLogin operation.
 //
 // Redirect to OIDC provider.
@@ -256,6 +371,42 @@ func (UnimplementedHandler) ListCalendars(ctx context.Context) (r []CalendarConn
 	return r, ht.ErrNotImplemented
 }
 
+// ListMeetingProviders implements listMeetingProviders operation.
+//
+// List enabled meeting link providers.
+//
+// GET /meeting-providers
+func (UnimplementedHandler) ListMeetingProviders(ctx context.Context) (r []string, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ListPollComments implements listPollComments operation.
+//
+// List all comments of a poll, including hidden ones.
+//
+// GET /polls/{id}/comments
+func (UnimplementedHandler) ListPollComments(ctx context.Context, params ListPollCommentsParams) (r []PollComment, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ListPollInvites implements listPollInvites operation.
+//
+// List invitees of a poll and whether they voted.
+//
+// GET /polls/{id}/invites
+func (UnimplementedHandler) ListPollInvites(ctx context.Context, params ListPollInvitesParams) (r []PollInvite, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ListPollProposals implements listPollProposals operation.
+//
+// List the option proposals waiting for approval.
+//
+// GET /polls/{id}/proposals
+func (UnimplementedHandler) ListPollProposals(ctx context.Context, params ListPollProposalsParams) (r []PollProposal, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // ListPolls implements listPolls operation.
 //
 // List all polls.
@@ -274,13 +425,41 @@ func (UnimplementedHandler) Logout(ctx context.Context) error {
 	return ht.ErrNotImplemented
 }
 
+// ModeratePollComment implements moderatePollComment operation.
+//
+// Hide or show a comment.
+//
+// PUT /polls/{id}/comments/{commentId}
+func (UnimplementedHandler) ModeratePollComment(ctx context.Context, req *ModeratePollCommentReq, params ModeratePollCommentParams) (r *PollComment, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // PickPollWinner implements pickPollWinner operation.
 //
 // Pick winning option for poll.
 //
 // POST /polls/{id}/pick-winner
-func (UnimplementedHandler) PickPollWinner(ctx context.Context, req *PickPollWinnerReq, params PickPollWinnerParams) error {
-	return ht.ErrNotImplemented
+func (UnimplementedHandler) PickPollWinner(ctx context.Context, req *PickPollWinnerReq, params PickPollWinnerParams) (r PickPollWinnerRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ProposePollOption implements proposePollOption operation.
+//
+// Only accepted if the poll allows proposals. Depending on the poll, the option is added right away
+// or waits for the organizer's approval.
+//
+// POST /p/poll/{slug}/proposals
+func (UnimplementedHandler) ProposePollOption(ctx context.Context, req *ProposePollOptionReq, params ProposePollOptionParams) (r ProposePollOptionRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// RemindPollInvites implements remindPollInvites operation.
+//
+// Send a reminder to all invitees who haven't voted yet.
+//
+// POST /polls/{id}/invites/remind
+func (UnimplementedHandler) RemindPollInvites(ctx context.Context, params RemindPollInvitesParams) (r *RemindPollInvitesOK, _ error) {
+	return r, ht.ErrNotImplemented
 }
 
 // RemoveCalendar implements removeCalendar operation.
@@ -297,7 +476,17 @@ func (UnimplementedHandler) RemoveCalendar(ctx context.Context, params RemoveCal
 // Submit poll vote.
 //
 // POST /p/poll/{slug}/vote
-func (UnimplementedHandler) SubmitVote(ctx context.Context, req *SubmitVoteReq, params SubmitVoteParams) (r *Vote, _ error) {
+func (UnimplementedHandler) SubmitVote(ctx context.Context, req *SubmitVoteReq, params SubmitVoteParams) (r SubmitVoteRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// SuggestPollOptions implements suggestPollOptions operation.
+//
+// Runs the booking availability logic for the given range: slots within the availability rules that
+// don't overlap busy times in the connected calendars. Times the poll already offers are skipped.
+//
+// POST /polls/{id}/options/suggest
+func (UnimplementedHandler) SuggestPollOptions(ctx context.Context, req *SuggestPollOptionsReq, params SuggestPollOptionsParams) (r SuggestPollOptionsRes, _ error) {
 	return r, ht.ErrNotImplemented
 }
 
@@ -315,7 +504,7 @@ func (UnimplementedHandler) TestCalendar(ctx context.Context, params TestCalenda
 // Update a booking link.
 //
 // PUT /booking-links/{id}
-func (UnimplementedHandler) UpdateBookingLink(ctx context.Context, req *UpdateBookingLinkReq, params UpdateBookingLinkParams) (r *BookingLink, _ error) {
+func (UnimplementedHandler) UpdateBookingLink(ctx context.Context, req *UpdateBookingLinkReq, params UpdateBookingLinkParams) (r UpdateBookingLinkRes, _ error) {
 	return r, ht.ErrNotImplemented
 }
 
@@ -328,11 +517,30 @@ func (UnimplementedHandler) UpdateCurrentUser(ctx context.Context, req *UpdateCu
 	return r, ht.ErrNotImplemented
 }
 
+// UpdateOwnVote implements updateOwnVote operation.
+//
+// Update own vote by edit token.
+//
+// PUT /p/poll/{slug}/vote/{token}
+func (UnimplementedHandler) UpdateOwnVote(ctx context.Context, req *UpdateOwnVoteReq, params UpdateOwnVoteParams) (r UpdateOwnVoteRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // UpdatePoll implements updatePoll operation.
 //
 // Update a poll.
 //
 // PUT /polls/{id}
-func (UnimplementedHandler) UpdatePoll(ctx context.Context, req *UpdatePollReq, params UpdatePollParams) (r *Poll, _ error) {
+func (UnimplementedHandler) UpdatePoll(ctx context.Context, req *UpdatePollReq, params UpdatePollParams) (r UpdatePollRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// VerifyBookingEmail implements verifyBookingEmail operation.
+//
+// Confirms a pending booking that matched an email based auto-confirm rule once the guest proved
+// they own the address.
+//
+// GET /actions/verify-email
+func (UnimplementedHandler) VerifyBookingEmail(ctx context.Context, params VerifyBookingEmailParams) (r VerifyBookingEmailRes, _ error) {
 	return r, ht.ErrNotImplemented
 }
@@ -10,21 +10,27 @@ import (
 // Handler implements the generated Handler interface
 type Handler struct {
 	gen.UnimplementedHandler
-	db     *gorm.DB
-	auth   *AuthService
-	caldav *CalDAVClient
-	mailer *Mailer
-	config *Config
+	db          *gorm.DB
+	auth        *AuthService
+	caldav      *CalDAVClient
+	mailer      *Mailer
+	meetings    *MeetingService
+	events      *EventBroker
+	attachments FileStorage
+	config      *Config
 }
 
 var _ gen.Handler = (*Handler)(nil)
 
-func NewHandler(db *gorm.DB, auth *AuthService, caldav *CalDAVClient, mailer *Mailer, config *Config) *Handler {
+func NewHandler(db *gorm.DB, auth *AuthService, caldav *CalDAVClient, mailer *Mailer, meetings *MeetingService, events *EventBroker, attachments FileStorage, config *Config) *Handler {
 	return &Handler{
-		db:     db,
-		auth:   auth,
-		caldav: caldav,
-		mailer: mailer,
-		config: config,
+		db:          db,
+		auth:        auth,
+		caldav:      caldav,
+		mailer:      mailer,
+		meetings:    meetings,
+		events:      events,
+		attachments: attachments,
+		config:      config,
 	}
 }
@@ -3,6 +3,9 @@ package api
 
 import (
 	"context"
+	"errors"
+
+	"gorm.io/gorm"
 
 	gen "github.com/kolaente/meet-mesh/api/gen"
 )
@@ -25,31 +28,22 @@ func (h *Handler) ApproveViaEmail(ctx context.Context) (gen.ApproveViaEmailRes,
 		}, nil
 	}
 
-	booking.Status = BookingStatusConfirmed
-	if err := h.db.Save(&booking).Error; err != nil {
+	// The action token is single use
+	resolved, err := h.resolvePendingBooking(ctx, &booking, BookingStatusConfirmed)
+	if err != nil {
 		return nil, err
 	}
-
-	// Clear the action token (single use)
-	h.db.Model(&booking).Update("action_token", "")
+	if !resolved {
+		return &gen.ApproveViaEmailOK{
+			Message: gen.NewOptString("Booking already processed"),
+		}, nil
+	}
 
 	// Get organizer email
 	var organizer User
 	h.db.First(&organizer, booking.BookingLink.UserID)
 
-	// Send confirmation email with ICS
-	if h.mailer != nil {
-		_ = h.mailer.SendBookingApprovedWithICS(&booking, &booking.BookingLink, &organizer)
-	}
-
-	// Create calendar event
-	if h.caldav != nil {
-		uid, err := h.caldav.CreateBookingEvent(ctx, booking.BookingLink.UserID, &booking, &booking.Slot, booking.BookingLink.EventTemplate, booking.BookingLink.MeetingLink)
-		if err == nil && uid != "" {
-			booking.CalendarUID = uid
-			h.db.Save(&booking)
-		}
-	}
+	h.notifyBookingConfirmed(ctx, &booking, &organizer)
 
 	return &gen.ApproveViaEmailOK{
 		Message: gen.NewOptString("Booking approved successfully"),
@@ -74,24 +68,60 @@ func (h *Handler) DeclineViaEmail(ctx context.Context) (gen.DeclineViaEmailRes,
 		}, nil
 	}
 
-	booking.Status = BookingStatusDeclined
-	if err := h.db.Save(&booking).Error; err != nil {
+	// The action token is single use
+	resolved, err := h.resolvePendingBooking(ctx, &booking, BookingStatusDeclined)
+	if err != nil {
 		return nil, err
 	}
-
-	// Clear the action token (single use)
-	h.db.Model(&booking).Update("action_token", "")
+	if !resolved {
+		return &gen.DeclineViaEmailOK{
+			Message: gen.NewOptString("Booking already processed"),
+		}, nil
+	}
 
 	// Get organizer for email
 	var organizer User
 	h.db.First(&organizer, booking.BookingLink.UserID)
 
-	// Send decline email
-	if h.mailer != nil {
-		_ = h.mailer.SendBookingDeclined(&booking, &booking.BookingLink, &organizer)
-	}
+	h.notifyBookingDeclined(ctx, &booking, &organizer)
 
 	return &gen.DeclineViaEmailOK{
 		Message: gen.NewOptString("Booking declined"),
 	}, nil
 }
+
+// VerifyBookingEmail confirms a booking that matched an email based
+// auto-confirm rule via the link sent to the guest
+func (h *Handler) VerifyBookingEmail(ctx context.Context, params gen.VerifyBookingEmailParams) (gen.VerifyBookingEmailRes, error) {
+	if params.Token == "" {
+		return &gen.Error{Message: "Invalid token"}, nil
+	}
+
+	var booking Booking
+	if err := h.db.Preload("BookingLink").Preload("Slot").Where("verify_token = ?", params.Token).First(&booking).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return &gen.Error{Message: "Invalid or expired link"}, nil
+		}
+		return nil, err
+	}
+
+	// The verify token is single use
+	resolved, err := h.resolvePendingBooking(ctx, &booking, BookingStatusConfirmed)
+	if err != nil {
+		return nil, err
+	}
+	if !resolved {
+		return &gen.VerifyBookingEmailOK{
+			Message: gen.NewOptString("Booking already processed"),
+		}, nil
+	}
+
+	var organizer User
+	h.db.First(&organizer, booking.BookingLink.UserID)
+
+	h.notifyBookingConfirmed(ctx, &booking, &organizer)
+
+	return &gen.VerifyBookingEmailOK{
+		Message: gen.NewOptString("Your booking is confirmed"),
+	}, nil
+}
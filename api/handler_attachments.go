@@ -0,0 +1,239 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"gorm.io/gorm"
+)
+
+// maxAttachmentNameLength limits the length of stored file names.
+const maxAttachmentNameLength = 200
+
+// AttachmentHandler handles files guests attach to bookings. Like
+// AvatarHandler these are plain HTTP handlers because ogen does not support
+// multipart uploads.
+type AttachmentHandler struct {
+	db      *gorm.DB
+	auth    *AuthService
+	storage FileStorage
+	// maxPendingBytes limits the total size of files no booking claimed yet
+	maxPendingBytes int64
+}
+
+func NewAttachmentHandler(db *gorm.DB, auth *AuthService, storage FileStorage, maxPendingBytes int64) *AttachmentHandler {
+	return &AttachmentHandler{
+		db:              db,
+		auth:            auth,
+		storage:         storage,
+		maxPendingBytes: maxPendingBytes,
+	}
+}
+
+// HandleAttachments is the main handler for /api/attachments that dispatches
+// by method.
+//
+//	POST /api/attachments?booking_link={slug}&field={name}  upload (public)
+//	GET  /api/attachments/{id}                              download (organizer)
+func (h *AttachmentHandler) HandleAttachments(w http.ResponseWriter, r *http.Request) {
+	id := strings.Trim(strings.TrimPrefix(r.URL.Path, "/api/attachments"), "/")
+
+	switch {
+	case r.Method == http.MethodPost && id == "":
+		h.Upload(w, r)
+	case r.Method == http.MethodGet && id != "":
+		h.Download(w, r, id)
+	default:
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+	}
+}
+
+// Upload handles POST /api/attachments. The file is kept until the booking
+// that claims it with the returned token is created.
+func (h *AttachmentHandler) Upload(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	var link BookingLink
+	if err := h.db.Where("slug = ? AND status = ?", query.Get("booking_link"), LinkStatusActive).First(&link).Error; err != nil {
+		http.Error(w, "Booking link not found", http.StatusNotFound)
+		return
+	}
+
+	var field *CustomField
+	for i, f := range link.CustomFields {
+		if f.Name == query.Get("field") && f.Type == CustomFieldTypeFile {
+			field = &link.CustomFields[i]
+			break
+		}
+	}
+	if field == nil {
+		http.Error(w, "Field not found", http.StatusNotFound)
+		return
+	}
+
+	// Files are public uploads, so only a few may wait for their booking
+	var pending int64
+	if err := h.db.Model(&Attachment{}).
+		Where("booking_link_id = ? AND field_name = ? AND booking_id IS NULL", link.ID, field.Name).
+		Count(&pending).Error; err != nil {
+		http.Error(w, "Failed to save file", http.StatusInternalServerError)
+		return
+	}
+	if pending >= maxPendingAttachmentsPerField {
+		http.Error(w, "Too many files are waiting for a booking, please try again later", http.StatusTooManyRequests)
+		return
+	}
+
+	// Leave room for the multipart headers around the file
+	limit := attachmentSizeLimit(*field)
+	r.Body = http.MaxBytesReader(w, r.Body, limit+64<<10)
+	if err := r.ParseMultipartForm(1 << 20); err != nil {
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			http.Error(w, fmt.Sprintf("File too large (max %dMB)", limit>>20), http.StatusBadRequest)
+			return
+		}
+		http.Error(w, "Invalid upload, expected a multipart form with a file", http.StatusBadRequest)
+		return
+	}
+	defer func() { _ = r.MultipartForm.RemoveAll() }()
+
+	file, header, err := r.FormFile("file")
+	if err != nil {
+		http.Error(w, "Missing file", http.StatusBadRequest)
+		return
+	}
+	defer func() { _ = file.Close() }()
+
+	if header.Size > limit {
+		http.Error(w, fmt.Sprintf("File too large (max %dMB)", limit>>20), http.StatusBadRequest)
+		return
+	}
+
+	var pendingBytes int64
+	if err := h.db.Model(&Attachment{}).
+		Where("booking_id IS NULL").
+		Select("COALESCE(SUM(size), 0)").
+		Scan(&pendingBytes).Error; err != nil {
+		http.Error(w, "Failed to save file", http.StatusInternalServerError)
+		return
+	}
+	if pendingBytes+header.Size > h.maxPendingBytes {
+		http.Error(w, "Too many files are waiting for a booking, please try again later", http.StatusTooManyRequests)
+		return
+	}
+
+	data, err := io.ReadAll(file)
+	if err != nil {
+		http.Error(w, "Failed to read file", http.StatusInternalServerError)
+		return
+	}
+
+	filename := attachmentFilename(header.Filename)
+	contentType := attachmentContentType(filename, http.DetectContentType(data))
+	if !acceptsFileType(*field, filename, contentType) {
+		http.Error(w, "Invalid file type. Allowed: "+strings.Join(field.FileTypes, ", "), http.StatusBadRequest)
+		return
+	}
+
+	attachment := Attachment{
+		BookingLinkID: link.ID,
+		FieldName:     field.Name,
+		Token:         generateToken(),
+		Filename:      filename,
+		StorageKey:    generateToken(),
+		ContentType:   contentType,
+		Size:          int64(len(data)),
+	}
+
+	if err := h.storage.Put(r.Context(), attachment.StorageKey, data, contentType); err != nil {
+		http.Error(w, "Failed to save file", http.StatusInternalServerError)
+		return
+	}
+	if err := h.db.Create(&attachment).Error; err != nil {
+		_ = h.storage.Delete(r.Context(), attachment.StorageKey)
+		http.Error(w, "Failed to save file", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	_ = json.NewEncoder(w).Encode(map[string]any{
+		"token":    attachment.Token,
+		"filename": attachment.Filename,
+		"size":     attachment.Size,
+	})
+}
+
+// Download handles GET /api/attachments/{id} for the organizer of the
+// booking.
+func (h *AttachmentHandler) Download(w http.ResponseWriter, r *http.Request, idStr string) {
+	userID, ok := authenticateSession(h.auth, r)
+	if !ok {
+		http.Error(w, "Not authenticated", http.StatusUnauthorized)
+		return
+	}
+
+	id, err := strconv.Atoi(idStr)
+	var attachment Attachment
+	if err != nil || h.db.
+		Joins("JOIN booking_links ON booking_links.id = attachments.booking_link_id").
+		Where("attachments.id = ? AND attachments.booking_id IS NOT NULL AND booking_links.user_id = ?", id, userID).
+		First(&attachment).Error != nil {
+		http.NotFound(w, r)
+		return
+	}
+
+	file, err := h.storage.Get(r.Context(), attachment.StorageKey)
+	if err != nil {
+		if errors.Is(err, ErrFileNotFound) {
+			http.NotFound(w, r)
+			return
+		}
+		http.Error(w, "Failed to read file", http.StatusInternalServerError)
+		return
+	}
+	defer func() { _ = file.Body.Close() }()
+
+	// Guests choose the content, so browsers must never render it inline
+	w.Header().Set("Content-Type", attachment.ContentType)
+	w.Header().Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": attachment.Filename}))
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.Header().Set("Cache-Control", "private, no-cache")
+	if file.ETag != "" {
+		w.Header().Set("ETag", file.ETag)
+	}
+
+	http.ServeContent(w, r, "", file.ModTime, file.Body)
+}
+
+// attachmentFilename returns the base name of an uploaded file without
+// control characters, shortened to maxAttachmentNameLength characters.
+func attachmentFilename(name string) string {
+	name = filepath.Base(strings.ReplaceAll(name, `\`, "/"))
+	name = strings.Map(func(r rune) rune {
+		if r < 0x20 || r == 0x7f {
+			return -1
+		}
+		return r
+	}, name)
+	if utf8.RuneCountInString(name) > maxAttachmentNameLength {
+		ext := filepath.Ext(name)
+		if utf8.RuneCountInString(ext) > 16 {
+			ext = ""
+		}
+		name = string([]rune(name)[:maxAttachmentNameLength-utf8.RuneCountInString(ext)]) + ext
+	}
+	if name == "" || name == "." || name == "/" {
+		name = "attachment"
+	}
+	return name
+}
@@ -0,0 +1,172 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	gen "github.com/kolaente/meet-mesh/api/gen"
+)
+
+func uploadAttachment(t *testing.T, h *AttachmentHandler, target, filename, content string) *httptest.ResponseRecorder {
+	t.Helper()
+	var body bytes.Buffer
+	mw := multipart.NewWriter(&body)
+	fw, err := mw.CreateFormFile("file", filename)
+	if err != nil {
+		t.Fatalf("CreateFormFile failed: %v", err)
+	}
+	_, _ = fw.Write([]byte(content))
+	_ = mw.Close()
+
+	r := httptest.NewRequest(http.MethodPost, target, &body)
+	r.Header.Set("Content-Type", mw.FormDataContentType())
+	w := httptest.NewRecorder()
+	h.HandleAttachments(w, r)
+	return w
+}
+
+func TestAttachments(t *testing.T) {
+	handler := newTestHandler(t)
+	storage, err := NewLocalFileStorage(t.TempDir())
+	if err != nil {
+		t.Fatalf("NewLocalFileStorage failed: %v", err)
+	}
+	handler.attachments = storage
+	handler.config.Server.BaseURL = "https://meet.example.com"
+	h := NewAttachmentHandler(handler.db, &AuthService{}, storage, 1<<20)
+	ctx := context.Background()
+
+	link := BookingLink{UserID: 1, Slug: "intro", Name: "Intro", Status: LinkStatusActive, CustomFields: []CustomField{
+		{Name: "agenda", Type: CustomFieldTypeFile, FileTypes: []string{".pdf", "text/plain"}},
+	}}
+	handler.db.Create(&link)
+
+	if w := uploadAttachment(t, h, "/api/attachments?booking_link=intro&field=agenda", "run.sh", "#!/bin/sh"); w.Code != http.StatusBadRequest {
+		t.Fatalf("expected disallowed type to be rejected, got %d", w.Code)
+	}
+	if w := uploadAttachment(t, h, "/api/attachments?booking_link=intro&field=other", "notes.txt", "hi"); w.Code != http.StatusNotFound {
+		t.Fatalf("expected unknown field to be rejected, got %d", w.Code)
+	}
+
+	w := uploadAttachment(t, h, "/api/attachments?booking_link=intro&field=agenda", `C:\docs\notes.txt`, "Agenda")
+	if w.Code != http.StatusCreated {
+		t.Fatalf("upload failed: %d %s", w.Code, w.Body.String())
+	}
+	var uploaded struct {
+		Token    string `json:"token"`
+		Filename string `json:"filename"`
+	}
+	if err := json.NewDecoder(w.Body).Decode(&uploaded); err != nil || uploaded.Filename != "notes.txt" {
+		t.Fatalf("unexpected upload response %+v: %v", uploaded, err)
+	}
+
+	answers := map[string]string{"agenda": uploaded.Token}
+	attachments, problems := handler.claimAttachments(&link, answers)
+	if len(problems) > 0 || len(attachments) != 1 || answers["agenda"] != "notes.txt" {
+		t.Fatalf("expected the upload to be claimed, got %+v %+v %v", attachments, problems, answers)
+	}
+
+	booking := Booking{BookingLinkID: link.ID, GuestEmail: "guest@example.com", ActionToken: "a"}
+	handler.db.Create(&booking)
+	handler.attachToBooking(attachments, booking.ID)
+
+	if _, problems := handler.claimAttachments(&link, map[string]string{"agenda": uploaded.Token}); len(problems) != 1 {
+		t.Fatalf("expected a claimed upload not to be claimed again, got %+v", problems)
+	}
+
+	notes := handler.attachmentNotes(booking.ID)
+	if !strings.Contains(notes, "notes.txt: https://meet.example.com/api/attachments/") {
+		t.Fatalf("unexpected notes %q", notes)
+	}
+
+	download := func(userID uint) *httptest.ResponseRecorder {
+		r := httptest.NewRequest(http.MethodGet, "/api/attachments/1", nil)
+		cookie, _ := h.auth.CreateSessionCookie(&Session{UserID: userID, ExpiresAt: time.Now().Add(time.Hour)})
+		r.AddCookie(cookie)
+		w := httptest.NewRecorder()
+		h.HandleAttachments(w, r)
+		return w
+	}
+	if w := download(2); w.Code != http.StatusNotFound {
+		t.Fatalf("expected other users not to download the file, got %d", w.Code)
+	}
+	w = download(1)
+	if w.Code != http.StatusOK || w.Body.String() != "Agenda" {
+		t.Fatalf("download failed: %d %s", w.Code, w.Body.String())
+	}
+	if !strings.HasPrefix(w.Header().Get("Content-Disposition"), "attachment") {
+		t.Errorf("expected the file to be downloaded as attachment, got %q", w.Header().Get("Content-Disposition"))
+	}
+
+	// Unused uploads expire, files of bookings stay
+	stale := Attachment{BookingLinkID: link.ID, FieldName: "agenda", Token: "stale", Filename: "old.pdf", StorageKey: "stale", ContentType: "application/pdf", CreatedAt: time.Now().Add(-2 * pendingAttachmentTTL)}
+	handler.db.Create(&stale)
+	if err := handler.CleanupAttachments(ctx); err != nil {
+		t.Fatalf("CleanupAttachments failed: %v", err)
+	}
+	var count int64
+	handler.db.Model(&Attachment{}).Count(&count)
+	if count != 1 {
+		t.Fatalf("expected only the booking's attachment to remain, got %d", count)
+	}
+
+	if err := handler.DeleteBookingLink(WithUserID(ctx, 1), gen.DeleteBookingLinkParams{ID: int(link.ID)}); err != nil {
+		t.Fatalf("DeleteBookingLink failed: %v", err)
+	}
+	handler.db.Model(&Attachment{}).Count(&count)
+	if count != 0 {
+		t.Fatalf("expected attachments of the deleted link to be removed, got %d", count)
+	}
+	if _, err := storage.Get(ctx, attachments[0].StorageKey); err != ErrFileNotFound {
+		t.Fatalf("expected the file to be deleted, got %v", err)
+	}
+}
+
+func TestAttachmentUploadLimits(t *testing.T) {
+	handler := newTestHandler(t)
+	storage, err := NewLocalFileStorage(t.TempDir())
+	if err != nil {
+		t.Fatalf("NewLocalFileStorage failed: %v", err)
+	}
+	h := NewAttachmentHandler(handler.db, &AuthService{}, storage, 100)
+
+	link := BookingLink{UserID: 1, Slug: "intro", Name: "Intro", Status: LinkStatusActive, CustomFields: []CustomField{
+		{Name: "agenda", Type: CustomFieldTypeFile},
+		{Name: "slides", Type: CustomFieldTypeFile},
+	}}
+	handler.db.Create(&link)
+	target := "/api/attachments?booking_link=intro&field="
+
+	r := httptest.NewRequest(http.MethodPost, target+"agenda", strings.NewReader("not a form"))
+	w := httptest.NewRecorder()
+	h.HandleAttachments(w, r)
+	if w.Code != http.StatusBadRequest || strings.Contains(w.Body.String(), "too large") {
+		t.Errorf("expected a malformed upload to be rejected as invalid, got %d %s", w.Code, w.Body.String())
+	}
+
+	// Uploads no booking claimed yet count against the total size limit
+	if w := uploadAttachment(t, h, target+"agenda", "a.txt", strings.Repeat("a", 60)); w.Code != http.StatusCreated {
+		t.Fatalf("upload failed: %d %s", w.Code, w.Body.String())
+	}
+	if w := uploadAttachment(t, h, target+"slides", "b.txt", strings.Repeat("b", 60)); w.Code != http.StatusTooManyRequests {
+		t.Errorf("expected the total size limit to be enforced, got %d", w.Code)
+	}
+
+	// And against the number of files per field
+	for i := 1; i < maxPendingAttachmentsPerField; i++ {
+		handler.db.Create(&Attachment{BookingLinkID: link.ID, FieldName: "agenda", Token: generateToken(), Filename: "x.txt", StorageKey: generateToken()})
+	}
+	if w := uploadAttachment(t, h, target+"agenda", "c.txt", "c"); w.Code != http.StatusTooManyRequests {
+		t.Errorf("expected the per field limit to be enforced, got %d", w.Code)
+	}
+	if w := uploadAttachment(t, h, target+"slides", "d.txt", "d"); w.Code != http.StatusCreated {
+		t.Errorf("expected other fields to accept uploads, got %d %s", w.Code, w.Body.String())
+	}
+}
@@ -135,6 +135,7 @@ func (h *Handler) GetCurrentUser(ctx context.Context) (gen.GetCurrentUserRes, er
 		Email:     user.Email,
 		Name:      gen.NewOptString(user.Name),
 		AvatarURL: gen.NewOptString(avatarURL(user.AvatarFilename)),
+		Branding:  mapBrandingToGen(user.Branding),
 	}, nil
 }
 
@@ -154,6 +155,7 @@ func (h *Handler) UpdateCurrentUser(ctx context.Context, req *gen.UpdateCurrentU
 	if req.Name.Set {
 		user.Name = req.Name.Value
 	}
+	user.Branding = applyBrandingColorsFromGen(user.Branding, req.Branding)
 
 	if err := h.db.Save(&user).Error; err != nil {
 		return &gen.Error{Message: "Failed to update user"}, nil
@@ -164,5 +166,6 @@ func (h *Handler) UpdateCurrentUser(ctx context.Context, req *gen.UpdateCurrentU
 		Email:     user.Email,
 		Name:      gen.NewOptString(user.Name),
 		AvatarURL: gen.NewOptString(avatarURL(user.AvatarFilename)),
+		Branding:  mapBrandingToGen(user.Branding),
 	}, nil
 }
@@ -4,13 +4,13 @@ import (
 	"bytes"
 	"crypto/sha256"
 	"encoding/hex"
+	"errors"
 	"fmt"
 	"image"
 	"image/jpeg"
-	_ "image/png"
+	"image/png"
 	"io"
 	"net/http"
-	"os"
 	"path/filepath"
 	"strings"
 
@@ -28,16 +28,16 @@ const (
 // AvatarHandler handles avatar upload, delete, and serving.
 // These are plain HTTP handlers (not ogen) because ogen does not support multipart uploads.
 type AvatarHandler struct {
-	db          *gorm.DB
-	auth        *AuthService
-	avatarsPath string
+	db      *gorm.DB
+	auth    *AuthService
+	storage FileStorage
 }
 
-func NewAvatarHandler(db *gorm.DB, auth *AuthService, avatarsPath string) *AvatarHandler {
+func NewAvatarHandler(db *gorm.DB, auth *AuthService, storage FileStorage) *AvatarHandler {
 	return &AvatarHandler{
-		db:          db,
-		auth:        auth,
-		avatarsPath: avatarsPath,
+		db:      db,
+		auth:    auth,
+		storage: storage,
 	}
 }
 
@@ -55,40 +55,8 @@ func (h *AvatarHandler) Upload(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// Limit request body size
-	r.Body = http.MaxBytesReader(w, r.Body, maxAvatarSize)
-
-	// Parse multipart form
-	if err := r.ParseMultipartForm(maxAvatarSize); err != nil {
-		http.Error(w, "File too large (max 2MB)", http.StatusBadRequest)
-		return
-	}
-
-	file, header, err := r.FormFile("avatar")
-	if err != nil {
-		http.Error(w, "Missing avatar file", http.StatusBadRequest)
-		return
-	}
-	defer func() { _ = file.Close() }()
-
-	// Validate content type
-	contentType := header.Header.Get("Content-Type")
-	if !isAllowedImageType(contentType) {
-		http.Error(w, "Invalid file type. Allowed: JPEG, PNG, WebP", http.StatusBadRequest)
-		return
-	}
-
-	// Read the file into memory for processing
-	data, err := io.ReadAll(file)
-	if err != nil {
-		http.Error(w, "Failed to read file", http.StatusInternalServerError)
-		return
-	}
-
-	// Decode the image
-	img, _, err := image.Decode(bytes.NewReader(data))
-	if err != nil {
-		http.Error(w, "Invalid image file", http.StatusBadRequest)
+	img, ok := readImageUpload(w, r, "avatar", maxAvatarSize)
+	if !ok {
 		return
 	}
 
@@ -106,9 +74,8 @@ func (h *AvatarHandler) Upload(w http.ResponseWriter, r *http.Request) {
 	hash := sha256.Sum256(jpegData)
 	filename := hex.EncodeToString(hash[:8]) + ".jpg"
 
-	// Save to disk
-	destPath := filepath.Join(h.avatarsPath, filename)
-	if err := os.WriteFile(destPath, jpegData, 0644); err != nil {
+	// Save to storage
+	if err := h.storage.Put(r.Context(), filename, jpegData, "image/jpeg"); err != nil {
 		http.Error(w, "Failed to save avatar", http.StatusInternalServerError)
 		return
 	}
@@ -124,14 +91,14 @@ func (h *AvatarHandler) Upload(w http.ResponseWriter, r *http.Request) {
 
 	// Update user record
 	if err := h.db.Model(&user).Update("avatar_filename", filename).Error; err != nil {
-		_ = os.Remove(destPath)
+		_ = h.storage.Delete(r.Context(), filename)
 		http.Error(w, "Failed to update user", http.StatusInternalServerError)
 		return
 	}
 
 	// Clean up old avatar file if different
 	if oldFilename != "" && oldFilename != filename {
-		_ = os.Remove(filepath.Join(h.avatarsPath, oldFilename))
+		_ = h.storage.Delete(r.Context(), oldFilename)
 	}
 
 	// Return the avatar URL as JSON
@@ -177,8 +144,8 @@ func (h *AvatarHandler) Delete(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// Remove file from disk (best-effort, don't fail if file is already gone)
-	_ = os.Remove(filepath.Join(h.avatarsPath, oldFilename))
+	// Remove file from storage (best-effort, don't fail if file is already gone)
+	_ = h.storage.Delete(r.Context(), oldFilename)
 
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(http.StatusOK)
@@ -187,12 +154,18 @@ func (h *AvatarHandler) Delete(w http.ResponseWriter, r *http.Request) {
 
 // authenticateRequest validates the session cookie and returns the user ID.
 func (h *AvatarHandler) authenticateRequest(r *http.Request) (uint, bool) {
+	return authenticateSession(h.auth, r)
+}
+
+// authenticateSession validates the session cookie of a plain HTTP request
+// and returns the user ID.
+func authenticateSession(auth *AuthService, r *http.Request) (uint, bool) {
 	cookie, err := r.Cookie("session")
 	if err != nil {
 		return 0, false
 	}
 
-	session, err := h.auth.ParseSessionCookie(cookie)
+	session, err := auth.ParseSessionCookie(cookie)
 	if err != nil {
 		return 0, false
 	}
@@ -200,6 +173,49 @@ func (h *AvatarHandler) authenticateRequest(r *http.Request) (uint, bool) {
 	return session.UserID, true
 }
 
+// readImageUpload reads and decodes the image uploaded in the multipart form
+// field. It writes an error response and returns false if the upload is invalid.
+func readImageUpload(w http.ResponseWriter, r *http.Request, field string, maxSize int64) (image.Image, bool) {
+	// Limit request body size
+	r.Body = http.MaxBytesReader(w, r.Body, maxSize)
+
+	// Parse multipart form
+	if err := r.ParseMultipartForm(maxSize); err != nil {
+		http.Error(w, fmt.Sprintf("File too large (max %dMB)", maxSize>>20), http.StatusBadRequest)
+		return nil, false
+	}
+
+	file, header, err := r.FormFile(field)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Missing %s file", field), http.StatusBadRequest)
+		return nil, false
+	}
+	defer func() { _ = file.Close() }()
+
+	// Validate content type
+	contentType := header.Header.Get("Content-Type")
+	if !isAllowedImageType(contentType) {
+		http.Error(w, "Invalid file type. Allowed: JPEG, PNG, WebP", http.StatusBadRequest)
+		return nil, false
+	}
+
+	// Read the file into memory for processing
+	data, err := io.ReadAll(file)
+	if err != nil {
+		http.Error(w, "Failed to read file", http.StatusInternalServerError)
+		return nil, false
+	}
+
+	// Decode the image
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		http.Error(w, "Invalid image file", http.StatusBadRequest)
+		return nil, false
+	}
+
+	return img, true
+}
+
 func isAllowedImageType(contentType string) bool {
 	switch contentType {
 	case "image/jpeg", "image/png", "image/webp":
@@ -232,6 +248,15 @@ func resizeImage(img image.Image, maxDim int) image.Image {
 	return dst
 }
 
+// encodePNG encodes an image as PNG bytes, keeping transparency.
+func encodePNG(img image.Image) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
 // encodeJPEG encodes an image as JPEG bytes.
 func encodeJPEG(img image.Image, quality int) ([]byte, error) {
 	var buf bytes.Buffer
@@ -264,19 +289,31 @@ func (h *AvatarHandler) Serve(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	filePath := filepath.Join(h.avatarsPath, filename)
+	serveStoredFile(w, r, h.storage, filename, "image/jpeg")
+}
 
-	// Check if file exists
-	if _, err := os.Stat(filePath); os.IsNotExist(err) {
-		http.NotFound(w, r)
+// serveStoredFile streams a file from storage with cache headers.
+// ServeContent answers conditional requests using the ETag and modification time.
+func serveStoredFile(w http.ResponseWriter, r *http.Request, storage FileStorage, key, contentType string) {
+	file, err := storage.Get(r.Context(), key)
+	if err != nil {
+		if errors.Is(err, ErrFileNotFound) {
+			http.NotFound(w, r)
+			return
+		}
+		http.Error(w, "Failed to read file", http.StatusInternalServerError)
 		return
 	}
+	defer func() { _ = file.Body.Close() }()
 
 	// Set cache headers - filenames are content-hashed so we can cache forever
 	w.Header().Set("Cache-Control", "public, max-age=31536000, immutable")
-	w.Header().Set("Content-Type", "image/jpeg")
+	w.Header().Set("Content-Type", contentType)
+	if file.ETag != "" {
+		w.Header().Set("ETag", file.ETag)
+	}
 
-	http.ServeFile(w, r, filePath)
+	http.ServeContent(w, r, key, file.ModTime, file.Body)
 }
 
 // HandleAvatars is the main handler for /api/avatars/ that dispatches by method.
@@ -5,6 +5,7 @@ import (
 	"context"
 	"crypto/rand"
 	"encoding/base64"
+	"encoding/hex"
 
 	gen "github.com/kolaente/meet-mesh/api/gen"
 )
@@ -22,9 +23,28 @@ func (h *Handler) ListBookingLinks(ctx context.Context) ([]gen.BookingLink, erro
 }
 
 // CreateBookingLink creates a new booking link
-func (h *Handler) CreateBookingLink(ctx context.Context, req *gen.CreateBookingLinkReq) (*gen.BookingLink, error) {
+func (h *Handler) CreateBookingLink(ctx context.Context, req *gen.CreateBookingLinkReq) (gen.CreateBookingLinkRes, error) {
 	userID, _ := GetUserID(ctx)
 
+	eventTemplate := mapEventTemplateFromGen(req.EventTemplate)
+	if err := ValidateEventTemplate(eventTemplate); err != nil {
+		return &gen.Error{Message: err.Error()}, nil
+	}
+
+	if !h.meetings.HasProvider(req.MeetingProvider.Value) {
+		return &gen.Error{Message: "Unknown meeting provider"}, nil
+	}
+
+	customFields := mapCustomFieldsFromGen(req.CustomFields)
+	if problem := checkCustomFields(customFields); problem != "" {
+		return &gen.Error{Message: problem}, nil
+	}
+
+	autoConfirmRules, problem := normalizeAutoConfirmRules(mapAutoConfirmRulesFromGen(req.AutoConfirmRules))
+	if problem != "" {
+		return &gen.Error{Message: problem}, nil
+	}
+
 	// Set defaults for slot duration and buffer
 	slotDuration := 30
 	if req.SlotDurationMinutes.Set {
@@ -37,20 +57,25 @@ func (h *Handler) CreateBookingLink(ctx context.Context, req *gen.CreateBookingL
 	}
 
 	link := BookingLink{
-		UserID:               userID,
-		Slug:                 generateSlug(),
-		Name:                 req.Name,
-		Description:          req.Description.Value,
-		Status:               LinkStatusActive,
-		AutoConfirm:          req.AutoConfirm.Value,
-		SlotDurationMinutes:  slotDuration,
-		SlotDurationsMinutes: req.SlotDurationsMinutes,
-		BufferMinutes:        bufferMinutes,
-		RequireEmail:         req.RequireEmail.Value,
-		MeetingLink:          req.MeetingLink.Value,
-		AvailabilityRules:    mapAvailabilityRulesFromGen(req.AvailabilityRules),
-		CustomFields:         mapCustomFieldsFromGen(req.CustomFields),
-		EventTemplate:        mapEventTemplateFromGen(req.EventTemplate),
+		UserID:                userID,
+		Slug:                  generateSlug(),
+		Name:                  req.Name,
+		Description:           req.Description.Value,
+		Status:                LinkStatusActive,
+		AutoConfirm:           req.AutoConfirm.Value,
+		AutoConfirmRules:      autoConfirmRules,
+		ApprovalTimeoutHours:  req.ApprovalTimeoutHours.Value,
+		ApprovalTimeoutAction: ApprovalTimeoutAction(req.ApprovalTimeoutAction.Or(gen.ApprovalTimeoutAction(ApprovalTimeoutDecline))),
+		SlotDurationMinutes:   slotDuration,
+		SlotDurationsMinutes:  req.SlotDurationsMinutes,
+		BufferMinutes:         bufferMinutes,
+		RequireEmail:          req.RequireEmail.Value,
+		MeetingLink:           req.MeetingLink.Value,
+		MeetingProvider:       req.MeetingProvider.Value,
+		AvailabilityRules:     mapAvailabilityRulesFromGen(req.AvailabilityRules),
+		CustomFields:          customFields,
+		EventTemplate:         eventTemplate,
+		Branding:              applyBrandingColorsFromGen(nil, req.Branding),
 	}
 
 	if err := h.db.Create(&link).Error; err != nil {
@@ -73,7 +98,7 @@ func (h *Handler) GetBookingLink(ctx context.Context, params gen.GetBookingLinkP
 }
 
 // UpdateBookingLink updates a booking link
-func (h *Handler) UpdateBookingLink(ctx context.Context, req *gen.UpdateBookingLinkReq, params gen.UpdateBookingLinkParams) (*gen.BookingLink, error) {
+func (h *Handler) UpdateBookingLink(ctx context.Context, req *gen.UpdateBookingLinkReq, params gen.UpdateBookingLinkParams) (gen.UpdateBookingLinkRes, error) {
 	userID, _ := GetUserID(ctx)
 
 	var link BookingLink
@@ -93,6 +118,19 @@ func (h *Handler) UpdateBookingLink(ctx context.Context, req *gen.UpdateBookingL
 	if req.AutoConfirm.Set {
 		link.AutoConfirm = req.AutoConfirm.Value
 	}
+	if req.AutoConfirmRules.Set {
+		rules, problem := normalizeAutoConfirmRules(mapAutoConfirmRulesFromGen(req.AutoConfirmRules))
+		if problem != "" {
+			return &gen.Error{Message: problem}, nil
+		}
+		link.AutoConfirmRules = rules
+	}
+	if req.ApprovalTimeoutHours.Set {
+		link.ApprovalTimeoutHours = req.ApprovalTimeoutHours.Value
+	}
+	if req.ApprovalTimeoutAction.Set {
+		link.ApprovalTimeoutAction = ApprovalTimeoutAction(req.ApprovalTimeoutAction.Value)
+	}
 	if req.RequireEmail.Set {
 		link.RequireEmail = req.RequireEmail.Value
 	}
@@ -100,10 +138,18 @@ func (h *Handler) UpdateBookingLink(ctx context.Context, req *gen.UpdateBookingL
 		link.AvailabilityRules = mapAvailabilityRulesFromGen(req.AvailabilityRules)
 	}
 	if req.CustomFields != nil {
-		link.CustomFields = mapCustomFieldsFromGen(req.CustomFields)
+		customFields := mapCustomFieldsFromGen(req.CustomFields)
+		if problem := checkCustomFields(customFields); problem != "" {
+			return &gen.Error{Message: problem}, nil
+		}
+		link.CustomFields = customFields
 	}
 	if req.EventTemplate.Set {
-		link.EventTemplate = mapEventTemplateFromGen(req.EventTemplate)
+		eventTemplate := mapEventTemplateFromGen(req.EventTemplate)
+		if err := ValidateEventTemplate(eventTemplate); err != nil {
+			return &gen.Error{Message: err.Error()}, nil
+		}
+		link.EventTemplate = eventTemplate
 	}
 	if req.SlotDurationMinutes.Set {
 		link.SlotDurationMinutes = req.SlotDurationMinutes.Value
@@ -114,9 +160,16 @@ func (h *Handler) UpdateBookingLink(ctx context.Context, req *gen.UpdateBookingL
 	if req.MeetingLink.Set {
 		link.MeetingLink = req.MeetingLink.Value
 	}
+	if req.MeetingProvider.Set {
+		if !h.meetings.HasProvider(req.MeetingProvider.Value) {
+			return &gen.Error{Message: "Unknown meeting provider"}, nil
+		}
+		link.MeetingProvider = req.MeetingProvider.Value
+	}
 	if req.SlotDurationsMinutes != nil {
 		link.SlotDurationsMinutes = req.SlotDurationsMinutes
 	}
+	link.Branding = applyBrandingColorsFromGen(link.Branding, req.Branding)
 
 	if err := h.db.Save(&link).Error; err != nil {
 		return nil, err
@@ -129,7 +182,17 @@ func (h *Handler) UpdateBookingLink(ctx context.Context, req *gen.UpdateBookingL
 func (h *Handler) DeleteBookingLink(ctx context.Context, params gen.DeleteBookingLinkParams) error {
 	userID, _ := GetUserID(ctx)
 
-	return h.db.Where("id = ? AND user_id = ?", params.ID, userID).Delete(&BookingLink{}).Error
+	result := h.db.Where("id = ? AND user_id = ?", params.ID, userID).Delete(&BookingLink{})
+	if result.Error != nil || result.RowsAffected == 0 {
+		return result.Error
+	}
+
+	// Bookings of a deleted link can't be reached anymore, neither can their files
+	var attachments []Attachment
+	if err := h.db.Where("booking_link_id = ?", params.ID).Find(&attachments).Error; err != nil {
+		return err
+	}
+	return h.deleteAttachments(ctx, attachments)
 }
 
 // GetBookingLinkBookings returns bookings for a booking link
@@ -143,13 +206,18 @@ func (h *Handler) GetBookingLinkBookings(ctx context.Context, params gen.GetBook
 	}
 
 	var bookings []Booking
-	if err := h.db.Preload("Slot").Where("booking_link_id = ?", params.ID).Order("created_at DESC").Find(&bookings).Error; err != nil {
+	if err := h.db.Preload("Slot").Preload("Attachments").Where("booking_link_id = ?", params.ID).Order("created_at DESC").Find(&bookings).Error; err != nil {
 		return nil, err
 	}
 
 	return mapBookingsToGen(bookings), nil
 }
 
+// ListMeetingProviders returns the enabled meeting link providers
+func (h *Handler) ListMeetingProviders(ctx context.Context) ([]string, error) {
+	return h.meetings.Providers(), nil
+}
+
 // Helper functions
 func generateSlug() string {
 	b := make([]byte, 8)
@@ -157,6 +225,13 @@ func generateSlug() string {
 	return base64.URLEncoding.EncodeToString(b)[:10]
 }
 
+// generateToken returns a random hex token for links sent by email.
+func generateToken() string {
+	b := make([]byte, 32)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
 func mapBookingLinksToGen(links []BookingLink) []gen.BookingLink {
 	result := make([]gen.BookingLink, len(links))
 	for i, link := range links {
@@ -167,21 +242,26 @@ func mapBookingLinksToGen(links []BookingLink) []gen.BookingLink {
 
 func mapBookingLinkToGen(link *BookingLink) *gen.BookingLink {
 	return &gen.BookingLink{
-		ID:                   int(link.ID),
-		Slug:                 link.Slug,
-		Name:                 link.Name,
-		Description:          gen.NewOptString(link.Description),
-		Status:               gen.LinkStatus(link.Status),
-		AutoConfirm:          gen.NewOptBool(link.AutoConfirm),
-		SlotDurationMinutes:  gen.NewOptInt(link.SlotDurationMinutes),
-		SlotDurationsMinutes: link.SlotDurationsMinutes,
-		BufferMinutes:        gen.NewOptInt(link.BufferMinutes),
-		RequireEmail:         gen.NewOptBool(link.RequireEmail),
-		MeetingLink:          gen.NewOptString(link.MeetingLink),
-		AvailabilityRules:    mapAvailabilityRulesToGen(link.AvailabilityRules),
-		CustomFields:         mapCustomFieldsToGen(link.CustomFields),
-		EventTemplate:        mapEventTemplateToGen(link.EventTemplate),
-		CreatedAt:            gen.NewOptDateTime(link.CreatedAt),
+		ID:                    int(link.ID),
+		Slug:                  link.Slug,
+		Name:                  link.Name,
+		Description:           gen.NewOptString(link.Description),
+		Status:                gen.LinkStatus(link.Status),
+		AutoConfirm:           gen.NewOptBool(link.AutoConfirm),
+		AutoConfirmRules:      mapAutoConfirmRulesToGen(link.AutoConfirmRules),
+		ApprovalTimeoutHours:  gen.NewOptInt(link.ApprovalTimeoutHours),
+		ApprovalTimeoutAction: gen.NewOptApprovalTimeoutAction(gen.ApprovalTimeoutAction(link.ApprovalTimeoutAction)),
+		SlotDurationMinutes:   gen.NewOptInt(link.SlotDurationMinutes),
+		SlotDurationsMinutes:  link.SlotDurationsMinutes,
+		BufferMinutes:         gen.NewOptInt(link.BufferMinutes),
+		RequireEmail:          gen.NewOptBool(link.RequireEmail),
+		MeetingLink:           gen.NewOptString(link.MeetingLink),
+		MeetingProvider:       gen.NewOptString(link.MeetingProvider),
+		AvailabilityRules:     mapAvailabilityRulesToGen(link.AvailabilityRules),
+		CustomFields:          mapCustomFieldsToGen(link.CustomFields),
+		EventTemplate:         mapEventTemplateToGen(link.EventTemplate),
+		Branding:              mapBrandingToGen(link.Branding),
+		CreatedAt:             gen.NewOptDateTime(link.CreatedAt),
 	}
 }
 
@@ -209,15 +289,40 @@ func mapAvailabilityRulesToGen(rules []AvailabilityRule) []gen.AvailabilityRule
 	return result
 }
 
+func mapAutoConfirmRulesFromGen(opt gen.OptAutoConfirmRules) *AutoConfirmRules {
+	if !opt.Set {
+		return nil
+	}
+	return &AutoConfirmRules{
+		KnownGuests:  opt.Value.KnownGuests.Value,
+		EmailDomains: opt.Value.EmailDomains,
+		Hours:        mapAvailabilityRulesFromGen(opt.Value.Hours),
+	}
+}
+
+func mapAutoConfirmRulesToGen(rules *AutoConfirmRules) gen.OptAutoConfirmRules {
+	if rules == nil {
+		return gen.OptAutoConfirmRules{}
+	}
+	return gen.NewOptAutoConfirmRules(gen.AutoConfirmRules{
+		KnownGuests:  gen.NewOptBool(rules.KnownGuests),
+		EmailDomains: rules.EmailDomains,
+		Hours:        mapAvailabilityRulesToGen(rules.Hours),
+	})
+}
+
 func mapCustomFieldsFromGen(fields []gen.CustomField) []CustomField {
 	result := make([]CustomField, len(fields))
 	for i, f := range fields {
 		result[i] = CustomField{
-			Name:     f.Name,
-			Label:    f.Label,
-			Type:     CustomFieldType(f.Type),
-			Required: f.Required,
-			Options:  f.Options,
+			Name:          f.Name,
+			Label:         f.Label,
+			Type:          CustomFieldType(f.Type),
+			Required:      f.Required,
+			Options:       f.Options,
+			Pattern:       f.Pattern.Value,
+			MaxFileSizeMB: f.MaxFileSizeMB.Value,
+			FileTypes:     f.FileTypes,
 		}
 	}
 	return result
@@ -227,11 +332,18 @@ func mapCustomFieldsToGen(fields []CustomField) []gen.CustomField {
 	result := make([]gen.CustomField, len(fields))
 	for i, f := range fields {
 		result[i] = gen.CustomField{
-			Name:     f.Name,
-			Label:    f.Label,
-			Type:     gen.CustomFieldType(f.Type),
-			Required: f.Required,
-			Options:  f.Options,
+			Name:      f.Name,
+			Label:     f.Label,
+			Type:      gen.CustomFieldType(f.Type),
+			Required:  f.Required,
+			Options:   f.Options,
+			FileTypes: f.FileTypes,
+		}
+		if f.Pattern != "" {
+			result[i].Pattern = gen.NewOptString(f.Pattern)
+		}
+		if f.MaxFileSizeMB > 0 {
+			result[i].MaxFileSizeMB = gen.NewOptInt(f.MaxFileSizeMB)
 		}
 	}
 	return result
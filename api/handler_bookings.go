@@ -4,9 +4,11 @@ package api
 import (
 	"context"
 	"errors"
+	"log"
 
 	gen "github.com/kolaente/meet-mesh/api/gen"
 	"github.com/ogen-go/ogen/ogenerrors"
+	"gorm.io/gorm"
 )
 
 // ApproveBooking approves a booking
@@ -14,7 +16,7 @@ func (h *Handler) ApproveBooking(ctx context.Context, params gen.ApproveBookingP
 	userID, _ := GetUserID(ctx)
 
 	var booking Booking
-	if err := h.db.Preload("BookingLink").Preload("Slot").First(&booking, params.ID).Error; err != nil {
+	if err := h.db.Preload("BookingLink").Preload("Slot").Preload("Attachments").First(&booking, params.ID).Error; err != nil {
 		return nil, err
 	}
 
@@ -27,28 +29,19 @@ func (h *Handler) ApproveBooking(ctx context.Context, params gen.ApproveBookingP
 		}
 	}
 
-	booking.Status = BookingStatusConfirmed
-	if err := h.db.Save(&booking).Error; err != nil {
+	resolved, err := h.resolvePendingBooking(ctx, &booking, BookingStatusConfirmed)
+	if err != nil {
 		return nil, err
 	}
+	if !resolved {
+		return h.currentBooking(&booking)
+	}
 
 	// Get organizer email
 	var organizer User
 	h.db.First(&organizer, booking.BookingLink.UserID)
 
-	// Send confirmation email with ICS
-	if h.mailer != nil {
-		_ = h.mailer.SendBookingApprovedWithICS(&booking, &booking.BookingLink, &organizer)
-	}
-
-	// Create calendar event
-	if h.caldav != nil {
-		uid, err := h.caldav.CreateBookingEvent(ctx, booking.BookingLink.UserID, &booking, &booking.Slot, booking.BookingLink.EventTemplate, booking.BookingLink.MeetingLink)
-		if err == nil && uid != "" {
-			booking.CalendarUID = uid
-			h.db.Save(&booking)
-		}
-	}
+	h.notifyBookingConfirmed(ctx, &booking, &organizer)
 
 	return mapBookingToGen(&booking), nil
 }
@@ -58,7 +51,7 @@ func (h *Handler) DeclineBooking(ctx context.Context, params gen.DeclineBookingP
 	userID, _ := GetUserID(ctx)
 
 	var booking Booking
-	if err := h.db.Preload("BookingLink").Preload("Slot").First(&booking, params.ID).Error; err != nil {
+	if err := h.db.Preload("BookingLink").Preload("Slot").Preload("Attachments").First(&booking, params.ID).Error; err != nil {
 		return nil, err
 	}
 
@@ -71,21 +64,122 @@ func (h *Handler) DeclineBooking(ctx context.Context, params gen.DeclineBookingP
 		}
 	}
 
-	booking.Status = BookingStatusDeclined
-	if err := h.db.Save(&booking).Error; err != nil {
+	resolved, err := h.resolvePendingBooking(ctx, &booking, BookingStatusDeclined)
+	if err != nil {
 		return nil, err
 	}
+	if !resolved {
+		return h.currentBooking(&booking)
+	}
 
 	// Get organizer for email
 	var organizer User
 	h.db.First(&organizer, booking.BookingLink.UserID)
 
+	h.notifyBookingDeclined(ctx, &booking, &organizer)
+
+	return mapBookingToGen(&booking), nil
+}
+
+// notifyBookingConfirmed sends the invitation for a booking the organizer
+// approved to the guest and adds the booking to the organizer's calendar.
+func (h *Handler) notifyBookingConfirmed(ctx context.Context, booking *Booking, organizer *User) {
+	// Send confirmation email with ICS
+	if h.mailer != nil {
+		_ = h.mailer.SendBookingApprovedWithICS(booking, &booking.BookingLink, organizer)
+	}
+
+	// Create calendar event
+	if h.caldav != nil {
+		uid, err := h.caldav.CreateBookingEvent(ctx, booking.BookingLink.UserID, booking, &booking.Slot, booking.BookingLink.EventTemplate, organizerMeetingLink(booking), h.attachmentNotes(booking.ID))
+		if err == nil && uid != "" {
+			booking.CalendarUID = uid
+			h.db.Model(&Booking{}).Where("id = ?", booking.ID).Update("calendar_uid", uid)
+		}
+	}
+
+	h.publishSlotTaken(booking.BookingLink.UserID, &booking.Slot)
+}
+
+// notifyBookingDeclined tells the guest that their booking was declined and
+// removes the booking from the organizer's calendar.
+func (h *Handler) notifyBookingDeclined(ctx context.Context, booking *Booking, organizer *User) {
 	// Send decline email
 	if h.mailer != nil {
-		_ = h.mailer.SendBookingDeclined(&booking, &booking.BookingLink, &organizer)
+		_ = h.mailer.SendBookingDeclined(booking, &booking.BookingLink, organizer)
 	}
 
-	return mapBookingToGen(&booking), nil
+	// Remove the hold of the pending booking
+	if h.caldav != nil && booking.CalendarUID != "" {
+		if err := h.caldav.DeleteEvent(ctx, booking.BookingLink.UserID, booking.CalendarUID); err != nil {
+			log.Printf("[WARN] Failed to delete calendar event of booking %d: %v", booking.ID, err)
+			return
+		}
+		booking.CalendarUID = ""
+		h.db.Model(&Booking{}).Where("id = ?", booking.ID).Update("calendar_uid", "")
+	}
+}
+
+// resolvePendingBooking confirms or declines a booking that waits for
+// approval and makes its approve, decline and verify links stop working. Confirmed
+// bookings get their meeting link. It reports false if the booking is no
+// longer pending, so only one of concurrent resolutions notifies anyone.
+// The token is set to NULL because the unique index allows only one empty
+// token.
+func (h *Handler) resolvePendingBooking(ctx context.Context, booking *Booking, status BookingStatus) (bool, error) {
+	result := h.db.WithContext(ctx).Model(&Booking{}).
+		Where("id = ? AND status = ?", booking.ID, BookingStatusPending).
+		Updates(map[string]any{"status": status, "action_token": gorm.Expr("NULL"), "verify_token": gorm.Expr("NULL")})
+	if result.Error != nil {
+		return false, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return false, nil
+	}
+	booking.Status = status
+	booking.ActionToken = ""
+	booking.VerifyToken = ""
+
+	if status == BookingStatusConfirmed {
+		h.assignMeetingLink(ctx, booking, &booking.BookingLink)
+		if err := h.db.Model(&Booking{}).Where("id = ?", booking.ID).
+			Updates(map[string]any{"meeting_link": booking.MeetingLink, "host_link": booking.HostLink}).Error; err != nil {
+			log.Printf("[WARN] Failed to save meeting link for booking %d: %v", booking.ID, err)
+		}
+	}
+	return true, nil
+}
+
+// currentBooking returns booking as it is stored, for requests that found
+// it already resolved.
+func (h *Handler) currentBooking(booking *Booking) (*gen.Booking, error) {
+	if err := h.db.Preload("BookingLink").Preload("Slot").Preload("Attachments").First(booking, booking.ID).Error; err != nil {
+		return nil, err
+	}
+	return mapBookingToGen(booking), nil
+}
+
+// assignMeetingLink generates the meeting link for a confirmed booking using
+// the link's meeting provider. It falls back to the link's static meeting link.
+func (h *Handler) assignMeetingLink(ctx context.Context, booking *Booking, link *BookingLink) {
+	if booking.MeetingLink != "" {
+		return
+	}
+	if h.meetings == nil {
+		booking.MeetingLink = link.MeetingLink
+		return
+	}
+
+	var organizer User
+	h.db.First(&organizer, link.UserID)
+
+	meeting, err := h.meetings.CreateMeeting(ctx, link, booking, &organizer)
+	if err != nil {
+		log.Printf("[WARN] Failed to create meeting link for booking %d: %v", booking.ID, err)
+		meeting = Meeting{JoinURL: link.MeetingLink}
+	}
+	booking.MeetingLink = meeting.JoinURL
+	booking.HostLink = meeting.HostURL
 }
 
 func mapBookingsToGen(bookings []Booking) []gen.Booking {
@@ -103,11 +197,31 @@ func mapBookingToGen(b *Booking) *gen.Booking {
 		GuestEmail:   b.GuestEmail,
 		GuestName:    gen.NewOptString(b.GuestName),
 		Status:       gen.BookingStatus(b.Status),
+		MeetingLink:  gen.NewOptString(b.MeetingLink),
 		CustomFields: mapBookingCustomFieldsToGen(b.CustomFields),
+		Attachments:  mapAttachmentsToGen(b.Attachments),
 		CreatedAt:    gen.NewOptDateTime(b.CreatedAt),
 	}
 }
 
+func mapAttachmentsToGen(attachments []Attachment) []gen.BookingAttachment {
+	if len(attachments) == 0 {
+		return nil
+	}
+	result := make([]gen.BookingAttachment, len(attachments))
+	for i, a := range attachments {
+		result[i] = gen.BookingAttachment{
+			ID:          int(a.ID),
+			Field:       a.FieldName,
+			Filename:    a.Filename,
+			ContentType: gen.NewOptString(a.ContentType),
+			Size:        a.Size,
+			URL:         attachmentURL("", a.ID),
+		}
+	}
+	return result
+}
+
 func mapBookingCustomFieldsToGen(fields map[string]string) gen.OptBookingCustomFields {
 	if fields == nil {
 		return gen.OptBookingCustomFields{}
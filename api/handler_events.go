@@ -0,0 +1,114 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// eventKeepAlive is how often an idle stream sends a comment, so proxies
+// don't close the connection.
+const eventKeepAlive = 30 * time.Second
+
+// EventsHandler streams live updates as Server-Sent Events. Like
+// AvatarHandler this is a plain HTTP handler because ogen does not support
+// streaming responses.
+type EventsHandler struct {
+	db     *gorm.DB
+	auth   *AuthService
+	broker *EventBroker
+}
+
+func NewEventsHandler(db *gorm.DB, auth *AuthService, broker *EventBroker) *EventsHandler {
+	return &EventsHandler{
+		db:     db,
+		auth:   auth,
+		broker: broker,
+	}
+}
+
+// HandleEvents is the handler for /api/events. The query selects the stream:
+//
+//	GET /api/events?poll={slug}          votes on an active poll
+//	GET /api/events?booking_link={slug}  taken slots of an active booking link
+//	GET /api/events                      votes and bookings of the logged-in organizer
+func (h *EventsHandler) HandleEvents(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	topic, status, message := h.resolveTopic(r)
+	if topic == "" {
+		http.Error(w, message, status)
+		return
+	}
+
+	events, cancel := h.broker.Subscribe(topic)
+	defer cancel()
+
+	rc := http.NewResponseController(w)
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+	if err := rc.Flush(); err != nil {
+		return
+	}
+
+	keepAlive := time.NewTicker(eventKeepAlive)
+	defer keepAlive.Stop()
+
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+		case <-keepAlive.C:
+			if _, err := fmt.Fprint(w, ": keep-alive\n\n"); err != nil {
+				return
+			}
+		case event := <-events:
+			data, err := json.Marshal(event.Data)
+			if err != nil {
+				continue
+			}
+			if _, err := fmt.Fprintf(w, "event: %s\ndata: %s\n\n", event.Type, data); err != nil {
+				return
+			}
+		}
+		if err := rc.Flush(); err != nil {
+			return
+		}
+	}
+}
+
+// resolveTopic returns the topic requested by r. Without a topic it returns
+// the status and message of the error response.
+func (h *EventsHandler) resolveTopic(r *http.Request) (topic string, status int, message string) {
+	query := r.URL.Query()
+
+	switch {
+	case query.Get("poll") != "":
+		var poll Poll
+		if err := h.db.Where("slug = ? AND status = ?", query.Get("poll"), LinkStatusActive).First(&poll).Error; err != nil {
+			return "", http.StatusNotFound, "Poll not found"
+		}
+		return pollTopic(poll.Slug), 0, ""
+	case query.Get("booking_link") != "":
+		var link BookingLink
+		if err := h.db.Where("slug = ? AND status = ?", query.Get("booking_link"), LinkStatusActive).First(&link).Error; err != nil {
+			return "", http.StatusNotFound, "Booking link not found"
+		}
+		return bookingLinkTopic(link.Slug), 0, ""
+	}
+
+	userID, ok := authenticateSession(h.auth, r)
+	if !ok {
+		return "", http.StatusUnauthorized, "Not authenticated"
+	}
+	return userTopic(userID), 0, ""
+}
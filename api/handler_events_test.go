@@ -0,0 +1,128 @@
+package api
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	gen "github.com/kolaente/meet-mesh/api/gen"
+)
+
+// readEvent reads the next event from an SSE stream.
+func readEvent(t *testing.T, r *bufio.Reader) (string, string) {
+	t.Helper()
+	var eventType, data string
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			t.Fatalf("reading event failed: %v", err)
+		}
+		line = strings.TrimRight(line, "\n")
+		switch {
+		case strings.HasPrefix(line, "event: "):
+			eventType = strings.TrimPrefix(line, "event: ")
+		case strings.HasPrefix(line, "data: "):
+			data = strings.TrimPrefix(line, "data: ")
+		case line == "" && eventType != "":
+			return eventType, data
+		}
+	}
+}
+
+func TestPollEventStream(t *testing.T) {
+	h := newTestHandler(t)
+	h.events = NewEventBroker(nil)
+	ctx := WithUserID(context.Background(), 1)
+
+	poll := Poll{UserID: 1, Slug: "live", Name: "Live", Status: LinkStatusActive, ResultsVisibility: ResultsVisibilityAggregate}
+	h.db.Create(&poll)
+	option := PollOption{PollID: poll.ID, Type: SlotTypeTime, StartTime: time.Now().Add(time.Hour), EndTime: time.Now().Add(2 * time.Hour)}
+	h.db.Create(&option)
+	optionKey := fmt.Sprint(option.ID)
+
+	server := httptest.NewServer(http.HandlerFunc(NewEventsHandler(h.db, nil, h.events).HandleEvents))
+	defer server.Close()
+
+	if res, err := http.Get(server.URL + "?poll=unknown"); err != nil || res.StatusCode != http.StatusNotFound {
+		t.Fatalf("expected unknown polls to be rejected, got %v (%v)", res.StatusCode, err)
+	}
+
+	res, err := http.Get(server.URL + "?poll=" + poll.Slug)
+	if err != nil {
+		t.Fatalf("subscribing failed: %v", err)
+	}
+	defer res.Body.Close()
+	if ct := res.Header.Get("Content-Type"); ct != "text/event-stream" {
+		t.Fatalf("unexpected content type %q", ct)
+	}
+	stream := bufio.NewReader(res.Body)
+
+	vote := func(name string) {
+		if res, _ := h.SubmitVote(ctx, &gen.SubmitVoteReq{
+			GuestName: gen.NewOptString(name),
+			Responses: gen.SubmitVoteReqResponses{optionKey: gen.VoteResponse(VoteResponseYes)},
+		}, gen.SubmitVoteParams{Slug: poll.Slug}); isError(res) {
+			t.Fatalf("SubmitVote failed: %#v", res)
+		}
+	}
+
+	// Hidden results only announce that something changed
+	vote("Alice")
+	eventType, data := readEvent(t, stream)
+	if eventType != EventVoteSubmitted || strings.Contains(data, "Alice") || strings.Contains(data, "tally") {
+		t.Fatalf("unexpected event %s: %s", eventType, data)
+	}
+
+	// Public aggregate results include the tally but no names
+	h.db.Model(&poll).Update("show_results", true)
+	vote("Bob")
+	_, data = readEvent(t, stream)
+	if strings.Contains(data, "Bob") || !strings.Contains(data, `"yes_count":2`) {
+		t.Fatalf("unexpected event data: %s", data)
+	}
+}
+
+func TestEventBrokerRelaysBetweenInstances(t *testing.T) {
+	h := newTestHandler(t)
+	ctx := context.Background()
+
+	h.db.Create(&LiveEvent{Topic: pollTopic("live"), Type: EventVoteSubmitted, Data: `{"poll_slug":"old"}`})
+	first := NewEventBroker(h.db)
+	second := NewEventBroker(h.db)
+	events, cancel := second.Subscribe(pollTopic("live"))
+	defer cancel()
+
+	first.Publish(pollTopic("live"), Event{Type: EventVoteSubmitted, Data: voteEvent{PollSlug: "live"}})
+	first.Publish(pollTopic("other"), Event{Type: EventVoteSubmitted, Data: voteEvent{PollSlug: "other"}})
+	if err := second.Relay(ctx); err != nil {
+		t.Fatalf("Relay failed: %v", err)
+	}
+
+	select {
+	case event := <-events:
+		if data, _ := json.Marshal(event.Data); string(data) != `{"poll_slug":"live"}` {
+			t.Errorf("unexpected event %s", data)
+		}
+	default:
+		t.Fatal("expected the event published by the other instance")
+	}
+	select {
+	case event := <-events:
+		t.Errorf("expected events from before the start and of other topics to be skipped, got %+v", event)
+	default:
+	}
+
+	// Relayed events aren't delivered twice
+	if err := second.Relay(ctx); err != nil {
+		t.Fatalf("Relay failed: %v", err)
+	}
+	if len(events) != 0 {
+		t.Errorf("expected no further events, got %d", len(events))
+	}
+}
@@ -0,0 +1,503 @@
+// api/handler_export.go
+package api
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	// The container image has no zoneinfo of its own
+	_ "time/tzdata"
+
+	"gorm.io/gorm"
+)
+
+// exportTimeFormat formats times in CSV and XLSX exports.
+const exportTimeFormat = "2006-01-02 15:04"
+
+// exportTable is one table of exported data, a sheet in XLSX exports.
+type exportTable struct {
+	Name   string
+	Header []string
+	Rows   [][]string
+}
+
+// exportRequest holds the format and time zone of an export.
+type exportRequest struct {
+	Format string
+	Loc    *time.Location
+	Table  string
+}
+
+// ExportHandler exports bookings and poll results as CSV, XLSX or JSON.
+// Like AvatarHandler these are plain HTTP handlers, so exports can be
+// downloaded through a link.
+type ExportHandler struct {
+	db   *gorm.DB
+	auth *AuthService
+}
+
+func NewExportHandler(db *gorm.DB, auth *AuthService) *ExportHandler {
+	return &ExportHandler{
+		db:   db,
+		auth: auth,
+	}
+}
+
+// HandleExports is the main handler for /api/exports/ that dispatches by path.
+// Times are formatted in the time zone tz, UTC by default.
+//
+//	GET /api/exports/booking-links/{id}?format=csv|xlsx|json&tz={zone}
+//	GET /api/exports/polls/{id}?format=csv|xlsx|json&tz={zone}&table=votes|tally|comments
+//
+// CSV holds a single table, selected by table. XLSX and JSON exports of
+// polls contain the votes, the tally and the comments.
+func (h *ExportHandler) HandleExports(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	userID, ok := authenticateSession(h.auth, r)
+	if !ok {
+		http.Error(w, "Not authenticated", http.StatusUnauthorized)
+		return
+	}
+
+	kind, rawID, _ := strings.Cut(strings.Trim(strings.TrimPrefix(r.URL.Path, "/api/exports"), "/"), "/")
+	id, err := strconv.ParseUint(rawID, 10, 64)
+	if err != nil {
+		http.Error(w, "Not found", http.StatusNotFound)
+		return
+	}
+
+	req, invalid := parseExportRequest(r)
+	if invalid != "" {
+		http.Error(w, invalid, http.StatusBadRequest)
+		return
+	}
+
+	switch kind {
+	case "booking-links":
+		h.exportBookings(w, userID, uint(id), req)
+	case "polls":
+		h.exportPoll(w, userID, uint(id), req)
+	default:
+		http.Error(w, "Not found", http.StatusNotFound)
+	}
+}
+
+// parseExportRequest reads the export options of r. If they are invalid, it
+// returns why.
+func parseExportRequest(r *http.Request) (exportRequest, string) {
+	query := r.URL.Query()
+
+	req := exportRequest{Format: query.Get("format"), Loc: time.UTC, Table: query.Get("table")}
+	switch req.Format {
+	case "":
+		req.Format = "csv"
+	case "csv", "xlsx", "json":
+	default:
+		return req, "Unsupported format"
+	}
+
+	if tz := query.Get("tz"); tz != "" {
+		loc, err := time.LoadLocation(tz)
+		if err != nil {
+			return req, "Unknown time zone"
+		}
+		req.Loc = loc
+	}
+	return req, ""
+}
+
+// bookingExport is a booking in JSON exports.
+type bookingExport struct {
+	ID           uint              `json:"id"`
+	Status       string            `json:"status"`
+	StartTime    time.Time         `json:"start_time"`
+	EndTime      time.Time         `json:"end_time"`
+	GuestName    string            `json:"guest_name"`
+	GuestEmail   string            `json:"guest_email"`
+	MeetingLink  string            `json:"meeting_link,omitempty"`
+	CustomFields map[string]string `json:"custom_fields,omitempty"`
+	CreatedAt    time.Time         `json:"created_at"`
+}
+
+func (h *ExportHandler) exportBookings(w http.ResponseWriter, userID, linkID uint, req exportRequest) {
+	var link BookingLink
+	if err := h.db.Where("id = ? AND user_id = ?", linkID, userID).First(&link).Error; err != nil {
+		http.Error(w, "Booking link not found", http.StatusNotFound)
+		return
+	}
+
+	var bookings []Booking
+	if err := h.db.Preload("Slot").Where("booking_link_id = ?", link.ID).Find(&bookings).Error; err != nil {
+		http.Error(w, "Failed to load bookings", http.StatusInternalServerError)
+		return
+	}
+	sort.SliceStable(bookings, func(i, j int) bool {
+		return bookings[i].Slot.StartTime.Before(bookings[j].Slot.StartTime)
+	})
+
+	if req.Format == "json" {
+		result := make([]bookingExport, len(bookings))
+		for i, b := range bookings {
+			result[i] = bookingExport{
+				ID:           b.ID,
+				Status:       bookingStatusName(b.Status),
+				StartTime:    b.Slot.StartTime.In(req.Loc),
+				EndTime:      b.Slot.EndTime.In(req.Loc),
+				GuestName:    b.GuestName,
+				GuestEmail:   b.GuestEmail,
+				MeetingLink:  b.MeetingLink,
+				CustomFields: b.CustomFields,
+				CreatedAt:    b.CreatedAt.In(req.Loc),
+			}
+		}
+		writeExportJSON(w, link.Slug, map[string]any{
+			"booking_link": map[string]any{"id": link.ID, "name": link.Name, "slug": link.Slug},
+			"time_zone":    req.Loc.String(),
+			"bookings":     result,
+		})
+		return
+	}
+
+	answers := make([]map[string]string, len(bookings))
+	for i, b := range bookings {
+		answers[i] = b.CustomFields
+	}
+	fields := exportCustomFields(link.CustomFields, answers)
+
+	table := exportTable{
+		Name:   "Bookings",
+		Header: []string{"ID", "Status", "Start", "End", "Guest name", "Guest email", "Meeting link", "Booked at"},
+	}
+	for _, f := range fields {
+		table.Header = append(table.Header, f.Label)
+	}
+	for _, b := range bookings {
+		row := []string{
+			strconv.FormatUint(uint64(b.ID), 10),
+			bookingStatusName(b.Status),
+			b.Slot.StartTime.In(req.Loc).Format(exportTimeFormat),
+			b.Slot.EndTime.In(req.Loc).Format(exportTimeFormat),
+			b.GuestName,
+			b.GuestEmail,
+			b.MeetingLink,
+			b.CreatedAt.In(req.Loc).Format(exportTimeFormat),
+		}
+		for _, f := range fields {
+			row = append(row, b.CustomFields[f.Name])
+		}
+		table.Rows = append(table.Rows, row)
+	}
+
+	writeExportTables(w, link.Slug, req.Format, []exportTable{table})
+}
+
+// pollOptionExport is an option with its tally in JSON exports.
+type pollOptionExport struct {
+	ID          uint      `json:"id"`
+	Label       string    `json:"label"`
+	StartTime   time.Time `json:"start_time"`
+	EndTime     time.Time `json:"end_time"`
+	Yes         int       `json:"yes"`
+	Maybe       int       `json:"maybe"`
+	No          int       `json:"no"`
+	Score       int       `json:"score"`
+	Recommended bool      `json:"recommended"`
+	Winner      bool      `json:"winner"`
+}
+
+// voteExport is a vote in JSON exports. Responses are keyed by option ID.
+type voteExport struct {
+	ID           uint              `json:"id"`
+	GuestName    string            `json:"guest_name"`
+	GuestEmail   string            `json:"guest_email,omitempty"`
+	Responses    map[string]string `json:"responses"`
+	CustomFields map[string]string `json:"custom_fields,omitempty"`
+	CreatedAt    time.Time         `json:"created_at"`
+}
+
+// commentExport is a comment in JSON exports.
+type commentExport struct {
+	ID          uint      `json:"id"`
+	OptionID    *uint     `json:"option_id,omitempty"`
+	AuthorName  string    `json:"author_name"`
+	AuthorEmail string    `json:"author_email,omitempty"`
+	Body        string    `json:"body"`
+	Hidden      bool      `json:"hidden"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+func (h *ExportHandler) exportPoll(w http.ResponseWriter, userID, pollID uint, req exportRequest) {
+	var poll Poll
+	if err := h.db.Where("id = ? AND user_id = ?", pollID, userID).First(&poll).Error; err != nil {
+		http.Error(w, "Poll not found", http.StatusNotFound)
+		return
+	}
+	if err := h.db.Where("poll_id = ?", poll.ID).Order("start_time").Find(&poll.PollOptions).Error; err != nil {
+		http.Error(w, "Failed to load poll", http.StatusInternalServerError)
+		return
+	}
+
+	ranks, votes, err := loadPollRanking(h.db, &poll)
+	if err != nil {
+		http.Error(w, "Failed to load votes", http.StatusInternalServerError)
+		return
+	}
+	sort.SliceStable(votes, func(i, j int) bool { return votes[i].CreatedAt.Before(votes[j].CreatedAt) })
+
+	var comments []PollComment
+	if err := h.db.Where("poll_id = ?", poll.ID).Order("created_at").Find(&comments).Error; err != nil {
+		http.Error(w, "Failed to load comments", http.StatusInternalServerError)
+		return
+	}
+
+	labels := make(map[uint]string, len(poll.PollOptions))
+	for _, opt := range poll.PollOptions {
+		labels[opt.ID] = pollOptionLabel(&opt, req.Loc)
+	}
+	recommended := recommendedOption(ranks)
+	isWinner := func(id uint) bool { return poll.WinnerOptionID != nil && *poll.WinnerOptionID == id }
+	isRecommended := func(id uint) bool { return recommended != nil && recommended.ID == id }
+
+	if req.Format == "json" {
+		options := make([]pollOptionExport, len(ranks))
+		for i, r := range ranks {
+			options[i] = pollOptionExport{
+				ID:          r.Option.ID,
+				Label:       labels[r.Option.ID],
+				StartTime:   r.Option.StartTime.In(req.Loc),
+				EndTime:     r.Option.EndTime.In(req.Loc),
+				Yes:         r.Yes,
+				Maybe:       r.Maybe,
+				No:          r.No,
+				Score:       r.Score,
+				Recommended: isRecommended(r.Option.ID),
+				Winner:      isWinner(r.Option.ID),
+			}
+		}
+		voteRows := make([]voteExport, len(votes))
+		for i, v := range votes {
+			responses := make(map[string]string, len(v.Responses))
+			for optionID, response := range v.Responses {
+				responses[strconv.FormatUint(uint64(optionID), 10)] = voteResponseName(response)
+			}
+			voteRows[i] = voteExport{
+				ID:           v.ID,
+				GuestName:    v.GuestName,
+				GuestEmail:   v.GuestEmail,
+				Responses:    responses,
+				CustomFields: v.CustomFields,
+				CreatedAt:    v.CreatedAt.In(req.Loc),
+			}
+		}
+		commentRows := make([]commentExport, len(comments))
+		for i, c := range comments {
+			commentRows[i] = commentExport{
+				ID:          c.ID,
+				OptionID:    c.OptionID,
+				AuthorName:  c.AuthorName,
+				AuthorEmail: c.AuthorEmail,
+				Body:        c.Body,
+				Hidden:      c.Hidden,
+				CreatedAt:   c.CreatedAt.In(req.Loc),
+			}
+		}
+		writeExportJSON(w, poll.Slug, map[string]any{
+			"poll":      map[string]any{"id": poll.ID, "name": poll.Name, "slug": poll.Slug},
+			"time_zone": req.Loc.String(),
+			"options":   options,
+			"votes":     voteRows,
+			"comments":  commentRows,
+		})
+		return
+	}
+
+	answers := make([]map[string]string, len(votes))
+	for i, v := range votes {
+		answers[i] = v.CustomFields
+	}
+	fields := exportCustomFields(poll.CustomFields, answers)
+
+	voteTable := exportTable{Name: "Votes", Header: []string{"Name", "Email", "Voted at"}}
+	for _, opt := range poll.PollOptions {
+		voteTable.Header = append(voteTable.Header, labels[opt.ID])
+	}
+	for _, f := range fields {
+		voteTable.Header = append(voteTable.Header, f.Label)
+	}
+	for _, v := range votes {
+		row := []string{v.GuestName, v.GuestEmail, v.CreatedAt.In(req.Loc).Format(exportTimeFormat)}
+		for _, opt := range poll.PollOptions {
+			row = append(row, voteResponseName(v.Responses[opt.ID]))
+		}
+		for _, f := range fields {
+			row = append(row, v.CustomFields[f.Name])
+		}
+		voteTable.Rows = append(voteTable.Rows, row)
+	}
+
+	tallyTable := exportTable{Name: "Tally", Header: []string{"Option", "Yes", "Maybe", "No", "Score", "Recommended", "Winner"}}
+	for _, r := range ranks {
+		tallyTable.Rows = append(tallyTable.Rows, []string{
+			labels[r.Option.ID],
+			strconv.Itoa(r.Yes),
+			strconv.Itoa(r.Maybe),
+			strconv.Itoa(r.No),
+			strconv.Itoa(r.Score),
+			exportBool(isRecommended(r.Option.ID)),
+			exportBool(isWinner(r.Option.ID)),
+		})
+	}
+
+	commentTable := exportTable{Name: "Comments", Header: []string{"Posted at", "Author", "Email", "Option", "Comment", "Hidden"}}
+	for _, c := range comments {
+		option := ""
+		if c.OptionID != nil {
+			option = labels[*c.OptionID]
+		}
+		commentTable.Rows = append(commentTable.Rows, []string{
+			c.CreatedAt.In(req.Loc).Format(exportTimeFormat),
+			c.AuthorName,
+			c.AuthorEmail,
+			option,
+			c.Body,
+			exportBool(c.Hidden),
+		})
+	}
+
+	tables := []exportTable{voteTable, tallyTable, commentTable}
+	if req.Format == "csv" {
+		switch req.Table {
+		case "", "votes":
+			tables = tables[:1]
+		case "tally":
+			tables = tables[1:2]
+		case "comments":
+			tables = tables[2:]
+		default:
+			http.Error(w, "Unknown table", http.StatusBadRequest)
+			return
+		}
+	}
+
+	writeExportTables(w, poll.Slug, req.Format, tables)
+}
+
+// exportCustomFields returns the custom fields of a link or poll, followed
+// by answers to fields that have been removed since, sorted by name.
+func exportCustomFields(defined []CustomField, answers []map[string]string) []CustomField {
+	fields := append([]CustomField(nil), defined...)
+	known := make(map[string]bool, len(defined))
+	for _, f := range defined {
+		known[f.Name] = true
+	}
+
+	var removed []string
+	for _, values := range answers {
+		for name := range values {
+			if !known[name] {
+				known[name] = true
+				removed = append(removed, name)
+			}
+		}
+	}
+	sort.Strings(removed)
+	for _, name := range removed {
+		fields = append(fields, CustomField{Name: name, Label: name})
+	}
+	return fields
+}
+
+// pollOptionLabel describes option for export headers. Full-day and
+// multi-day options are dates and don't depend on the time zone.
+func pollOptionLabel(option *PollOption, loc *time.Location) string {
+	switch option.Type {
+	case SlotTypeFullDay:
+		return option.StartTime.UTC().Format("2006-01-02")
+	case SlotTypeMultiDay:
+		return option.StartTime.UTC().Format("2006-01-02") + " – " + option.EndTime.UTC().Format("2006-01-02")
+	default:
+		return option.StartTime.In(loc).Format(exportTimeFormat) + "–" + option.EndTime.In(loc).Format("15:04")
+	}
+}
+
+func bookingStatusName(status BookingStatus) string {
+	switch status {
+	case BookingStatusPending:
+		return "pending"
+	case BookingStatusConfirmed:
+		return "confirmed"
+	case BookingStatusDeclined:
+		return "declined"
+	}
+	return ""
+}
+
+func voteResponseName(response VoteResponseType) string {
+	switch response {
+	case VoteResponseYes:
+		return "yes"
+	case VoteResponseNo:
+		return "no"
+	case VoteResponseMaybe:
+		return "maybe"
+	}
+	return ""
+}
+
+func exportBool(b bool) string {
+	if b {
+		return "yes"
+	}
+	return ""
+}
+
+func writeExportJSON(w http.ResponseWriter, name string, data any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="%s.json"`, name))
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	_ = enc.Encode(data)
+}
+
+func writeExportTables(w http.ResponseWriter, name, format string, tables []exportTable) {
+	if format == "xlsx" {
+		w.Header().Set("Content-Type", "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet")
+		w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="%s.xlsx"`, name))
+		_ = writeXLSX(w, tables)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/csv; charset=utf-8")
+	w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="%s-%s.csv"`, name, strings.ToLower(tables[0].Name)))
+	_ = writeCSV(w, tables[0])
+}
+
+// writeCSV writes table as CSV. Values that spreadsheet applications would
+// run as formulas are prefixed with a quote.
+func writeCSV(w io.Writer, table exportTable) error {
+	cw := csv.NewWriter(w)
+	for _, row := range append([][]string{table.Header}, table.Rows...) {
+		escaped := make([]string, len(row))
+		for i, value := range row {
+			if value != "" && strings.ContainsRune("=+-@\t\r", rune(value[0])) {
+				value = "'" + value
+			}
+			escaped[i] = value
+		}
+		if err := cw.Write(escaped); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
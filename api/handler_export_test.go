@@ -0,0 +1,137 @@
+package api
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func exportRequestAs(t *testing.T, h *ExportHandler, userID uint, target string) *httptest.ResponseRecorder {
+	t.Helper()
+	r := httptest.NewRequest(http.MethodGet, target, nil)
+	cookie, err := h.auth.CreateSessionCookie(&Session{UserID: userID, ExpiresAt: time.Now().Add(time.Hour)})
+	if err != nil {
+		t.Fatalf("CreateSessionCookie failed: %v", err)
+	}
+	r.AddCookie(cookie)
+	w := httptest.NewRecorder()
+	h.HandleExports(w, r)
+	return w
+}
+
+func TestExportBookings(t *testing.T) {
+	db := newTestHandler(t).db
+	h := NewExportHandler(db, &AuthService{})
+
+	link := BookingLink{UserID: 1, Slug: "intro", Name: "Intro", Status: LinkStatusActive, CustomFields: []CustomField{
+		{Name: "company", Label: "Company", Type: CustomFieldTypeText},
+	}}
+	db.Create(&link)
+	start := time.Date(2030, 1, 7, 9, 0, 0, 0, time.UTC)
+	for i, guest := range []string{"Bob", "=Alice"} {
+		slot := Slot{BookingLinkID: link.ID, Type: SlotTypeTime, StartTime: start.Add(-time.Duration(i) * time.Hour), EndTime: start.Add(time.Duration(1-i) * time.Hour)}
+		db.Create(&slot)
+		db.Create(&Booking{BookingLinkID: link.ID, SlotID: slot.ID, GuestName: guest, GuestEmail: "guest@example.com", Status: BookingStatusConfirmed,
+			ActionToken: guest, CustomFields: map[string]string{"company": "ACME", "referrer": "web"}})
+	}
+
+	w := exportRequestAs(t, h, 1, "/api/exports/booking-links/1?format=csv&tz=Europe/Berlin")
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d: %s", w.Code, w.Body)
+	}
+	rows, err := csv.NewReader(w.Body).ReadAll()
+	if err != nil {
+		t.Fatalf("reading CSV failed: %v", err)
+	}
+	if got := strings.Join(rows[0], ","); got != "ID,Status,Start,End,Guest name,Guest email,Meeting link,Booked at,Company,referrer" {
+		t.Errorf("unexpected header %q", got)
+	}
+	// Sorted by start, in Berlin time, with formulas defused
+	if rows[1][2] != "2030-01-07 09:00" || rows[1][4] != "'=Alice" || rows[1][8] != "ACME" || rows[1][9] != "web" {
+		t.Errorf("unexpected row %q", rows[1])
+	}
+
+	if w := exportRequestAs(t, h, 2, "/api/exports/booking-links/1"); w.Code != http.StatusNotFound {
+		t.Errorf("expected other users to get 404, got %d", w.Code)
+	}
+	if w := exportRequestAs(t, h, 1, "/api/exports/booking-links/1?tz=Mars/Olympus"); w.Code != http.StatusBadRequest {
+		t.Errorf("expected unknown time zones to be rejected, got %d", w.Code)
+	}
+}
+
+func TestExportPoll(t *testing.T) {
+	db := newTestHandler(t).db
+	h := NewExportHandler(db, &AuthService{})
+
+	poll := Poll{UserID: 1, Slug: "offsite", Name: "Offsite", Status: LinkStatusActive}
+	db.Create(&poll)
+	options := []PollOption{
+		{PollID: poll.ID, Type: SlotTypeTime, StartTime: time.Date(2030, 1, 7, 9, 0, 0, 0, time.UTC), EndTime: time.Date(2030, 1, 7, 10, 0, 0, 0, time.UTC)},
+		{PollID: poll.ID, Type: SlotTypeFullDay, StartTime: time.Date(2030, 1, 8, 0, 0, 0, 0, time.UTC), EndTime: time.Date(2030, 1, 8, 23, 59, 59, 0, time.UTC)},
+	}
+	db.Create(&options)
+	db.Create(&Vote{PollID: poll.ID, GuestName: "Alice", EditToken: "a", Responses: map[uint]VoteResponseType{options[0].ID: VoteResponseYes, options[1].ID: VoteResponseNo}})
+	db.Create(&PollComment{PollID: poll.ID, OptionID: &options[1].ID, AuthorName: "Alice", Body: "I'm traveling"})
+
+	w := exportRequestAs(t, h, 1, "/api/exports/polls/1?format=csv&tz=America/New_York")
+	rows, _ := csv.NewReader(w.Body).ReadAll()
+	if len(rows) != 2 || rows[0][3] != "2030-01-07 04:00–05:00" || rows[0][4] != "2030-01-08" || rows[1][3] != "yes" || rows[1][4] != "no" {
+		t.Fatalf("unexpected votes: %q", rows)
+	}
+
+	w = exportRequestAs(t, h, 1, "/api/exports/polls/1?format=csv&table=comments")
+	rows, _ = csv.NewReader(w.Body).ReadAll()
+	if len(rows) != 2 || rows[1][3] != "2030-01-08" || rows[1][4] != "I'm traveling" {
+		t.Fatalf("unexpected comments: %q", rows)
+	}
+
+	w = exportRequestAs(t, h, 1, "/api/exports/polls/1?format=json")
+	var data struct {
+		Options  []pollOptionExport `json:"options"`
+		Votes    []voteExport       `json:"votes"`
+		Comments []commentExport    `json:"comments"`
+	}
+	if err := json.NewDecoder(w.Body).Decode(&data); err != nil {
+		t.Fatalf("decoding JSON failed: %v", err)
+	}
+	if len(data.Options) != 2 || data.Options[0].Yes != 1 || len(data.Votes) != 1 || len(data.Comments) != 1 {
+		t.Errorf("unexpected JSON export: %+v", data)
+	}
+
+	w = exportRequestAs(t, h, 1, "/api/exports/polls/1?format=xlsx")
+	zr, err := zip.NewReader(bytes.NewReader(w.Body.Bytes()), int64(w.Body.Len()))
+	if err != nil {
+		t.Fatalf("reading XLSX failed: %v", err)
+	}
+	sheets := 0
+	for _, f := range zr.File {
+		if !strings.HasPrefix(f.Name, "xl/worksheets/") {
+			continue
+		}
+		sheets++
+		rc, _ := f.Open()
+		content, _ := io.ReadAll(rc)
+		rc.Close()
+		if f.Name == "xl/worksheets/sheet3.xml" && !strings.Contains(string(content), "I&#39;m traveling") {
+			t.Errorf("expected the comment in the comments sheet, got %s", content)
+		}
+	}
+	if sheets != 3 {
+		t.Errorf("expected 3 sheets, got %d", sheets)
+	}
+}
+
+func TestXLSXColumn(t *testing.T) {
+	for index, want := range map[int]string{0: "A", 25: "Z", 26: "AA", 701: "ZZ", 702: "AAA"} {
+		if got := xlsxColumn(index); got != want {
+			t.Errorf("xlsxColumn(%d) = %s, want %s", index, got, want)
+		}
+	}
+}
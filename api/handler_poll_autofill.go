@@ -0,0 +1,341 @@
+// api/handler_poll_autofill.go
+package api
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/emersion/go-ical"
+	"gorm.io/gorm"
+
+	gen "github.com/kolaente/meet-mesh/api/gen"
+)
+
+// maxCalendarSize limits the size of a voter's calendar.
+const maxCalendarSize = 1 << 20 // 1MB
+
+// maxCalendarEvents limits how many events of a voter's calendar are read,
+// maxRecurrenceSteps how many occurrences of recurring events are computed
+// in total. Both keep crafted calendars from using up the server.
+const (
+	maxCalendarEvents  = 5000
+	maxRecurrenceSteps = 100000
+)
+
+// calendarFetchTimeout limits how long fetching a voter's calendar may take.
+const calendarFetchTimeout = 10 * time.Second
+
+// errPrivateAddress is returned when a calendar URL points into the
+// server's own network.
+var errPrivateAddress = errors.New("calendar URL points to a private address")
+
+// calendarHTTPClient fetches voters' calendars. It refuses to connect to
+// loopback, private and link-local addresses, so voters can't make the
+// server request internal services, also not through redirects.
+var calendarHTTPClient = &http.Client{
+	Timeout: calendarFetchTimeout,
+	Transport: &http.Transport{
+		DialContext: (&net.Dialer{
+			Timeout: calendarFetchTimeout,
+			Control: func(network, address string, _ syscall.RawConn) error {
+				host, _, err := net.SplitHostPort(address)
+				if err != nil {
+					return err
+				}
+				ip := net.ParseIP(host)
+				if ip == nil || ip.IsLoopback() || ip.IsPrivate() || ip.IsUnspecified() ||
+					ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() || ip.IsMulticast() {
+					return errPrivateAddress
+				}
+				return nil
+			},
+		}).DialContext,
+		TLSHandshakeTimeout: calendarFetchTimeout,
+	},
+}
+
+// AutofillVote suggests answers for a poll from the voter's calendar. The
+// calendar is only held in memory while the answers are computed.
+func (h *Handler) AutofillVote(ctx context.Context, req *gen.AutofillVoteReq, params gen.AutofillVoteParams) (gen.AutofillVoteRes, error) {
+	var poll Poll
+	if err := h.db.Preload("PollOptions").Where("slug = ? AND status = ?", params.Slug, LinkStatusActive).First(&poll).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return &gen.Error{Message: "Poll not found"}, nil
+		}
+		return nil, err
+	}
+
+	if pollDeadlinePassed(&poll, time.Now()) {
+		return &gen.Error{Message: "Voting for this poll has closed"}, nil
+	}
+
+	var data []byte
+	switch {
+	case req.Ics.Value != "":
+		if len(req.Ics.Value) > maxCalendarSize {
+			return &gen.Error{Message: "Calendar file is too large"}, nil
+		}
+		data = []byte(req.Ics.Value)
+	case req.URL.Value != "":
+		var err error
+		data, err = fetchCalendar(ctx, req.URL.Value)
+		if err != nil {
+			// The URL is a secret of the voter and is not logged
+			return &gen.Error{Message: "Could not load the calendar: " + err.Error()}, nil
+		}
+	default:
+		return &gen.Error{Message: "Calendar URL or file required"}, nil
+	}
+
+	start, end := pollOptionsRange(poll.PollOptions)
+	busy, err := parseBusyPeriods(data, start, end)
+	if err != nil {
+		return &gen.Error{Message: "Could not read the calendar: " + err.Error()}, nil
+	}
+
+	result := &gen.AutofillVoteOK{Responses: make(gen.AutofillVoteOKResponses)}
+	for optionID, response := range suggestVoteResponses(poll.PollOptions, busy, poll.Mode) {
+		result.Responses[fmt.Sprint(optionID)] = gen.VoteResponse(response)
+	}
+	return result, nil
+}
+
+// fetchCalendar downloads the calendar at rawURL. webcal URLs are fetched
+// over https.
+func fetchCalendar(ctx context.Context, rawURL string) ([]byte, error) {
+	u, err := url.Parse(strings.TrimSpace(rawURL))
+	if err != nil {
+		return nil, errors.New("invalid URL")
+	}
+	switch u.Scheme {
+	case "webcal", "webcals":
+		u.Scheme = "https"
+	case "http", "https":
+	default:
+		return nil, errors.New("only http(s) and webcal URLs are supported")
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return nil, errors.New("invalid URL")
+	}
+	req.Header.Set("Accept", "text/calendar")
+
+	resp, err := calendarHTTPClient.Do(req)
+	if err != nil {
+		if errors.Is(err, errPrivateAddress) {
+			return nil, errPrivateAddress
+		}
+		return nil, errors.New("request failed")
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("server responded with %s", resp.Status)
+	}
+
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxCalendarSize+1))
+	if err != nil {
+		return nil, errors.New("request failed")
+	}
+	if len(data) > maxCalendarSize {
+		return nil, errors.New("calendar is too large")
+	}
+	return data, nil
+}
+
+// pollOptionsRange returns the earliest start and latest end of options.
+func pollOptionsRange(options []PollOption) (start, end time.Time) {
+	for i, opt := range options {
+		if i == 0 || opt.StartTime.Before(start) {
+			start = opt.StartTime
+		}
+		if i == 0 || opt.EndTime.After(end) {
+			end = opt.EndTime
+		}
+	}
+	return start, end
+}
+
+// parseBusyPeriods returns the busy times between start and end of the
+// events and free-busy entries in data. Recurring events are expanded;
+// transparent and cancelled events don't count.
+func parseBusyPeriods(data []byte, start, end time.Time) ([]TimePeriod, error) {
+	var busy []TimePeriod
+	overlaps := func(p TimePeriod) bool {
+		return p.Start.Before(end) && p.End.After(start)
+	}
+	events, steps := 0, maxRecurrenceSteps
+
+	dec := ical.NewDecoder(bytes.NewReader(data))
+	for {
+		cal, err := dec.Decode()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, errors.New("not a valid iCalendar file")
+		}
+
+		for _, child := range cal.Children {
+			switch child.Name {
+			case ical.CompEvent:
+				if events++; events > maxCalendarEvents {
+					return nil, fmt.Errorf("the calendar has more than %d events", maxCalendarEvents)
+				}
+				busy = append(busy, eventBusyPeriods(ical.Event{Component: child}, start, end, &steps)...)
+			case ical.CompFreeBusy:
+				for _, prop := range child.Props.Values(ical.PropFreeBusy) {
+					if fbType := prop.Params.Get(ical.ParamFreeBusyType); strings.EqualFold(fbType, "FREE") {
+						continue
+					}
+					for _, value := range strings.Split(prop.Value, ",") {
+						if period, ok := parseICalPeriod(value); ok && overlaps(period) {
+							busy = append(busy, period)
+						}
+					}
+				}
+			}
+		}
+	}
+
+	return mergePeriods(busy), nil
+}
+
+// eventBusyPeriods returns the occurrences of event between start and end.
+// Computing occurrences of recurring events takes from the budget steps;
+// once it is used up the remaining occurrences are ignored. Events repeating
+// every second are ignored as well.
+func eventBusyPeriods(event ical.Event, start, end time.Time, steps *int) []TimePeriod {
+	if transp, _ := event.Props.Text(ical.PropTransparency); strings.EqualFold(transp, "TRANSPARENT") {
+		return nil
+	}
+	if status, _ := event.Props.Text(ical.PropStatus); strings.EqualFold(status, "CANCELLED") {
+		return nil
+	}
+
+	dtstart, err := event.DateTimeStart(time.UTC)
+	if err != nil {
+		return nil
+	}
+	dtend, err := event.DateTimeEnd(time.UTC)
+	if err != nil || !dtend.After(dtstart) {
+		return nil
+	}
+	duration := dtend.Sub(dtstart)
+
+	set, err := event.RecurrenceSet(time.UTC)
+	if err != nil || set == nil {
+		if dtstart.Before(end) && dtend.After(start) {
+			return []TimePeriod{{Start: dtstart, End: dtend}}
+		}
+		return nil
+	}
+
+	if repeatsEverySecond(event) {
+		return nil
+	}
+
+	var periods []TimePeriod
+	next := set.Iterator()
+	for ; *steps > 0; *steps-- {
+		occurrence, ok := next()
+		if !ok || !occurrence.Before(end) {
+			break
+		}
+		period := TimePeriod{Start: occurrence, End: occurrence.Add(duration)}
+		if period.End.After(start) {
+			periods = append(periods, period)
+		}
+	}
+	return periods
+}
+
+// repeatsEverySecond reports whether event has a secondly recurrence rule.
+func repeatsEverySecond(event ical.Event) bool {
+	for _, prop := range event.Props.Values(ical.PropRecurrenceRule) {
+		for _, part := range strings.Split(strings.ToUpper(prop.Value), ";") {
+			if strings.TrimSpace(part) == "FREQ=SECONDLY" {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// parseICalPeriod parses a period of time, given as start/end or
+// start/duration.
+func parseICalPeriod(value string) (TimePeriod, bool) {
+	parts := strings.SplitN(strings.TrimSpace(value), "/", 2)
+	if len(parts) != 2 {
+		return TimePeriod{}, false
+	}
+
+	startProp := ical.NewProp(ical.PropDateTimeStart)
+	startProp.Value = parts[0]
+	start, err := startProp.DateTime(time.UTC)
+	if err != nil {
+		return TimePeriod{}, false
+	}
+
+	var end time.Time
+	if strings.HasPrefix(parts[1], "P") || strings.HasPrefix(parts[1], "+P") {
+		durationProp := ical.NewProp(ical.PropDuration)
+		durationProp.Value = parts[1]
+		duration, err := durationProp.Duration()
+		if err != nil {
+			return TimePeriod{}, false
+		}
+		end = start.Add(duration)
+	} else {
+		endProp := ical.NewProp(ical.PropDateTimeEnd)
+		endProp.Value = parts[1]
+		if end, err = endProp.DateTime(time.UTC); err != nil {
+			return TimePeriod{}, false
+		}
+	}
+
+	if !end.After(start) {
+		return TimePeriod{}, false
+	}
+	return TimePeriod{Start: start, End: end}, true
+}
+
+// suggestVoteResponses answers every option from the voter's busy times:
+// no when busy, yes when free. Full-day and multi-day options the voter is
+// only partly busy on get maybe, or no on sign-up sheets, which don't accept
+// maybe.
+func suggestVoteResponses(options []PollOption, busy []TimePeriod, mode PollMode) map[uint]VoteResponseType {
+	responses := make(map[uint]VoteResponseType, len(options))
+	for _, opt := range options {
+		switch {
+		case !isSlotBusy(opt.StartTime, opt.EndTime, busy):
+			responses[opt.ID] = VoteResponseYes
+		case opt.Type != SlotTypeTime && mode != PollModeSignup && !coversPeriod(busy, opt.StartTime, opt.EndTime):
+			responses[opt.ID] = VoteResponseMaybe
+		default:
+			responses[opt.ID] = VoteResponseNo
+		}
+	}
+	return responses
+}
+
+// coversPeriod reports whether the merged busy periods cover start to end
+// completely.
+func coversPeriod(busy []TimePeriod, start, end time.Time) bool {
+	for _, p := range busy {
+		if !p.Start.After(start) && !p.End.Before(end) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,155 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	gen "github.com/kolaente/meet-mesh/api/gen"
+)
+
+const voterCalendar = `BEGIN:VCALENDAR
+VERSION:2.0
+PRODID:-//Test//EN
+BEGIN:VEVENT
+UID:standup@example.com
+DTSTAMP:20300101T000000Z
+DTSTART:20300107T090000Z
+DTEND:20300107T093000Z
+RRULE:FREQ=DAILY;COUNT=5
+SUMMARY:Standup
+END:VEVENT
+BEGIN:VEVENT
+UID:focus@example.com
+DTSTAMP:20300101T000000Z
+DTSTART:20300107T140000Z
+DTEND:20300107T160000Z
+TRANSP:TRANSPARENT
+SUMMARY:Focus time
+END:VEVENT
+END:VCALENDAR
+BEGIN:VCALENDAR
+VERSION:2.0
+PRODID:-//Test//EN
+BEGIN:VFREEBUSY
+UID:fb@example.com
+DTSTAMP:20300101T000000Z
+FREEBUSY;FBTYPE=BUSY:20300109T000000Z/P1D
+FREEBUSY;FBTYPE=FREE:20300110T000000Z/20300110T235959Z
+END:VFREEBUSY
+END:VCALENDAR
+`
+
+func TestAutofillVote(t *testing.T) {
+	h := newTestHandler(t)
+	ctx := context.Background()
+
+	// Monday
+	day := time.Date(2030, 1, 7, 0, 0, 0, 0, time.UTC)
+	poll := Poll{UserID: 1, Slug: "autofill", Name: "Autofill", Status: LinkStatusActive}
+	h.db.Create(&poll)
+	options := []PollOption{
+		// Overlaps the standup on Tuesday
+		{PollID: poll.ID, Type: SlotTypeTime, StartTime: day.AddDate(0, 0, 1).Add(9 * time.Hour), EndTime: day.AddDate(0, 0, 1).Add(10 * time.Hour)},
+		// During focus time, which doesn't block
+		{PollID: poll.ID, Type: SlotTypeTime, StartTime: day.Add(14 * time.Hour), EndTime: day.Add(15 * time.Hour)},
+		// Busy all Wednesday
+		{PollID: poll.ID, Type: SlotTypeFullDay, StartTime: day.AddDate(0, 0, 2), EndTime: day.AddDate(0, 0, 2).Add(24*time.Hour - time.Second)},
+		// Only the standup on Thursday
+		{PollID: poll.ID, Type: SlotTypeFullDay, StartTime: day.AddDate(0, 0, 3), EndTime: day.AddDate(0, 0, 3).Add(24*time.Hour - time.Second)},
+		// After the standups ended
+		{PollID: poll.ID, Type: SlotTypeTime, StartTime: day.AddDate(0, 0, 7).Add(9 * time.Hour), EndTime: day.AddDate(0, 0, 7).Add(10 * time.Hour)},
+	}
+	h.db.Create(&options)
+
+	res, err := h.AutofillVote(ctx, &gen.AutofillVoteReq{Ics: gen.NewOptString(voterCalendar)}, gen.AutofillVoteParams{Slug: poll.Slug})
+	if err != nil {
+		t.Fatalf("AutofillVote failed: %v", err)
+	}
+	ok, isOK := res.(*gen.AutofillVoteOK)
+	if !isOK {
+		t.Fatalf("expected suggestions, got %#v", res)
+	}
+	want := []VoteResponseType{VoteResponseNo, VoteResponseYes, VoteResponseNo, VoteResponseMaybe, VoteResponseYes}
+	for i, opt := range options {
+		if got := VoteResponseType(ok.Responses[fmt.Sprint(opt.ID)]); got != want[i] {
+			t.Errorf("option %d: expected %d, got %d", i, want[i], got)
+		}
+	}
+
+	// Sign-up sheets don't accept maybe
+	suggested := suggestVoteResponses(options, mustParseBusy(t, voterCalendar), PollModeSignup)
+	if suggested[options[3].ID] != VoteResponseNo {
+		t.Errorf("expected no on sign-up sheets, got %d", suggested[options[3].ID])
+	}
+
+	for _, req := range []*gen.AutofillVoteReq{
+		{},
+		{Ics: gen.NewOptString("not a calendar")},
+		{URL: gen.NewOptString("file:///etc/passwd")},
+	} {
+		if res, _ := h.AutofillVote(ctx, req, gen.AutofillVoteParams{Slug: poll.Slug}); !isError(res) {
+			t.Errorf("expected request to be rejected, got %#v", res)
+		}
+	}
+}
+
+func mustParseBusy(t *testing.T, data string) []TimePeriod {
+	t.Helper()
+	busy, err := parseBusyPeriods([]byte(data), time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2030, 2, 1, 0, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatalf("parseBusyPeriods failed: %v", err)
+	}
+	return busy
+}
+
+func TestFetchCalendarRejectsPrivateAddresses(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(voterCalendar))
+	}))
+	defer server.Close()
+
+	_, err := fetchCalendar(context.Background(), strings.Replace(server.URL, "http://", "webcal://", 1))
+	if err == nil {
+		t.Fatal("expected loopback URL to be rejected")
+	}
+	if _, err := fetchCalendar(context.Background(), server.URL); err != errPrivateAddress {
+		t.Errorf("expected %v, got %v", errPrivateAddress, err)
+	}
+}
+
+func TestParseBusyPeriodsLimitsRecurrences(t *testing.T) {
+	event := func(uid, rrule string) string {
+		return "BEGIN:VEVENT\r\nUID:" + uid + "\r\nDTSTAMP:20300101T000000Z\r\nDTSTART:20300101T000000Z\r\nDTEND:20300101T000001Z\r\nRRULE:" + rrule + "\r\nEND:VEVENT\r\n"
+	}
+	calendar := func(events ...string) []byte {
+		return []byte("BEGIN:VCALENDAR\r\nVERSION:2.0\r\nPRODID:-//Test//EN\r\n" + strings.Join(events, "") + "END:VCALENDAR\r\n")
+	}
+	start, end := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2030, 4, 1, 0, 0, 0, 0, time.UTC)
+
+	busy, err := parseBusyPeriods(calendar(event("secondly", "FREQ=SECONDLY")), start, end)
+	if err != nil || len(busy) != 0 {
+		t.Errorf("expected secondly events to be ignored, got %d periods (%v)", len(busy), err)
+	}
+
+	// Every minute for three months is more than the whole budget
+	began := time.Now()
+	if _, err := parseBusyPeriods(calendar(event("a", "FREQ=MINUTELY"), event("b", "FREQ=MINUTELY")), start, end); err != nil {
+		t.Fatalf("parseBusyPeriods failed: %v", err)
+	}
+	if took := time.Since(began); took > 5*time.Second {
+		t.Errorf("expanding recurrences took %v", took)
+	}
+
+	events := make([]string, maxCalendarEvents+1)
+	for i := range events {
+		events[i] = event(fmt.Sprint(i), "FREQ=DAILY;COUNT=1")
+	}
+	if _, err := parseBusyPeriods(calendar(events...), start, end); err == nil {
+		t.Error("expected calendars with too many events to be rejected")
+	}
+}
@@ -0,0 +1,174 @@
+// api/handler_poll_comments.go
+package api
+
+import (
+	"context"
+	"errors"
+	"log"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"gorm.io/gorm"
+
+	gen "github.com/kolaente/meet-mesh/api/gen"
+)
+
+// maxCommentLength is the maximum number of characters of a comment.
+const maxCommentLength = 2000
+
+// GetPublicPollComments returns the visible comments of a poll
+func (h *Handler) GetPublicPollComments(ctx context.Context, params gen.GetPublicPollCommentsParams) (gen.GetPublicPollCommentsRes, error) {
+	var poll Poll
+	if err := h.db.Where("slug = ?", params.Slug).First(&poll).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return &gen.Error{Message: "Poll not found"}, nil
+		}
+		return nil, err
+	}
+
+	var comments []PollComment
+	if err := h.db.Where("poll_id = ? AND hidden = ?", poll.ID, false).Order("created_at").Find(&comments).Error; err != nil {
+		return nil, err
+	}
+
+	result := make(gen.GetPublicPollCommentsOKApplicationJSON, len(comments))
+	for i, c := range comments {
+		result[i] = *mapPublicPollCommentToGen(&c)
+	}
+	return &result, nil
+}
+
+// AddPollComment adds a comment to a poll and notifies the organizer
+func (h *Handler) AddPollComment(ctx context.Context, req *gen.AddPollCommentReq, params gen.AddPollCommentParams) (gen.AddPollCommentRes, error) {
+	var poll Poll
+	if err := h.db.Where("slug = ? AND status = ?", params.Slug, LinkStatusActive).First(&poll).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return &gen.Error{Message: "Poll not found"}, nil
+		}
+		return nil, err
+	}
+
+	if pollDeadlinePassed(&poll, time.Now()) {
+		return &gen.Error{Message: "Voting for this poll has closed"}, nil
+	}
+
+	comment := PollComment{
+		PollID:      poll.ID,
+		AuthorName:  strings.TrimSpace(req.AuthorName),
+		AuthorEmail: strings.TrimSpace(req.AuthorEmail.Value),
+		Body:        strings.TrimSpace(req.Body),
+	}
+	if comment.AuthorName == "" {
+		return &gen.Error{Message: "Name required"}, nil
+	}
+	if comment.Body == "" {
+		return &gen.Error{Message: "Comment must not be empty"}, nil
+	}
+	if utf8.RuneCountInString(comment.Body) > maxCommentLength {
+		return &gen.Error{Message: "Comment is too long"}, nil
+	}
+
+	var option *PollOption
+	if req.OptionID.Set {
+		option = &PollOption{}
+		if err := h.db.Where("id = ? AND poll_id = ?", req.OptionID.Value, poll.ID).First(option).Error; err != nil {
+			return &gen.Error{Message: "Option not found"}, nil
+		}
+		comment.OptionID = &option.ID
+	}
+
+	if err := h.db.Create(&comment).Error; err != nil {
+		return nil, err
+	}
+
+	if h.mailer != nil {
+		var organizer User
+		h.db.First(&organizer, poll.UserID)
+		if err := h.mailer.SendPollComment(&poll, &comment, option, &organizer); err != nil {
+			log.Printf("[WARN] Failed to send comment notification for poll %d: %v", poll.ID, err)
+		}
+	}
+
+	return mapPublicPollCommentToGen(&comment), nil
+}
+
+// ListPollComments returns all comments of a poll for the organizer
+func (h *Handler) ListPollComments(ctx context.Context, params gen.ListPollCommentsParams) ([]gen.PollComment, error) {
+	userID, _ := GetUserID(ctx)
+
+	// Verify poll ownership
+	var poll Poll
+	if err := h.db.Where("id = ? AND user_id = ?", params.ID, userID).First(&poll).Error; err != nil {
+		return nil, err
+	}
+
+	var comments []PollComment
+	if err := h.db.Where("poll_id = ?", poll.ID).Order("created_at").Find(&comments).Error; err != nil {
+		return nil, err
+	}
+
+	return mapPollCommentsToGen(comments), nil
+}
+
+// ModeratePollComment hides or shows a comment
+func (h *Handler) ModeratePollComment(ctx context.Context, req *gen.ModeratePollCommentReq, params gen.ModeratePollCommentParams) (*gen.PollComment, error) {
+	userID, _ := GetUserID(ctx)
+
+	// Verify poll ownership
+	var poll Poll
+	if err := h.db.Where("id = ? AND user_id = ?", params.ID, userID).First(&poll).Error; err != nil {
+		return nil, err
+	}
+
+	var comment PollComment
+	if err := h.db.Where("id = ? AND poll_id = ?", params.CommentId, poll.ID).First(&comment).Error; err != nil {
+		return nil, err
+	}
+
+	comment.Hidden = req.Hidden
+	if err := h.db.Model(&comment).Update("hidden", comment.Hidden).Error; err != nil {
+		return nil, err
+	}
+
+	return mapPollCommentToGen(&comment), nil
+}
+
+// DeletePollComment deletes a comment
+func (h *Handler) DeletePollComment(ctx context.Context, params gen.DeletePollCommentParams) error {
+	userID, _ := GetUserID(ctx)
+
+	// Verify poll ownership
+	var poll Poll
+	if err := h.db.Where("id = ? AND user_id = ?", params.ID, userID).First(&poll).Error; err != nil {
+		return err
+	}
+
+	return h.db.Where("id = ? AND poll_id = ?", params.CommentId, poll.ID).Delete(&PollComment{}).Error
+}
+
+func mapPollCommentsToGen(comments []PollComment) []gen.PollComment {
+	result := make([]gen.PollComment, len(comments))
+	for i, c := range comments {
+		result[i] = *mapPollCommentToGen(&c)
+	}
+	return result
+}
+
+func mapPollCommentToGen(c *PollComment) *gen.PollComment {
+	result := mapPublicPollCommentToGen(c)
+	result.AuthorEmail = gen.NewOptString(c.AuthorEmail)
+	result.Hidden = gen.NewOptBool(c.Hidden)
+	return result
+}
+
+// mapPublicPollCommentToGen maps a comment without the author's email.
+func mapPublicPollCommentToGen(c *PollComment) *gen.PollComment {
+	return &gen.PollComment{
+		ID:         int(c.ID),
+		OptionID:   optUintID(c.OptionID),
+		AuthorName: c.AuthorName,
+		Body:       c.Body,
+		CreatedAt:  gen.NewOptDateTime(c.CreatedAt),
+	}
+}
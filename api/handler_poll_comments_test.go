@@ -0,0 +1,71 @@
+package api
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	gen "github.com/kolaente/meet-mesh/api/gen"
+)
+
+func TestPollComments(t *testing.T) {
+	h := newTestHandler(t)
+	ctx := WithUserID(context.Background(), 1)
+
+	poll := Poll{UserID: 1, Slug: "discuss", Name: "Discuss", Status: LinkStatusActive}
+	h.db.Create(&poll)
+	option := PollOption{PollID: poll.ID, Type: SlotTypeTime}
+	h.db.Create(&option)
+	params := gen.AddPollCommentParams{Slug: poll.Slug}
+
+	res, err := h.AddPollComment(ctx, &gen.AddPollCommentReq{
+		AuthorName:  "Alice",
+		AuthorEmail: gen.NewOptString("alice@example.com"),
+		Body:        "Tuesday works if we start after 10",
+		OptionID:    gen.NewOptInt(int(option.ID)),
+	}, params)
+	if err != nil {
+		t.Fatalf("AddPollComment failed: %v", err)
+	}
+	comment, ok := res.(*gen.PollComment)
+	if !ok || comment.OptionID.Value != int(option.ID) || comment.AuthorEmail.Set {
+		t.Fatalf("unexpected comment: %#v", res)
+	}
+
+	for _, req := range []*gen.AddPollCommentReq{
+		{AuthorName: "Bob", Body: "  "},
+		{AuthorName: "Bob", Body: strings.Repeat("a", maxCommentLength+1)},
+		{AuthorName: "Bob", Body: "Hi", OptionID: gen.NewOptInt(9999)},
+	} {
+		if res, _ := h.AddPollComment(ctx, req, params); !isError(res) {
+			t.Errorf("expected comment to be rejected, got %#v", res)
+		}
+	}
+
+	h.AddPollComment(ctx, &gen.AddPollCommentReq{AuthorName: "Spam", Body: "Buy now"}, params)
+	all, err := h.ListPollComments(ctx, gen.ListPollCommentsParams{ID: int(poll.ID)})
+	if err != nil || len(all) != 2 {
+		t.Fatalf("expected 2 comments, got %d (%v)", len(all), err)
+	}
+	if _, err := h.ModeratePollComment(ctx, &gen.ModeratePollCommentReq{Hidden: true},
+		gen.ModeratePollCommentParams{ID: int(poll.ID), CommentId: all[1].ID}); err != nil {
+		t.Fatalf("ModeratePollComment failed: %v", err)
+	}
+
+	public, err := h.GetPublicPollComments(ctx, gen.GetPublicPollCommentsParams{Slug: poll.Slug})
+	if err != nil {
+		t.Fatalf("GetPublicPollComments failed: %v", err)
+	}
+	list := *public.(*gen.GetPublicPollCommentsOKApplicationJSON)
+	if len(list) != 1 || list[0].AuthorName != "Alice" {
+		t.Errorf("expected hidden comment to be left out, got %+v", list)
+	}
+
+	if err := h.DeletePollComment(ctx, gen.DeletePollCommentParams{ID: int(poll.ID), CommentId: comment.ID}); err != nil {
+		t.Fatalf("DeletePollComment failed: %v", err)
+	}
+	all, _ = h.ListPollComments(ctx, gen.ListPollCommentsParams{ID: int(poll.ID)})
+	if len(all) != 1 {
+		t.Errorf("expected 1 comment left, got %d", len(all))
+	}
+}
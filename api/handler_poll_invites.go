@@ -0,0 +1,210 @@
+// api/handler_poll_invites.go
+package api
+
+import (
+	"context"
+	"log"
+	"net/mail"
+	"strings"
+	"time"
+
+	gen "github.com/kolaente/meet-mesh/api/gen"
+)
+
+// ListPollInvites returns the invitees of a poll with their response status
+func (h *Handler) ListPollInvites(ctx context.Context, params gen.ListPollInvitesParams) ([]gen.PollInvite, error) {
+	userID, _ := GetUserID(ctx)
+
+	// Verify poll ownership
+	var poll Poll
+	if err := h.db.Where("id = ? AND user_id = ?", params.ID, userID).First(&poll).Error; err != nil {
+		return nil, err
+	}
+
+	var invites []PollInvite
+	if err := h.db.Where("poll_id = ?", poll.ID).Order("created_at").Find(&invites).Error; err != nil {
+		return nil, err
+	}
+
+	return mapPollInvitesToGen(invites), nil
+}
+
+// AddPollInvites invites participants to a poll and emails each of them a
+// personal link
+func (h *Handler) AddPollInvites(ctx context.Context, req *gen.AddPollInvitesReq, params gen.AddPollInvitesParams) (gen.AddPollInvitesRes, error) {
+	userID, _ := GetUserID(ctx)
+
+	var poll Poll
+	if err := h.db.Where("id = ? AND user_id = ?", params.ID, userID).First(&poll).Error; err != nil {
+		return nil, err
+	}
+
+	if poll.Status != LinkStatusActive {
+		return &gen.Error{Message: "Poll is closed"}, nil
+	}
+
+	var existing []PollInvite
+	if err := h.db.Where("poll_id = ?", poll.ID).Find(&existing).Error; err != nil {
+		return nil, err
+	}
+	invited := make(map[string]bool, len(existing))
+	for _, invite := range existing {
+		invited[strings.ToLower(invite.Email)] = true
+	}
+
+	var invites []PollInvite
+	for _, invitee := range req.Invitees {
+		email := strings.TrimSpace(invitee.Email)
+		if _, err := mail.ParseAddress(email); err != nil {
+			return &gen.Error{Message: "Invalid email address: " + invitee.Email}, nil
+		}
+		if invited[strings.ToLower(email)] {
+			continue
+		}
+		invited[strings.ToLower(email)] = true
+
+		invites = append(invites, PollInvite{
+			PollID:   poll.ID,
+			Email:    email,
+			Name:     strings.TrimSpace(invitee.Name.Value),
+			Required: invitee.Required.Value,
+			Token:    generateToken(),
+		})
+	}
+
+	if len(invites) == 0 {
+		return &gen.AddPollInvitesCreatedApplicationJSON{}, nil
+	}
+
+	if err := h.db.Create(&invites).Error; err != nil {
+		return nil, err
+	}
+
+	// Invitees who voted before they were invited count as voted
+	for i := range invites {
+		var vote Vote
+		if err := h.db.Where("poll_id = ? AND LOWER(guest_email) = LOWER(?)", poll.ID, invites[i].Email).First(&vote).Error; err == nil {
+			h.markInviteVoted(&vote, &invites[i])
+		}
+	}
+
+	if h.mailer != nil {
+		var organizer User
+		h.db.First(&organizer, poll.UserID)
+
+		for i := range invites {
+			if invites[i].VoteID != nil {
+				continue
+			}
+			if err := h.mailer.SendPollInvite(&poll, &invites[i], &organizer); err != nil {
+				log.Printf("[WARN] Failed to send poll invite to %s: %v", invites[i].Email, err)
+			}
+		}
+	}
+
+	result := gen.AddPollInvitesCreatedApplicationJSON(mapPollInvitesToGen(invites))
+	return &result, nil
+}
+
+// RemindPollInvites sends a reminder to every invitee who hasn't voted yet
+func (h *Handler) RemindPollInvites(ctx context.Context, params gen.RemindPollInvitesParams) (*gen.RemindPollInvitesOK, error) {
+	userID, _ := GetUserID(ctx)
+
+	var poll Poll
+	if err := h.db.Where("id = ? AND user_id = ?", params.ID, userID).First(&poll).Error; err != nil {
+		return nil, err
+	}
+
+	if poll.Status != LinkStatusActive {
+		return &gen.RemindPollInvitesOK{Sent: 0}, nil
+	}
+
+	var invites []PollInvite
+	if err := h.db.Where("poll_id = ? AND vote_id IS NULL", poll.ID).Find(&invites).Error; err != nil {
+		return nil, err
+	}
+
+	sent := 0
+	for i := range invites {
+		if h.sendPollReminder(&poll, &invites[i]) {
+			sent++
+		}
+	}
+
+	return &gen.RemindPollInvitesOK{Sent: sent}, nil
+}
+
+// DeletePollInvite removes an invitee from a poll. Their vote is kept.
+func (h *Handler) DeletePollInvite(ctx context.Context, params gen.DeletePollInviteParams) error {
+	userID, _ := GetUserID(ctx)
+
+	// Verify poll ownership
+	var poll Poll
+	if err := h.db.Where("id = ? AND user_id = ?", params.ID, userID).First(&poll).Error; err != nil {
+		return err
+	}
+
+	return h.db.Where("id = ? AND poll_id = ?", params.InviteId, params.ID).Delete(&PollInvite{}).Error
+}
+
+// sendPollReminder emails a reminder to invite and records when it was sent.
+// It reports whether the email went out.
+func (h *Handler) sendPollReminder(poll *Poll, invite *PollInvite) bool {
+	if h.mailer == nil {
+		return false
+	}
+
+	var organizer User
+	h.db.First(&organizer, poll.UserID)
+
+	if err := h.mailer.SendPollReminder(poll, invite, &organizer); err != nil {
+		log.Printf("[WARN] Failed to send poll reminder to %s: %v", invite.Email, err)
+		return false
+	}
+
+	now := time.Now().UTC()
+	invite.RemindedAt = &now
+	h.db.Model(invite).Update("reminded_at", now)
+	return true
+}
+
+// markInviteVoted links vote to invite. Without an invite, the invite with
+// the voter's email address is used, if there is one.
+func (h *Handler) markInviteVoted(vote *Vote, invite *PollInvite) {
+	query := h.db.Model(&PollInvite{}).Where("poll_id = ? AND vote_id IS NULL", vote.PollID)
+	switch {
+	case invite != nil:
+		query = query.Where("id = ?", invite.ID)
+	case vote.GuestEmail != "":
+		query = query.Where("LOWER(email) = LOWER(?)", vote.GuestEmail)
+	default:
+		return
+	}
+
+	now := time.Now().UTC()
+	if err := query.Updates(map[string]any{"vote_id": vote.ID, "voted_at": now}).Error; err != nil {
+		log.Printf("[WARN] Failed to mark invite as voted for vote %d: %v", vote.ID, err)
+		return
+	}
+	if invite != nil {
+		invite.VoteID = &vote.ID
+		invite.VotedAt = &now
+	}
+}
+
+func mapPollInvitesToGen(invites []PollInvite) []gen.PollInvite {
+	result := make([]gen.PollInvite, len(invites))
+	for i, invite := range invites {
+		result[i] = gen.PollInvite{
+			ID:         int(invite.ID),
+			Email:      invite.Email,
+			Name:       gen.NewOptString(invite.Name),
+			Voted:      invite.VoteID != nil,
+			Required:   gen.NewOptBool(invite.Required),
+			VotedAt:    optDateTime(invite.VotedAt),
+			InvitedAt:  gen.NewOptDateTime(invite.CreatedAt),
+			RemindedAt: optDateTime(invite.RemindedAt),
+		}
+	}
+	return result
+}
@@ -0,0 +1,85 @@
+package api
+
+import (
+	"context"
+	"testing"
+
+	gen "github.com/kolaente/meet-mesh/api/gen"
+)
+
+func TestPollInvites(t *testing.T) {
+	h := newTestHandler(t)
+	ctx := WithUserID(context.Background(), 1)
+
+	poll := Poll{UserID: 1, Slug: "invites", Name: "Invites", Status: LinkStatusActive}
+	h.db.Create(&poll)
+	params := gen.AddPollInvitesParams{ID: int(poll.ID)}
+
+	res, err := h.AddPollInvites(ctx, &gen.AddPollInvitesReq{Invitees: []gen.Invitee{
+		{Email: "alice@example.com", Name: gen.NewOptString("Alice")},
+		{Email: "bob@example.com"},
+		{Email: "ALICE@example.com"},
+	}}, params)
+	if err != nil {
+		t.Fatalf("AddPollInvites failed: %v", err)
+	}
+	created, ok := res.(*gen.AddPollInvitesCreatedApplicationJSON)
+	if !ok || len(*created) != 2 {
+		t.Fatalf("expected 2 invites, got %#v", res)
+	}
+
+	// Inviting the same address again is a no-op
+	res, _ = h.AddPollInvites(ctx, &gen.AddPollInvitesReq{Invitees: []gen.Invitee{{Email: "bob@example.com"}}}, params)
+	if created, ok := res.(*gen.AddPollInvitesCreatedApplicationJSON); !ok || len(*created) != 0 {
+		t.Errorf("expected no new invites, got %#v", res)
+	}
+
+	res, _ = h.AddPollInvites(ctx, &gen.AddPollInvitesReq{Invitees: []gen.Invitee{{Email: "not an email"}}}, params)
+	if _, ok := res.(*gen.Error); !ok {
+		t.Errorf("expected invalid address to be rejected, got %#v", res)
+	}
+
+	var alice PollInvite
+	h.db.Where("poll_id = ? AND email = ?", poll.ID, "alice@example.com").First(&alice)
+
+	public, err := h.GetPublicPoll(ctx, gen.GetPublicPollParams{Slug: poll.Slug, Invite: gen.NewOptString(alice.Token)})
+	if err != nil {
+		t.Fatalf("GetPublicPoll failed: %v", err)
+	}
+	if p, ok := public.(*gen.GetPublicPollOK); !ok || p.Invitee.Value.Email != "alice@example.com" {
+		t.Fatalf("expected invitee in public poll, got %#v", public)
+	}
+
+	voteRes, err := h.SubmitVote(ctx, &gen.SubmitVoteReq{
+		Responses:   gen.SubmitVoteReqResponses{},
+		InviteToken: gen.NewOptString(alice.Token),
+	}, gen.SubmitVoteParams{Slug: poll.Slug})
+	if err != nil {
+		t.Fatalf("SubmitVote failed: %v", err)
+	}
+	vote, ok := voteRes.(*gen.Vote)
+	if !ok || vote.GuestEmail.Value != "alice@example.com" || vote.GuestName.Value != "Alice" {
+		t.Fatalf("expected vote with invitee details, got %#v", voteRes)
+	}
+
+	invites, err := h.ListPollInvites(ctx, gen.ListPollInvitesParams{ID: int(poll.ID)})
+	if err != nil {
+		t.Fatalf("ListPollInvites failed: %v", err)
+	}
+	voted := map[string]bool{}
+	for _, invite := range invites {
+		voted[invite.Email] = invite.Voted
+	}
+	if !voted["alice@example.com"] || voted["bob@example.com"] {
+		t.Errorf("unexpected response status: %v", voted)
+	}
+
+	// Withdrawing the vote resets the status
+	if _, err := h.DeleteOwnVote(ctx, gen.DeleteOwnVoteParams{Slug: poll.Slug, Token: vote.EditToken.Value}); err != nil {
+		t.Fatalf("DeleteOwnVote failed: %v", err)
+	}
+	h.db.First(&alice, alice.ID)
+	if alice.VoteID != nil || alice.VotedAt != nil {
+		t.Errorf("expected invite to be reset, got %+v", alice)
+	}
+}
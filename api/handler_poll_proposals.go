@@ -0,0 +1,228 @@
+// api/handler_poll_proposals.go
+package api
+
+import (
+	"context"
+	"errors"
+	"log"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+
+	gen "github.com/kolaente/meet-mesh/api/gen"
+)
+
+// maxPendingProposals limits how many proposals of a poll may wait for
+// approval at the same time.
+const maxPendingProposals = 50
+
+// maxProposedOptions limits how many options participants may add to a poll
+// that accepts proposals without approval. Participants are anonymous, so the
+// limit is per poll rather than per participant.
+const maxProposedOptions = 20
+
+// maxProposalDuration limits how long a proposed option may run.
+const maxProposalDuration = 7 * 24 * time.Hour
+
+// ProposePollOption lets a participant propose an option for a poll
+func (h *Handler) ProposePollOption(ctx context.Context, req *gen.ProposePollOptionReq, params gen.ProposePollOptionParams) (gen.ProposePollOptionRes, error) {
+	var poll Poll
+	if err := h.db.Where("slug = ? AND status = ?", params.Slug, LinkStatusActive).First(&poll).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return &gen.Error{Message: "Poll not found"}, nil
+		}
+		return nil, err
+	}
+
+	if pollDeadlinePassed(&poll, time.Now()) || poll.WinnerOptionID != nil {
+		return &gen.Error{Message: "Voting for this poll has closed"}, nil
+	}
+	if poll.OptionProposals != ProposalModeApproval && poll.OptionProposals != ProposalModeAuto {
+		return &gen.Error{Message: "This poll doesn't accept proposals"}, nil
+	}
+
+	proposal := PollProposal{
+		PollID:    poll.ID,
+		Type:      SlotType(req.Type),
+		StartTime: req.StartTime.UTC(),
+		EndTime:   req.EndTime.UTC(),
+		Name:      strings.TrimSpace(req.Name),
+		Email:     strings.TrimSpace(req.Email.Value),
+	}
+	if proposal.Name == "" {
+		return &gen.Error{Message: "Name required"}, nil
+	}
+	if poll.RequireEmail && proposal.Email == "" {
+		return &gen.Error{Message: "Email required"}, nil
+	}
+	if !proposal.EndTime.After(proposal.StartTime) {
+		return &gen.Error{Message: "End must be after start"}, nil
+	}
+	if proposal.EndTime.Sub(proposal.StartTime) > maxProposalDuration {
+		return &gen.Error{Message: "The proposed time is too long"}, nil
+	}
+	if proposal.StartTime.Before(time.Now()) {
+		return &gen.Error{Message: "The proposed time has already passed"}, nil
+	}
+
+	var offered int64
+	if err := h.db.Model(&PollOption{}).
+		Where("poll_id = ? AND start_time = ? AND end_time = ?", poll.ID, proposal.StartTime, proposal.EndTime).
+		Count(&offered).Error; err != nil {
+		return nil, err
+	}
+	if offered > 0 {
+		return &gen.Error{Message: "This time is already an option"}, nil
+	}
+
+	if poll.OptionProposals == ProposalModeAuto {
+		var proposed int64
+		if err := h.db.Model(&PollOption{}).Where("poll_id = ? AND unapproved = ?", poll.ID, true).Count(&proposed).Error; err != nil {
+			return nil, err
+		}
+		if proposed >= maxProposedOptions {
+			return &gen.Error{Message: "This poll doesn't accept more proposals"}, nil
+		}
+
+		option := PollOption{
+			PollID:     poll.ID,
+			Type:       proposal.Type,
+			StartTime:  proposal.StartTime,
+			EndTime:    proposal.EndTime,
+			ProposedBy: proposal.Name,
+			Unapproved: true,
+		}
+		if err := h.db.Create(&option).Error; err != nil {
+			return nil, err
+		}
+		h.notifyPollProposal(&poll, &proposal, false)
+
+		return &gen.ProposePollOptionCreated{
+			Pending: false,
+			Option:  gen.NewOptPollOption(*mapPollOptionToGen(&option)),
+		}, nil
+	}
+
+	var pending []PollProposal
+	if err := h.db.Where("poll_id = ?", poll.ID).Find(&pending).Error; err != nil {
+		return nil, err
+	}
+	if len(pending) >= maxPendingProposals {
+		return &gen.Error{Message: "This poll has too many proposals waiting for approval"}, nil
+	}
+	for _, p := range pending {
+		if p.StartTime.Equal(proposal.StartTime) && p.EndTime.Equal(proposal.EndTime) {
+			return &gen.Error{Message: "This time has already been proposed"}, nil
+		}
+	}
+
+	if err := h.db.Create(&proposal).Error; err != nil {
+		return nil, err
+	}
+	h.notifyPollProposal(&poll, &proposal, true)
+
+	return &gen.ProposePollOptionCreated{Pending: true}, nil
+}
+
+// ListPollProposals returns the proposals of a poll that wait for approval
+func (h *Handler) ListPollProposals(ctx context.Context, params gen.ListPollProposalsParams) ([]gen.PollProposal, error) {
+	userID, _ := GetUserID(ctx)
+
+	// Verify poll ownership
+	var poll Poll
+	if err := h.db.Where("id = ? AND user_id = ?", params.ID, userID).First(&poll).Error; err != nil {
+		return nil, err
+	}
+
+	var proposals []PollProposal
+	if err := h.db.Where("poll_id = ?", poll.ID).Order("created_at").Find(&proposals).Error; err != nil {
+		return nil, err
+	}
+
+	result := make([]gen.PollProposal, len(proposals))
+	for i, p := range proposals {
+		result[i] = gen.PollProposal{
+			ID:        int(p.ID),
+			Type:      gen.SlotType(p.Type),
+			StartTime: p.StartTime,
+			EndTime:   p.EndTime,
+			Name:      p.Name,
+			Email:     gen.NewOptString(p.Email),
+			CreatedAt: gen.NewOptDateTime(p.CreatedAt),
+		}
+	}
+	return result, nil
+}
+
+// ApprovePollProposal turns a proposal into an option participants can vote on
+func (h *Handler) ApprovePollProposal(ctx context.Context, params gen.ApprovePollProposalParams) (gen.ApprovePollProposalRes, error) {
+	userID, _ := GetUserID(ctx)
+
+	var poll Poll
+	if err := h.db.Where("id = ? AND user_id = ?", params.ID, userID).First(&poll).Error; err != nil {
+		return nil, err
+	}
+
+	var proposal PollProposal
+	if err := h.db.Where("id = ? AND poll_id = ?", params.ProposalId, poll.ID).First(&proposal).Error; err != nil {
+		return nil, err
+	}
+
+	var offered int64
+	if err := h.db.Model(&PollOption{}).
+		Where("poll_id = ? AND start_time = ? AND end_time = ?", poll.ID, proposal.StartTime, proposal.EndTime).
+		Count(&offered).Error; err != nil {
+		return nil, err
+	}
+	if offered > 0 {
+		return &gen.Error{Message: "This time is already an option"}, nil
+	}
+
+	option := PollOption{
+		PollID:     poll.ID,
+		Type:       proposal.Type,
+		StartTime:  proposal.StartTime,
+		EndTime:    proposal.EndTime,
+		ProposedBy: proposal.Name,
+	}
+	err := h.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(&option).Error; err != nil {
+			return err
+		}
+		return tx.Delete(&proposal).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	h.holdPollOptions(ctx, &poll, []PollOption{option})
+
+	return mapPollOptionToGen(&option), nil
+}
+
+// DeclinePollProposal deletes a proposal
+func (h *Handler) DeclinePollProposal(ctx context.Context, params gen.DeclinePollProposalParams) error {
+	userID, _ := GetUserID(ctx)
+
+	// Verify poll ownership
+	var poll Poll
+	if err := h.db.Where("id = ? AND user_id = ?", params.ID, userID).First(&poll).Error; err != nil {
+		return err
+	}
+
+	return h.db.Where("id = ? AND poll_id = ?", params.ProposalId, poll.ID).Delete(&PollProposal{}).Error
+}
+
+// notifyPollProposal emails the organizer about a proposal.
+func (h *Handler) notifyPollProposal(poll *Poll, proposal *PollProposal, pending bool) {
+	if h.mailer == nil {
+		return
+	}
+
+	var organizer User
+	h.db.First(&organizer, poll.UserID)
+
+	if err := h.mailer.SendPollProposal(poll, proposal, pending, &organizer); err != nil {
+		log.Printf("[WARN] Failed to send proposal notification for poll %d: %v", poll.ID, err)
+	}
+}
@@ -0,0 +1,160 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	gen "github.com/kolaente/meet-mesh/api/gen"
+)
+
+func TestPollProposals(t *testing.T) {
+	h := newTestHandler(t)
+	ctx := WithUserID(context.Background(), 1)
+
+	start := time.Now().Add(48 * time.Hour).Truncate(time.Hour).UTC()
+	poll := Poll{UserID: 1, Slug: "propose", Name: "Propose", Status: LinkStatusActive}
+	h.db.Create(&poll)
+	h.db.Create(&PollOption{PollID: poll.ID, Type: SlotTypeTime, StartTime: start, EndTime: start.Add(time.Hour)})
+	params := gen.ProposePollOptionParams{Slug: poll.Slug}
+	req := &gen.ProposePollOptionReq{
+		Type:      gen.SlotType(SlotTypeTime),
+		StartTime: start.Add(2 * time.Hour),
+		EndTime:   start.Add(3 * time.Hour),
+		Name:      "Alice",
+	}
+
+	if res, _ := h.ProposePollOption(ctx, req, params); !isError(res) {
+		t.Fatalf("expected proposals to be off by default, got %#v", res)
+	}
+
+	h.db.Model(&poll).Update("option_proposals", ProposalModeApproval)
+	res, err := h.ProposePollOption(ctx, req, params)
+	if err != nil {
+		t.Fatalf("ProposePollOption failed: %v", err)
+	}
+	if created, ok := res.(*gen.ProposePollOptionCreated); !ok || !created.Pending {
+		t.Fatalf("expected a pending proposal, got %#v", res)
+	}
+
+	for _, invalid := range []*gen.ProposePollOptionReq{
+		// Already proposed
+		req,
+		// Already an option
+		{Type: gen.SlotType(SlotTypeTime), StartTime: start, EndTime: start.Add(time.Hour), Name: "Bob"},
+		{Type: gen.SlotType(SlotTypeTime), StartTime: start.Add(time.Hour), EndTime: start, Name: "Bob"},
+		{Type: gen.SlotType(SlotTypeTime), StartTime: start.Add(-72 * time.Hour), EndTime: start.Add(-71 * time.Hour), Name: "Bob"},
+	} {
+		if res, _ := h.ProposePollOption(ctx, invalid, params); !isError(res) {
+			t.Errorf("expected proposal to be rejected, got %#v", res)
+		}
+	}
+
+	// Pending proposals can't be voted on yet
+	options, _ := h.GetPollOptions(ctx, gen.GetPollOptionsParams{ID: int(poll.ID)})
+	if len(options) != 1 {
+		t.Fatalf("expected 1 option before approval, got %d", len(options))
+	}
+
+	proposals, err := h.ListPollProposals(ctx, gen.ListPollProposalsParams{ID: int(poll.ID)})
+	if err != nil || len(proposals) != 1 {
+		t.Fatalf("expected 1 proposal, got %d (%v)", len(proposals), err)
+	}
+	approved, err := h.ApprovePollProposal(ctx, gen.ApprovePollProposalParams{ID: int(poll.ID), ProposalId: proposals[0].ID})
+	if err != nil {
+		t.Fatalf("ApprovePollProposal failed: %v", err)
+	}
+	if option, ok := approved.(*gen.PollOption); !ok || option.ProposedBy.Value != "Alice" {
+		t.Fatalf("unexpected option: %#v", approved)
+	}
+	if proposals, _ := h.ListPollProposals(ctx, gen.ListPollProposalsParams{ID: int(poll.ID)}); len(proposals) != 0 {
+		t.Errorf("expected approved proposal to be removed, got %d", len(proposals))
+	}
+
+	// Other participants vote on the approved option
+	public, _ := h.GetPublicPoll(ctx, gen.GetPublicPollParams{Slug: poll.Slug})
+	if ok, isOK := public.(*gen.GetPublicPollOK); !isOK || len(ok.Options) != 2 {
+		t.Fatalf("expected 2 public options, got %#v", public)
+	}
+
+	h.db.Model(&poll).Update("option_proposals", ProposalModeAuto)
+	res, _ = h.ProposePollOption(ctx, &gen.ProposePollOptionReq{
+		Type:      gen.SlotType(SlotTypeTime),
+		StartTime: start.Add(4 * time.Hour),
+		EndTime:   start.Add(5 * time.Hour),
+		Name:      "Bob",
+	}, params)
+	if created, ok := res.(*gen.ProposePollOptionCreated); !ok || created.Pending || !created.Option.Set {
+		t.Fatalf("expected the option to be added, got %#v", res)
+	}
+	options, _ = h.GetPollOptions(ctx, gen.GetPollOptionsParams{ID: int(poll.ID)})
+	if len(options) != 3 {
+		t.Errorf("expected 3 options, got %d", len(options))
+	}
+
+	// Options added without approval are never held and are limited per
+	// poll and in duration
+	h.db.Model(&poll).Update("hold_times", true)
+	if periods := h.pollHoldPeriods(1, start.Add(4*time.Hour), start.Add(5*time.Hour)); len(periods) != 0 {
+		t.Errorf("expected proposed options not to be held, got %v", periods)
+	}
+	if res, _ := h.ProposePollOption(ctx, &gen.ProposePollOptionReq{
+		Type:      gen.SlotType(SlotTypeMultiDay),
+		StartTime: start.Add(6 * time.Hour),
+		EndTime:   start.Add(6*time.Hour + maxProposalDuration + time.Hour),
+		Name:      "Carol",
+	}, params); !isError(res) {
+		t.Errorf("expected a too long proposal to be rejected, got %#v", res)
+	}
+	// Changing the name doesn't get around the limit
+	for i := 1; i <= maxProposedOptions; i++ {
+		res, _ = h.ProposePollOption(ctx, &gen.ProposePollOptionReq{
+			Type:      gen.SlotType(SlotTypeTime),
+			StartTime: start.Add(time.Duration(10+i) * time.Hour),
+			EndTime:   start.Add(time.Duration(11+i) * time.Hour),
+			Name:      fmt.Sprintf("bob%d", i),
+		}, params)
+	}
+	if !isError(res) {
+		t.Errorf("expected proposals beyond the limit per poll to be rejected, got %#v", res)
+	}
+}
+
+func TestApprovedProposalIsHeld(t *testing.T) {
+	h := newTestHandler(t)
+	ctx := WithUserID(context.Background(), 1)
+
+	var puts int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPut {
+			puts++
+		}
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer server.Close()
+	h.caldav = NewCalDAVClient(h.db)
+	h.db.Create(&CalendarConnection{UserID: 1, ServerURL: server.URL, Username: "u", Password: "p", WriteURL: "/calendar"})
+
+	start := time.Now().Add(48 * time.Hour).Truncate(time.Hour).UTC()
+	poll := Poll{UserID: 1, Slug: "held", Name: "Held", Status: LinkStatusActive, HoldTimes: true, OptionProposals: ProposalModeApproval}
+	h.db.Create(&poll)
+	proposal := PollProposal{PollID: poll.ID, Type: SlotTypeTime, StartTime: start, EndTime: start.Add(time.Hour), Name: "Alice"}
+	h.db.Create(&proposal)
+
+	res, err := h.ApprovePollProposal(ctx, gen.ApprovePollProposalParams{ID: int(poll.ID), ProposalId: int(proposal.ID)})
+	if err != nil || isError(res) {
+		t.Fatalf("ApprovePollProposal failed: %#v %v", res, err)
+	}
+
+	var option PollOption
+	h.db.Where("poll_id = ?", poll.ID).First(&option)
+	if option.HoldUID == "" || option.Unapproved || puts != 1 {
+		t.Errorf("expected the approved option to be held, got %+v after %d requests", option, puts)
+	}
+	if periods := h.pollHoldPeriods(1, start, start.Add(time.Hour)); len(periods) != 1 {
+		t.Errorf("expected the approved option to block bookings, got %v", periods)
+	}
+}
@@ -4,10 +4,16 @@ package api
 import (
 	"context"
 	"fmt"
+	"log"
+	"time"
 
 	gen "github.com/kolaente/meet-mesh/api/gen"
 )
 
+// defaultReminderHours is how long before the deadline invitees who haven't
+// voted are reminded, unless the poll sets it.
+const defaultReminderHours = 24
+
 // ListPolls returns all polls for user
 func (h *Handler) ListPolls(ctx context.Context) ([]gen.Poll, error) {
 	userID, _ := GetUserID(ctx)
@@ -21,18 +27,47 @@ func (h *Handler) ListPolls(ctx context.Context) ([]gen.Poll, error) {
 }
 
 // CreatePoll creates a new poll
-func (h *Handler) CreatePoll(ctx context.Context, req *gen.CreatePollReq) (*gen.Poll, error) {
+func (h *Handler) CreatePoll(ctx context.Context, req *gen.CreatePollReq) (gen.CreatePollRes, error) {
 	userID, _ := GetUserID(ctx)
 
+	eventTemplate := mapEventTemplateFromGen(req.EventTemplate)
+	if err := ValidateEventTemplate(eventTemplate); err != nil {
+		return &gen.Error{Message: err.Error()}, nil
+	}
+
+	customFields := mapCustomFieldsFromGen(req.CustomFields)
+	if problem := checkPollCustomFields(customFields); problem != "" {
+		return &gen.Error{Message: problem}, nil
+	}
+
 	poll := Poll{
-		UserID:       userID,
-		Slug:         generateSlug(),
-		Name:         req.Name,
-		Description:  req.Description.Value,
-		Status:       LinkStatusActive,
-		ShowResults:  req.ShowResults.Value,
-		RequireEmail: req.RequireEmail.Value,
-		CustomFields: mapCustomFieldsFromGen(req.CustomFields),
+		UserID:             userID,
+		Slug:               generateSlug(),
+		Name:               req.Name,
+		Description:        req.Description.Value,
+		Status:             LinkStatusActive,
+		ShowResults:        req.ShowResults.Value,
+		ResultsVisibility:  ResultsVisibility(req.ResultsVisibility.Or(gen.ResultsVisibility(ResultsVisibilityNames))),
+		ResultsTiming:      ResultsTiming(req.ResultsTiming.Or(gen.ResultsTiming(ResultsTimingAlways))),
+		RequireEmail:       req.RequireEmail.Value,
+		Mode:               PollMode(req.Mode.Or(gen.PollMode(PollModeVote))),
+		MaxChoices:         req.MaxChoices.Value,
+		CustomFields:       customFields,
+		Branding:           applyBrandingColorsFromGen(nil, req.Branding),
+		EventTemplate:      eventTemplate,
+		ReminderHours:      defaultReminderHours,
+		Scoring:            mapPollScoringFromGen(req.Scoring),
+		AutoPickOnClose:    req.AutoPickOnClose.Value,
+		AutoPickWhenAllYes: req.AutoPickWhenAllYes.Value,
+		HoldTimes:          req.HoldTimes.Value,
+		OptionProposals:    ProposalMode(req.OptionProposals.Or(gen.ProposalMode(ProposalModeOff))),
+	}
+	if req.ReminderHours.Set {
+		poll.ReminderHours = req.ReminderHours.Value
+	}
+	if req.Deadline.Set {
+		deadline := req.Deadline.Value.UTC()
+		poll.Deadline = &deadline
 	}
 
 	if err := h.db.Create(&poll).Error; err != nil {
@@ -55,7 +90,7 @@ func (h *Handler) GetPoll(ctx context.Context, params gen.GetPollParams) (*gen.P
 }
 
 // UpdatePoll updates a poll
-func (h *Handler) UpdatePoll(ctx context.Context, req *gen.UpdatePollReq, params gen.UpdatePollParams) (*gen.Poll, error) {
+func (h *Handler) UpdatePoll(ctx context.Context, req *gen.UpdatePollReq, params gen.UpdatePollParams) (gen.UpdatePollRes, error) {
 	userID, _ := GetUserID(ctx)
 
 	var poll Poll
@@ -69,23 +104,79 @@ func (h *Handler) UpdatePoll(ctx context.Context, req *gen.UpdatePollReq, params
 	if req.Description.Set {
 		poll.Description = req.Description.Value
 	}
+	statusChanged := req.Status.Set && LinkStatus(req.Status.Value) != poll.Status
 	if req.Status.Set {
 		poll.Status = LinkStatus(req.Status.Value)
 	}
 	if req.ShowResults.Set {
 		poll.ShowResults = req.ShowResults.Value
 	}
+	if req.ResultsVisibility.Set {
+		poll.ResultsVisibility = ResultsVisibility(req.ResultsVisibility.Value)
+	}
+	if req.ResultsTiming.Set {
+		poll.ResultsTiming = ResultsTiming(req.ResultsTiming.Value)
+	}
 	if req.RequireEmail.Set {
 		poll.RequireEmail = req.RequireEmail.Value
 	}
+	if req.Mode.Set {
+		poll.Mode = PollMode(req.Mode.Value)
+	}
+	if req.MaxChoices.Set {
+		poll.MaxChoices = req.MaxChoices.Value
+	}
 	if req.CustomFields != nil {
-		poll.CustomFields = mapCustomFieldsFromGen(req.CustomFields)
+		customFields := mapCustomFieldsFromGen(req.CustomFields)
+		if problem := checkPollCustomFields(customFields); problem != "" {
+			return &gen.Error{Message: problem}, nil
+		}
+		poll.CustomFields = customFields
+	}
+	poll.Branding = applyBrandingColorsFromGen(poll.Branding, req.Branding)
+	if req.Deadline.Set {
+		if req.Deadline.Null {
+			poll.Deadline = nil
+		} else {
+			deadline := req.Deadline.Value.UTC()
+			poll.Deadline = &deadline
+		}
+	}
+	if req.EventTemplate.Set {
+		eventTemplate := mapEventTemplateFromGen(req.EventTemplate)
+		if err := ValidateEventTemplate(eventTemplate); err != nil {
+			return &gen.Error{Message: err.Error()}, nil
+		}
+		poll.EventTemplate = eventTemplate
+	}
+	if req.ReminderHours.Set {
+		poll.ReminderHours = req.ReminderHours.Value
+	}
+	if req.Scoring.Set {
+		poll.Scoring = mapPollScoringFromGen(req.Scoring)
+	}
+	if req.AutoPickOnClose.Set {
+		poll.AutoPickOnClose = req.AutoPickOnClose.Value
+	}
+	if req.AutoPickWhenAllYes.Set {
+		poll.AutoPickWhenAllYes = req.AutoPickWhenAllYes.Value
+	}
+	if req.OptionProposals.Set {
+		poll.OptionProposals = ProposalMode(req.OptionProposals.Value)
+	}
+	holdTimesChanged := req.HoldTimes.Set && req.HoldTimes.Value != poll.HoldTimes
+	if req.HoldTimes.Set {
+		poll.HoldTimes = req.HoldTimes.Value
 	}
 
 	if err := h.db.Save(&poll).Error; err != nil {
 		return nil, err
 	}
 
+	if holdTimesChanged || statusChanged {
+		h.syncPollHolds(ctx, &poll)
+	}
+
 	return mapPollToGen(&poll), nil
 }
 
@@ -93,7 +184,14 @@ func (h *Handler) UpdatePoll(ctx context.Context, req *gen.UpdatePollReq, params
 func (h *Handler) DeletePoll(ctx context.Context, params gen.DeletePollParams) error {
 	userID, _ := GetUserID(ctx)
 
-	return h.db.Where("id = ? AND user_id = ?", params.ID, userID).Delete(&Poll{}).Error
+	var poll Poll
+	if err := h.db.Preload("PollOptions").Where("id = ? AND user_id = ?", params.ID, userID).First(&poll).Error; err != nil {
+		return err
+	}
+
+	h.releasePollHolds(ctx, &poll, poll.PollOptions, 0)
+
+	return h.db.Delete(&poll).Error
 }
 
 // GetPollOptions returns options for a poll
@@ -111,7 +209,16 @@ func (h *Handler) GetPollOptions(ctx context.Context, params gen.GetPollOptionsP
 		return nil, err
 	}
 
-	return mapPollOptionsToGen(options), nil
+	result := mapPollOptionsToGen(options)
+	if poll.Mode == PollModeSignup {
+		var votes []Vote
+		if err := h.db.Where("poll_id = ?", poll.ID).Find(&votes).Error; err != nil {
+			return nil, err
+		}
+		applySignupCounts(result, options, votes)
+	}
+
+	return result, nil
 }
 
 // AddPollOption adds an option to a poll
@@ -127,17 +234,188 @@ func (h *Handler) AddPollOption(ctx context.Context, req *gen.AddPollOptionReq,
 	option := PollOption{
 		PollID:    uint(params.ID),
 		Type:      SlotType(req.Type),
-		StartTime: req.StartTime,
-		EndTime:   req.EndTime,
+		StartTime: req.StartTime.UTC(),
+		EndTime:   req.EndTime.UTC(),
+		Capacity:  req.Capacity.Value,
 	}
 
 	if err := h.db.Create(&option).Error; err != nil {
 		return nil, err
 	}
 
+	h.holdPollOptions(ctx, &poll, []PollOption{option})
+
 	return mapPollOptionToGen(&option), nil
 }
 
+// maxSuggestionRange limits how far apart start and end of an option
+// suggestion request may be.
+const maxSuggestionRange = 90 * 24 * time.Hour
+
+// SuggestPollOptions proposes poll options from the organizer's free time
+func (h *Handler) SuggestPollOptions(ctx context.Context, req *gen.SuggestPollOptionsReq, params gen.SuggestPollOptionsParams) (gen.SuggestPollOptionsRes, error) {
+	userID, _ := GetUserID(ctx)
+
+	var poll Poll
+	if err := h.db.Preload("PollOptions").Where("id = ? AND user_id = ?", params.ID, userID).First(&poll).Error; err != nil {
+		return nil, err
+	}
+
+	if !req.End.After(req.Start) {
+		return &gen.Error{Message: "End must be after start"}, nil
+	}
+	if req.End.Sub(req.Start) > maxSuggestionRange {
+		return &gen.Error{Message: "Date range must not exceed 90 days"}, nil
+	}
+
+	// Rules from the request win over those of the organizer's booking links
+	rules := mapAvailabilityRulesFromGen(req.AvailabilityRules)
+	if len(rules) == 0 {
+		query := h.db.Where("user_id = ? AND status = ?", userID, LinkStatusActive)
+		if req.BookingLinkID.Set {
+			query = h.db.Where("id = ? AND user_id = ?", req.BookingLinkID.Value, userID)
+		}
+		var links []BookingLink
+		if err := query.Find(&links).Error; err != nil {
+			return nil, err
+		}
+		for _, link := range links {
+			rules = append(rules, link.AvailabilityRules...)
+		}
+	}
+	if len(rules) == 0 {
+		return &gen.Error{Message: "No availability rules configured"}, nil
+	}
+
+	var busyTimes []TimePeriod
+	if h.caldav != nil {
+		var err error
+		busyTimes, err = h.caldav.GetBusyTimes(ctx, userID, req.Start, req.End)
+		if err != nil {
+			log.Printf("[WARN] Failed to get busy times for user %d: %v", userID, err)
+		}
+	}
+	busyTimes = append(busyTimes, h.pollHoldPeriods(userID, req.Start, req.End)...)
+
+	limit := 50
+	if req.Limit.Set {
+		limit = req.Limit.Value
+	}
+
+	slots := generateAvailableSlots(rules, req.Start, req.End, busyTimes, req.DurationMinutes, req.BufferMinutes.Value)
+
+	// Overlapping rules can produce the same slot twice
+	seen := make(map[[2]int64]bool, len(poll.PollOptions))
+	for _, opt := range poll.PollOptions {
+		seen[[2]int64{opt.StartTime.Unix(), opt.EndTime.Unix()}] = true
+	}
+	var options []PollOption
+	for _, slot := range slots {
+		key := [2]int64{slot.StartTime.Unix(), slot.EndTime.Unix()}
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		options = append(options, PollOption{
+			PollID:    poll.ID,
+			Type:      slot.Type,
+			StartTime: slot.StartTime.UTC(),
+			EndTime:   slot.EndTime.UTC(),
+		})
+		if len(options) == limit {
+			break
+		}
+	}
+
+	result := &gen.SuggestPollOptionsOK{
+		Candidates: make([]gen.PollOptionCandidate, len(options)),
+	}
+	for i, opt := range options {
+		result.Candidates[i] = gen.PollOptionCandidate{
+			Type:      gen.SlotType(opt.Type),
+			StartTime: opt.StartTime,
+			EndTime:   opt.EndTime,
+		}
+	}
+
+	if req.Add.Value && len(options) > 0 {
+		if err := h.db.Create(&options).Error; err != nil {
+			return nil, err
+		}
+		h.holdPollOptions(ctx, &poll, options)
+		result.Added = mapPollOptionsToGen(options)
+	}
+
+	return result, nil
+}
+
+// GeneratePollOptions creates poll options from a recurrence pattern
+func (h *Handler) GeneratePollOptions(ctx context.Context, req *gen.GeneratePollOptionsReq, params gen.GeneratePollOptionsParams) (gen.GeneratePollOptionsRes, error) {
+	userID, _ := GetUserID(ctx)
+
+	var poll Poll
+	if err := h.db.Preload("PollOptions").Where("id = ? AND user_id = ?", params.ID, userID).First(&poll).Error; err != nil {
+		return nil, err
+	}
+
+	loc := time.UTC
+	if req.TimeZone.Value != "" {
+		var err error
+		if loc, err = time.LoadLocation(req.TimeZone.Value); err != nil {
+			return &gen.Error{Message: "Unknown time zone"}, nil
+		}
+	}
+
+	pattern := optionPattern{
+		Type:       SlotType(req.Type),
+		StartDate:  req.StartDate,
+		EndDate:    req.EndDate,
+		DaysOfWeek: req.DaysOfWeek,
+		Times:      req.Times,
+		Duration:   time.Duration(req.DurationMinutes.Value) * time.Minute,
+		SpanDays:   req.SpanDays.Value,
+		Location:   loc,
+	}
+	generated, invalid := pattern.generate()
+	if invalid != "" {
+		return &gen.Error{Message: invalid}, nil
+	}
+
+	seen := make(map[[2]int64]bool, len(poll.PollOptions))
+	for _, opt := range poll.PollOptions {
+		seen[[2]int64{opt.StartTime.Unix(), opt.EndTime.Unix()}] = true
+	}
+	result := &gen.GeneratePollOptionsOK{}
+	var options []PollOption
+	for _, opt := range generated {
+		if seen[[2]int64{opt.StartTime.Unix(), opt.EndTime.Unix()}] {
+			result.Skipped++
+			continue
+		}
+		opt.PollID = poll.ID
+		options = append(options, opt)
+	}
+
+	result.Candidates = make([]gen.PollOptionCandidate, len(options))
+	for i, opt := range options {
+		result.Candidates[i] = gen.PollOptionCandidate{
+			Type:      gen.SlotType(opt.Type),
+			StartTime: opt.StartTime,
+			EndTime:   opt.EndTime,
+		}
+	}
+
+	if req.Add.Value && len(options) > 0 {
+		if err := h.db.Create(&options).Error; err != nil {
+			return nil, err
+		}
+		h.holdPollOptions(ctx, &poll, options)
+		result.Added = mapPollOptionsToGen(options)
+	}
+
+	return result, nil
+}
+
 // DeletePollOption deletes an option from a poll
 func (h *Handler) DeletePollOption(ctx context.Context, params gen.DeletePollOptionParams) error {
 	userID, _ := GetUserID(ctx)
@@ -148,7 +426,14 @@ func (h *Handler) DeletePollOption(ctx context.Context, params gen.DeletePollOpt
 		return err
 	}
 
-	return h.db.Where("id = ? AND poll_id = ?", params.OptionId, params.ID).Delete(&PollOption{}).Error
+	var option PollOption
+	if err := h.db.Where("id = ? AND poll_id = ?", params.OptionId, params.ID).First(&option).Error; err != nil {
+		return err
+	}
+
+	h.releasePollHolds(ctx, &poll, []PollOption{option}, 0)
+
+	return h.db.Delete(&option).Error
 }
 
 // GetPollVotes returns votes for a poll
@@ -169,40 +454,141 @@ func (h *Handler) GetPollVotes(ctx context.Context, params gen.GetPollVotesParam
 	return mapVotesToGen(votes), nil
 }
 
-// PickPollWinner picks the winning option for a poll
-func (h *Handler) PickPollWinner(ctx context.Context, req *gen.PickPollWinnerReq, params gen.PickPollWinnerParams) error {
+// GetPollRanking returns the ranked results of a poll
+func (h *Handler) GetPollRanking(ctx context.Context, params gen.GetPollRankingParams) (*gen.PollResults, error) {
+	userID, _ := GetUserID(ctx)
+
+	var poll Poll
+	if err := h.db.Preload("PollOptions").Where("id = ? AND user_id = ?", params.ID, userID).First(&poll).Error; err != nil {
+		return nil, err
+	}
+
+	ranks, _, err := h.rankPoll(&poll)
+	if err != nil {
+		return nil, err
+	}
+
+	return &gen.PollResults{
+		Tally:               mapOptionRanksToGen(ranks),
+		RecommendedOptionID: optUintID(optionID(recommendedOption(ranks))),
+	}, nil
+}
+
+// optionID returns the ID of option, or nil without an option.
+func optionID(option *PollOption) *uint {
+	if option == nil {
+		return nil
+	}
+	return &option.ID
+}
+
+// PickPollWinner picks the winning option for a poll. The winner is
+// announced with a calendar invitation, so it can only be picked once.
+func (h *Handler) PickPollWinner(ctx context.Context, req *gen.PickPollWinnerReq, params gen.PickPollWinnerParams) (gen.PickPollWinnerRes, error) {
 	userID, _ := GetUserID(ctx)
 
 	var poll Poll
 	if err := h.db.Where("id = ? AND user_id = ?", params.ID, userID).First(&poll).Error; err != nil {
-		return err
+		return nil, err
 	}
 
 	var option PollOption
 	if err := h.db.Where("id = ? AND poll_id = ?", req.OptionID, poll.ID).First(&option).Error; err != nil {
-		return err
+		return nil, err
 	}
 
-	// Close the poll
+	picked, err := h.pickWinner(ctx, &poll, &option)
+	if err != nil {
+		return nil, err
+	}
+	if !picked {
+		return &gen.Error{Message: "A winner was already picked for this poll"}, nil
+	}
+	return &gen.PickPollWinnerOK{}, nil
+}
+
+// pickWinner closes poll with option as winner unless a winner was
+// already picked, then announces it. It reports whether option was picked.
+func (h *Handler) pickWinner(ctx context.Context, poll *Poll, option *PollOption) (bool, error) {
+	result := h.db.Model(&Poll{}).
+		Where("id = ? AND winner_option_id IS NULL", poll.ID).
+		Updates(map[string]any{"status": LinkStatusClosed, "winner_option_id": option.ID})
+	if result.Error != nil {
+		return false, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return false, nil
+	}
 	poll.Status = LinkStatusClosed
-	if err := h.db.Save(&poll).Error; err != nil {
-		return err
+	poll.WinnerOptionID = &option.ID
+
+	h.announceWinner(ctx, poll, option)
+	return true, nil
+}
+
+// pickWinnerIfAllYes picks the winner of a poll with AutoPickWhenAllYes once
+// every invitee has voted and all voters said yes to an option.
+func (h *Handler) pickWinnerIfAllYes(ctx context.Context, poll *Poll) {
+	if !poll.AutoPickWhenAllYes || poll.WinnerOptionID != nil {
+		return
+	}
+
+	var invites, pending int64
+	h.db.Model(&PollInvite{}).Where("poll_id = ?", poll.ID).Count(&invites)
+	h.db.Model(&PollInvite{}).Where("poll_id = ? AND vote_id IS NULL", poll.ID).Count(&pending)
+	if invites == 0 || pending > 0 {
+		return
 	}
 
-	// Get votes for notification
+	if err := h.db.Where("poll_id = ?", poll.ID).Find(&poll.PollOptions).Error; err != nil {
+		log.Printf("[WARN] Failed to load options for poll %d: %v", poll.ID, err)
+		return
+	}
+	ranks, votes, err := h.rankPoll(poll)
+	if err != nil {
+		log.Printf("[WARN] Failed to rank poll %d: %v", poll.ID, err)
+		return
+	}
+	if option := allYesOption(ranks, len(votes)); option != nil {
+		if _, err := h.pickWinner(ctx, poll, option); err != nil {
+			log.Printf("[WARN] Failed to pick winner for poll %d: %v", poll.ID, err)
+		}
+	}
+}
+
+// announceWinner creates the calendar event for the winning option and
+// invites the voters.
+func (h *Handler) announceWinner(ctx context.Context, poll *Poll, option *PollOption) {
+	// Remove the holds of the other options, the winner's hold becomes the event
+	var options []PollOption
+	h.db.Where("poll_id = ?", poll.ID).Find(&options)
+	h.releasePollHolds(ctx, poll, options, option.ID)
+
+	// Get votes for invitations
 	var votes []Vote
 	h.db.Where("poll_id = ?", poll.ID).Find(&votes)
+	attendees := pollAttendees(votes)
 
 	// Get organizer for email
 	var organizer User
 	h.db.First(&organizer, poll.UserID)
 
-	// Send winner notification
-	if h.mailer != nil {
-		_ = h.mailer.SendPollWinner(&poll, &option, votes, &organizer)
+	// Create calendar event with voters as attendees
+	if h.caldav != nil {
+		uid, err := h.caldav.CreatePollEvent(ctx, poll.UserID, poll, option, attendees)
+		if err != nil {
+			log.Printf("[WARN] Failed to create calendar event for poll %d: %v", poll.ID, err)
+		} else {
+			poll.CalendarUID = uid
+			h.db.Model(poll).Update("calendar_uid", uid)
+			h.db.Model(option).Update("hold_uid", "")
+		}
 	}
 
-	return nil
+	// Send winner notification with invitation
+	if h.mailer != nil {
+		_ = h.mailer.SendPollWinner(poll, option, attendees, &organizer)
+	}
 }
 
 // Helper functions
@@ -216,16 +602,46 @@ func mapPollsToGen(polls []Poll) []gen.Poll {
 
 func mapPollToGen(poll *Poll) *gen.Poll {
 	return &gen.Poll{
-		ID:           int(poll.ID),
-		Slug:         poll.Slug,
-		Name:         poll.Name,
-		Description:  gen.NewOptString(poll.Description),
-		Status:       gen.LinkStatus(poll.Status),
-		ShowResults:  gen.NewOptBool(poll.ShowResults),
-		RequireEmail: gen.NewOptBool(poll.RequireEmail),
-		CustomFields: mapCustomFieldsToGen(poll.CustomFields),
-		CreatedAt:    gen.NewOptDateTime(poll.CreatedAt),
+		ID:                 int(poll.ID),
+		Slug:               poll.Slug,
+		Name:               poll.Name,
+		Description:        gen.NewOptString(poll.Description),
+		Status:             gen.LinkStatus(poll.Status),
+		Mode:               gen.NewOptPollMode(gen.PollMode(poll.Mode)),
+		MaxChoices:         gen.NewOptInt(poll.MaxChoices),
+		ShowResults:        gen.NewOptBool(poll.ShowResults),
+		ResultsVisibility:  gen.NewOptResultsVisibility(gen.ResultsVisibility(poll.ResultsVisibility)),
+		ResultsTiming:      gen.NewOptResultsTiming(gen.ResultsTiming(poll.ResultsTiming)),
+		RequireEmail:       gen.NewOptBool(poll.RequireEmail),
+		CustomFields:       mapCustomFieldsToGen(poll.CustomFields),
+		Branding:           mapBrandingToGen(poll.Branding),
+		Deadline:           optDateTime(poll.Deadline),
+		EventTemplate:      mapEventTemplateToGen(poll.EventTemplate),
+		WinnerOptionID:     optUintID(poll.WinnerOptionID),
+		ReminderHours:      gen.NewOptInt(poll.ReminderHours),
+		Scoring:            mapPollScoringToGen(poll),
+		AutoPickOnClose:    gen.NewOptBool(poll.AutoPickOnClose),
+		AutoPickWhenAllYes: gen.NewOptBool(poll.AutoPickWhenAllYes),
+		HoldTimes:          gen.NewOptBool(poll.HoldTimes),
+		OptionProposals:    gen.NewOptProposalMode(gen.ProposalMode(poll.OptionProposals)),
+		CreatedAt:          gen.NewOptDateTime(poll.CreatedAt),
+	}
+}
+
+// optUintID maps an optional ID to the API, omitting unset IDs.
+func optUintID(id *uint) gen.OptInt {
+	if id == nil {
+		return gen.OptInt{}
+	}
+	return gen.NewOptInt(int(*id))
+}
+
+// optDateTime maps an optional time to the API, omitting unset times.
+func optDateTime(t *time.Time) gen.OptDateTime {
+	if t == nil {
+		return gen.OptDateTime{}
 	}
+	return gen.NewOptDateTime(*t)
 }
 
 func mapPollOptionsToGen(options []PollOption) []gen.PollOption {
@@ -238,10 +654,12 @@ func mapPollOptionsToGen(options []PollOption) []gen.PollOption {
 
 func mapPollOptionToGen(opt *PollOption) *gen.PollOption {
 	return &gen.PollOption{
-		ID:        int(opt.ID),
-		Type:      gen.SlotType(opt.Type),
-		StartTime: opt.StartTime,
-		EndTime:   opt.EndTime,
+		ID:         int(opt.ID),
+		Type:       gen.SlotType(opt.Type),
+		StartTime:  opt.StartTime,
+		EndTime:    opt.EndTime,
+		Capacity:   gen.NewOptInt(opt.Capacity),
+		ProposedBy: gen.NewOptString(opt.ProposedBy),
 	}
 }
 
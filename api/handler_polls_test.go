@@ -0,0 +1,221 @@
+package api
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	gen "github.com/kolaente/meet-mesh/api/gen"
+)
+
+func TestSuggestPollOptions(t *testing.T) {
+	h := newTestHandler(t)
+	ctx := WithUserID(context.Background(), 1)
+
+	// Monday
+	start := time.Date(2030, 1, 7, 0, 0, 0, 0, time.UTC)
+
+	poll := Poll{UserID: 1, Slug: "suggest", Name: "Suggest", Status: LinkStatusActive}
+	h.db.Create(&poll)
+	h.db.Create(&PollOption{PollID: poll.ID, Type: SlotTypeTime, StartTime: start.Add(9 * time.Hour), EndTime: start.Add(10 * time.Hour)})
+	h.db.Create(&BookingLink{UserID: 1, Slug: "office-hours", Name: "Office hours", Status: LinkStatusActive, AvailabilityRules: []AvailabilityRule{
+		{DaysOfWeek: []int{1}, StartTime: "09:00", EndTime: "12:00"},
+	}})
+
+	req := &gen.SuggestPollOptionsReq{
+		Start:           start,
+		End:             start.AddDate(0, 0, 7),
+		DurationMinutes: 60,
+	}
+	res, err := h.SuggestPollOptions(ctx, req, gen.SuggestPollOptionsParams{ID: int(poll.ID)})
+	if err != nil {
+		t.Fatalf("SuggestPollOptions failed: %v", err)
+	}
+	ok, isOK := res.(*gen.SuggestPollOptionsOK)
+	if !isOK {
+		t.Fatalf("expected suggestions, got %#v", res)
+	}
+	// 9:00 is already an option
+	if len(ok.Candidates) != 2 || !ok.Candidates[0].StartTime.Equal(start.Add(10*time.Hour)) {
+		t.Fatalf("unexpected candidates: %+v", ok.Candidates)
+	}
+	if len(ok.Added) != 0 {
+		t.Errorf("expected nothing to be added, got %d", len(ok.Added))
+	}
+
+	req.AvailabilityRules = []gen.AvailabilityRule{{DaysOfWeek: []int{1, 2}, StartTime: "14:00", EndTime: "15:00"}}
+	req.Add = gen.NewOptBool(true)
+	res, err = h.SuggestPollOptions(ctx, req, gen.SuggestPollOptionsParams{ID: int(poll.ID)})
+	if err != nil {
+		t.Fatalf("SuggestPollOptions failed: %v", err)
+	}
+	if ok, isOK := res.(*gen.SuggestPollOptionsOK); !isOK || len(ok.Added) != 2 {
+		t.Fatalf("expected 2 options to be added, got %#v", res)
+	}
+	var count int64
+	h.db.Model(&PollOption{}).Where("poll_id = ?", poll.ID).Count(&count)
+	if count != 3 {
+		t.Errorf("expected 3 options, got %d", count)
+	}
+
+	req.End = req.Start
+	res, _ = h.SuggestPollOptions(ctx, req, gen.SuggestPollOptionsParams{ID: int(poll.ID)})
+	if _, isErr := res.(*gen.Error); !isErr {
+		t.Errorf("expected an empty range to be rejected, got %#v", res)
+	}
+}
+
+func TestGeneratePollOptions(t *testing.T) {
+	h := newTestHandler(t)
+	ctx := WithUserID(context.Background(), 1)
+
+	// Monday
+	start := time.Date(2030, 1, 7, 0, 0, 0, 0, time.UTC)
+
+	poll := Poll{UserID: 1, Slug: "generate", Name: "Generate", Status: LinkStatusActive}
+	h.db.Create(&poll)
+	h.db.Create(&PollOption{PollID: poll.ID, Type: SlotTypeTime, StartTime: start.Add(10 * time.Hour), EndTime: start.Add(11 * time.Hour)})
+
+	// Every weekday at 10:00 and 14:00 for two weeks
+	req := &gen.GeneratePollOptionsReq{
+		Type:            gen.SlotType(SlotTypeTime),
+		StartDate:       start,
+		EndDate:         start.AddDate(0, 0, 13),
+		DaysOfWeek:      []int{1, 2, 3, 4, 5},
+		Times:           []string{"14:00", "10:00"},
+		DurationMinutes: gen.NewOptInt(60),
+	}
+	res, err := h.GeneratePollOptions(ctx, req, gen.GeneratePollOptionsParams{ID: int(poll.ID)})
+	if err != nil {
+		t.Fatalf("GeneratePollOptions failed: %v", err)
+	}
+	ok, isOK := res.(*gen.GeneratePollOptionsOK)
+	if !isOK {
+		t.Fatalf("expected generated options, got %#v", res)
+	}
+	// Monday 10:00 is already an option
+	if len(ok.Candidates) != 19 || ok.Skipped != 1 {
+		t.Fatalf("expected 19 candidates and 1 skipped, got %d and %d", len(ok.Candidates), ok.Skipped)
+	}
+	if !ok.Candidates[0].StartTime.Equal(start.Add(14*time.Hour)) || !ok.Candidates[1].StartTime.Equal(start.AddDate(0, 0, 1).Add(10*time.Hour)) {
+		t.Errorf("unexpected order: %+v", ok.Candidates[:2])
+	}
+	var count int64
+	h.db.Model(&PollOption{}).Where("poll_id = ?", poll.ID).Count(&count)
+	if count != 1 {
+		t.Fatalf("preview must not add options, got %d", count)
+	}
+
+	req.Add = gen.NewOptBool(true)
+	if res, err := h.GeneratePollOptions(ctx, req, gen.GeneratePollOptionsParams{ID: int(poll.ID)}); err != nil {
+		t.Fatalf("GeneratePollOptions failed: %v", err)
+	} else if ok, isOK := res.(*gen.GeneratePollOptionsOK); !isOK || len(ok.Added) != 19 {
+		t.Fatalf("expected 19 options to be added, got %#v", res)
+	}
+	h.db.Model(&PollOption{}).Where("poll_id = ?", poll.ID).Count(&count)
+	if count != 20 {
+		t.Errorf("expected 20 options, got %d", count)
+	}
+
+	// Running the pattern again adds nothing
+	res, _ = h.GeneratePollOptions(ctx, req, gen.GeneratePollOptionsParams{ID: int(poll.ID)})
+	if ok, isOK := res.(*gen.GeneratePollOptionsOK); !isOK || len(ok.Added) != 0 || ok.Skipped != 20 {
+		t.Fatalf("expected every option to be skipped, got %#v", res)
+	}
+
+	// Multi-day options on Fridays
+	res, _ = h.GeneratePollOptions(ctx, &gen.GeneratePollOptionsReq{
+		Type:       gen.SlotType(SlotTypeMultiDay),
+		StartDate:  start,
+		EndDate:    start.AddDate(0, 0, 13),
+		DaysOfWeek: []int{5},
+		SpanDays:   gen.NewOptInt(3),
+	}, gen.GeneratePollOptionsParams{ID: int(poll.ID)})
+	ok, isOK = res.(*gen.GeneratePollOptionsOK)
+	if !isOK || len(ok.Candidates) != 2 {
+		t.Fatalf("expected 2 multi-day candidates, got %#v", res)
+	}
+	if want := start.AddDate(0, 0, 7).Add(-time.Second); !ok.Candidates[0].EndTime.Equal(want) {
+		t.Errorf("expected multi-day option to end %v, got %v", want, ok.Candidates[0].EndTime)
+	}
+
+	res, _ = h.GeneratePollOptions(ctx, &gen.GeneratePollOptionsReq{
+		Type:      gen.SlotType(SlotTypeTime),
+		StartDate: start,
+		EndDate:   start.AddDate(0, 0, 1),
+		Times:     []string{"10:00"},
+	}, gen.GeneratePollOptionsParams{ID: int(poll.ID)})
+	if !isError(res) {
+		t.Errorf("expected time options without duration to fail, got %#v", res)
+	}
+}
+
+func TestGeneratePollOptionsTimeZone(t *testing.T) {
+	h := newTestHandler(t)
+	ctx := WithUserID(context.Background(), 1)
+
+	poll := Poll{UserID: 1, Slug: "berlin", Name: "Berlin", Status: LinkStatusActive}
+	h.db.Create(&poll)
+
+	// Daylight saving time starts in Berlin on Sunday, March 31 2030
+	res, err := h.GeneratePollOptions(ctx, &gen.GeneratePollOptionsReq{
+		Type:            gen.SlotType(SlotTypeTime),
+		StartDate:       time.Date(2030, 3, 30, 0, 0, 0, 0, time.UTC),
+		EndDate:         time.Date(2030, 3, 31, 0, 0, 0, 0, time.UTC),
+		Times:           []string{"10:00"},
+		DurationMinutes: gen.NewOptInt(60),
+		TimeZone:        gen.NewOptString("Europe/Berlin"),
+	}, gen.GeneratePollOptionsParams{ID: int(poll.ID)})
+	if err != nil {
+		t.Fatalf("GeneratePollOptions failed: %v", err)
+	}
+	ok, isOK := res.(*gen.GeneratePollOptionsOK)
+	if !isOK || len(ok.Candidates) != 2 {
+		t.Fatalf("expected 2 candidates, got %#v", res)
+	}
+	for i, want := range []time.Time{
+		time.Date(2030, 3, 30, 9, 0, 0, 0, time.UTC),
+		time.Date(2030, 3, 31, 8, 0, 0, 0, time.UTC),
+	} {
+		if !ok.Candidates[i].StartTime.Equal(want) {
+			t.Errorf("expected candidate %d to start at 10:00 Berlin time (%v), got %v", i, want, ok.Candidates[i].StartTime)
+		}
+	}
+
+	res, _ = h.GeneratePollOptions(ctx, &gen.GeneratePollOptionsReq{
+		Type:      gen.SlotType(SlotTypeFullDay),
+		StartDate: time.Date(2030, 3, 30, 0, 0, 0, 0, time.UTC),
+		EndDate:   time.Date(2030, 3, 31, 0, 0, 0, 0, time.UTC),
+		TimeZone:  gen.NewOptString("Mars/Olympus"),
+	}, gen.GeneratePollOptionsParams{ID: int(poll.ID)})
+	if !isError(res) {
+		t.Errorf("expected an unknown time zone to be rejected, got %#v", res)
+	}
+}
+
+func TestPickPollWinnerOnce(t *testing.T) {
+	h := newTestHandler(t)
+	ctx := WithUserID(context.Background(), 1)
+
+	poll := Poll{UserID: 1, Slug: "team", Name: "Team", Status: LinkStatusActive}
+	h.db.Create(&poll)
+	options := []PollOption{{PollID: poll.ID, Type: SlotTypeTime}, {PollID: poll.ID, Type: SlotTypeTime}}
+	h.db.Create(&options)
+
+	res, err := h.PickPollWinner(ctx, &gen.PickPollWinnerReq{OptionID: int(options[0].ID)}, gen.PickPollWinnerParams{ID: int(poll.ID)})
+	if err != nil || isError(res) {
+		t.Fatalf("PickPollWinner failed: %#v %v", res, err)
+	}
+
+	// The first winner was already announced with a calendar invitation
+	res, err = h.PickPollWinner(ctx, &gen.PickPollWinnerReq{OptionID: int(options[1].ID)}, gen.PickPollWinnerParams{ID: int(poll.ID)})
+	if err != nil || !isError(res) {
+		t.Fatalf("expected a second pick to be rejected, got %#v %v", res, err)
+	}
+
+	var got Poll
+	h.db.First(&got, poll.ID)
+	if got.Status != LinkStatusClosed || got.WinnerOptionID == nil || *got.WinnerOptionID != options[0].ID {
+		t.Errorf("expected the first winner to stay, got %+v", got)
+	}
+}
@@ -3,8 +3,6 @@ package api
 
 import (
 	"context"
-	"crypto/rand"
-	"encoding/hex"
 	"time"
 
 	"gorm.io/gorm"
@@ -42,6 +40,7 @@ func (h *Handler) GetPublicBookingLink(ctx context.Context, params gen.GetPublic
 		SlotDurationsMinutes: durations,
 		OrganizerName:        gen.NewOptString(organizer.Name),
 		OrganizerAvatarURL:   gen.NewOptString(avatarURL(organizer.AvatarFilename)),
+		Branding:             mapBrandingToGen(effectiveBranding(&organizer, link.Branding)),
 	}, nil
 }
 
@@ -87,6 +86,7 @@ func (h *Handler) GetBookingAvailability(ctx context.Context, params gen.GetBook
 			busyTimes = nil
 		}
 	}
+	busyTimes = append(busyTimes, h.pollHoldPeriods(link.UserID, params.Start, params.End)...)
 
 	// Generate available slots based on availability rules
 	slots := h.generateAvailableSlotsWithDuration(link, params.Start, params.End, busyTimes, duration)
@@ -99,21 +99,27 @@ func (h *Handler) GetBookingAvailability(ctx context.Context, params gen.GetBook
 
 
 func (h *Handler) generateAvailableSlotsWithDuration(link BookingLink, start, end time.Time, busyTimes []TimePeriod, durationMinutes int) []Slot {
+	return generateAvailableSlots(link.AvailabilityRules, start, end, busyTimes, durationMinutes, link.BufferMinutes)
+}
+
+// generateAvailableSlots returns the free slots of the given duration within
+// the availability rules between start and end.
+func generateAvailableSlots(rules []AvailabilityRule, start, end time.Time, busyTimes []TimePeriod, durationMinutes, bufferMinutes int) []Slot {
 	var slots []Slot
 
 	// If no availability rules, return empty
-	if len(link.AvailabilityRules) == 0 {
+	if len(rules) == 0 {
 		return slots
 	}
 
 	slotDuration := time.Duration(durationMinutes) * time.Minute
-	bufferDuration := time.Duration(link.BufferMinutes) * time.Minute
+	bufferDuration := time.Duration(bufferMinutes) * time.Minute
 
 	// Generate slots for each day in the range
 	for day := start; day.Before(end); day = day.AddDate(0, 0, 1) {
 		weekday := int(day.Weekday())
 
-		for _, rule := range link.AvailabilityRules {
+		for _, rule := range rules {
 			if !containsDay(rule.DaysOfWeek, weekday) {
 				continue
 			}
@@ -195,6 +201,15 @@ func (h *Handler) CreateBooking(ctx context.Context, req *gen.CreateBookingReq,
 		return nil, err
 	}
 
+	customFields, problems := validateCustomFieldAnswers(link.CustomFields, req.CustomFields.Value)
+	if len(problems) > 0 {
+		return customFieldsError(problems), nil
+	}
+	attachments, problems := h.claimAttachments(&link, customFields)
+	if len(problems) > 0 {
+		return customFieldsError(problems), nil
+	}
+
 	// Validate slot duration matches one of the booking link's configurations
 	requestedDuration := req.EndTime.Sub(req.StartTime)
 	requestedMinutes := int(requestedDuration.Minutes())
@@ -241,30 +256,26 @@ func (h *Handler) CreateBooking(ctx context.Context, req *gen.CreateBookingReq,
 		}
 	}
 
+	// Times held for open polls can't be booked
+	if len(h.pollHoldPeriods(link.UserID, req.StartTime, req.EndTime)) > 0 {
+		return &gen.Error{Message: "Slot no longer available"}, nil
+	}
+
 	// Save the slot
 	if err := h.db.Create(&slot).Error; err != nil {
 		return nil, err
 	}
 
 	// Generate action token
-	tokenBytes := make([]byte, 32)
-	_, _ = rand.Read(tokenBytes)
-	actionToken := hex.EncodeToString(tokenBytes)
+	actionToken := generateToken()
 
+	// Bookings that don't match an auto-confirm rule wait for approval
+	autoConfirm, verifyEmail := h.autoConfirmBooking(&link, req.GuestEmail, req.StartTime, req.EndTime)
 	status := BookingStatusPending
-	if link.AutoConfirm {
+	if autoConfirm {
 		status = BookingStatusConfirmed
 	}
 
-	// Convert custom fields
-	var customFields map[string]string
-	if req.CustomFields.Set {
-		customFields = make(map[string]string)
-		for k, v := range req.CustomFields.Value {
-			customFields[k] = v
-		}
-	}
-
 	booking := Booking{
 		BookingLinkID: link.ID,
 		SlotID:        slot.ID,
@@ -274,23 +285,33 @@ func (h *Handler) CreateBooking(ctx context.Context, req *gen.CreateBookingReq,
 		Status:        status,
 		ActionToken:   actionToken,
 	}
+	if verifyEmail {
+		booking.VerifyToken = generateToken()
+	}
+
+	if autoConfirm {
+		h.assignMeetingLink(ctx, &booking, &link)
+	}
 
 	if err := h.db.Create(&booking).Error; err != nil {
 		return nil, err
 	}
+	booking.BookingLink = link
+	booking.Slot = slot
+	h.attachToBooking(attachments, booking.ID)
 
 	// Get organizer for emails
 	var organizer User
 	h.db.First(&organizer, link.UserID)
 
 	// Send notification email and create calendar event if auto-confirmed
-	if link.AutoConfirm {
+	if autoConfirm {
 		if h.mailer != nil {
 			_ = h.mailer.SendBookingConfirmationWithICS(&booking, &link, &organizer)
 		}
 		// Create calendar event
 		if h.caldav != nil {
-			uid, err := h.caldav.CreateBookingEvent(ctx, link.UserID, &booking, &slot, link.EventTemplate, link.MeetingLink)
+			uid, err := h.caldav.CreateBookingEvent(ctx, link.UserID, &booking, &slot, link.EventTemplate, organizerMeetingLink(&booking), h.attachmentNotes(booking.ID))
 			if err == nil && uid != "" {
 				booking.CalendarUID = uid
 				h.db.Save(&booking)
@@ -299,17 +320,33 @@ func (h *Handler) CreateBooking(ctx context.Context, req *gen.CreateBookingReq,
 	} else {
 		if h.mailer != nil {
 			_ = h.mailer.SendBookingPending(&booking, &link, &organizer)
+			if verifyEmail {
+				_ = h.mailer.SendBookingVerification(&booking, &link, &organizer)
+			}
+		}
+		// Hold the slot in the calendar until the organizer reacts
+		if h.caldav != nil {
+			uid, err := h.caldav.CreateBookingHold(ctx, link.UserID, &booking, &slot, link.EventTemplate, h.attachmentNotes(booking.ID))
+			if err == nil && uid != "" {
+				booking.CalendarUID = uid
+				h.db.Save(&booking)
+			}
 		}
 	}
 
+	h.publishBooking(&link, &booking, &slot)
+
 	message := "Booking confirmed"
-	if !link.AutoConfirm {
+	if verifyEmail {
+		message = "Please confirm your email address to complete the booking"
+	} else if !autoConfirm {
 		message = "Booking pending approval"
 	}
 
 	return &gen.CreateBookingCreated{
-		Status:  gen.BookingStatus(status),
-		Message: gen.NewOptString(message),
+		Status:      gen.BookingStatus(status),
+		Message:     gen.NewOptString(message),
+		VerifyEmail: gen.NewOptBool(verifyEmail),
 	}, nil
 }
 
@@ -5,84 +5,303 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"log"
+	"strings"
+	"time"
 
 	"gorm.io/gorm"
 
 	gen "github.com/kolaente/meet-mesh/api/gen"
-	"github.com/ogen-go/ogen/ogenerrors"
 )
 
 // GetPublicPoll returns public poll info
 func (h *Handler) GetPublicPoll(ctx context.Context, params gen.GetPublicPollParams) (gen.GetPublicPollRes, error) {
 	var poll Poll
-	if err := h.db.Preload("PollOptions").Where("slug = ? AND status = ?", params.Slug, LinkStatusActive).First(&poll).Error; err != nil {
+	if err := h.db.Preload("PollOptions").Where("slug = ?", params.Slug).First(&poll).Error; err != nil {
 		if err == gorm.ErrRecordNotFound {
 			return &gen.Error{Message: "Poll not found"}, nil
 		}
 		return nil, err
 	}
 
+	// Closed polls stay readable for their outcome, only votes, comments and
+	// proposals are rejected
+	closed := poll.Status != LinkStatusActive || pollDeadlinePassed(&poll, time.Now())
+
 	// Fetch organizer for public display
 	var organizer User
 	h.db.First(&organizer, poll.UserID)
 
-	return &gen.GetPublicPollOK{
+	result := &gen.GetPublicPollOK{
 		Name:               poll.Name,
 		Description:        gen.NewOptString(poll.Description),
 		CustomFields:       mapCustomFieldsToGen(poll.CustomFields),
 		Options:            mapPollOptionsToGen(poll.PollOptions),
 		ShowResults:        gen.NewOptBool(poll.ShowResults),
+		ResultsVisibility:  gen.NewOptResultsVisibility(gen.ResultsVisibility(poll.ResultsVisibility)),
+		ResultsTiming:      gen.NewOptResultsTiming(gen.ResultsTiming(poll.ResultsTiming)),
 		RequireEmail:       gen.NewOptBool(poll.RequireEmail),
+		Mode:               gen.NewOptPollMode(gen.PollMode(poll.Mode)),
+		MaxChoices:         gen.NewOptInt(poll.MaxChoices),
+		OptionProposals:    gen.NewOptProposalMode(gen.ProposalMode(poll.OptionProposals)),
 		OrganizerName:      gen.NewOptString(organizer.Name),
 		OrganizerAvatarURL: gen.NewOptString(avatarURL(organizer.AvatarFilename)),
-	}, nil
+		Branding:           mapBrandingToGen(effectiveBranding(&organizer, poll.Branding)),
+		Deadline:           optDateTime(poll.Deadline),
+		Closed:             gen.NewOptBool(closed),
+		WinnerOptionID:     optUintID(poll.WinnerOptionID),
+	}
+
+	// Full options of sign-up sheets are shown as unavailable
+	if poll.Mode == PollModeSignup {
+		var votes []Vote
+		if err := h.db.Where("poll_id = ?", poll.ID).Find(&votes).Error; err != nil {
+			return nil, err
+		}
+		applySignupCounts(result.Options, poll.PollOptions, votes)
+	}
+
+	// Personal invite links identify the participant
+	if params.Invite.Value != "" {
+		var invite PollInvite
+		if err := h.db.Where("poll_id = ? AND token = ?", poll.ID, params.Invite.Value).First(&invite).Error; err == nil {
+			invitee := gen.GetPublicPollOKInvitee{
+				Email: invite.Email,
+				Name:  gen.NewOptString(invite.Name),
+			}
+			var vote Vote
+			if invite.VoteID != nil && h.db.First(&vote, *invite.VoteID).Error == nil {
+				invitee.EditToken = gen.NewOptString(vote.EditToken)
+			}
+			result.Invitee = gen.NewOptGetPublicPollOKInvitee(invitee)
+		}
+	}
+
+	return result, nil
 }
 
 // SubmitVote submits a poll vote
-func (h *Handler) SubmitVote(ctx context.Context, req *gen.SubmitVoteReq, params gen.SubmitVoteParams) (*gen.Vote, error) {
+func (h *Handler) SubmitVote(ctx context.Context, req *gen.SubmitVoteReq, params gen.SubmitVoteParams) (gen.SubmitVoteRes, error) {
 	var poll Poll
-	if err := h.db.Where("slug = ? AND status = ?", params.Slug, LinkStatusActive).First(&poll).Error; err != nil {
+	if err := h.db.Where("slug = ?", params.Slug).First(&poll).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return &gen.Error{Message: "Poll not found"}, nil
+		}
 		return nil, err
 	}
 
-	if poll.RequireEmail && req.GuestEmail.Value == "" {
-		return nil, &ogenerrors.DecodeBodyError{
-			ContentType: "application/json",
-			Body:        nil,
-			Err:         errors.New("email required"),
+	if poll.Status != LinkStatusActive || pollDeadlinePassed(&poll, time.Now()) {
+		return &gen.Error{Message: "Voting for this poll has closed"}, nil
+	}
+
+	guestEmail := strings.TrimSpace(req.GuestEmail.Value)
+	guestName := req.GuestName.Value
+
+	var invite *PollInvite
+	if req.InviteToken.Value != "" {
+		invite = &PollInvite{}
+		if err := h.db.Where("poll_id = ? AND token = ?", poll.ID, req.InviteToken.Value).First(invite).Error; err != nil {
+			return &gen.Error{Message: "Invalid invite link"}, nil
+		}
+		if invite.VoteID != nil {
+			var existing Vote
+			if err := h.db.First(&existing, *invite.VoteID).Error; err == nil {
+				h.sendVoteConfirmation(&poll, &existing)
+				return &gen.Error{Message: "You already voted in this poll. Use the link in your confirmation email to change your vote."}, nil
+			}
+		}
+		if guestEmail == "" {
+			guestEmail = invite.Email
+		}
+		if guestName == "" {
+			guestName = invite.Name
 		}
 	}
 
-	// Convert responses - note that the map keys are strings in the API
-	responses := make(map[uint]VoteResponseType)
-	for optionIDStr, resp := range req.Responses {
-		var optionID uint
-		_, _ = fmt.Sscanf(optionIDStr, "%d", &optionID)
-		responses[optionID] = VoteResponseType(resp)
+	if poll.RequireEmail && guestEmail == "" {
+		return &gen.Error{Message: "Email required"}, nil
 	}
 
-	// Convert custom fields
-	var customFields map[string]string
-	if req.CustomFields.Set {
-		customFields = make(map[string]string)
-		for k, v := range req.CustomFields.Value {
-			customFields[k] = v
+	// Polls that require an email accept one vote per address. Changes go
+	// through the edit link, which is sent again in case it got lost.
+	if poll.RequireEmail {
+		var existing Vote
+		if err := h.db.Where("poll_id = ? AND LOWER(guest_email) = LOWER(?)", poll.ID, guestEmail).First(&existing).Error; err == nil {
+			h.sendVoteConfirmation(&poll, &existing)
+			return &gen.Error{Message: "You already voted in this poll. Use the link in your confirmation email to change your vote."}, nil
 		}
 	}
 
+	customFields, problems := validateCustomFieldAnswers(poll.CustomFields, req.CustomFields.Value)
+	if len(problems) > 0 {
+		return customFieldsError(problems), nil
+	}
+
 	vote := Vote{
 		PollID:       poll.ID,
-		GuestEmail:   req.GuestEmail.Value,
-		GuestName:    req.GuestName.Value,
-		Responses:    responses,
+		GuestEmail:   guestEmail,
+		GuestName:    guestName,
+		Responses:    mapVoteResponsesFromGen(req.Responses),
 		CustomFields: customFields,
+		EditToken:    generateToken(),
+	}
+
+	if poll.Mode == PollModeSignup {
+		rejected, err := h.saveSignupVote(&poll, &vote)
+		if err != nil {
+			return nil, err
+		}
+		if rejected != "" {
+			return &gen.Error{Message: rejected}, nil
+		}
+	} else if err := h.db.Create(&vote).Error; err != nil {
+		return nil, err
+	}
+
+	h.markInviteVoted(&vote, invite)
+	h.sendVoteConfirmation(&poll, &vote)
+	h.publishVote(&poll, &vote)
+	h.pickWinnerIfAllYes(ctx, &poll)
+
+	return mapOwnVoteToGen(&vote), nil
+}
+
+// GetOwnVote returns the vote belonging to an edit token
+func (h *Handler) GetOwnVote(ctx context.Context, params gen.GetOwnVoteParams) (gen.GetOwnVoteRes, error) {
+	_, vote, err := h.findOwnVote(params.Slug, params.Token)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return &gen.Error{Message: "Vote not found"}, nil
+		}
+		return nil, err
+	}
+
+	return mapOwnVoteToGen(vote), nil
+}
+
+// UpdateOwnVote replaces the responses of the vote belonging to an edit token
+func (h *Handler) UpdateOwnVote(ctx context.Context, req *gen.UpdateOwnVoteReq, params gen.UpdateOwnVoteParams) (gen.UpdateOwnVoteRes, error) {
+	poll, vote, err := h.findOwnVote(params.Slug, params.Token)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return &gen.Error{Message: "Vote not found"}, nil
+		}
+		return nil, err
+	}
+
+	if poll.Status != LinkStatusActive || pollDeadlinePassed(poll, time.Now()) {
+		return &gen.Error{Message: "Voting for this poll has closed"}, nil
+	}
+
+	if req.GuestName.Set {
+		vote.GuestName = req.GuestName.Value
+	}
+	vote.Responses = mapVoteResponsesFromGen(req.Responses)
+	if req.CustomFields.Set {
+		customFields, problems := validateCustomFieldAnswers(poll.CustomFields, req.CustomFields.Value)
+		if len(problems) > 0 {
+			return customFieldsError(problems), nil
+		}
+		vote.CustomFields = customFields
 	}
 
-	if err := h.db.Create(&vote).Error; err != nil {
+	if poll.Mode == PollModeSignup {
+		rejected, err := h.saveSignupVote(poll, vote)
+		if err != nil {
+			return nil, err
+		}
+		if rejected != "" {
+			return &gen.Error{Message: rejected}, nil
+		}
+	} else if err := h.db.Save(vote).Error; err != nil {
+		return nil, err
+	}
+
+	h.publishVote(poll, vote)
+	h.pickWinnerIfAllYes(ctx, poll)
+
+	return mapOwnVoteToGen(vote), nil
+}
+
+// DeleteOwnVote deletes the vote belonging to an edit token
+func (h *Handler) DeleteOwnVote(ctx context.Context, params gen.DeleteOwnVoteParams) (gen.DeleteOwnVoteRes, error) {
+	poll, vote, err := h.findOwnVote(params.Slug, params.Token)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return &gen.Error{Message: "Vote not found"}, nil
+		}
+		return nil, err
+	}
+
+	if poll.Status != LinkStatusActive || pollDeadlinePassed(poll, time.Now()) {
+		return &gen.Error{Message: "Voting for this poll has closed"}, nil
+	}
+
+	if err := h.db.Delete(vote).Error; err != nil {
 		return nil, err
 	}
 
-	return mapVoteToGen(&vote), nil
+	// The invitee hasn't voted anymore and gets reminders again
+	h.db.Model(&PollInvite{}).Where("vote_id = ?", vote.ID).
+		Updates(map[string]any{"vote_id": nil, "voted_at": nil})
+
+	return &gen.DeleteOwnVoteNoContent{}, nil
+}
+
+// findOwnVote loads the poll and the vote with the given edit token.
+func (h *Handler) findOwnVote(slug, token string) (*Poll, *Vote, error) {
+	var poll Poll
+	if err := h.db.Where("slug = ?", slug).First(&poll).Error; err != nil {
+		return nil, nil, err
+	}
+
+	var vote Vote
+	if token == "" {
+		return nil, nil, gorm.ErrRecordNotFound
+	}
+	if err := h.db.Where("poll_id = ? AND edit_token = ?", poll.ID, token).First(&vote).Error; err != nil {
+		return nil, nil, err
+	}
+
+	return &poll, &vote, nil
+}
+
+// sendVoteConfirmation emails the voter a link to edit their vote.
+func (h *Handler) sendVoteConfirmation(poll *Poll, vote *Vote) {
+	if h.mailer == nil || vote.GuestEmail == "" {
+		return
+	}
+
+	var organizer User
+	h.db.First(&organizer, poll.UserID)
+
+	if err := h.mailer.SendVoteConfirmation(poll, vote, &organizer); err != nil {
+		log.Printf("[WARN] Failed to send vote confirmation for vote %d: %v", vote.ID, err)
+	}
+}
+
+// mapVoteResponsesFromGen converts responses keyed by option ID strings.
+func mapVoteResponsesFromGen(responses map[string]gen.VoteResponse) map[uint]VoteResponseType {
+	result := make(map[uint]VoteResponseType)
+	for optionIDStr, resp := range responses {
+		var optionID uint
+		_, _ = fmt.Sscanf(optionIDStr, "%d", &optionID)
+		result[optionID] = VoteResponseType(resp)
+	}
+	return result
+}
+
+// mapOwnVoteToGen maps a vote including its edit token, for the voter only.
+func mapOwnVoteToGen(v *Vote) *gen.Vote {
+	result := mapVoteToGen(v)
+	result.EditToken = gen.NewOptString(v.EditToken)
+	return result
+}
+
+// pollDeadlinePassed reports whether the voting deadline of poll is over.
+// The poll may still be active until the closing job has picked it up.
+func pollDeadlinePassed(poll *Poll, now time.Time) bool {
+	return poll.Deadline != nil && !now.Before(*poll.Deadline)
 }
 
 // GetPollResults returns poll results
@@ -96,49 +315,56 @@ func (h *Handler) GetPollResults(ctx context.Context, params gen.GetPollResultsP
 		return &gen.Error{Message: "Results not public"}, nil
 	}
 
-	var votes []Vote
-	if err := h.db.Where("poll_id = ?", poll.ID).Find(&votes).Error; err != nil {
-		return nil, err
+	closed := poll.Status != LinkStatusActive || pollDeadlinePassed(&poll, time.Now())
+	switch poll.ResultsTiming {
+	case ResultsTimingAfterClose:
+		if !closed {
+			return &gen.Error{Message: "Results are available once the poll closes"}, nil
+		}
+	case ResultsTimingAfterVote:
+		if !closed && !h.hasVoted(&poll, params.Token.Value) {
+			return &gen.Error{Message: "Results are available after voting"}, nil
+		}
 	}
 
-	// Calculate tally
-	tally := calculatePollTally(poll.PollOptions, votes)
+	ranks, votes, err := h.rankPoll(&poll)
+	if err != nil {
+		return nil, err
+	}
 
 	return &gen.GetPollResultsOK{
-		Tally: tally,
-		Votes: mapVotesToGen(votes),
+		Tally:               mapOptionRanksToGen(ranks),
+		RecommendedOptionID: optUintID(optionID(recommendedOption(ranks))),
+		Votes:               mapPublicVotesToGen(poll.ResultsVisibility, votes),
 	}, nil
 }
 
-func calculatePollTally(options []PollOption, votes []Vote) []gen.VoteTally {
-	tally := make(map[uint]*gen.VoteTally)
-	for _, opt := range options {
-		tally[opt.ID] = &gen.VoteTally{
-			OptionID:   int(opt.ID),
-			YesCount:   0,
-			NoCount:    0,
-			MaybeCount: 0,
-		}
-	}
-
-	for _, vote := range votes {
-		for optionID, response := range vote.Responses {
-			if t, ok := tally[optionID]; ok {
-				switch response {
-				case VoteResponseYes:
-					t.YesCount++
-				case VoteResponseNo:
-					t.NoCount++
-				case VoteResponseMaybe:
-					t.MaybeCount++
-				}
-			}
-		}
+// hasVoted reports whether token is the edit token of a vote in poll.
+func (h *Handler) hasVoted(poll *Poll, token string) bool {
+	if token == "" {
+		return false
 	}
+	var count int64
+	h.db.Model(&Vote{}).Where("poll_id = ? AND edit_token = ?", poll.ID, token).Count(&count)
+	return count > 0
+}
 
-	result := make([]gen.VoteTally, 0, len(tally))
-	for _, t := range tally {
-		result = append(result, *t)
+// mapPublicVotesToGen maps votes for public results, leaving out what the
+// visibility of the poll doesn't allow.
+func mapPublicVotesToGen(visibility ResultsVisibility, votes []Vote) []gen.Vote {
+	switch visibility {
+	case ResultsVisibilityFull:
+		return mapVotesToGen(votes)
+	case ResultsVisibilityNames:
+		result := make([]gen.Vote, len(votes))
+		for i, v := range votes {
+			vote := mapVoteToGen(&v)
+			vote.GuestEmail = gen.OptString{}
+			vote.CustomFields = gen.OptVoteCustomFields{}
+			result[i] = *vote
+		}
+		return result
+	default:
+		return nil
 	}
-	return result
 }
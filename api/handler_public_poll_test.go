@@ -0,0 +1,171 @@
+package api
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	gen "github.com/kolaente/meet-mesh/api/gen"
+)
+
+func newTestHandler(t *testing.T) *Handler {
+	t.Helper()
+	db := openTestDatabase(t)
+	if err := MigrateUp(db); err != nil {
+		t.Fatalf("MigrateUp failed: %v", err)
+	}
+	return NewHandler(db, nil, nil, nil, NewMeetingService(&MeetingsConfig{}), nil, nil, &Config{})
+}
+
+func TestEditOwnVote(t *testing.T) {
+	h := newTestHandler(t)
+	ctx := context.Background()
+
+	poll := Poll{UserID: 1, Slug: "team", Name: "Team", Status: LinkStatusActive, RequireEmail: true}
+	h.db.Create(&poll)
+	option := PollOption{PollID: poll.ID, Type: SlotTypeTime}
+	h.db.Create(&option)
+	optionKey := "1"
+
+	res, err := h.SubmitVote(ctx, &gen.SubmitVoteReq{
+		GuestEmail: gen.NewOptString("alice@example.com"),
+		Responses:  gen.SubmitVoteReqResponses{optionKey: gen.VoteResponse(VoteResponseNo)},
+	}, gen.SubmitVoteParams{Slug: poll.Slug})
+	if err != nil {
+		t.Fatalf("SubmitVote failed: %v", err)
+	}
+	vote, ok := res.(*gen.Vote)
+	if !ok || vote.EditToken.Value == "" {
+		t.Fatalf("expected vote with edit token, got %#v", res)
+	}
+
+	// A second vote with the same address is rejected
+	res, _ = h.SubmitVote(ctx, &gen.SubmitVoteReq{
+		GuestEmail: gen.NewOptString("Alice@Example.com"),
+		Responses:  gen.SubmitVoteReqResponses{optionKey: gen.VoteResponse(VoteResponseYes)},
+	}, gen.SubmitVoteParams{Slug: poll.Slug})
+	if _, ok := res.(*gen.Error); !ok {
+		t.Fatalf("expected duplicate vote to be rejected, got %#v", res)
+	}
+
+	updated, err := h.UpdateOwnVote(ctx, &gen.UpdateOwnVoteReq{
+		Responses: gen.UpdateOwnVoteReqResponses{optionKey: gen.VoteResponse(VoteResponseYes)},
+	}, gen.UpdateOwnVoteParams{Slug: poll.Slug, Token: vote.EditToken.Value})
+	if err != nil {
+		t.Fatalf("UpdateOwnVote failed: %v", err)
+	}
+	if v, ok := updated.(*gen.Vote); !ok || v.Responses[optionKey] != gen.VoteResponse(VoteResponseYes) {
+		t.Fatalf("expected updated response, got %#v", updated)
+	}
+
+	var count int64
+	h.db.Model(&Vote{}).Where("poll_id = ?", poll.ID).Count(&count)
+	if count != 1 {
+		t.Errorf("expected 1 vote, got %d", count)
+	}
+
+	if res, _ := h.GetOwnVote(ctx, gen.GetOwnVoteParams{Slug: poll.Slug, Token: "wrong"}); res == nil {
+		t.Error("expected a response for unknown token")
+	} else if _, ok := res.(*gen.Error); !ok {
+		t.Errorf("expected error for unknown token, got %#v", res)
+	}
+
+	if _, err := h.DeleteOwnVote(ctx, gen.DeleteOwnVoteParams{Slug: poll.Slug, Token: vote.EditToken.Value}); err != nil {
+		t.Fatalf("DeleteOwnVote failed: %v", err)
+	}
+	h.db.Model(&Vote{}).Where("poll_id = ?", poll.ID).Count(&count)
+	if count != 0 {
+		t.Errorf("expected vote to be deleted, %d left", count)
+	}
+}
+
+func TestGetPublicPollClosed(t *testing.T) {
+	h := newTestHandler(t)
+	ctx := context.Background()
+
+	past := time.Now().Add(-time.Hour).UTC()
+	polls := []Poll{
+		{UserID: 1, Slug: "expired", Name: "Expired", Status: LinkStatusActive, Deadline: &past},
+		{UserID: 1, Slug: "closed", Name: "Closed", Status: LinkStatusClosed},
+	}
+	h.db.Create(&polls)
+	winner := PollOption{PollID: polls[0].ID, Type: SlotTypeTime}
+	h.db.Create(&winner)
+	h.db.Model(&polls[0]).Update("winner_option_id", winner.ID)
+
+	for _, poll := range polls {
+		res, err := h.GetPublicPoll(ctx, gen.GetPublicPollParams{Slug: poll.Slug})
+		if err != nil {
+			t.Fatalf("GetPublicPoll failed: %v", err)
+		}
+		got, ok := res.(*gen.GetPublicPollOK)
+		if !ok || !got.Closed.Value {
+			t.Fatalf("%s: expected the poll to be shown as closed, got %#v", poll.Slug, res)
+		}
+
+		res2, err := h.SubmitVote(ctx, &gen.SubmitVoteReq{
+			Responses: gen.SubmitVoteReqResponses{"1": gen.VoteResponse(VoteResponseYes)},
+		}, gen.SubmitVoteParams{Slug: poll.Slug})
+		if err != nil || !isError(res2) {
+			t.Errorf("%s: expected votes to be rejected, got %#v %v", poll.Slug, res2, err)
+		}
+	}
+
+	res, _ := h.GetPublicPoll(ctx, gen.GetPublicPollParams{Slug: "expired"})
+	if got := res.(*gen.GetPublicPollOK); got.WinnerOptionID.Value != int(winner.ID) {
+		t.Errorf("expected the winner to be shown, got %#v", got.WinnerOptionID)
+	}
+}
+
+func TestPollResultsVisibility(t *testing.T) {
+	h := newTestHandler(t)
+	ctx := context.Background()
+
+	poll := Poll{UserID: 1, Slug: "results", Name: "Results", Status: LinkStatusActive, ShowResults: true}
+	h.db.Create(&poll)
+	h.db.Create(&Vote{PollID: poll.ID, GuestName: "Alice", GuestEmail: "alice@example.com", EditToken: "alice",
+		Responses: map[uint]VoteResponseType{}, CustomFields: map[string]string{"phone": "123"}})
+
+	results := func(token string) gen.GetPollResultsRes {
+		t.Helper()
+		res, err := h.GetPollResults(ctx, gen.GetPollResultsParams{Slug: poll.Slug, Token: gen.NewOptString(token)})
+		if err != nil {
+			t.Fatalf("GetPollResults failed: %v", err)
+		}
+		return res
+	}
+
+	// Names without emails by default
+	h.db.First(&poll, poll.ID)
+	res, ok := results("").(*gen.GetPollResultsOK)
+	if !ok || len(res.Votes) != 1 || res.Votes[0].GuestName.Value != "Alice" || res.Votes[0].GuestEmail.Set || res.Votes[0].CustomFields.Set {
+		t.Errorf("expected names only, got %#v", res)
+	}
+
+	h.db.Model(&poll).Update("results_visibility", ResultsVisibilityAggregate)
+	if res, ok := results("").(*gen.GetPollResultsOK); !ok || res.Votes != nil {
+		t.Errorf("expected aggregate results only, got %#v", res)
+	}
+
+	h.db.Model(&poll).Update("results_visibility", ResultsVisibilityFull)
+	if res, ok := results("").(*gen.GetPollResultsOK); !ok || res.Votes[0].GuestEmail.Value != "alice@example.com" {
+		t.Errorf("expected full results, got %#v", res)
+	}
+
+	h.db.Model(&poll).Update("results_timing", ResultsTimingAfterVote)
+	if _, ok := results("").(*gen.Error); !ok {
+		t.Error("expected results to be hidden before voting")
+	}
+	if _, ok := results("alice").(*gen.GetPollResultsOK); !ok {
+		t.Error("expected results to be shown to a voter")
+	}
+
+	h.db.Model(&poll).Update("results_timing", ResultsTimingAfterClose)
+	if _, ok := results("alice").(*gen.Error); !ok {
+		t.Error("expected results to be hidden while the poll is open")
+	}
+	h.db.Model(&poll).Update("status", LinkStatusClosed)
+	if _, ok := results("").(*gen.GetPollResultsOK); !ok {
+		t.Error("expected results to be shown after the poll closed")
+	}
+}
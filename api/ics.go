@@ -10,7 +10,7 @@ import (
 )
 
 // GenerateICSData creates an ICS calendar file for a booking
-func GenerateICSData(booking *Booking, slot *Slot, template *EventTemplate, organizerEmail string) (string, error) {
+func GenerateICSData(booking *Booking, slot *Slot, template *EventTemplate, organizer *User) (string, error) {
 	cal := ical.NewCalendar()
 	cal.Props.SetText(ical.PropProductID, "-//Meet Mesh//EN")
 	cal.Props.SetText(ical.PropVersion, "2.0")
@@ -27,26 +27,26 @@ func GenerateICSData(booking *Booking, slot *Slot, template *EventTemplate, orga
 	event.Props.SetDateTime(ical.PropDateTimeStart, slot.StartTime.UTC())
 	event.Props.SetDateTime(ical.PropDateTimeEnd, slot.EndTime.UTC())
 
-	// Title
-	title := "Meeting"
-	if template != nil && template.TitleTemplate != "" {
-		title = expandTemplateICS(template.TitleTemplate, booking)
-	}
+	// Title and description
+	title, description := renderEventTemplate(template, newBookingTemplateData(booking, slot, organizer))
 	event.Props.SetText(ical.PropSummary, title)
-
-	// Description (optional)
-	if template != nil && template.DescriptionTemplate != "" {
-		event.Props.SetText(ical.PropDescription, expandTemplateICS(template.DescriptionTemplate, booking))
+	if description != "" {
+		event.Props.SetText(ical.PropDescription, description)
 	}
 
-	// Location (optional)
+	// Location (optional), falling back to the meeting link
 	if template != nil && template.Location != "" {
 		event.Props.SetText(ical.PropLocation, template.Location)
+	} else if meetingLink := bookingMeetingLink(booking, &booking.BookingLink); meetingLink != "" {
+		event.Props.SetText(ical.PropLocation, meetingLink)
 	}
 
 	// Organizer
 	organizerProp := ical.NewProp(ical.PropOrganizer)
-	organizerProp.Value = "mailto:" + organizerEmail
+	organizerProp.Value = "mailto:" + organizer.Email
+	if organizer.Name != "" {
+		organizerProp.Params.Set(ical.ParamCommonName, organizer.Name)
+	}
 	event.Props.Set(organizerProp)
 
 	// Attendee (guest)
@@ -69,12 +69,163 @@ func GenerateICSData(booking *Booking, slot *Slot, template *EventTemplate, orga
 	return buf.String(), nil
 }
 
-// expandTemplateICS expands template variables (same as caldav.go expandTemplate)
-func expandTemplateICS(template string, booking *Booking) string {
-	result := template
-	if booking != nil {
-		result = strings.ReplaceAll(result, "{{guest_name}}", booking.GuestName)
-		result = strings.ReplaceAll(result, "{{guest_email}}", booking.GuestEmail)
+// pollAttendees returns the voters that gave an email address, one per address.
+func pollAttendees(votes []Vote) []Vote {
+	seen := make(map[string]bool)
+	var attendees []Vote
+	for _, vote := range votes {
+		email := strings.ToLower(strings.TrimSpace(vote.GuestEmail))
+		if email == "" || seen[email] {
+			continue
+		}
+		seen[email] = true
+		attendees = append(attendees, vote)
 	}
-	return result
+	return attendees
+}
+
+// setEventTime sets the start and end of event. Full-day and multi-day
+// options become all-day events with an exclusive end date.
+func setEventTime(event *ical.Event, slotType SlotType, start, end time.Time) {
+	if slotType == SlotTypeTime {
+		event.Props.SetDateTime(ical.PropDateTimeStart, start.UTC())
+		event.Props.SetDateTime(ical.PropDateTimeEnd, end.UTC())
+		return
+	}
+
+	startDate := time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, time.UTC)
+	endDate := time.Date(end.Year(), end.Month(), end.Day(), 0, 0, 0, 0, time.UTC)
+	if !endDate.After(startDate) || !end.Equal(endDate) {
+		endDate = endDate.AddDate(0, 0, 1)
+	}
+	event.Props.SetDate(ical.PropDateTimeStart, startDate)
+	event.Props.SetDate(ical.PropDateTimeEnd, endDate)
+}
+
+// newPollEventCalendar builds the event for the winning option of a poll,
+// with all attendees invited.
+func newPollEventCalendar(uid string, poll *Poll, option *PollOption, attendees []Vote, organizer *User) *ical.Calendar {
+	cal := ical.NewCalendar()
+	cal.Props.SetText(ical.PropProductID, "-//Meet Mesh//EN")
+	cal.Props.SetText(ical.PropVersion, "2.0")
+
+	event := ical.NewEvent()
+	event.Props.SetText(ical.PropUID, uid)
+	event.Props.SetDateTime(ical.PropDateTimeStamp, time.Now().UTC())
+	setEventTime(event, option.Type, option.StartTime, option.EndTime)
+
+	event.SetStatus(ical.EventConfirmed)
+
+	title, description := renderEventTemplate(poll.EventTemplate, newPollTemplateData(poll, option, organizer))
+	if poll.EventTemplate == nil || poll.EventTemplate.TitleTemplate == "" {
+		title = poll.Name
+	}
+	event.Props.SetText(ical.PropSummary, title)
+	if description != "" {
+		event.Props.SetText(ical.PropDescription, description)
+	}
+	if poll.EventTemplate != nil && poll.EventTemplate.Location != "" {
+		event.Props.SetText(ical.PropLocation, poll.EventTemplate.Location)
+	}
+
+	organizerProp := ical.NewProp(ical.PropOrganizer)
+	organizerProp.Value = "mailto:" + organizer.Email
+	if organizer.Name != "" {
+		organizerProp.Params.Set(ical.ParamCommonName, organizer.Name)
+	}
+	event.Props.Set(organizerProp)
+
+	for _, attendee := range attendees {
+		attendeeProp := ical.NewProp(ical.PropAttendee)
+		attendeeProp.Value = "mailto:" + attendee.GuestEmail
+		if attendee.GuestName != "" {
+			attendeeProp.Params.Set(ical.ParamCommonName, attendee.GuestName)
+		}
+		attendeeProp.Params.Set(ical.ParamRole, "REQ-PARTICIPANT")
+		attendeeProp.Params.Set(ical.ParamRSVP, "TRUE")
+		event.Props.Add(attendeeProp)
+	}
+
+	cal.Children = append(cal.Children, event.Component)
+	return cal
+}
+
+// newPollHoldCalendar builds a tentative event that reserves an option of an
+// open poll in the organizer's calendar.
+func newPollHoldCalendar(uid string, poll *Poll, option *PollOption) *ical.Calendar {
+	cal := ical.NewCalendar()
+	cal.Props.SetText(ical.PropProductID, "-//Meet Mesh//EN")
+	cal.Props.SetText(ical.PropVersion, "2.0")
+
+	event := ical.NewEvent()
+	event.Props.SetText(ical.PropUID, uid)
+	event.Props.SetDateTime(ical.PropDateTimeStamp, time.Now().UTC())
+	setEventTime(event, option.Type, option.StartTime, option.EndTime)
+	event.SetStatus(ical.EventTentative)
+	event.Props.SetText(ical.PropTransparency, "OPAQUE")
+	event.Props.SetText(ical.PropSummary, "Hold: "+poll.Name)
+	event.Props.SetText(ical.PropDescription, "Reserved while the poll is open. This hold is removed once a date is picked.")
+
+	cal.Children = append(cal.Children, event.Component)
+	return cal
+}
+
+// newBookingEventCalendar builds the organizer's calendar event for a
+// booking. Tentative events hold the slot of a booking that waits for
+// approval and are marked as pending.
+func newBookingEventCalendar(uid string, booking *Booking, slot *Slot, template *EventTemplate, organizer *User, meetingLink, notes string, status ical.EventStatus) *ical.Calendar {
+	cal := ical.NewCalendar()
+	cal.Props.SetText(ical.PropProductID, "-//Meet Mesh//EN")
+	cal.Props.SetText(ical.PropVersion, "2.0")
+
+	event := ical.NewEvent()
+	event.Props.SetText(ical.PropUID, uid)
+	event.Props.SetDateTime(ical.PropDateTimeStamp, time.Now().UTC())
+	event.Props.SetDateTime(ical.PropDateTimeStart, slot.StartTime)
+	event.Props.SetDateTime(ical.PropDateTimeEnd, slot.EndTime)
+	event.SetStatus(status)
+
+	data := newBookingTemplateData(booking, slot, organizer)
+	data.MeetingLink = meetingLink
+	title, description := renderEventTemplate(template, data)
+	if status == ical.EventTentative {
+		title = "Pending: " + title
+		notes = strings.TrimSpace("Waiting for your approval.\n\n" + notes)
+	}
+	if notes != "" {
+		if description != "" {
+			description += "\n\n"
+		}
+		description += notes
+	}
+	event.Props.SetText(ical.PropSummary, title)
+	if description != "" {
+		event.Props.SetText(ical.PropDescription, description)
+	}
+
+	// Use meeting link as location if provided and no location set in template
+	if meetingLink != "" {
+		if template == nil || template.Location == "" {
+			event.Props.SetText(ical.PropLocation, meetingLink)
+		} else {
+			event.Props.SetText(ical.PropLocation, template.Location)
+		}
+	} else if template != nil && template.Location != "" {
+		event.Props.SetText(ical.PropLocation, template.Location)
+	}
+
+	cal.Children = append(cal.Children, event.Component)
+	return cal
+}
+
+// GeneratePollICSData creates the ICS invitation for the winning option of a poll.
+func GeneratePollICSData(uid string, poll *Poll, option *PollOption, attendees []Vote, organizer *User) (string, error) {
+	cal := newPollEventCalendar(uid, poll, option, attendees, organizer)
+	cal.Props.SetText(ical.PropMethod, "REQUEST")
+
+	var buf bytes.Buffer
+	if err := ical.NewEncoder(&buf).Encode(cal); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
 }
@@ -1,9 +1,12 @@
 package api
 
 import (
+	"bytes"
 	"strings"
 	"testing"
 	"time"
+
+	"github.com/emersion/go-ical"
 )
 
 func TestGenerateICSData(t *testing.T) {
@@ -20,9 +23,9 @@ func TestGenerateICSData(t *testing.T) {
 		DescriptionTemplate: "Booking with {{guest_email}}",
 		Location:            "Conference Room A",
 	}
-	organizerEmail := "organizer@example.com"
+	organizer := &User{Email: "organizer@example.com"}
 
-	icsData, err := GenerateICSData(booking, slot, template, organizerEmail)
+	icsData, err := GenerateICSData(booking, slot, template, organizer)
 	if err != nil {
 		t.Fatalf("GenerateICSData failed: %v", err)
 	}
@@ -59,10 +62,10 @@ func TestGenerateICSData_MinimalTemplate(t *testing.T) {
 		StartTime: time.Date(2026, 2, 15, 14, 0, 0, 0, time.UTC),
 		EndTime:   time.Date(2026, 2, 15, 15, 0, 0, 0, time.UTC),
 	}
-	organizerEmail := "organizer@example.com"
+	organizer := &User{Email: "organizer@example.com"}
 
 	// nil template should use defaults
-	icsData, err := GenerateICSData(booking, slot, nil, organizerEmail)
+	icsData, err := GenerateICSData(booking, slot, nil, organizer)
 	if err != nil {
 		t.Fatalf("GenerateICSData failed: %v", err)
 	}
@@ -71,3 +74,94 @@ func TestGenerateICSData_MinimalTemplate(t *testing.T) {
 		t.Errorf("Expected default summary 'Meeting', got: %s", icsData)
 	}
 }
+
+func TestGeneratePollICSData(t *testing.T) {
+	poll := &Poll{
+		Name: "Team Offsite",
+		EventTemplate: &EventTemplate{
+			DescriptionTemplate: "Organized by {{organizer_name}}",
+			Location:            "Berlin",
+		},
+	}
+	option := &PollOption{
+		Type:      SlotTypeFullDay,
+		StartTime: time.Date(2026, 3, 10, 0, 0, 0, 0, time.UTC),
+		EndTime:   time.Date(2026, 3, 10, 23, 59, 0, 0, time.UTC),
+	}
+	attendees := pollAttendees([]Vote{
+		{GuestName: "Alice", GuestEmail: "alice@example.com"},
+		{GuestName: "Alice again", GuestEmail: "Alice@example.com"},
+		{GuestName: "Anonymous"},
+		{GuestName: "Bob", GuestEmail: "bob@example.com"},
+	})
+	if len(attendees) != 2 {
+		t.Fatalf("expected 2 attendees, got %d", len(attendees))
+	}
+
+	icsData, err := GeneratePollICSData("uid-1@meet-mesh", poll, option, attendees, &User{Name: "Jane", Email: "jane@example.com"})
+	if err != nil {
+		t.Fatalf("GeneratePollICSData failed: %v", err)
+	}
+
+	checks := []string{
+		"METHOD:REQUEST",
+		"UID:uid-1@meet-mesh",
+		"SUMMARY:Team Offsite",
+		"DESCRIPTION:Organized by Jane",
+		"LOCATION:Berlin",
+		"DTSTART;VALUE=DATE:20260310",
+		"DTEND;VALUE=DATE:20260311",
+		"mailto:alice@example.com",
+		"mailto:bob@example.com",
+	}
+	for _, check := range checks {
+		if !strings.Contains(icsData, check) {
+			t.Errorf("ICS data missing %q:\n%s", check, icsData)
+		}
+	}
+}
+
+func TestNewBookingEventCalendar(t *testing.T) {
+	booking := &Booking{GuestEmail: "guest@example.com", GuestName: "John Doe"}
+	slot := &Slot{
+		StartTime: time.Date(2026, 2, 15, 14, 0, 0, 0, time.UTC),
+		EndTime:   time.Date(2026, 2, 15, 15, 0, 0, 0, time.UTC),
+	}
+	template := &EventTemplate{TitleTemplate: "Meeting with {{guest_name}}"}
+	organizer := &User{Name: "Jane"}
+
+	encode := func(cal *ical.Calendar) string {
+		var buf bytes.Buffer
+		if err := ical.NewEncoder(&buf).Encode(cal); err != nil {
+			t.Fatalf("failed to encode event: %v", err)
+		}
+		return buf.String()
+	}
+
+	hold := encode(newBookingEventCalendar("booking-1", booking, slot, template, organizer, "", "", ical.EventTentative))
+	for _, check := range []string{
+		"UID:booking-1",
+		"STATUS:TENTATIVE",
+		"SUMMARY:Pending: Meeting with John Doe",
+		"DESCRIPTION:Waiting for your approval.",
+	} {
+		if !strings.Contains(hold, check) {
+			t.Errorf("hold missing %q", check)
+		}
+	}
+
+	confirmed := encode(newBookingEventCalendar("booking-1", booking, slot, template, organizer, "https://meet.example.com/abc", "", ical.EventConfirmed))
+	for _, check := range []string{
+		"UID:booking-1",
+		"STATUS:CONFIRMED",
+		"SUMMARY:Meeting with John Doe",
+		"LOCATION:https://meet.example.com/abc",
+	} {
+		if !strings.Contains(confirmed, check) {
+			t.Errorf("confirmed event missing %q", check)
+		}
+	}
+	if strings.Contains(confirmed, "Pending") {
+		t.Errorf("confirmed event still marked as pending")
+	}
+}
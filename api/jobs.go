@@ -0,0 +1,197 @@
+// api/jobs.go
+package api
+
+import (
+	"context"
+	"log"
+	"time"
+)
+
+// RegisterJobs adds the handler's background jobs to s.
+func (h *Handler) RegisterJobs(s *Scheduler) {
+	s.Every("close-expired-polls", time.Minute, h.CloseExpiredPolls)
+	s.Every("send-poll-reminders", 5*time.Minute, h.SendPollReminders)
+	s.Every("cleanup-attachments", time.Hour, h.CleanupAttachments)
+	s.Every("expire-pending-bookings", time.Minute, h.ExpirePendingBookings)
+	if h.events != nil {
+		s.Every("relay-live-events", eventRelayInterval, h.events.Relay)
+		s.Every("cleanup-live-events", eventRetention, h.events.CleanupEvents)
+	}
+}
+
+// approvalDeadline returns when a pending booking of link is resolved
+// automatically. ok is false if it waits for the organizer.
+func approvalDeadline(booking *Booking, link *BookingLink) (deadline time.Time, ok bool) {
+	if link.ApprovalTimeoutHours <= 0 {
+		return time.Time{}, false
+	}
+	return booking.CreatedAt.Add(time.Duration(link.ApprovalTimeoutHours) * time.Hour), true
+}
+
+// ExpirePendingBookings resolves bookings that waited for approval longer
+// than the approval timeout of their link, with the action configured on the
+// link. Bookings whose slot has already started are always declined. The
+// guest and the organizer are told, and the approve and decline links stop
+// working.
+func (h *Handler) ExpirePendingBookings(ctx context.Context) error {
+	var bookings []Booking
+	if err := h.db.WithContext(ctx).
+		Preload("BookingLink").
+		Preload("Slot").
+		Where("status = ? AND booking_link_id IN (SELECT id FROM booking_links WHERE approval_timeout_hours > 0)", BookingStatusPending).
+		Find(&bookings).Error; err != nil {
+		return err
+	}
+
+	now := time.Now()
+	for i := range bookings {
+		booking := &bookings[i]
+		link := &booking.BookingLink
+
+		if deadline, ok := approvalDeadline(booking, link); !ok || now.Before(deadline) {
+			continue
+		}
+
+		status := BookingStatusDeclined
+		if link.ApprovalTimeoutAction == ApprovalTimeoutConfirm && now.Before(booking.Slot.StartTime) {
+			status = BookingStatusConfirmed
+		}
+
+		// Only the instance that actually resolves the booking sends the emails
+		resolved, err := h.resolvePendingBooking(ctx, booking, status)
+		if err != nil {
+			return err
+		}
+		if !resolved {
+			continue
+		}
+
+		var organizer User
+		if err := h.db.First(&organizer, link.UserID).Error; err != nil {
+			log.Printf("[WARN] Failed to load organizer for expired booking %d: %v", booking.ID, err)
+			continue
+		}
+
+		if status == BookingStatusConfirmed {
+			h.notifyBookingConfirmed(ctx, booking, &organizer)
+		} else {
+			h.notifyBookingDeclined(ctx, booking, &organizer)
+		}
+
+		if h.mailer != nil {
+			if err := h.mailer.SendBookingExpired(booking, link, &organizer); err != nil {
+				log.Printf("[WARN] Failed to send expiry notification for booking %d: %v", booking.ID, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// CloseExpiredPolls closes active polls whose deadline has passed and
+// notifies their organizers with the tally at closing time.
+func (h *Handler) CloseExpiredPolls(ctx context.Context) error {
+	var polls []Poll
+	if err := h.db.WithContext(ctx).
+		Preload("PollOptions").
+		Where("status = ? AND deadline IS NOT NULL AND deadline <= ?", LinkStatusActive, time.Now().UTC()).
+		Find(&polls).Error; err != nil {
+		return err
+	}
+
+	for i := range polls {
+		poll := &polls[i]
+
+		// Only the instance that actually closes the poll sends the notification
+		result := h.db.WithContext(ctx).Model(&Poll{}).
+			Where("id = ? AND status = ?", poll.ID, LinkStatusActive).
+			Update("status", LinkStatusClosed)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			continue
+		}
+		poll.Status = LinkStatusClosed
+
+		ranks, votes, err := h.rankPoll(poll)
+		if err != nil {
+			return err
+		}
+
+		if poll.AutoPickOnClose && poll.WinnerOptionID == nil {
+			if option := recommendedOption(ranks); option != nil {
+				if _, err := h.pickWinner(ctx, poll, option); err != nil {
+					log.Printf("[WARN] Failed to pick winner for poll %d: %v", poll.ID, err)
+				}
+			}
+		}
+		// Without a winner nothing may stay blocked for the poll
+		h.syncPollHolds(ctx, poll)
+
+		if h.mailer == nil {
+			continue
+		}
+
+		var organizer User
+		if err := h.db.First(&organizer, poll.UserID).Error; err != nil {
+			log.Printf("[WARN] Failed to load organizer for closed poll %d: %v", poll.ID, err)
+			continue
+		}
+
+		if err := h.mailer.SendPollClosed(poll, ranks, len(votes), &organizer); err != nil {
+			log.Printf("[WARN] Failed to send poll closed notification for poll %d: %v", poll.ID, err)
+		}
+	}
+
+	return nil
+}
+
+// SendPollReminders reminds invitees who haven't voted once the deadline of
+// their poll is less than the poll's reminder hours away. Every invitee gets
+// at most one scheduled reminder.
+func (h *Handler) SendPollReminders(ctx context.Context) error {
+	if h.mailer == nil {
+		return nil
+	}
+
+	now := time.Now().UTC()
+	var polls []Poll
+	if err := h.db.WithContext(ctx).
+		Where("status = ? AND deadline > ? AND reminder_hours > 0", LinkStatusActive, now).
+		Find(&polls).Error; err != nil {
+		return err
+	}
+
+	for i := range polls {
+		poll := &polls[i]
+		remindAt := poll.Deadline.Add(-time.Duration(poll.ReminderHours) * time.Hour)
+		if now.Before(remindAt) {
+			continue
+		}
+
+		var invites []PollInvite
+		if err := h.db.WithContext(ctx).
+			Where("poll_id = ? AND vote_id IS NULL AND (reminded_at IS NULL OR reminded_at < ?)", poll.ID, remindAt).
+			Find(&invites).Error; err != nil {
+			return err
+		}
+
+		for j := range invites {
+			// Claim the invite first so it is only reminded once. Manual
+			// reminders sent before remindAt don't replace this one.
+			result := h.db.WithContext(ctx).Model(&PollInvite{}).
+				Where("id = ? AND (reminded_at IS NULL OR reminded_at < ?)", invites[j].ID, remindAt).
+				Update("reminded_at", now)
+			if result.Error != nil {
+				return result.Error
+			}
+			if result.RowsAffected == 0 {
+				continue
+			}
+			h.sendPollReminder(poll, &invites[j])
+		}
+	}
+
+	return nil
+}
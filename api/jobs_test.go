@@ -0,0 +1,171 @@
+package api
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	gen "github.com/kolaente/meet-mesh/api/gen"
+)
+
+func TestCloseExpiredPolls(t *testing.T) {
+	db := openTestDatabase(t)
+	if err := MigrateUp(db); err != nil {
+		t.Fatalf("MigrateUp failed: %v", err)
+	}
+	h := NewHandler(db, nil, nil, nil, NewMeetingService(&MeetingsConfig{}), nil, nil, &Config{})
+
+	past := time.Now().Add(-time.Hour).UTC()
+	future := time.Now().Add(time.Hour).UTC()
+	polls := []Poll{
+		{UserID: 1, Slug: "expired", Name: "Expired", Status: LinkStatusActive, Deadline: &past},
+		{UserID: 1, Slug: "open", Name: "Open", Status: LinkStatusActive, Deadline: &future},
+		{UserID: 1, Slug: "no-deadline", Name: "No deadline", Status: LinkStatusActive},
+	}
+	if err := db.Create(&polls).Error; err != nil {
+		t.Fatalf("failed to create polls: %v", err)
+	}
+
+	if err := h.CloseExpiredPolls(context.Background()); err != nil {
+		t.Fatalf("CloseExpiredPolls failed: %v", err)
+	}
+
+	want := map[string]LinkStatus{
+		"expired":     LinkStatusClosed,
+		"open":        LinkStatusActive,
+		"no-deadline": LinkStatusActive,
+	}
+	for slug, status := range want {
+		var poll Poll
+		db.Where("slug = ?", slug).First(&poll)
+		if poll.Status != status {
+			t.Errorf("poll %s: got status %d, want %d", slug, poll.Status, status)
+		}
+	}
+}
+
+func TestExpirePendingBookings(t *testing.T) {
+	h := newTestHandler(t)
+	db := h.db
+
+	db.Create(&User{ID: 1, Email: "organizer@example.com"})
+	links := []BookingLink{
+		{UserID: 1, Slug: "decline", Name: "Decline", Status: LinkStatusActive, ApprovalTimeoutHours: 1, ApprovalTimeoutAction: ApprovalTimeoutDecline},
+		{UserID: 1, Slug: "confirm", Name: "Confirm", Status: LinkStatusActive, ApprovalTimeoutHours: 1, ApprovalTimeoutAction: ApprovalTimeoutConfirm},
+		{UserID: 1, Slug: "wait", Name: "Wait", Status: LinkStatusActive},
+	}
+	db.Create(&links)
+
+	future := Slot{Type: SlotTypeTime, StartTime: time.Now().Add(24 * time.Hour).UTC(), EndTime: time.Now().Add(25 * time.Hour).UTC()}
+	past := Slot{Type: SlotTypeTime, StartTime: time.Now().Add(-time.Hour).UTC(), EndTime: time.Now().UTC()}
+	db.Create(&future)
+	db.Create(&past)
+
+	old := time.Now().Add(-2 * time.Hour)
+	bookings := map[string]*Booking{
+		"declined":  {BookingLinkID: links[0].ID, SlotID: future.ID, CreatedAt: old},
+		"declined2": {BookingLinkID: links[0].ID, SlotID: future.ID, CreatedAt: old},
+		"recent":    {BookingLinkID: links[0].ID, SlotID: future.ID},
+		"confirmed": {BookingLinkID: links[1].ID, SlotID: future.ID, CreatedAt: old},
+		"started":   {BookingLinkID: links[1].ID, SlotID: past.ID, CreatedAt: old},
+		"waiting":   {BookingLinkID: links[2].ID, SlotID: future.ID, CreatedAt: old},
+	}
+	for name, b := range bookings {
+		b.GuestEmail = name + "@example.com"
+		b.Status = BookingStatusPending
+		b.ActionToken = generateToken()
+		if err := db.Create(b).Error; err != nil {
+			t.Fatalf("failed to create booking: %v", err)
+		}
+	}
+
+	if err := h.ExpirePendingBookings(context.Background()); err != nil {
+		t.Fatalf("ExpirePendingBookings failed: %v", err)
+	}
+
+	want := map[string]BookingStatus{
+		"declined":  BookingStatusDeclined,
+		"declined2": BookingStatusDeclined,
+		"recent":    BookingStatusPending,
+		"confirmed": BookingStatusConfirmed,
+		"started":   BookingStatusDeclined,
+		"waiting":   BookingStatusPending,
+	}
+	for name, status := range want {
+		var booking Booking
+		db.First(&booking, bookings[name].ID)
+		if booking.Status != status {
+			t.Errorf("booking %s: got status %d, want %d", name, booking.Status, status)
+		}
+		if expired := status != BookingStatusPending; expired != (booking.ActionToken == "") {
+			t.Errorf("booking %s: unexpected action token %q", name, booking.ActionToken)
+		}
+	}
+
+	// Organizers acting on a booking the job already resolved don't change it
+	ctx := WithUserID(context.Background(), 1)
+	declined, err := h.DeclineBooking(ctx, gen.DeclineBookingParams{ID: int(bookings["confirmed"].ID)})
+	if err != nil {
+		t.Fatalf("DeclineBooking failed: %v", err)
+	}
+	if declined.Status != gen.BookingStatus(BookingStatusConfirmed) {
+		t.Errorf("expected the booking to stay confirmed, got status %d", declined.Status)
+	}
+	approved, err := h.ApproveBooking(ctx, gen.ApproveBookingParams{ID: int(bookings["declined"].ID)})
+	if err != nil {
+		t.Fatalf("ApproveBooking failed: %v", err)
+	}
+	if approved.Status != gen.BookingStatus(BookingStatusDeclined) {
+		t.Errorf("expected the booking to stay declined, got status %d", approved.Status)
+	}
+}
+
+func TestSendPollReminders(t *testing.T) {
+	h := newTestHandler(t)
+	db := h.db
+	// Nothing listens on this port, sending fails right away
+	mailer, err := NewMailer(&SMTPConfig{Host: "127.0.0.1", Port: 1, From: "meet@example.com"}, "https://meet.example.com")
+	if err != nil {
+		t.Fatalf("NewMailer failed: %v", err)
+	}
+	h.mailer = mailer
+
+	deadline := time.Now().Add(time.Hour).UTC()
+	remindAt := deadline.Add(-24 * time.Hour)
+	poll := Poll{UserID: 1, Slug: "team", Name: "Team", Status: LinkStatusActive, Deadline: &deadline, ReminderHours: 24}
+	db.Create(&poll)
+
+	nudged := remindAt.Add(-time.Hour)
+	reminded := time.Now().Add(-time.Minute).UTC()
+	invites := map[string]*PollInvite{
+		"never":    {},
+		"nudged":   {RemindedAt: &nudged},
+		"reminded": {RemindedAt: &reminded},
+	}
+	for name, invite := range invites {
+		invite.PollID = poll.ID
+		invite.Email = name + "@example.com"
+		invite.Token = generateToken()
+		db.Create(invite)
+	}
+
+	if err := h.SendPollReminders(context.Background()); err != nil {
+		t.Fatalf("SendPollReminders failed: %v", err)
+	}
+
+	for name, invite := range invites {
+		var got PollInvite
+		db.First(&got, invite.ID)
+		if got.RemindedAt == nil {
+			t.Errorf("%s: expected a reminder", name)
+			continue
+		}
+		if name == "reminded" {
+			if !got.RemindedAt.Equal(reminded) {
+				t.Errorf("%s: expected no second reminder, got one at %v", name, got.RemindedAt)
+			}
+		} else if got.RemindedAt.Before(remindAt) {
+			t.Errorf("%s: expected the scheduled reminder despite an earlier manual one, got %v", name, got.RemindedAt)
+		}
+	}
+}
@@ -90,15 +90,34 @@ func (m *Mailer) organizerAvatarURL(organizer *User) string {
 	return m.baseURL + "/api/avatars/" + organizer.AvatarFilename
 }
 
+// withBranding adds the effective branding of a booking link or poll to the
+// values of a guest-facing email.
+func (m *Mailer) withBranding(data map[string]any, organizer *User, own *Branding) map[string]any {
+	branding := effectiveBranding(organizer, own)
+	if branding.LogoFilename != "" {
+		data["LogoURL"] = m.baseURL + brandingFileURL(branding.LogoFilename)
+	}
+	data["PrimaryColor"] = branding.PrimaryColor
+	return data
+}
+
+// bookingTemplateData returns the event template values for a booking of link.
+func bookingTemplateData(booking *Booking, link *BookingLink, organizer *User) *TemplateData {
+	data := newBookingTemplateData(booking, &booking.Slot, organizer)
+	data.LinkName = link.Name
+	data.MeetingLink = bookingMeetingLink(booking, link)
+	return data
+}
+
 // SendBookingConfirmation sends confirmation to guest
 func (m *Mailer) SendBookingConfirmation(booking *Booking, link *BookingLink, organizer *User) error {
-	body := m.renderTemplate("booking_confirmed_guest", map[string]any{
+	body := m.renderTemplate("booking_confirmed_guest", m.withBranding(map[string]any{
 		"LinkName":           link.Name,
 		"GuestName":          booking.GuestName,
 		"Time":               booking.Slot.StartTime.Format("Monday, January 2 at 3:04 PM"),
 		"OrganizerName":      organizer.Name,
 		"OrganizerAvatarURL": m.organizerAvatarURL(organizer),
-	})
+	}, organizer, link.Branding))
 	return m.send(booking.GuestEmail, "Booking Confirmed: "+link.Name, body)
 }
 
@@ -107,43 +126,66 @@ func (m *Mailer) SendBookingPending(booking *Booking, link *BookingLink, organiz
 	approveURL := fmt.Sprintf("%s/api/actions/approve?token=%s", m.baseURL, booking.ActionToken)
 	declineURL := fmt.Sprintf("%s/api/actions/decline?token=%s", m.baseURL, booking.ActionToken)
 
-	body := m.renderTemplate("booking_pending", map[string]any{
+	data := map[string]any{
 		"LinkName":   link.Name,
 		"GuestEmail": booking.GuestEmail,
 		"GuestName":  booking.GuestName,
 		"Time":       booking.Slot.StartTime.Format("Monday, January 2 at 3:04 PM"),
 		"ApproveURL": approveURL,
 		"DeclineURL": declineURL,
-	})
+	}
+	if deadline, ok := approvalDeadline(booking, link); ok {
+		data["Deadline"] = deadline.Format("Monday, January 2 at 3:04 PM")
+		data["AutoConfirm"] = link.ApprovalTimeoutAction == ApprovalTimeoutConfirm
+	}
+
+	body := m.renderTemplate("booking_pending", data)
 
 	return m.send(organizer.Email, "New Booking Request: "+link.Name, body)
 }
 
+// SendBookingVerification asks the guest to confirm their email address,
+// which confirms a booking that matched an email based auto-confirm rule.
+func (m *Mailer) SendBookingVerification(booking *Booking, link *BookingLink, organizer *User) error {
+	body := m.renderTemplate("booking_verify_email", m.withBranding(map[string]any{
+		"LinkName":           link.Name,
+		"GuestName":          booking.GuestName,
+		"Time":               booking.Slot.StartTime.Format("Monday, January 2 at 3:04 PM"),
+		"VerifyURL":          fmt.Sprintf("%s/actions/verify-email?token=%s", m.baseURL, booking.VerifyToken),
+		"OrganizerName":      organizer.Name,
+		"OrganizerAvatarURL": m.organizerAvatarURL(organizer),
+	}, organizer, link.Branding))
+	return m.send(booking.GuestEmail, "Confirm your booking: "+link.Name, body)
+}
+
 // SendBookingApproved sends approval notification to guest
 func (m *Mailer) SendBookingApproved(booking *Booking, link *BookingLink, organizer *User) error {
-	body := m.renderTemplate("booking_approved", map[string]any{
+	body := m.renderTemplate("booking_approved", m.withBranding(map[string]any{
 		"LinkName":           link.Name,
 		"GuestName":          booking.GuestName,
 		"Time":               booking.Slot.StartTime.Format("Monday, January 2 at 3:04 PM"),
 		"OrganizerName":      organizer.Name,
 		"OrganizerAvatarURL": m.organizerAvatarURL(organizer),
-	})
+	}, organizer, link.Branding))
 	return m.send(booking.GuestEmail, "Booking Approved: "+link.Name, body)
 }
 
 // SendBookingConfirmationWithICS sends confirmation to guest with ICS attachment
 func (m *Mailer) SendBookingConfirmationWithICS(booking *Booking, link *BookingLink, organizer *User) error {
-	body := m.renderTemplate("booking_confirmed_guest", map[string]any{
+	title, description := renderEventTemplate(link.EventTemplate, bookingTemplateData(booking, link, organizer))
+	body := m.renderTemplate("booking_confirmed_guest", m.withBranding(map[string]any{
 		"LinkName":           link.Name,
 		"GuestName":          booking.GuestName,
 		"Time":               booking.Slot.StartTime.Format("Monday, January 2 at 3:04 PM"),
-		"MeetingLink":        link.MeetingLink,
+		"MeetingLink":        bookingMeetingLink(booking, link),
+		"EventTitle":         title,
+		"EventDescription":   description,
 		"OrganizerName":      organizer.Name,
 		"OrganizerAvatarURL": m.organizerAvatarURL(organizer),
-	})
+	}, organizer, link.Branding))
 
 	// Generate ICS data
-	icsData, err := GenerateICSData(booking, &booking.Slot, link.EventTemplate, organizer.Email)
+	icsData, err := GenerateICSData(booking, &booking.Slot, link.EventTemplate, organizer)
 	if err != nil {
 		log.Printf("[WARN] Failed to generate ICS for booking %d: %v", booking.ID, err)
 		// Send email without attachment
@@ -161,17 +203,20 @@ func (m *Mailer) SendBookingConfirmationWithICS(booking *Booking, link *BookingL
 
 // SendBookingApprovedWithICS sends approval notification to guest with ICS attachment
 func (m *Mailer) SendBookingApprovedWithICS(booking *Booking, link *BookingLink, organizer *User) error {
-	body := m.renderTemplate("booking_approved", map[string]any{
+	title, description := renderEventTemplate(link.EventTemplate, bookingTemplateData(booking, link, organizer))
+	body := m.renderTemplate("booking_approved", m.withBranding(map[string]any{
 		"LinkName":           link.Name,
 		"GuestName":          booking.GuestName,
 		"Time":               booking.Slot.StartTime.Format("Monday, January 2 at 3:04 PM"),
-		"MeetingLink":        link.MeetingLink,
+		"MeetingLink":        bookingMeetingLink(booking, link),
+		"EventTitle":         title,
+		"EventDescription":   description,
 		"OrganizerName":      organizer.Name,
 		"OrganizerAvatarURL": m.organizerAvatarURL(organizer),
-	})
+	}, organizer, link.Branding))
 
 	// Generate ICS data
-	icsData, err := GenerateICSData(booking, &booking.Slot, link.EventTemplate, organizer.Email)
+	icsData, err := GenerateICSData(booking, &booking.Slot, link.EventTemplate, organizer)
 	if err != nil {
 		log.Printf("[WARN] Failed to generate ICS for booking %d: %v", booking.ID, err)
 		// Send email without attachment
@@ -187,48 +232,215 @@ func (m *Mailer) SendBookingApprovedWithICS(booking *Booking, link *BookingLink,
 	return m.sendWithAttachment(booking.GuestEmail, "Booking Approved: "+link.Name, body, attachment)
 }
 
+// SendBookingExpired tells the organizer that a booking request they didn't
+// answer in time was confirmed or declined automatically.
+func (m *Mailer) SendBookingExpired(booking *Booking, link *BookingLink, organizer *User) error {
+	body := m.renderTemplate("booking_expired", map[string]any{
+		"LinkName":   link.Name,
+		"GuestEmail": booking.GuestEmail,
+		"GuestName":  booking.GuestName,
+		"Time":       booking.Slot.StartTime.Format("Monday, January 2 at 3:04 PM"),
+		"Hours":      link.ApprovalTimeoutHours,
+		"Confirmed":  booking.Status == BookingStatusConfirmed,
+	})
+
+	subject := "Booking Request Declined: "
+	if booking.Status == BookingStatusConfirmed {
+		subject = "Booking Request Confirmed: "
+	}
+	return m.send(organizer.Email, subject+link.Name, body)
+}
+
 // SendBookingDeclined sends decline notification to guest
 func (m *Mailer) SendBookingDeclined(booking *Booking, link *BookingLink, organizer *User) error {
-	body := m.renderTemplate("booking_declined", map[string]any{
+	body := m.renderTemplate("booking_declined", m.withBranding(map[string]any{
 		"LinkName":           link.Name,
 		"GuestName":          booking.GuestName,
 		"Time":               booking.Slot.StartTime.Format("Monday, January 2 at 3:04 PM"),
 		"OrganizerName":      organizer.Name,
 		"OrganizerAvatarURL": m.organizerAvatarURL(organizer),
-	})
+	}, organizer, link.Branding))
 	return m.send(booking.GuestEmail, "Booking Declined: "+link.Name, body)
 }
 
-// SendPollWinner sends winner notification to all voters
-func (m *Mailer) SendPollWinner(poll *Poll, option *PollOption, votes []Vote, organizer *User) error {
-	body := m.renderTemplate("poll_winner", map[string]any{
+// SendPollWinner sends winner notification with a calendar invitation to the
+// attendees. The invitation uses poll.CalendarUID so it matches the event in
+// the organizer's calendar.
+func (m *Mailer) SendPollWinner(poll *Poll, option *PollOption, attendees []Vote, organizer *User) error {
+	title, description := renderEventTemplate(poll.EventTemplate, newPollTemplateData(poll, option, organizer))
+	body := m.renderTemplate("poll_winner", m.withBranding(map[string]any{
 		"LinkName":           poll.Name,
 		"Time":               option.StartTime.Format("Monday, January 2 at 3:04 PM"),
+		"EventTitle":         title,
+		"EventDescription":   description,
 		"OrganizerName":      organizer.Name,
 		"OrganizerAvatarURL": m.organizerAvatarURL(organizer),
-	})
+	}, organizer, poll.Branding))
+
+	uid := poll.CalendarUID
+	if uid == "" {
+		uid = generateUID()
+	}
+	var attachments []*EmailAttachment
+	icsData, err := GeneratePollICSData(uid, poll, option, attendees, organizer)
+	if err != nil {
+		log.Printf("[WARN] Failed to generate ICS for poll %d: %v", poll.ID, err)
+	} else {
+		attachments = append(attachments, &EmailAttachment{
+			Filename:    "invite.ics",
+			ContentType: "text/calendar; charset=utf-8; method=REQUEST",
+			Data:        []byte(icsData),
+		})
+	}
 
-	for _, vote := range votes {
-		if vote.GuestEmail != "" {
-			_ = m.send(vote.GuestEmail, "Date Selected: "+poll.Name, body)
+	for _, attendee := range attendees {
+		if err := m.sendWithAttachment(attendee.GuestEmail, "Date Selected: "+poll.Name, body, attachments...); err != nil {
+			log.Printf("[WARN] Failed to send poll winner email to %s: %v", attendee.GuestEmail, err)
 		}
 	}
 	return nil
 }
 
+// SendVoteConfirmation sends the voter a link to change or withdraw their vote
+func (m *Mailer) SendVoteConfirmation(poll *Poll, vote *Vote, organizer *User) error {
+	body := m.renderTemplate("vote_confirmation", m.withBranding(map[string]any{
+		"LinkName":           poll.Name,
+		"GuestName":          vote.GuestName,
+		"EditURL":            fmt.Sprintf("%s/p/poll/%s?vote=%s", m.baseURL, poll.Slug, vote.EditToken),
+		"OrganizerName":      organizer.Name,
+		"OrganizerAvatarURL": m.organizerAvatarURL(organizer),
+	}, organizer, poll.Branding))
+	return m.send(vote.GuestEmail, "Your vote: "+poll.Name, body)
+}
+
+// SendPollInvite sends an invitee their personal link to a poll
+func (m *Mailer) SendPollInvite(poll *Poll, invite *PollInvite, organizer *User) error {
+	body := m.renderTemplate("poll_invite", m.withBranding(m.pollInviteData(poll, invite, organizer), organizer, poll.Branding))
+	return m.send(invite.Email, "Invitation: "+poll.Name, body)
+}
+
+// SendPollReminder reminds an invitee who hasn't voted yet
+func (m *Mailer) SendPollReminder(poll *Poll, invite *PollInvite, organizer *User) error {
+	body := m.renderTemplate("poll_reminder", m.withBranding(m.pollInviteData(poll, invite, organizer), organizer, poll.Branding))
+	return m.send(invite.Email, "Reminder: "+poll.Name, body)
+}
+
+func (m *Mailer) pollInviteData(poll *Poll, invite *PollInvite, organizer *User) map[string]any {
+	data := map[string]any{
+		"LinkName":           poll.Name,
+		"Description":        poll.Description,
+		"GuestName":          invite.Name,
+		"InviteURL":          fmt.Sprintf("%s/p/poll/%s?invite=%s", m.baseURL, poll.Slug, invite.Token),
+		"OrganizerName":      organizer.Name,
+		"OrganizerAvatarURL": m.organizerAvatarURL(organizer),
+	}
+	if poll.Deadline != nil {
+		data["Deadline"] = poll.Deadline.Format("Monday, January 2 at 3:04 PM")
+	}
+	return data
+}
+
+// SendPollComment notifies the organizer of a new comment on a poll
+func (m *Mailer) SendPollComment(poll *Poll, comment *PollComment, option *PollOption, organizer *User) error {
+	data := map[string]any{
+		"LinkName":    poll.Name,
+		"AuthorName":  comment.AuthorName,
+		"AuthorEmail": comment.AuthorEmail,
+		"Body":        comment.Body,
+		"PollURL":     fmt.Sprintf("%s/polls/%d", m.baseURL, poll.ID),
+	}
+	if option != nil {
+		data["Time"] = option.StartTime.Format("Monday, January 2 at 3:04 PM")
+	}
+
+	body := m.renderTemplate("poll_comment", data)
+	return m.send(organizer.Email, "New Comment: "+poll.Name, body)
+}
+
+// SendPollProposal notifies the organizer of an option proposed by a
+// participant. Pending proposals ask for approval.
+func (m *Mailer) SendPollProposal(poll *Poll, proposal *PollProposal, pending bool, organizer *User) error {
+	data := map[string]any{
+		"LinkName": poll.Name,
+		"Name":     proposal.Name,
+		"Email":    proposal.Email,
+		"Time":     proposal.StartTime.Format("Monday, January 2 at 3:04 PM"),
+		"Pending":  pending,
+		"PollURL":  fmt.Sprintf("%s/polls/%d", m.baseURL, poll.ID),
+	}
+
+	body := m.renderTemplate("poll_proposal", data)
+	return m.send(organizer.Email, "New Proposal: "+poll.Name, body)
+}
+
+// PollTallyRow is one option of a poll with its vote counts.
+type PollTallyRow struct {
+	Time        string
+	Yes         int
+	Maybe       int
+	No          int
+	Score       int
+	Recommended bool
+	Winner      bool
+}
+
+// pollTallyRows lists the ranked options of poll, marking the recommended
+// option and the winner.
+func pollTallyRows(poll *Poll, ranks []OptionRank) []PollTallyRow {
+	recommended := recommendedOption(ranks)
+	rows := make([]PollTallyRow, len(ranks))
+	for i, r := range ranks {
+		rows[i] = PollTallyRow{
+			Time:        r.Option.StartTime.Format("Monday, January 2 at 3:04 PM"),
+			Yes:         r.Yes,
+			Maybe:       r.Maybe,
+			No:          r.No,
+			Score:       r.Score,
+			Recommended: recommended != nil && recommended.ID == r.Option.ID,
+			Winner:      poll.WinnerOptionID != nil && *poll.WinnerOptionID == r.Option.ID,
+		}
+	}
+	return rows
+}
+
+// SendPollClosed notifies the organizer that a poll reached its deadline,
+// with the options ranked from best to worst.
+func (m *Mailer) SendPollClosed(poll *Poll, ranks []OptionRank, voteCount int, organizer *User) error {
+	body := m.renderTemplate("poll_closed", map[string]any{
+		"LinkName":  poll.Name,
+		"VoteCount": voteCount,
+		"Tally":     pollTallyRows(poll, ranks),
+		"Picked":    poll.WinnerOptionID != nil,
+		"PollURL":   fmt.Sprintf("%s/polls/%d", m.baseURL, poll.ID),
+	})
+	return m.send(organizer.Email, "Poll Closed: "+poll.Name, body)
+}
+
 const emailTemplates = `
+{{define "brand_logo"}}
+{{if .LogoURL}}
+<div style="margin-bottom: 16px;">
+  <img src="{{.LogoURL}}" alt="{{.OrganizerName}}" style="max-height: 64px; max-width: 240px;" />
+</div>
+{{end}}
+{{end}}
 {{define "booking_confirmed_guest"}}
 <html>
 <body>
+{{template "brand_logo" .}}
 {{if .OrganizerAvatarURL}}
 <div style="margin-bottom: 16px;">
   <img src="{{.OrganizerAvatarURL}}" alt="{{.OrganizerName}}" width="48" height="48" style="border-radius: 50%; width: 48px; height: 48px; object-fit: cover;" />
 </div>
 {{end}}
-<h1>Booking Confirmed!</h1>
+<h1{{if .PrimaryColor}} style="color: {{.PrimaryColor}};"{{end}}>Booking Confirmed!</h1>
 <p>Hi {{.GuestName}},</p>
 <p>Your booking for <strong>{{.LinkName}}</strong> has been confirmed.</p>
 <p><strong>When:</strong> {{.Time}}</p>
+{{if .EventDescription}}
+<p><strong>{{.EventTitle}}</strong></p>
+<p style="white-space: pre-line;">{{.EventDescription}}</p>
+{{end}}
 {{if .MeetingLink}}
 <p><strong>Meeting Link:</strong> <a href="{{.MeetingLink}}">{{.MeetingLink}}</a></p>
 {{end}}
@@ -246,6 +458,7 @@ const emailTemplates = `
 <p>You have a new booking request for <strong>{{.LinkName}}</strong>.</p>
 <p><strong>Guest:</strong> {{.GuestName}} ({{.GuestEmail}})</p>
 <p><strong>Requested time:</strong> {{.Time}}</p>
+{{if .Deadline}}<p>If you don't respond by {{.Deadline}}, the booking is {{if .AutoConfirm}}confirmed{{else}}declined{{end}} automatically.</p>{{end}}
 <p>
 <a href="{{.ApproveURL}}" style="background:#22c55e;color:white;padding:10px 20px;text-decoration:none;border-radius:5px;">Approve</a>
 <a href="{{.DeclineURL}}" style="background:#ef4444;color:white;padding:10px 20px;text-decoration:none;border-radius:5px;margin-left:10px;">Decline</a>
@@ -254,18 +467,50 @@ const emailTemplates = `
 </html>
 {{end}}
 
+{{define "booking_expired"}}
+<html>
+<body>
+<h1>Booking Request {{if .Confirmed}}Confirmed{{else}}Declined{{end}}</h1>
+<p>The booking request from <strong>{{.GuestName}}</strong> ({{.GuestEmail}}) for <strong>{{.LinkName}}</strong> wasn't answered within {{.Hours}} hours.</p>
+<p><strong>Requested time:</strong> {{.Time}}</p>
+{{if .Confirmed}}<p>It was confirmed automatically and the guest received an invitation.</p>{{else}}<p>It was declined automatically and the guest was told.</p>{{end}}
+</body>
+</html>
+{{end}}
+
+{{define "booking_verify_email"}}
+<html>
+<body>
+{{template "brand_logo" .}}
+<h1{{if .PrimaryColor}} style="color: {{.PrimaryColor}};"{{end}}>Confirm Your Booking</h1>
+<p>Hi {{.GuestName}},</p>
+<p>Please confirm your email address to complete your booking for <strong>{{.LinkName}}</strong>.</p>
+<p><strong>When:</strong> {{.Time}}</p>
+<p>
+<a href="{{.VerifyURL}}" style="background:#22c55e;color:white;padding:10px 20px;text-decoration:none;border-radius:5px;">Confirm booking</a>
+</p>
+<p>If you didn't request this booking, you can ignore this email.</p>
+</body>
+</html>
+{{end}}
+
 {{define "booking_approved"}}
 <html>
 <body>
+{{template "brand_logo" .}}
 {{if .OrganizerAvatarURL}}
 <div style="margin-bottom: 16px;">
   <img src="{{.OrganizerAvatarURL}}" alt="{{.OrganizerName}}" width="48" height="48" style="border-radius: 50%; width: 48px; height: 48px; object-fit: cover;" />
 </div>
 {{end}}
-<h1>Booking Approved!</h1>
+<h1{{if .PrimaryColor}} style="color: {{.PrimaryColor}};"{{end}}>Booking Approved!</h1>
 <p>Hi {{.GuestName}},</p>
 <p>Great news! Your booking for <strong>{{.LinkName}}</strong> has been approved.</p>
 <p><strong>When:</strong> {{.Time}}</p>
+{{if .EventDescription}}
+<p><strong>{{.EventTitle}}</strong></p>
+<p style="white-space: pre-line;">{{.EventDescription}}</p>
+{{end}}
 {{if .MeetingLink}}
 <p><strong>Meeting Link:</strong> <a href="{{.MeetingLink}}">{{.MeetingLink}}</a></p>
 {{end}}
@@ -279,12 +524,13 @@ const emailTemplates = `
 {{define "booking_declined"}}
 <html>
 <body>
+{{template "brand_logo" .}}
 {{if .OrganizerAvatarURL}}
 <div style="margin-bottom: 16px;">
   <img src="{{.OrganizerAvatarURL}}" alt="{{.OrganizerName}}" width="48" height="48" style="border-radius: 50%; width: 48px; height: 48px; object-fit: cover;" />
 </div>
 {{end}}
-<h1>Booking Update</h1>
+<h1{{if .PrimaryColor}} style="color: {{.PrimaryColor}};"{{end}}>Booking Update</h1>
 <p>Hi {{.GuestName}},</p>
 <p>Unfortunately, your booking request for <strong>{{.LinkName}}</strong> could not be accommodated.</p>
 <p><strong>Requested time:</strong> {{.Time}}</p>
@@ -295,14 +541,142 @@ const emailTemplates = `
 {{define "poll_winner"}}
 <html>
 <body>
+{{template "brand_logo" .}}
 {{if .OrganizerAvatarURL}}
 <div style="margin-bottom: 16px;">
   <img src="{{.OrganizerAvatarURL}}" alt="{{.OrganizerName}}" width="48" height="48" style="border-radius: 50%; width: 48px; height: 48px; object-fit: cover;" />
 </div>
 {{end}}
-<h1>Date Selected!</h1>
+<h1{{if .PrimaryColor}} style="color: {{.PrimaryColor}};"{{end}}>Date Selected!</h1>
 <p>The organizer has selected a date for <strong>{{.LinkName}}</strong>.</p>
 <p><strong>Selected time:</strong> {{.Time}}</p>
+{{if .EventDescription}}
+<p><strong>{{.EventTitle}}</strong></p>
+<p style="white-space: pre-line;">{{.EventDescription}}</p>
+{{end}}
+<p style="margin-top: 20px; padding: 15px; background: #f0f9ff; border-radius: 8px;">
+📅 <strong>Add to your calendar:</strong> Open the attached <code>invite.ics</code> file to add this event to your calendar.
+</p>
+</body>
+</html>
+{{end}}
+
+{{define "vote_confirmation"}}
+<html>
+<body>
+{{template "brand_logo" .}}
+{{if .OrganizerAvatarURL}}
+<div style="margin-bottom: 16px;">
+  <img src="{{.OrganizerAvatarURL}}" alt="{{.OrganizerName}}" width="48" height="48" style="border-radius: 50%; width: 48px; height: 48px; object-fit: cover;" />
+</div>
+{{end}}
+<h1{{if .PrimaryColor}} style="color: {{.PrimaryColor}};"{{end}}>Thanks for Voting!</h1>
+<p>Hi {{.GuestName}},</p>
+<p>Your vote for <strong>{{.LinkName}}</strong> has been recorded.</p>
+<p>You can change or withdraw your vote until the poll closes:</p>
+<p><a href="{{.EditURL}}">Edit your vote</a></p>
+<p style="color: #6b7280; font-size: 12px;">Anyone with this link can change your vote, so don't share it.</p>
+</body>
+</html>
+{{end}}
+
+{{define "poll_invite"}}
+<html>
+<body>
+{{template "brand_logo" .}}
+{{if .OrganizerAvatarURL}}
+<div style="margin-bottom: 16px;">
+  <img src="{{.OrganizerAvatarURL}}" alt="{{.OrganizerName}}" width="48" height="48" style="border-radius: 50%; width: 48px; height: 48px; object-fit: cover;" />
+</div>
+{{end}}
+<h1{{if .PrimaryColor}} style="color: {{.PrimaryColor}};"{{end}}>You're Invited!</h1>
+<p>Hi{{if .GuestName}} {{.GuestName}}{{end}},</p>
+<p>{{.OrganizerName}} would like to know when you're available for <strong>{{.LinkName}}</strong>.</p>
+{{if .Description}}
+<p style="white-space: pre-line;">{{.Description}}</p>
+{{end}}
+{{if .Deadline}}
+<p><strong>Please vote by:</strong> {{.Deadline}}</p>
+{{end}}
+<p><a href="{{.InviteURL}}">Vote now</a></p>
+<p style="color: #6b7280; font-size: 12px;">This link is personal, please don't share it.</p>
+</body>
+</html>
+{{end}}
+
+{{define "poll_reminder"}}
+<html>
+<body>
+{{template "brand_logo" .}}
+{{if .OrganizerAvatarURL}}
+<div style="margin-bottom: 16px;">
+  <img src="{{.OrganizerAvatarURL}}" alt="{{.OrganizerName}}" width="48" height="48" style="border-radius: 50%; width: 48px; height: 48px; object-fit: cover;" />
+</div>
+{{end}}
+<h1{{if .PrimaryColor}} style="color: {{.PrimaryColor}};"{{end}}>Don't Forget to Vote</h1>
+<p>Hi{{if .GuestName}} {{.GuestName}}{{end}},</p>
+<p>You haven't voted in <strong>{{.LinkName}}</strong> yet.</p>
+{{if .Deadline}}
+<p><strong>Voting closes:</strong> {{.Deadline}}</p>
+{{end}}
+<p><a href="{{.InviteURL}}">Vote now</a></p>
+</body>
+</html>
+{{end}}
+
+{{define "poll_comment"}}
+<html>
+<body>
+<h1>New Comment</h1>
+<p><strong>{{.AuthorName}}</strong>{{if .AuthorEmail}} ({{.AuthorEmail}}){{end}} commented on <strong>{{.LinkName}}</strong>{{if .Time}} about the option on {{.Time}}{{end}}:</p>
+<blockquote style="margin: 0 0 16px; padding: 8px 16px; border-left: 4px solid #e5e7eb; white-space: pre-line;">{{.Body}}</blockquote>
+<p><a href="{{.PollURL}}">View or moderate comments</a></p>
+</body>
+</html>
+{{end}}
+
+{{define "poll_proposal"}}
+<html>
+<body>
+<h1>New Proposal</h1>
+<p><strong>{{.Name}}</strong>{{if .Email}} ({{.Email}}){{end}} proposed {{.Time}} as an option for <strong>{{.LinkName}}</strong>.</p>
+{{if .Pending}}<p>The option is offered once you approve it.</p>
+<p><a href="{{.PollURL}}">Review proposals</a></p>{{else}}<p>The option was added to the poll.</p>
+<p><a href="{{.PollURL}}">View poll</a></p>{{end}}
+</body>
+</html>
+{{end}}
+
+{{define "poll_closed"}}
+<html>
+<body>
+<h1>Poll Closed</h1>
+<p>The voting deadline for <strong>{{.LinkName}}</strong> has passed and the poll is now closed.</p>
+<p><strong>Votes received:</strong> {{.VoteCount}}</p>
+<table style="border-collapse: collapse;">
+<tr>
+  <th style="text-align: left; padding: 4px 12px 4px 0;">Option</th>
+  <th style="padding: 4px 8px;">Yes</th>
+  <th style="padding: 4px 8px;">Maybe</th>
+  <th style="padding: 4px 8px;">No</th>
+  <th style="padding: 4px 8px;">Score</th>
+</tr>
+{{range .Tally}}
+<tr>
+  <td style="padding: 4px 12px 4px 0;">{{.Time}}{{if .Winner}} <strong>(selected)</strong>{{else if .Recommended}} <strong>(recommended)</strong>{{end}}</td>
+  <td style="padding: 4px 8px; text-align: center;">{{.Yes}}</td>
+  <td style="padding: 4px 8px; text-align: center;">{{.Maybe}}</td>
+  <td style="padding: 4px 8px; text-align: center;">{{.No}}</td>
+  <td style="padding: 4px 8px; text-align: center;">{{.Score}}</td>
+</tr>
+{{end}}
+</table>
+{{if .Picked}}
+<p>The selected date was picked automatically and the invitations have been sent.</p>
+<p><a href="{{.PollURL}}">View the poll</a></p>
+{{else}}
+<p><a href="{{.PollURL}}">Pick the winning date</a></p>
+{{end}}
 </body>
 </html>
 {{end}}
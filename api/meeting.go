@@ -0,0 +1,190 @@
+package api
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha1"
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	MeetingProviderStatic        = "static"
+	MeetingProviderJitsi         = "jitsi"
+	MeetingProviderBigBlueButton = "bigbluebutton"
+)
+
+// Meeting holds the links to the video meeting of a booking. Providers that
+// distinguish roles return a separate HostURL for the organizer.
+type Meeting struct {
+	JoinURL string
+	HostURL string
+}
+
+// MeetingProvider generates a video meeting for a single booking.
+type MeetingProvider interface {
+	CreateMeeting(ctx context.Context, link *BookingLink, booking *Booking, organizer *User) (Meeting, error)
+}
+
+// MeetingService creates meeting links using the providers enabled in the config.
+type MeetingService struct {
+	providers map[string]MeetingProvider
+}
+
+func NewMeetingService(cfg *MeetingsConfig) *MeetingService {
+	providers := map[string]MeetingProvider{
+		MeetingProviderStatic: &StaticMeetingProvider{},
+	}
+	if cfg.Jitsi.BaseURL != "" {
+		providers[MeetingProviderJitsi] = &JitsiMeetingProvider{baseURL: cfg.Jitsi.BaseURL}
+	}
+	if cfg.BigBlueButton.URL != "" {
+		providers[MeetingProviderBigBlueButton] = &BigBlueButtonMeetingProvider{
+			apiURL:     cfg.BigBlueButton.URL,
+			secret:     cfg.BigBlueButton.Secret,
+			httpClient: &http.Client{Timeout: 10 * time.Second},
+		}
+	}
+	return &MeetingService{providers: providers}
+}
+
+// Providers returns the names of all enabled providers.
+func (s *MeetingService) Providers() []string {
+	names := make([]string, 0, len(s.providers))
+	for name := range s.providers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// HasProvider reports whether name is an enabled provider. An empty name
+// means the static meeting link of the booking link is used.
+func (s *MeetingService) HasProvider(name string) bool {
+	if name == "" {
+		return true
+	}
+	_, ok := s.providers[name]
+	return ok
+}
+
+// CreateMeeting generates a meeting for booking using the provider
+// configured on link.
+func (s *MeetingService) CreateMeeting(ctx context.Context, link *BookingLink, booking *Booking, organizer *User) (Meeting, error) {
+	name := link.MeetingProvider
+	if name == "" {
+		name = MeetingProviderStatic
+	}
+	provider, ok := s.providers[name]
+	if !ok {
+		return Meeting{}, fmt.Errorf("meeting provider %q is not enabled", name)
+	}
+	return provider.CreateMeeting(ctx, link, booking, organizer)
+}
+
+// StaticMeetingProvider returns the same meeting link for every booking.
+type StaticMeetingProvider struct{}
+
+func (p *StaticMeetingProvider) CreateMeeting(ctx context.Context, link *BookingLink, booking *Booking, organizer *User) (Meeting, error) {
+	return Meeting{JoinURL: link.MeetingLink}, nil
+}
+
+// JitsiMeetingProvider creates a Jitsi room with a random name per booking.
+// Jitsi creates rooms on first join, so no API call is needed.
+type JitsiMeetingProvider struct {
+	baseURL string
+}
+
+func (p *JitsiMeetingProvider) CreateMeeting(ctx context.Context, link *BookingLink, booking *Booking, organizer *User) (Meeting, error) {
+	room, err := randomRoomName()
+	if err != nil {
+		return Meeting{}, err
+	}
+	return Meeting{JoinURL: strings.TrimRight(p.baseURL, "/") + "/" + room}, nil
+}
+
+// BigBlueButtonMeetingProvider creates a meeting through the BigBlueButton API
+// and returns a viewer join link for the guest and a moderator join link for
+// the organizer.
+type BigBlueButtonMeetingProvider struct {
+	apiURL     string
+	secret     string
+	httpClient *http.Client
+}
+
+type bbbResponse struct {
+	ReturnCode string `xml:"returncode"`
+	MessageKey string `xml:"messageKey"`
+	Message    string `xml:"message"`
+}
+
+func (p *BigBlueButtonMeetingProvider) CreateMeeting(ctx context.Context, link *BookingLink, booking *Booking, organizer *User) (Meeting, error) {
+	meetingID, err := randomRoomName()
+	if err != nil {
+		return Meeting{}, err
+	}
+
+	createParams := url.Values{}
+	createParams.Set("meetingID", meetingID)
+	createParams.Set("name", link.Name)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, p.callURL("create", createParams), nil)
+	if err != nil {
+		return Meeting{}, err
+	}
+	resp, err := p.httpClient.Do(req)
+	if err != nil {
+		return Meeting{}, fmt.Errorf("failed to create BigBlueButton meeting: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	var result bbbResponse
+	if err := xml.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return Meeting{}, fmt.Errorf("failed to decode BigBlueButton response: %w", err)
+	}
+	if result.ReturnCode != "SUCCESS" {
+		return Meeting{}, fmt.Errorf("BigBlueButton create failed: %s %s", result.MessageKey, result.Message)
+	}
+
+	return Meeting{
+		JoinURL: p.joinURL(meetingID, booking.GuestName, booking.GuestEmail, "VIEWER"),
+		HostURL: p.joinURL(meetingID, organizer.Name, organizer.Email, "MODERATOR"),
+	}, nil
+}
+
+// joinURL builds the link that joins meetingID with role under name, or
+// under email without a name.
+func (p *BigBlueButtonMeetingProvider) joinURL(meetingID, name, email, role string) string {
+	fullName := name
+	if fullName == "" {
+		fullName = email
+	}
+	joinParams := url.Values{}
+	joinParams.Set("meetingID", meetingID)
+	joinParams.Set("fullName", fullName)
+	joinParams.Set("role", role)
+	return p.callURL("join", joinParams)
+}
+
+// callURL builds a signed BigBlueButton API URL. The checksum is the SHA-1
+// of the call name, the query string and the shared secret.
+func (p *BigBlueButtonMeetingProvider) callURL(call string, params url.Values) string {
+	query := params.Encode()
+	sum := sha1.Sum([]byte(call + query + p.secret))
+	base := strings.TrimRight(p.apiURL, "/") + "/api/" + call
+	return base + "?" + query + "&checksum=" + hex.EncodeToString(sum[:])
+}
+
+func randomRoomName() (string, error) {
+	b := make([]byte, 12)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return "MeetMesh" + hex.EncodeToString(b), nil
+}
@@ -0,0 +1,106 @@
+package api
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+func TestJitsiMeetingProvider(t *testing.T) {
+	service := NewMeetingService(&MeetingsConfig{Jitsi: JitsiConfig{BaseURL: "https://meet.example.com/"}})
+	link := &BookingLink{MeetingProvider: MeetingProviderJitsi}
+
+	first, err := service.CreateMeeting(context.Background(), link, &Booking{}, &User{})
+	if err != nil {
+		t.Fatalf("CreateMeeting failed: %v", err)
+	}
+	second, err := service.CreateMeeting(context.Background(), link, &Booking{}, &User{})
+	if err != nil {
+		t.Fatalf("CreateMeeting failed: %v", err)
+	}
+
+	if !strings.HasPrefix(first.JoinURL, "https://meet.example.com/MeetMesh") {
+		t.Errorf("unexpected meeting link %q", first.JoinURL)
+	}
+	if first.JoinURL == second.JoinURL {
+		t.Errorf("expected unique rooms per booking, got %q twice", first.JoinURL)
+	}
+}
+
+func TestStaticMeetingProvider(t *testing.T) {
+	service := NewMeetingService(&MeetingsConfig{})
+	link := &BookingLink{MeetingLink: "https://zoom.example.com/j/123"}
+
+	got, err := service.CreateMeeting(context.Background(), link, &Booking{}, &User{})
+	if err != nil {
+		t.Fatalf("CreateMeeting failed: %v", err)
+	}
+	if got.JoinURL != link.MeetingLink {
+		t.Errorf("got %q, want %q", got.JoinURL, link.MeetingLink)
+	}
+
+	if service.HasProvider(MeetingProviderJitsi) {
+		t.Error("jitsi should not be enabled without a base URL")
+	}
+}
+
+func TestBigBlueButtonMeetingProvider(t *testing.T) {
+	const secret = "s3cret"
+
+	var createdMeetingID string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/bigbluebutton/api/create" {
+			http.NotFound(w, r)
+			return
+		}
+		query := r.URL.RawQuery
+		idx := strings.LastIndex(query, "&checksum=")
+		sum := sha1.Sum([]byte("create" + query[:idx] + secret))
+		if query[idx+len("&checksum="):] != hex.EncodeToString(sum[:]) {
+			_, _ = w.Write([]byte(`<response><returncode>FAILED</returncode><messageKey>checksumError</messageKey></response>`))
+			return
+		}
+		createdMeetingID = r.URL.Query().Get("meetingID")
+		_, _ = w.Write([]byte(`<response><returncode>SUCCESS</returncode></response>`))
+	}))
+	defer server.Close()
+
+	service := NewMeetingService(&MeetingsConfig{
+		BigBlueButton: BigBlueButtonConfig{URL: server.URL + "/bigbluebutton", Secret: secret},
+	})
+	link := &BookingLink{Name: "Intro Call", MeetingProvider: MeetingProviderBigBlueButton}
+	booking := &Booking{GuestName: "John Doe"}
+
+	meeting, err := service.CreateMeeting(context.Background(), link, booking, &User{Name: "Jane Host"})
+	if err != nil {
+		t.Fatalf("CreateMeeting failed: %v", err)
+	}
+	joinURL := meeting.JoinURL
+
+	parsed, err := url.Parse(joinURL)
+	if err != nil {
+		t.Fatalf("invalid join URL %q: %v", joinURL, err)
+	}
+	if parsed.Path != "/bigbluebutton/api/join" {
+		t.Errorf("unexpected join path %q", parsed.Path)
+	}
+	if createdMeetingID == "" || parsed.Query().Get("meetingID") != createdMeetingID {
+		t.Errorf("join URL %q does not reference created meeting %q", joinURL, createdMeetingID)
+	}
+	if parsed.Query().Get("fullName") != "John Doe" || parsed.Query().Get("role") != "VIEWER" {
+		t.Errorf("expected the guest to join as viewer, got %q", joinURL)
+	}
+
+	host, err := url.Parse(meeting.HostURL)
+	if err != nil {
+		t.Fatalf("invalid host URL %q: %v", meeting.HostURL, err)
+	}
+	if host.Query().Get("meetingID") != createdMeetingID || host.Query().Get("fullName") != "Jane Host" || host.Query().Get("role") != "MODERATOR" {
+		t.Errorf("expected the organizer to join the meeting as moderator, got %q", meeting.HostURL)
+	}
+}
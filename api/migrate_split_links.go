@@ -0,0 +1,242 @@
+package api
+
+import (
+	"log"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// Legacy models of the unified Link table that was split into BookingLink and
+// Poll. JSON columns are copied verbatim.
+type legacyLink struct {
+	ID                uint
+	UserID            uint
+	Type              int // 1=booking, 2=poll
+	Slug              string
+	Name              string
+	Description       string
+	Status            int
+	AutoConfirm       bool
+	ShowResults       bool
+	RequireEmail      bool
+	AvailabilityRules string
+	CustomFields      string
+	EventTemplate     string
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+}
+
+func (legacyLink) TableName() string {
+	return "links"
+}
+
+type legacySlot struct {
+	ID        uint
+	LinkID    uint
+	Type      int
+	StartTime time.Time
+	EndTime   time.Time
+	CreatedAt time.Time
+}
+
+func (legacySlot) TableName() string {
+	return "slots"
+}
+
+type splitBookingLink struct {
+	ID                uint
+	UserID            uint
+	Slug              string
+	Name              string
+	Description       string
+	Status            int
+	AutoConfirm       bool
+	AvailabilityRules string
+	RequireEmail      bool
+	CustomFields      string
+	EventTemplate     string
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+}
+
+func (splitBookingLink) TableName() string {
+	return "booking_links"
+}
+
+type splitPoll struct {
+	ID           uint
+	UserID       uint
+	Slug         string
+	Name         string
+	Description  string
+	Status       int
+	ShowResults  bool
+	RequireEmail bool
+	CustomFields string
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+func (splitPoll) TableName() string {
+	return "polls"
+}
+
+type splitPollOption struct {
+	ID        uint
+	PollID    uint
+	Type      int
+	StartTime time.Time
+	EndTime   time.Time
+	CreatedAt time.Time
+}
+
+func (splitPollOption) TableName() string {
+	return "poll_options"
+}
+
+// migrateSplitLinks moves databases from before the Link split to separate
+// booking links and polls. Poll slots become poll options, bookings and votes
+// are pointed at the new rows and the links table is dropped afterwards.
+// On databases without a links table it only ensures the new foreign key
+// columns are indexed.
+func migrateSplitLinks(tx *gorm.DB) error {
+	for _, column := range []struct{ table, name string }{
+		{"slots", "booking_link_id"},
+		{"bookings", "booking_link_id"},
+		{"votes", "poll_id"},
+	} {
+		if !tx.Migrator().HasColumn(column.table, column.name) {
+			if err := tx.Exec("ALTER TABLE `" + column.table + "` ADD COLUMN `" + column.name + "` integer NOT NULL DEFAULT 0").Error; err != nil {
+				return err
+			}
+		}
+	}
+
+	if tx.Migrator().HasTable("links") {
+		if err := splitLinks(tx); err != nil {
+			return err
+		}
+	}
+
+	for _, index := range []string{
+		"CREATE INDEX IF NOT EXISTS `idx_slots_booking_link_id` ON `slots`(`booking_link_id`)",
+		"CREATE INDEX IF NOT EXISTS `idx_bookings_booking_link_id` ON `bookings`(`booking_link_id`)",
+		"CREATE INDEX IF NOT EXISTS `idx_votes_poll_id` ON `votes`(`poll_id`)",
+	} {
+		if err := tx.Exec(index).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// revertSplitLinks only drops the indexes. Split links are not merged back,
+// the old links table does not exist in any schema this code can run on.
+func revertSplitLinks(tx *gorm.DB) error {
+	for _, index := range []string{"idx_slots_booking_link_id", "idx_bookings_booking_link_id", "idx_votes_poll_id"} {
+		if err := tx.Exec("DROP INDEX IF EXISTS `" + index + "`").Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func splitLinks(tx *gorm.DB) error {
+	var links []legacyLink
+	if err := tx.Find(&links).Error; err != nil {
+		return err
+	}
+
+	linkToBookingLink := make(map[uint]uint)
+	linkToPoll := make(map[uint]uint)
+
+	for _, link := range links {
+		if link.Type == 1 {
+			bookingLink := splitBookingLink{
+				UserID:            link.UserID,
+				Slug:              link.Slug,
+				Name:              link.Name,
+				Description:       link.Description,
+				Status:            link.Status,
+				AutoConfirm:       link.AutoConfirm,
+				AvailabilityRules: link.AvailabilityRules,
+				RequireEmail:      link.RequireEmail,
+				CustomFields:      link.CustomFields,
+				EventTemplate:     link.EventTemplate,
+				CreatedAt:         link.CreatedAt,
+				UpdatedAt:         link.UpdatedAt,
+			}
+			if err := tx.Create(&bookingLink).Error; err != nil {
+				return err
+			}
+			linkToBookingLink[link.ID] = bookingLink.ID
+		} else {
+			poll := splitPoll{
+				UserID:       link.UserID,
+				Slug:         link.Slug,
+				Name:         link.Name,
+				Description:  link.Description,
+				Status:       link.Status,
+				ShowResults:  link.ShowResults,
+				RequireEmail: link.RequireEmail,
+				CustomFields: link.CustomFields,
+				CreatedAt:    link.CreatedAt,
+				UpdatedAt:    link.UpdatedAt,
+			}
+			if err := tx.Create(&poll).Error; err != nil {
+				return err
+			}
+			linkToPoll[link.ID] = poll.ID
+		}
+	}
+
+	var slots []legacySlot
+	if err := tx.Find(&slots).Error; err != nil {
+		return err
+	}
+
+	var pollSlots []uint
+	for _, slot := range slots {
+		if pollID, ok := linkToPoll[slot.LinkID]; ok {
+			option := splitPollOption{
+				PollID:    pollID,
+				Type:      slot.Type,
+				StartTime: slot.StartTime,
+				EndTime:   slot.EndTime,
+				CreatedAt: slot.CreatedAt,
+			}
+			if err := tx.Create(&option).Error; err != nil {
+				return err
+			}
+			pollSlots = append(pollSlots, slot.ID)
+		} else if bookingLinkID, ok := linkToBookingLink[slot.LinkID]; ok {
+			if err := tx.Exec("UPDATE slots SET booking_link_id = ? WHERE id = ?", bookingLinkID, slot.ID).Error; err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(pollSlots) > 0 {
+		if err := tx.Exec("DELETE FROM slots WHERE id IN ?", pollSlots).Error; err != nil {
+			return err
+		}
+	}
+
+	for linkID, bookingLinkID := range linkToBookingLink {
+		if err := tx.Exec("UPDATE bookings SET booking_link_id = ? WHERE link_id = ?", bookingLinkID, linkID).Error; err != nil {
+			return err
+		}
+	}
+	for linkID, pollID := range linkToPoll {
+		if err := tx.Exec("UPDATE votes SET poll_id = ? WHERE link_id = ?", pollID, linkID).Error; err != nil {
+			return err
+		}
+	}
+
+	log.Printf("Split %d links into %d booking links and %d polls", len(links), len(linkToBookingLink), len(linkToPoll))
+
+	// The old link_id columns are left in place, SQLite can't drop columns
+	// that are part of a foreign key.
+	return tx.Migrator().DropTable("links")
+}
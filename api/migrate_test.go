@@ -0,0 +1,147 @@
+package api
+
+import (
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/schema"
+)
+
+// schemaModels are all models that are stored in the database. Migrations
+// must create every column of these models.
+var schemaModels = []any{
+	&User{},
+	&CalendarConnection{},
+	&BookingLink{},
+	&Poll{},
+	&PollOption{},
+	&Slot{},
+	&Booking{},
+	&Vote{},
+	&PollInvite{},
+	&PollComment{},
+	&PollProposal{},
+	&Attachment{},
+	&LiveEvent{},
+}
+
+func openTestDatabase(t *testing.T) *gorm.DB {
+	t.Helper()
+	db, err := gorm.Open(sqlite.Open(filepath.Join(t.TempDir(), "test.db")), &gorm.Config{})
+	if err != nil {
+		t.Fatalf("failed to open database: %v", err)
+	}
+	return db
+}
+
+func TestMigrationsMatchModels(t *testing.T) {
+	db := openTestDatabase(t)
+	if err := MigrateUp(db); err != nil {
+		t.Fatalf("MigrateUp failed: %v", err)
+	}
+
+	for _, model := range schemaModels {
+		s, err := schema.Parse(model, &sync.Map{}, db.NamingStrategy)
+		if err != nil {
+			t.Fatalf("failed to parse %T: %v", model, err)
+		}
+		if !db.Migrator().HasTable(s.Table) {
+			t.Errorf("table %s is missing, add a migration", s.Table)
+			continue
+		}
+		for _, field := range s.Fields {
+			if field.DBName != "" && !db.Migrator().HasColumn(s.Table, field.DBName) {
+				t.Errorf("column %s.%s is missing, add a migration", s.Table, field.DBName)
+			}
+		}
+	}
+}
+
+func TestMigrateDownAndUp(t *testing.T) {
+	db := openTestDatabase(t)
+	migrator, err := NewMigrator(db)
+	if err != nil {
+		t.Fatalf("NewMigrator failed: %v", err)
+	}
+
+	applied, err := migrator.Up()
+	if err != nil {
+		t.Fatalf("Up failed: %v", err)
+	}
+	if again, _ := migrator.Up(); len(again) != 0 {
+		t.Errorf("expected no pending migrations, got %d", len(again))
+	}
+
+	if _, err := migrator.Down(len(applied)); err != nil {
+		t.Fatalf("Down failed: %v", err)
+	}
+	if db.Migrator().HasTable("users") {
+		t.Error("expected users table to be dropped")
+	}
+	status, _ := migrator.Status()
+	for _, s := range status {
+		if s.AppliedAt != nil {
+			t.Errorf("migration %d still marked as applied", s.Version)
+		}
+	}
+
+	if _, err := migrator.Up(); err != nil {
+		t.Fatalf("Up after Down failed: %v", err)
+	}
+}
+
+func TestMigrateSplitLinks(t *testing.T) {
+	db := openTestDatabase(t)
+	for _, stmt := range []string{
+		"CREATE TABLE links (id integer PRIMARY KEY AUTOINCREMENT, user_id integer, type integer, slug text, name text, description text, status integer, auto_confirm numeric, show_results numeric, require_email numeric, availability_rules text, custom_fields text, event_template text, created_at datetime, updated_at datetime)",
+		"CREATE TABLE slots (id integer PRIMARY KEY AUTOINCREMENT, link_id integer, type integer, start_time datetime, end_time datetime, manual numeric, created_at datetime)",
+		"CREATE TABLE bookings (id integer PRIMARY KEY AUTOINCREMENT, link_id integer, slot_id integer, guest_email text, guest_name text, custom_fields text, status integer, action_token text, calendar_uid text, created_at datetime, updated_at datetime)",
+		"CREATE TABLE votes (id integer PRIMARY KEY AUTOINCREMENT, link_id integer, guest_email text, guest_name text, responses text, custom_fields text, created_at datetime)",
+		`INSERT INTO links (id, user_id, type, slug, name, status, custom_fields) VALUES (1, 1, 1, 'book', 'Booking', 1, '[]'), (2, 1, 2, 'poll', 'Poll', 1, '[]')`,
+		"INSERT INTO slots (id, link_id, type, start_time, end_time) VALUES (1, 1, 1, '2026-01-01 10:00:00', '2026-01-01 11:00:00'), (2, 2, 1, '2026-01-02 10:00:00', '2026-01-02 11:00:00')",
+		"INSERT INTO bookings (id, link_id, slot_id, guest_email, status, action_token) VALUES (1, 1, 1, 'guest@example.com', 1, 'token')",
+		`INSERT INTO votes (id, link_id, guest_name, responses) VALUES (1, 2, 'Voter', '{}')`,
+	} {
+		if err := db.Exec(stmt).Error; err != nil {
+			t.Fatalf("failed to set up legacy schema: %v", err)
+		}
+	}
+
+	if err := MigrateUp(db); err != nil {
+		t.Fatalf("MigrateUp failed: %v", err)
+	}
+
+	if db.Migrator().HasTable("links") {
+		t.Error("expected links table to be dropped")
+	}
+
+	var link BookingLink
+	if err := db.Where("slug = ?", "book").First(&link).Error; err != nil {
+		t.Fatalf("booking link not migrated: %v", err)
+	}
+	var booking Booking
+	if err := db.First(&booking, 1).Error; err != nil || booking.BookingLinkID != link.ID {
+		t.Errorf("booking not linked to booking link %d: %+v (%v)", link.ID, booking, err)
+	}
+
+	var poll Poll
+	if err := db.Preload("PollOptions").Where("slug = ?", "poll").First(&poll).Error; err != nil {
+		t.Fatalf("poll not migrated: %v", err)
+	}
+	if len(poll.PollOptions) != 1 {
+		t.Errorf("expected 1 poll option, got %d", len(poll.PollOptions))
+	}
+	var vote Vote
+	if err := db.First(&vote, 1).Error; err != nil || vote.PollID != poll.ID {
+		t.Errorf("vote not linked to poll %d: %+v (%v)", poll.ID, vote, err)
+	}
+
+	var slotCount int64
+	db.Model(&Slot{}).Count(&slotCount)
+	if slotCount != 1 {
+		t.Errorf("expected poll slot to be removed, %d slots left", slotCount)
+	}
+}
@@ -0,0 +1,8 @@
+DROP TABLE IF EXISTS `votes`;
+DROP TABLE IF EXISTS `bookings`;
+DROP TABLE IF EXISTS `slots`;
+DROP TABLE IF EXISTS `poll_options`;
+DROP TABLE IF EXISTS `polls`;
+DROP TABLE IF EXISTS `booking_links`;
+DROP TABLE IF EXISTS `calendar_connections`;
+DROP TABLE IF EXISTS `users`;
@@ -0,0 +1,26 @@
+-- Schema as created by GORM AutoMigrate before versioned migrations were
+-- introduced. IF NOT EXISTS makes this a no-op for existing databases.
+CREATE TABLE IF NOT EXISTS `users` (`id` integer PRIMARY KEY AUTOINCREMENT,`oidc_sub` text NOT NULL,`email` text NOT NULL,`name` text,`avatar_filename` text,`created_at` datetime);
+CREATE UNIQUE INDEX IF NOT EXISTS `idx_users_o_id_c_sub` ON `users`(`oidc_sub`);
+
+CREATE TABLE IF NOT EXISTS `calendar_connections` (`id` integer PRIMARY KEY AUTOINCREMENT,`user_id` integer NOT NULL,`server_url` text NOT NULL,`username` text NOT NULL,`password` text NOT NULL,`calendar_urls` text,`write_url` text,`created_at` datetime,`updated_at` datetime,CONSTRAINT `fk_users_calendars` FOREIGN KEY (`user_id`) REFERENCES `users`(`id`));
+CREATE INDEX IF NOT EXISTS `idx_calendar_connections_user_id` ON `calendar_connections`(`user_id`);
+
+CREATE TABLE IF NOT EXISTS `booking_links` (`id` integer PRIMARY KEY AUTOINCREMENT,`user_id` integer NOT NULL,`slug` text NOT NULL,`name` text NOT NULL,`description` text,`status` integer NOT NULL DEFAULT 1,`auto_confirm` numeric,`slot_duration_minutes` integer NOT NULL DEFAULT 30,`slot_durations_minutes` text,`buffer_minutes` integer NOT NULL DEFAULT 0,`availability_rules` text,`require_email` numeric,`meeting_link` text,`custom_fields` text,`event_template` text,`created_at` datetime,`updated_at` datetime,CONSTRAINT `fk_users_booking_links` FOREIGN KEY (`user_id`) REFERENCES `users`(`id`));
+CREATE UNIQUE INDEX IF NOT EXISTS `idx_booking_links_slug` ON `booking_links`(`slug`);
+CREATE INDEX IF NOT EXISTS `idx_booking_links_user_id` ON `booking_links`(`user_id`);
+
+CREATE TABLE IF NOT EXISTS `polls` (`id` integer PRIMARY KEY AUTOINCREMENT,`user_id` integer NOT NULL,`slug` text NOT NULL,`name` text NOT NULL,`description` text,`status` integer NOT NULL DEFAULT 1,`show_results` numeric,`require_email` numeric,`custom_fields` text,`created_at` datetime,`updated_at` datetime,CONSTRAINT `fk_users_polls` FOREIGN KEY (`user_id`) REFERENCES `users`(`id`));
+CREATE UNIQUE INDEX IF NOT EXISTS `idx_polls_slug` ON `polls`(`slug`);
+CREATE INDEX IF NOT EXISTS `idx_polls_user_id` ON `polls`(`user_id`);
+
+CREATE TABLE IF NOT EXISTS `poll_options` (`id` integer PRIMARY KEY AUTOINCREMENT,`poll_id` integer NOT NULL,`type` integer NOT NULL,`start_time` datetime NOT NULL,`end_time` datetime NOT NULL,`created_at` datetime,CONSTRAINT `fk_polls_poll_options` FOREIGN KEY (`poll_id`) REFERENCES `polls`(`id`));
+CREATE INDEX IF NOT EXISTS `idx_poll_options_poll_id` ON `poll_options`(`poll_id`);
+
+CREATE TABLE IF NOT EXISTS `slots` (`id` integer PRIMARY KEY AUTOINCREMENT,`booking_link_id` integer NOT NULL DEFAULT 0,`type` integer NOT NULL,`start_time` datetime NOT NULL,`end_time` datetime NOT NULL,`manual` numeric,`created_at` datetime);
+
+CREATE TABLE IF NOT EXISTS `bookings` (`id` integer PRIMARY KEY AUTOINCREMENT,`booking_link_id` integer NOT NULL DEFAULT 0,`slot_id` integer NOT NULL,`guest_email` text NOT NULL,`guest_name` text,`custom_fields` text,`status` integer NOT NULL DEFAULT 1,`action_token` text,`calendar_uid` text,`created_at` datetime,`updated_at` datetime,CONSTRAINT `fk_booking_links_bookings` FOREIGN KEY (`booking_link_id`) REFERENCES `booking_links`(`id`),CONSTRAINT `fk_bookings_slot` FOREIGN KEY (`slot_id`) REFERENCES `slots`(`id`));
+CREATE UNIQUE INDEX IF NOT EXISTS `idx_bookings_action_token` ON `bookings`(`action_token`);
+CREATE INDEX IF NOT EXISTS `idx_bookings_slot_id` ON `bookings`(`slot_id`);
+
+CREATE TABLE IF NOT EXISTS `votes` (`id` integer PRIMARY KEY AUTOINCREMENT,`poll_id` integer NOT NULL DEFAULT 0,`guest_email` text,`guest_name` text,`responses` text NOT NULL,`custom_fields` text,`created_at` datetime,CONSTRAINT `fk_polls_votes` FOREIGN KEY (`poll_id`) REFERENCES `polls`(`id`));
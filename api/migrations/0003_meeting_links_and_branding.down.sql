@@ -0,0 +1,5 @@
+ALTER TABLE `polls` DROP COLUMN `branding`;
+ALTER TABLE `booking_links` DROP COLUMN `branding`;
+ALTER TABLE `users` DROP COLUMN `branding`;
+ALTER TABLE `bookings` DROP COLUMN `meeting_link`;
+ALTER TABLE `booking_links` DROP COLUMN `meeting_provider`;
@@ -0,0 +1,5 @@
+ALTER TABLE `booking_links` ADD COLUMN `meeting_provider` text;
+ALTER TABLE `bookings` ADD COLUMN `meeting_link` text;
+ALTER TABLE `users` ADD COLUMN `branding` text;
+ALTER TABLE `booking_links` ADD COLUMN `branding` text;
+ALTER TABLE `polls` ADD COLUMN `branding` text;
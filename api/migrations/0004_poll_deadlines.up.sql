@@ -0,0 +1 @@
+ALTER TABLE `polls` ADD COLUMN `deadline` datetime;
@@ -0,0 +1,3 @@
+ALTER TABLE `polls` DROP COLUMN `calendar_uid`;
+ALTER TABLE `polls` DROP COLUMN `winner_option_id`;
+ALTER TABLE `polls` DROP COLUMN `event_template`;
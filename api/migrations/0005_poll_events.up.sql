@@ -0,0 +1,3 @@
+ALTER TABLE `polls` ADD COLUMN `event_template` text;
+ALTER TABLE `polls` ADD COLUMN `winner_option_id` integer;
+ALTER TABLE `polls` ADD COLUMN `calendar_uid` text;
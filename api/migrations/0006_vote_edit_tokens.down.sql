@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS `idx_votes_edit_token`;
+ALTER TABLE `votes` DROP COLUMN `updated_at`;
+ALTER TABLE `votes` DROP COLUMN `edit_token`;
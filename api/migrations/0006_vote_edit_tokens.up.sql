@@ -0,0 +1,5 @@
+ALTER TABLE `votes` ADD COLUMN `edit_token` text;
+ALTER TABLE `votes` ADD COLUMN `updated_at` datetime;
+-- Existing votes get a token so the unique index can be created
+UPDATE `votes` SET `edit_token` = lower(hex(randomblob(32)));
+CREATE UNIQUE INDEX `idx_votes_edit_token` ON `votes`(`edit_token`);
@@ -0,0 +1,2 @@
+DROP TABLE `poll_invites`;
+ALTER TABLE `polls` DROP COLUMN `reminder_hours`;
@@ -0,0 +1,6 @@
+ALTER TABLE `polls` ADD COLUMN `reminder_hours` integer;
+
+CREATE TABLE `poll_invites` (`id` integer PRIMARY KEY AUTOINCREMENT,`poll_id` integer NOT NULL,`email` text NOT NULL,`name` text,`token` text NOT NULL,`vote_id` integer,`voted_at` datetime,`reminded_at` datetime,`created_at` datetime,CONSTRAINT `fk_polls_invites` FOREIGN KEY (`poll_id`) REFERENCES `polls`(`id`));
+CREATE INDEX `idx_poll_invites_poll_id` ON `poll_invites`(`poll_id`);
+CREATE UNIQUE INDEX `idx_poll_invites_token` ON `poll_invites`(`token`);
+CREATE INDEX `idx_poll_invites_vote_id` ON `poll_invites`(`vote_id`);
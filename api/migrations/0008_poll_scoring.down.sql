@@ -0,0 +1,4 @@
+ALTER TABLE `poll_invites` DROP COLUMN `required`;
+ALTER TABLE `polls` DROP COLUMN `auto_pick_when_all_yes`;
+ALTER TABLE `polls` DROP COLUMN `auto_pick_on_close`;
+ALTER TABLE `polls` DROP COLUMN `scoring`;
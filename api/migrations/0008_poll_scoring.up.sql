@@ -0,0 +1,4 @@
+ALTER TABLE `polls` ADD COLUMN `scoring` text;
+ALTER TABLE `polls` ADD COLUMN `auto_pick_on_close` numeric;
+ALTER TABLE `polls` ADD COLUMN `auto_pick_when_all_yes` numeric;
+ALTER TABLE `poll_invites` ADD COLUMN `required` numeric;
@@ -0,0 +1,2 @@
+ALTER TABLE `poll_options` DROP COLUMN `hold_uid`;
+ALTER TABLE `polls` DROP COLUMN `hold_times`;
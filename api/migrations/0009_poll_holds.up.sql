@@ -0,0 +1,2 @@
+ALTER TABLE `polls` ADD COLUMN `hold_times` numeric;
+ALTER TABLE `poll_options` ADD COLUMN `hold_uid` text;
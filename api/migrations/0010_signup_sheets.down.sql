@@ -0,0 +1,3 @@
+ALTER TABLE `poll_options` DROP COLUMN `capacity`;
+ALTER TABLE `polls` DROP COLUMN `max_choices`;
+ALTER TABLE `polls` DROP COLUMN `mode`;
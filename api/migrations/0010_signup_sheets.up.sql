@@ -0,0 +1,3 @@
+ALTER TABLE `polls` ADD COLUMN `mode` integer NOT NULL DEFAULT 1;
+ALTER TABLE `polls` ADD COLUMN `max_choices` integer;
+ALTER TABLE `poll_options` ADD COLUMN `capacity` integer;
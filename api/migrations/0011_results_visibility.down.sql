@@ -0,0 +1,2 @@
+ALTER TABLE `polls` DROP COLUMN `results_timing`;
+ALTER TABLE `polls` DROP COLUMN `results_visibility`;
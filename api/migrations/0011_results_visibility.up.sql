@@ -0,0 +1,4 @@
+-- Existing polls no longer expose voter emails, organizers can opt into
+-- full results.
+ALTER TABLE `polls` ADD COLUMN `results_visibility` integer NOT NULL DEFAULT 2;
+ALTER TABLE `polls` ADD COLUMN `results_timing` integer NOT NULL DEFAULT 1;
@@ -0,0 +1 @@
+DROP TABLE `poll_comments`;
@@ -0,0 +1,3 @@
+CREATE TABLE `poll_comments` (`id` integer PRIMARY KEY AUTOINCREMENT,`poll_id` integer NOT NULL,`option_id` integer,`author_name` text NOT NULL,`author_email` text,`body` text NOT NULL,`hidden` numeric,`created_at` datetime);
+CREATE INDEX `idx_poll_comments_poll_id` ON `poll_comments`(`poll_id`);
+CREATE INDEX `idx_poll_comments_option_id` ON `poll_comments`(`option_id`);
@@ -0,0 +1,3 @@
+DROP TABLE `poll_proposals`;
+ALTER TABLE `poll_options` DROP COLUMN `proposed_by`;
+ALTER TABLE `polls` DROP COLUMN `option_proposals`;
@@ -0,0 +1,4 @@
+ALTER TABLE `polls` ADD COLUMN `option_proposals` integer NOT NULL DEFAULT 1;
+ALTER TABLE `poll_options` ADD COLUMN `proposed_by` text;
+CREATE TABLE `poll_proposals` (`id` integer PRIMARY KEY AUTOINCREMENT,`poll_id` integer NOT NULL,`type` integer NOT NULL,`start_time` datetime NOT NULL,`end_time` datetime NOT NULL,`name` text NOT NULL,`email` text,`created_at` datetime);
+CREATE INDEX `idx_poll_proposals_poll_id` ON `poll_proposals`(`poll_id`);
@@ -0,0 +1,4 @@
+CREATE TABLE `attachments` (`id` integer PRIMARY KEY AUTOINCREMENT,`booking_link_id` integer NOT NULL,`booking_id` integer,`field_name` text NOT NULL,`token` text NOT NULL,`filename` text NOT NULL,`storage_key` text NOT NULL,`content_type` text NOT NULL,`size` integer NOT NULL,`created_at` datetime);
+CREATE INDEX `idx_attachments_booking_link_id` ON `attachments`(`booking_link_id`);
+CREATE INDEX `idx_attachments_booking_id` ON `attachments`(`booking_id`);
+CREATE UNIQUE INDEX `idx_attachments_token` ON `attachments`(`token`);
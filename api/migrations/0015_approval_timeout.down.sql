@@ -0,0 +1,2 @@
+ALTER TABLE `booking_links` DROP COLUMN `approval_timeout_action`;
+ALTER TABLE `booking_links` DROP COLUMN `approval_timeout_hours`;
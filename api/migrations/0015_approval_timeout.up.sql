@@ -0,0 +1,2 @@
+ALTER TABLE `booking_links` ADD COLUMN `approval_timeout_hours` integer NOT NULL DEFAULT 0;
+ALTER TABLE `booking_links` ADD COLUMN `approval_timeout_action` integer NOT NULL DEFAULT 1;
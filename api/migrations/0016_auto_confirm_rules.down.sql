@@ -0,0 +1 @@
+ALTER TABLE `booking_links` DROP COLUMN `auto_confirm_rules`;
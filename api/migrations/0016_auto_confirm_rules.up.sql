@@ -0,0 +1 @@
+ALTER TABLE `booking_links` ADD COLUMN `auto_confirm_rules` text;
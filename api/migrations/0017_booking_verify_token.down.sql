@@ -0,0 +1,2 @@
+DROP INDEX `idx_bookings_verify_token`;
+ALTER TABLE `bookings` DROP COLUMN `verify_token`;
@@ -0,0 +1,2 @@
+ALTER TABLE `bookings` ADD COLUMN `verify_token` text;
+CREATE INDEX `idx_bookings_verify_token` ON `bookings`(`verify_token`);
@@ -0,0 +1 @@
+ALTER TABLE `bookings` ADD COLUMN `host_link` text;
@@ -0,0 +1,2 @@
+ALTER TABLE `poll_options` ADD COLUMN `unapproved` numeric;
+UPDATE `poll_options` SET `unapproved` = true WHERE COALESCE(`proposed_by`, '') != '' AND `poll_id` IN (SELECT `id` FROM `polls` WHERE `option_proposals` = 3);
@@ -0,0 +1,2 @@
+CREATE TABLE `live_events` (`id` integer PRIMARY KEY AUTOINCREMENT,`topic` text NOT NULL,`type` text NOT NULL,`data` text NOT NULL,`created_at` datetime);
+CREATE INDEX `idx_live_events_created_at` ON `live_events`(`created_at`);
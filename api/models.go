@@ -20,6 +20,38 @@ const (
 	LinkStatusClosed LinkStatus = 2
 )
 
+type PollMode int
+
+const (
+	PollModeVote   PollMode = 1
+	PollModeSignup PollMode = 2
+)
+
+type ResultsVisibility int
+
+const (
+	ResultsVisibilityAggregate ResultsVisibility = 1
+	ResultsVisibilityNames     ResultsVisibility = 2
+	ResultsVisibilityFull      ResultsVisibility = 3
+)
+
+type ResultsTiming int
+
+const (
+	ResultsTimingAlways     ResultsTiming = 1
+	ResultsTimingAfterVote  ResultsTiming = 2
+	ResultsTimingAfterClose ResultsTiming = 3
+)
+
+// ProposalMode controls whether participants may propose poll options.
+type ProposalMode int
+
+const (
+	ProposalModeOff      ProposalMode = 1
+	ProposalModeApproval ProposalMode = 2
+	ProposalModeAuto     ProposalMode = 3
+)
+
 type BookingStatus int
 
 const (
@@ -31,11 +63,25 @@ const (
 type CustomFieldType int
 
 const (
-	CustomFieldTypeText     CustomFieldType = 1
-	CustomFieldTypeEmail    CustomFieldType = 2
-	CustomFieldTypePhone    CustomFieldType = 3
-	CustomFieldTypeSelect   CustomFieldType = 4
-	CustomFieldTypeTextarea CustomFieldType = 5
+	CustomFieldTypeText        CustomFieldType = 1
+	CustomFieldTypeEmail       CustomFieldType = 2
+	CustomFieldTypePhone       CustomFieldType = 3
+	CustomFieldTypeSelect      CustomFieldType = 4
+	CustomFieldTypeTextarea    CustomFieldType = 5
+	CustomFieldTypeCheckbox    CustomFieldType = 6
+	CustomFieldTypeNumber      CustomFieldType = 7
+	CustomFieldTypeDate        CustomFieldType = 8
+	CustomFieldTypeMultiSelect CustomFieldType = 9
+	CustomFieldTypeFile        CustomFieldType = 10
+)
+
+// ApprovalTimeoutAction is what happens to bookings the organizer doesn't
+// approve or decline in time.
+type ApprovalTimeoutAction int
+
+const (
+	ApprovalTimeoutDecline ApprovalTimeoutAction = 1
+	ApprovalTimeoutConfirm ApprovalTimeoutAction = 2
 )
 
 type VoteResponseType int
@@ -53,12 +99,31 @@ type AvailabilityRule struct {
 	EndTime    string `json:"end_time"`
 }
 
+// AutoConfirmRules confirm matching bookings of links that otherwise
+// require approval.
+type AutoConfirmRules struct {
+	KnownGuests  bool               `json:"known_guests,omitempty"`
+	EmailDomains []string           `json:"email_domains,omitempty"`
+	Hours        []AvailabilityRule `json:"hours,omitempty"`
+}
+
 type CustomField struct {
 	Name     string          `json:"name"`
 	Label    string          `json:"label"`
 	Type     CustomFieldType `json:"type"`
 	Required bool            `json:"required"`
 	Options  []string        `json:"options,omitempty"`
+	Pattern  string          `json:"pattern,omitempty"`
+	// MaxFileSizeMB and FileTypes limit the uploads of file fields
+	MaxFileSizeMB int      `json:"max_file_size_mb,omitempty"`
+	FileTypes     []string `json:"file_types,omitempty"`
+}
+
+type Branding struct {
+	LogoFilename  string `json:"logo_filename,omitempty"`
+	CoverFilename string `json:"cover_filename,omitempty"`
+	PrimaryColor  string `json:"primary_color,omitempty"`
+	AccentColor   string `json:"accent_color,omitempty"`
 }
 
 type EventTemplate struct {
@@ -67,13 +132,20 @@ type EventTemplate struct {
 	Location            string `json:"location,omitempty"`
 }
 
+// PollScoring sets how many points an option earns per response.
+type PollScoring struct {
+	YesPoints   int `json:"yes_points"`
+	MaybePoints int `json:"maybe_points"`
+}
+
 // GORM Models
 type User struct {
-	ID             uint      `gorm:"primaryKey"`
-	OIDCSub        string    `gorm:"column:oidc_sub;uniqueIndex;not null"`
-	Email          string    `gorm:"not null"`
+	ID             uint   `gorm:"primaryKey"`
+	OIDCSub        string `gorm:"column:oidc_sub;uniqueIndex;not null"`
+	Email          string `gorm:"not null"`
 	Name           string
 	AvatarFilename string
+	Branding       *Branding `gorm:"serializer:json"`
 	CreatedAt      time.Time
 	Calendars      []CalendarConnection `gorm:"foreignKey:UserID"`
 	BookingLinks   []BookingLink        `gorm:"foreignKey:UserID"`
@@ -81,63 +153,130 @@ type User struct {
 }
 
 type CalendarConnection struct {
-	ID           uint      `gorm:"primaryKey"`
-	UserID       uint      `gorm:"index;not null"`
-	ServerURL    string    `gorm:"not null"`
-	Username     string    `gorm:"not null"`
-	Password     string    `gorm:"not null"`
-	CalendarURLs []string  `gorm:"serializer:json"`
+	ID           uint     `gorm:"primaryKey"`
+	UserID       uint     `gorm:"index;not null"`
+	ServerURL    string   `gorm:"not null"`
+	Username     string   `gorm:"not null"`
+	Password     string   `gorm:"not null"`
+	CalendarURLs []string `gorm:"serializer:json"`
 	WriteURL     string
 	CreatedAt    time.Time
 	UpdatedAt    time.Time
 }
 
 type BookingLink struct {
-	ID                   uint               `gorm:"primaryKey"`
-	UserID               uint               `gorm:"index;not null"`
-	Slug                 string             `gorm:"uniqueIndex;not null"`
-	Name                 string             `gorm:"not null"`
-	Description          string
-	Status               LinkStatus         `gorm:"not null;default:1"`
-	AutoConfirm          bool
-	SlotDurationMinutes  int                `gorm:"not null;default:30"`
-	SlotDurationsMinutes []int              `gorm:"serializer:json"`
-	BufferMinutes        int                `gorm:"not null;default:0"`
-	AvailabilityRules    []AvailabilityRule `gorm:"serializer:json"`
-	RequireEmail         bool
-	MeetingLink          string
-	CustomFields         []CustomField      `gorm:"serializer:json"`
-	EventTemplate        *EventTemplate     `gorm:"serializer:json"`
-	CreatedAt            time.Time
-	UpdatedAt            time.Time
-	Bookings             []Booking          `gorm:"foreignKey:BookingLinkID"`
+	ID                    uint   `gorm:"primaryKey"`
+	UserID                uint   `gorm:"index;not null"`
+	Slug                  string `gorm:"uniqueIndex;not null"`
+	Name                  string `gorm:"not null"`
+	Description           string
+	Status                LinkStatus `gorm:"not null;default:1"`
+	AutoConfirm           bool
+	AutoConfirmRules      *AutoConfirmRules     `gorm:"serializer:json"`
+	ApprovalTimeoutHours  int                   `gorm:"not null;default:0"`
+	ApprovalTimeoutAction ApprovalTimeoutAction `gorm:"not null;default:1"`
+	SlotDurationMinutes   int                   `gorm:"not null;default:30"`
+	SlotDurationsMinutes  []int                 `gorm:"serializer:json"`
+	BufferMinutes         int                   `gorm:"not null;default:0"`
+	AvailabilityRules     []AvailabilityRule    `gorm:"serializer:json"`
+	RequireEmail          bool
+	MeetingLink           string
+	MeetingProvider       string
+	CustomFields          []CustomField  `gorm:"serializer:json"`
+	EventTemplate         *EventTemplate `gorm:"serializer:json"`
+	Branding              *Branding      `gorm:"serializer:json"`
+	CreatedAt             time.Time
+	UpdatedAt             time.Time
+	Bookings              []Booking `gorm:"foreignKey:BookingLinkID"`
 }
 
 type Poll struct {
-	ID           uint          `gorm:"primaryKey"`
-	UserID       uint          `gorm:"index;not null"`
-	Slug         string        `gorm:"uniqueIndex;not null"`
-	Name         string        `gorm:"not null"`
-	Description  string
-	Status       LinkStatus    `gorm:"not null;default:1"`
-	ShowResults  bool
-	RequireEmail bool
-	CustomFields []CustomField `gorm:"serializer:json"`
-	CreatedAt    time.Time
-	UpdatedAt    time.Time
-	PollOptions  []PollOption  `gorm:"foreignKey:PollID"`
-	Votes        []Vote        `gorm:"foreignKey:PollID"`
+	ID                 uint   `gorm:"primaryKey"`
+	UserID             uint   `gorm:"index;not null"`
+	Slug               string `gorm:"uniqueIndex;not null"`
+	Name               string `gorm:"not null"`
+	Description        string
+	Status             LinkStatus `gorm:"not null;default:1"`
+	Mode               PollMode   `gorm:"not null;default:1"`
+	MaxChoices         int
+	ShowResults        bool
+	ResultsVisibility  ResultsVisibility `gorm:"not null;default:2"`
+	ResultsTiming      ResultsTiming     `gorm:"not null;default:1"`
+	RequireEmail       bool
+	CustomFields       []CustomField `gorm:"serializer:json"`
+	Branding           *Branding     `gorm:"serializer:json"`
+	Deadline           *time.Time
+	EventTemplate      *EventTemplate `gorm:"serializer:json"`
+	WinnerOptionID     *uint
+	CalendarUID        string
+	ReminderHours      int
+	Scoring            *PollScoring `gorm:"serializer:json"`
+	AutoPickOnClose    bool
+	AutoPickWhenAllYes bool
+	HoldTimes          bool
+	OptionProposals    ProposalMode `gorm:"not null;default:1"`
+	CreatedAt          time.Time
+	UpdatedAt          time.Time
+	PollOptions        []PollOption `gorm:"foreignKey:PollID"`
+	Votes              []Vote       `gorm:"foreignKey:PollID"`
+	Invites            []PollInvite `gorm:"foreignKey:PollID"`
 }
 
 type PollOption struct {
+	ID         uint      `gorm:"primaryKey"`
+	PollID     uint      `gorm:"index;not null"`
+	Type       SlotType  `gorm:"not null"`
+	StartTime  time.Time `gorm:"not null"`
+	EndTime    time.Time `gorm:"not null"`
+	Capacity   int
+	HoldUID    string
+	ProposedBy string
+	// Unapproved options were proposed and added without the organizer's
+	// approval, they are never held
+	Unapproved bool
+	CreatedAt  time.Time
+}
+
+// PollProposal is an option proposed by a participant that waits for the
+// organizer's approval.
+type PollProposal struct {
 	ID        uint      `gorm:"primaryKey"`
 	PollID    uint      `gorm:"index;not null"`
 	Type      SlotType  `gorm:"not null"`
 	StartTime time.Time `gorm:"not null"`
 	EndTime   time.Time `gorm:"not null"`
+	Name      string    `gorm:"not null"`
+	Email     string
 	CreatedAt time.Time
 }
 
+// Attachment is a file a guest uploaded for a file custom field of a booking
+// link. It is claimed with its token when the booking is created; until then
+// BookingID is nil.
+type Attachment struct {
+	ID            uint   `gorm:"primaryKey"`
+	BookingLinkID uint   `gorm:"index;not null"`
+	BookingID     *uint  `gorm:"index"`
+	FieldName     string `gorm:"not null"`
+	Token         string `gorm:"uniqueIndex;not null"`
+	Filename      string `gorm:"not null"`
+	StorageKey    string `gorm:"not null"`
+	ContentType   string `gorm:"not null"`
+	Size          int64  `gorm:"not null"`
+	CreatedAt     time.Time
+}
+
+// LiveEvent is an event published to the live update streams. Events go
+// through the database so every instance can deliver them to its
+// subscribers.
+type LiveEvent struct {
+	ID        uint      `gorm:"primaryKey"`
+	Topic     string    `gorm:"not null"`
+	Type      string    `gorm:"not null"`
+	Data      string    `gorm:"not null"`
+	CreatedAt time.Time `gorm:"index"`
+}
+
 type Slot struct {
 	ID            uint      `gorm:"primaryKey"`
 	BookingLinkID uint      `gorm:"index;not null;default:0"`
@@ -149,28 +288,60 @@ type Slot struct {
 }
 
 type Booking struct {
-	ID            uint              `gorm:"primaryKey"`
-	BookingLinkID uint              `gorm:"index;not null;default:0"`
-	SlotID        uint              `gorm:"index;not null"`
-	GuestEmail    string            `gorm:"not null"`
+	ID            uint   `gorm:"primaryKey"`
+	BookingLinkID uint   `gorm:"index;not null;default:0"`
+	SlotID        uint   `gorm:"index;not null"`
+	GuestEmail    string `gorm:"not null"`
 	GuestName     string
 	CustomFields  map[string]string `gorm:"serializer:json"`
 	Status        BookingStatus     `gorm:"not null;default:1"`
 	ActionToken   string            `gorm:"uniqueIndex"`
+	VerifyToken   string            `gorm:"index"`
 	CalendarUID   string
+	MeetingLink   string
+	HostLink      string
 	CreatedAt     time.Time
 	UpdatedAt     time.Time
-	BookingLink   BookingLink       `gorm:"foreignKey:BookingLinkID"`
-	Slot          Slot              `gorm:"foreignKey:SlotID"`
+	BookingLink   BookingLink  `gorm:"foreignKey:BookingLinkID"`
+	Slot          Slot         `gorm:"foreignKey:SlotID"`
+	Attachments   []Attachment `gorm:"foreignKey:BookingID"`
+}
+
+// PollInvite is a participant invited to a poll through a personal link.
+type PollInvite struct {
+	ID         uint   `gorm:"primaryKey"`
+	PollID     uint   `gorm:"index;not null"`
+	Email      string `gorm:"not null"`
+	Name       string
+	Required   bool
+	Token      string `gorm:"uniqueIndex;not null"`
+	VoteID     *uint  `gorm:"index"`
+	VotedAt    *time.Time
+	RemindedAt *time.Time
+	CreatedAt  time.Time
+}
+
+// PollComment is a comment on a poll, or on one of its options.
+type PollComment struct {
+	ID          uint   `gorm:"primaryKey"`
+	PollID      uint   `gorm:"index;not null"`
+	OptionID    *uint  `gorm:"index"`
+	AuthorName  string `gorm:"not null"`
+	AuthorEmail string
+	Body        string `gorm:"not null"`
+	Hidden      bool
+	CreatedAt   time.Time
 }
 
 type Vote struct {
-	ID           uint                      `gorm:"primaryKey"`
-	PollID       uint                      `gorm:"index;not null;default:0"`
+	ID           uint `gorm:"primaryKey"`
+	PollID       uint `gorm:"index;not null;default:0"`
 	GuestEmail   string
 	GuestName    string
 	Responses    map[uint]VoteResponseType `gorm:"serializer:json;not null"`
 	CustomFields map[string]string         `gorm:"serializer:json"`
+	EditToken    string                    `gorm:"uniqueIndex"`
 	CreatedAt    time.Time
-	Poll         Poll                      `gorm:"foreignKey:PollID"`
+	UpdatedAt    time.Time
+	Poll         Poll `gorm:"foreignKey:PollID"`
 }
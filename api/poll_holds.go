@@ -0,0 +1,92 @@
+// api/poll_holds.go
+package api
+
+import (
+	"context"
+	"log"
+	"time"
+)
+
+// holdPollOptions creates tentative calendar events for options of poll
+// that aren't held yet. Nothing happens unless the poll holds its times and
+// is active without a winner. Options participants
+// added without approval are never held.
+func (h *Handler) holdPollOptions(ctx context.Context, poll *Poll, options []PollOption) {
+	if h.caldav == nil || !poll.HoldTimes || poll.Status != LinkStatusActive || poll.WinnerOptionID != nil {
+		return
+	}
+
+	for i := range options {
+		option := &options[i]
+		if option.HoldUID != "" || option.Unapproved {
+			continue
+		}
+		uid, err := h.caldav.CreatePollHold(ctx, poll.UserID, poll, option)
+		if err != nil {
+			log.Printf("[WARN] Failed to create hold for poll option %d: %v", option.ID, err)
+			continue
+		}
+		option.HoldUID = uid
+		h.db.Model(option).Update("hold_uid", uid)
+	}
+}
+
+// releasePollHolds removes the calendar holds of options, except the one of
+// the option with ID keep.
+func (h *Handler) releasePollHolds(ctx context.Context, poll *Poll, options []PollOption, keep uint) {
+	for i := range options {
+		option := &options[i]
+		if option.HoldUID == "" || option.ID == keep {
+			continue
+		}
+		if h.caldav != nil {
+			if err := h.caldav.DeleteEvent(ctx, poll.UserID, option.HoldUID); err != nil {
+				log.Printf("[WARN] Failed to remove hold for poll option %d: %v", option.ID, err)
+			}
+		}
+		option.HoldUID = ""
+		h.db.Model(option).Update("hold_uid", "")
+	}
+}
+
+// syncPollHolds holds the options of poll while it is active and holds its
+// times, and releases them otherwise. Holds of polls with a winner are left
+// to announceWinner.
+func (h *Handler) syncPollHolds(ctx context.Context, poll *Poll) {
+	// The winner may have been picked concurrently
+	var current Poll
+	if err := h.db.Select("id", "winner_option_id").First(&current, poll.ID).Error; err != nil || current.WinnerOptionID != nil {
+		return
+	}
+
+	var options []PollOption
+	if err := h.db.Where("poll_id = ?", poll.ID).Find(&options).Error; err != nil {
+		log.Printf("[WARN] Failed to load options for poll %d: %v", poll.ID, err)
+		return
+	}
+	if poll.HoldTimes && poll.Status == LinkStatusActive {
+		h.holdPollOptions(ctx, poll, options)
+	} else {
+		h.releasePollHolds(ctx, poll, options, 0)
+	}
+}
+
+// pollHoldPeriods returns the options of the user's active polls that hold
+// their times and overlap start and end. Held times are busy for booking links,
+// except for options participants added without approval.
+func (h *Handler) pollHoldPeriods(userID uint, start, end time.Time) []TimePeriod {
+	var options []PollOption
+	if err := h.db.Joins("JOIN polls ON polls.id = poll_options.poll_id").
+		Where("polls.user_id = ? AND polls.hold_times = ? AND polls.status = ? AND polls.winner_option_id IS NULL", userID, true, LinkStatusActive).
+		Where("(poll_options.unapproved IS NULL OR poll_options.unapproved = ?) AND poll_options.start_time < ? AND poll_options.end_time > ?", false, end.UTC(), start.UTC()).
+		Find(&options).Error; err != nil {
+		log.Printf("[WARN] Failed to load poll holds for user %d: %v", userID, err)
+		return nil
+	}
+
+	periods := make([]TimePeriod, len(options))
+	for i, opt := range options {
+		periods[i] = TimePeriod{Start: opt.StartTime, End: opt.EndTime}
+	}
+	return periods
+}
@@ -0,0 +1,93 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/emersion/go-ical"
+
+	gen "github.com/kolaente/meet-mesh/api/gen"
+)
+
+func TestPollHoldsBlockBookings(t *testing.T) {
+	h := newTestHandler(t)
+	ctx := context.Background()
+
+	// Monday
+	day := time.Date(2030, 1, 7, 0, 0, 0, 0, time.UTC)
+
+	link := BookingLink{UserID: 1, Slug: "book", Name: "Book", Status: LinkStatusActive, SlotDurationMinutes: 60, AvailabilityRules: []AvailabilityRule{
+		{DaysOfWeek: []int{1}, StartTime: "09:00", EndTime: "12:00"},
+	}}
+	h.db.Create(&link)
+	poll := Poll{UserID: 1, Slug: "held", Name: "Held", Status: LinkStatusActive, HoldTimes: true}
+	h.db.Create(&poll)
+	option := PollOption{PollID: poll.ID, Type: SlotTypeTime, StartTime: day.Add(10 * time.Hour), EndTime: day.Add(11 * time.Hour)}
+	h.db.Create(&option)
+
+	availability := func() int {
+		t.Helper()
+		res, err := h.GetBookingAvailability(ctx, gen.GetBookingAvailabilityParams{Slug: link.Slug, Start: day, End: day.AddDate(0, 0, 1)})
+		if err != nil {
+			t.Fatalf("GetBookingAvailability failed: %v", err)
+		}
+		return len(res.Slots)
+	}
+
+	if got := availability(); got != 2 {
+		t.Errorf("expected held time to be unavailable, got %d slots", got)
+	}
+
+	res, err := h.CreateBooking(ctx, &gen.CreateBookingReq{
+		GuestEmail: "guest@example.com",
+		StartTime:  option.StartTime,
+		EndTime:    option.EndTime,
+	}, gen.CreateBookingParams{Slug: link.Slug})
+	if err != nil {
+		t.Fatalf("CreateBooking failed: %v", err)
+	}
+	if _, ok := res.(*gen.Error); !ok {
+		t.Errorf("expected booking a held time to fail, got %#v", res)
+	}
+
+	// Holds end when the poll closes without a winner
+	h.db.Model(&poll).Update("status", LinkStatusClosed)
+	if got := availability(); got != 3 {
+		t.Errorf("expected all slots after the poll closed, got %d", got)
+	}
+
+	// Holds end once a winner is picked
+	h.db.Model(&poll).Updates(map[string]any{"status": LinkStatusActive, "winner_option_id": option.ID})
+	if got := availability(); got != 3 {
+		t.Errorf("expected all slots after the winner was picked, got %d", got)
+	}
+}
+
+func TestNewPollHoldCalendar(t *testing.T) {
+	poll := &Poll{Name: "Team Offsite"}
+	option := &PollOption{
+		Type:      SlotTypeTime,
+		StartTime: time.Date(2026, 2, 15, 14, 0, 0, 0, time.UTC),
+		EndTime:   time.Date(2026, 2, 15, 15, 0, 0, 0, time.UTC),
+	}
+
+	var buf bytes.Buffer
+	if err := ical.NewEncoder(&buf).Encode(newPollHoldCalendar("hold-1", poll, option)); err != nil {
+		t.Fatalf("failed to encode hold: %v", err)
+	}
+
+	for _, check := range []string{
+		"UID:hold-1",
+		"STATUS:TENTATIVE",
+		"TRANSP:OPAQUE",
+		"SUMMARY:Hold: Team Offsite",
+		"DTSTART:20260215T140000Z",
+	} {
+		if !strings.Contains(buf.String(), check) {
+			t.Errorf("hold missing %q", check)
+		}
+	}
+}
@@ -0,0 +1,110 @@
+// api/poll_patterns.go
+package api
+
+import (
+	"sort"
+	"time"
+)
+
+// maxPatternOptions limits how many options a single pattern may generate.
+const maxPatternOptions = 500
+
+// optionPattern describes recurring poll options. Times are wall-clock times
+// in Location, so they stay the same across DST changes. Full-day and
+// multi-day options are dates, stored at midnight UTC like single options.
+type optionPattern struct {
+	Type       SlotType
+	StartDate  time.Time
+	EndDate    time.Time
+	DaysOfWeek []int
+	Times      []string
+	Duration   time.Duration
+	SpanDays   int
+	Location   *time.Location
+}
+
+// generate returns the options of the pattern in chronological order. Every
+// matching day between the start and end date gets one option per time, or
+// a single full-day or multi-day option. If the pattern is invalid, it
+// returns why.
+func (p optionPattern) generate() ([]PollOption, string) {
+	loc := p.Location
+	if loc == nil {
+		loc = time.UTC
+	}
+	start := time.Date(p.StartDate.Year(), p.StartDate.Month(), p.StartDate.Day(), 0, 0, 0, 0, time.UTC)
+	end := time.Date(p.EndDate.Year(), p.EndDate.Month(), p.EndDate.Day(), 0, 0, 0, 0, time.UTC)
+	if end.Before(start) {
+		return nil, "End date must not be before start date"
+	}
+	if end.Sub(start) > maxSuggestionRange {
+		return nil, "Date range must not exceed 90 days"
+	}
+
+	var offsets []time.Duration
+	switch p.Type {
+	case SlotTypeTime:
+		if len(p.Times) == 0 {
+			return nil, "At least one time is required"
+		}
+		if p.Duration <= 0 {
+			return nil, "Duration is required for time options"
+		}
+		for _, value := range p.Times {
+			t, err := time.Parse("15:04", value)
+			if err != nil {
+				return nil, "Invalid time: " + value
+			}
+			offsets = append(offsets, time.Duration(t.Hour())*time.Hour+time.Duration(t.Minute())*time.Minute)
+		}
+		sort.Slice(offsets, func(i, j int) bool { return offsets[i] < offsets[j] })
+	case SlotTypeFullDay:
+	case SlotTypeMultiDay:
+		if p.SpanDays < 2 {
+			return nil, "Multi-day options must span at least 2 days"
+		}
+	default:
+		return nil, "Invalid option type"
+	}
+
+	var options []PollOption
+	for day := start; !day.After(end); day = day.AddDate(0, 0, 1) {
+		if len(p.DaysOfWeek) > 0 && !containsDay(p.DaysOfWeek, int(day.Weekday())) {
+			continue
+		}
+
+		switch p.Type {
+		case SlotTypeTime:
+			for i, offset := range offsets {
+				// The same time twice yields the same option
+				if i > 0 && offset == offsets[i-1] {
+					continue
+				}
+				startTime := time.Date(day.Year(), day.Month(), day.Day(), int(offset/time.Hour), int(offset%time.Hour/time.Minute), 0, 0, loc).UTC()
+				options = append(options, PollOption{
+					Type:      SlotTypeTime,
+					StartTime: startTime,
+					EndTime:   startTime.Add(p.Duration),
+				})
+			}
+		case SlotTypeFullDay:
+			options = append(options, PollOption{
+				Type:      SlotTypeFullDay,
+				StartTime: day,
+				EndTime:   day.Add(24*time.Hour - time.Second),
+			})
+		case SlotTypeMultiDay:
+			options = append(options, PollOption{
+				Type:      SlotTypeMultiDay,
+				StartTime: day,
+				EndTime:   day.AddDate(0, 0, p.SpanDays).Add(-time.Second),
+			})
+		}
+
+		if len(options) > maxPatternOptions {
+			return nil, "The pattern generates too many options"
+		}
+	}
+
+	return options, ""
+}
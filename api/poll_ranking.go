@@ -0,0 +1,168 @@
+// api/poll_ranking.go
+package api
+
+import (
+	"sort"
+
+	"gorm.io/gorm"
+
+	gen "github.com/kolaente/meet-mesh/api/gen"
+)
+
+var defaultPollScoring = PollScoring{YesPoints: 2, MaybePoints: 1}
+
+// pollScoring returns the scoring of poll, falling back to the default.
+func pollScoring(poll *Poll) PollScoring {
+	if poll.Scoring == nil {
+		return defaultPollScoring
+	}
+	return *poll.Scoring
+}
+
+// OptionRank is a poll option with its vote counts and score.
+type OptionRank struct {
+	Option     PollOption
+	Yes        int
+	Maybe      int
+	No         int
+	Score      int
+	RequiredNo int
+}
+
+// rankPollOptions scores every option and sorts them from best to worst.
+// requiredVotes holds the IDs of votes cast by required participants.
+//
+// Options a required participant can't attend come last. Ties are broken by
+// score, then by more yes and fewer no answers, then by the earlier option.
+func rankPollOptions(options []PollOption, votes []Vote, scoring PollScoring, requiredVotes map[uint]bool) []OptionRank {
+	ranks := make([]OptionRank, len(options))
+	index := make(map[uint]int, len(options))
+	for i, opt := range options {
+		ranks[i].Option = opt
+		index[opt.ID] = i
+	}
+
+	for _, vote := range votes {
+		for optionID, response := range vote.Responses {
+			i, ok := index[optionID]
+			if !ok {
+				continue
+			}
+			switch response {
+			case VoteResponseYes:
+				ranks[i].Yes++
+				ranks[i].Score += scoring.YesPoints
+			case VoteResponseMaybe:
+				ranks[i].Maybe++
+				ranks[i].Score += scoring.MaybePoints
+			case VoteResponseNo:
+				ranks[i].No++
+				if requiredVotes[vote.ID] {
+					ranks[i].RequiredNo++
+				}
+			}
+		}
+	}
+
+	sort.SliceStable(ranks, func(i, j int) bool {
+		a, b := ranks[i], ranks[j]
+		switch {
+		case a.RequiredNo != b.RequiredNo:
+			return a.RequiredNo < b.RequiredNo
+		case a.Score != b.Score:
+			return a.Score > b.Score
+		case a.Yes != b.Yes:
+			return a.Yes > b.Yes
+		case a.No != b.No:
+			return a.No < b.No
+		case !a.Option.StartTime.Equal(b.Option.StartTime):
+			return a.Option.StartTime.Before(b.Option.StartTime)
+		default:
+			return a.Option.ID < b.Option.ID
+		}
+	})
+
+	return ranks
+}
+
+// recommendedOption returns the best ranked option, or nil while nobody is
+// available for any option.
+func recommendedOption(ranks []OptionRank) *PollOption {
+	if len(ranks) == 0 || ranks[0].Yes+ranks[0].Maybe == 0 {
+		return nil
+	}
+	return &ranks[0].Option
+}
+
+// allYesOption returns the best ranked option every voter said yes to.
+func allYesOption(ranks []OptionRank, voteCount int) *PollOption {
+	if voteCount == 0 {
+		return nil
+	}
+	for i := range ranks {
+		if ranks[i].Yes == voteCount {
+			return &ranks[i].Option
+		}
+	}
+	return nil
+}
+
+// rankPoll loads the votes of poll and ranks its options. poll.PollOptions
+// must be loaded.
+func (h *Handler) rankPoll(poll *Poll) ([]OptionRank, []Vote, error) {
+	return loadPollRanking(h.db, poll)
+}
+
+// loadPollRanking is rankPoll for callers without a Handler.
+func loadPollRanking(db *gorm.DB, poll *Poll) ([]OptionRank, []Vote, error) {
+	var votes []Vote
+	if err := db.Where("poll_id = ?", poll.ID).Find(&votes).Error; err != nil {
+		return nil, nil, err
+	}
+
+	var requiredVoteIDs []uint
+	if err := db.Model(&PollInvite{}).
+		Where("poll_id = ? AND required = ? AND vote_id IS NOT NULL", poll.ID, true).
+		Pluck("vote_id", &requiredVoteIDs).Error; err != nil {
+		return nil, nil, err
+	}
+	requiredVotes := make(map[uint]bool, len(requiredVoteIDs))
+	for _, id := range requiredVoteIDs {
+		requiredVotes[id] = true
+	}
+
+	return rankPollOptions(poll.PollOptions, votes, pollScoring(poll), requiredVotes), votes, nil
+}
+
+func mapOptionRanksToGen(ranks []OptionRank) []gen.VoteTally {
+	result := make([]gen.VoteTally, len(ranks))
+	for i, r := range ranks {
+		result[i] = gen.VoteTally{
+			OptionID:        int(r.Option.ID),
+			YesCount:        r.Yes,
+			NoCount:         r.No,
+			MaybeCount:      r.Maybe,
+			Score:           gen.NewOptInt(r.Score),
+			RequiredNoCount: gen.NewOptInt(r.RequiredNo),
+		}
+	}
+	return result
+}
+
+func mapPollScoringToGen(poll *Poll) gen.OptPollScoring {
+	scoring := pollScoring(poll)
+	return gen.NewOptPollScoring(gen.PollScoring{
+		YesPoints:   scoring.YesPoints,
+		MaybePoints: scoring.MaybePoints,
+	})
+}
+
+func mapPollScoringFromGen(scoring gen.OptPollScoring) *PollScoring {
+	if !scoring.Set {
+		return nil
+	}
+	return &PollScoring{
+		YesPoints:   scoring.Value.YesPoints,
+		MaybePoints: scoring.Value.MaybePoints,
+	}
+}
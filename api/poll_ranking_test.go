@@ -0,0 +1,148 @@
+package api
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	gen "github.com/kolaente/meet-mesh/api/gen"
+)
+
+func TestRankPollOptions(t *testing.T) {
+	base := time.Date(2026, 3, 2, 10, 0, 0, 0, time.UTC)
+	options := []PollOption{
+		{ID: 1, StartTime: base},
+		{ID: 2, StartTime: base.Add(time.Hour)},
+		{ID: 3, StartTime: base.Add(2 * time.Hour)},
+		{ID: 4, StartTime: base.Add(-time.Hour)},
+	}
+	votes := []Vote{
+		{ID: 1, Responses: map[uint]VoteResponseType{1: VoteResponseYes, 2: VoteResponseYes, 3: VoteResponseYes, 4: VoteResponseMaybe}},
+		{ID: 2, Responses: map[uint]VoteResponseType{1: VoteResponseMaybe, 2: VoteResponseYes, 3: VoteResponseNo, 4: VoteResponseYes}},
+		{ID: 3, Responses: map[uint]VoteResponseType{1: VoteResponseYes, 2: VoteResponseNo, 3: VoteResponseYes, 4: VoteResponseMaybe}},
+	}
+
+	tests := []struct {
+		name     string
+		scoring  PollScoring
+		required map[uint]bool
+		want     []uint
+	}{
+		{
+			name:    "default scoring",
+			scoring: defaultPollScoring,
+			// 2, 3 and 4 are tied on score, 4 has fewer yes and 2 is
+			// earlier than 3
+			want: []uint{1, 2, 3, 4},
+		},
+		{
+			name:    "maybe counts as much as yes",
+			scoring: PollScoring{YesPoints: 1, MaybePoints: 1},
+			// 1 and 4 are tied on score, 1 has more yes
+			want: []uint{1, 4, 2, 3},
+		},
+		{
+			name:     "required participant ranks conflicts last",
+			scoring:  defaultPollScoring,
+			required: map[uint]bool{3: true},
+			want:     []uint{1, 3, 4, 2},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ranks := rankPollOptions(options, votes, tt.scoring, tt.required)
+			for i, id := range tt.want {
+				if ranks[i].Option.ID != id {
+					t.Fatalf("rank %d: got option %d, want %d (%+v)", i, ranks[i].Option.ID, id, ranks)
+				}
+			}
+			if got := recommendedOption(ranks); got == nil || got.ID != tt.want[0] {
+				t.Errorf("recommended option: got %v, want %d", got, tt.want[0])
+			}
+		})
+	}
+}
+
+func TestRecommendedOptionWithoutVotes(t *testing.T) {
+	ranks := rankPollOptions([]PollOption{{ID: 1}}, nil, defaultPollScoring, nil)
+	if got := recommendedOption(ranks); got != nil {
+		t.Errorf("expected no recommendation, got option %d", got.ID)
+	}
+}
+
+func TestAutoPickWhenAllYes(t *testing.T) {
+	h := newTestHandler(t)
+	ctx := context.Background()
+
+	poll := Poll{UserID: 1, Slug: "all-yes", Name: "All yes", Status: LinkStatusActive, AutoPickWhenAllYes: true}
+	h.db.Create(&poll)
+	options := []PollOption{
+		{PollID: poll.ID, Type: SlotTypeTime, StartTime: time.Now().Add(24 * time.Hour)},
+		{PollID: poll.ID, Type: SlotTypeTime, StartTime: time.Now().Add(48 * time.Hour)},
+	}
+	h.db.Create(&options)
+	invites := []PollInvite{
+		{PollID: poll.ID, Email: "a@example.com", Token: "a"},
+		{PollID: poll.ID, Email: "b@example.com", Token: "b"},
+	}
+	h.db.Create(&invites)
+
+	vote := func(token string, second VoteResponseType) {
+		t.Helper()
+		res, err := h.SubmitVote(ctx, &gen.SubmitVoteReq{
+			Responses: gen.SubmitVoteReqResponses{
+				"1": gen.VoteResponse(VoteResponseYes),
+				"2": gen.VoteResponse(second),
+			},
+			InviteToken: gen.NewOptString(token),
+		}, gen.SubmitVoteParams{Slug: poll.Slug})
+		if err != nil {
+			t.Fatalf("SubmitVote failed: %v", err)
+		}
+		if _, ok := res.(*gen.Vote); !ok {
+			t.Fatalf("expected vote, got %#v", res)
+		}
+	}
+
+	vote("a", VoteResponseYes)
+	h.db.First(&poll, poll.ID)
+	if poll.WinnerOptionID != nil {
+		t.Fatal("expected no winner before every invitee voted")
+	}
+
+	vote("b", VoteResponseNo)
+	h.db.First(&poll, poll.ID)
+	if poll.WinnerOptionID == nil || *poll.WinnerOptionID != options[0].ID {
+		t.Fatalf("expected option %d to win, got %v", options[0].ID, poll.WinnerOptionID)
+	}
+	if poll.Status != LinkStatusClosed {
+		t.Errorf("expected poll to be closed, got status %d", poll.Status)
+	}
+}
+
+func TestAutoPickOnClose(t *testing.T) {
+	h := newTestHandler(t)
+
+	past := time.Now().Add(-time.Hour).UTC()
+	poll := Poll{UserID: 1, Slug: "on-close", Name: "On close", Status: LinkStatusActive, Deadline: &past, AutoPickOnClose: true}
+	h.db.Create(&poll)
+	options := []PollOption{
+		{PollID: poll.ID, Type: SlotTypeTime, StartTime: time.Now().Add(24 * time.Hour)},
+		{PollID: poll.ID, Type: SlotTypeTime, StartTime: time.Now().Add(48 * time.Hour)},
+	}
+	h.db.Create(&options)
+	h.db.Create(&Vote{PollID: poll.ID, EditToken: "v", Responses: map[uint]VoteResponseType{
+		options[0].ID: VoteResponseMaybe,
+		options[1].ID: VoteResponseYes,
+	}})
+
+	if err := h.CloseExpiredPolls(context.Background()); err != nil {
+		t.Fatalf("CloseExpiredPolls failed: %v", err)
+	}
+
+	h.db.First(&poll, poll.ID)
+	if poll.WinnerOptionID == nil || *poll.WinnerOptionID != options[1].ID {
+		t.Errorf("expected option %d to win, got %v", options[1].ID, poll.WinnerOptionID)
+	}
+}
@@ -0,0 +1,90 @@
+// api/poll_signup.go
+package api
+
+import (
+	"fmt"
+
+	"gorm.io/gorm"
+
+	gen "github.com/kolaente/meet-mesh/api/gen"
+)
+
+// signupCounts counts the participants per option of a sign-up sheet. A
+// participant signs up for an option by answering yes.
+func signupCounts(votes []Vote, excludeVoteID uint) map[uint]int {
+	counts := make(map[uint]int)
+	for _, vote := range votes {
+		if vote.ID == excludeVoteID {
+			continue
+		}
+		for optionID, response := range vote.Responses {
+			if response == VoteResponseYes {
+				counts[optionID]++
+			}
+		}
+	}
+	return counts
+}
+
+// checkSignup validates the responses of a participant against the choice
+// limit of poll and the capacity of its options. It returns why the
+// responses are rejected, or an empty string.
+func checkSignup(poll *Poll, options []PollOption, votes []Vote, responses map[uint]VoteResponseType, excludeVoteID uint) string {
+	counts := signupCounts(votes, excludeVoteID)
+
+	chosen := 0
+	for _, opt := range options {
+		switch responses[opt.ID] {
+		case VoteResponseMaybe:
+			return "Sign-up sheets only accept yes or no"
+		case VoteResponseYes:
+			chosen++
+			if opt.Capacity > 0 && counts[opt.ID] >= opt.Capacity {
+				return fmt.Sprintf("The option on %s is already full", opt.StartTime.Format("Monday, January 2 at 3:04 PM"))
+			}
+		}
+	}
+
+	if poll.MaxChoices > 0 && chosen > poll.MaxChoices {
+		return fmt.Sprintf("You can choose at most %d options", poll.MaxChoices)
+	}
+	return ""
+}
+
+// saveSignupVote saves vote if its choices still fit into the sign-up sheet.
+// It returns why the vote was rejected, or an empty string.
+func (h *Handler) saveSignupVote(poll *Poll, vote *Vote) (string, error) {
+	var rejected string
+	err := h.db.Transaction(func(tx *gorm.DB) error {
+		// Writing to the poll first takes the lock, so concurrent sign-ups
+		// see each other's votes
+		if err := tx.Exec("UPDATE polls SET mode = mode WHERE id = ?", poll.ID).Error; err != nil {
+			return err
+		}
+
+		var options []PollOption
+		if err := tx.Where("poll_id = ?", poll.ID).Find(&options).Error; err != nil {
+			return err
+		}
+		var votes []Vote
+		if err := tx.Where("poll_id = ?", poll.ID).Find(&votes).Error; err != nil {
+			return err
+		}
+
+		if rejected = checkSignup(poll, options, votes, vote.Responses, vote.ID); rejected != "" {
+			return nil
+		}
+		return tx.Save(vote).Error
+	})
+	return rejected, err
+}
+
+// applySignupCounts adds the number of participants to the options of a
+// sign-up sheet.
+func applySignupCounts(result []gen.PollOption, options []PollOption, votes []Vote) {
+	counts := signupCounts(votes, 0)
+	for i, opt := range options {
+		result[i].Signups = gen.NewOptInt(counts[opt.ID])
+		result[i].Full = gen.NewOptBool(opt.Capacity > 0 && counts[opt.ID] >= opt.Capacity)
+	}
+}
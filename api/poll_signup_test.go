@@ -0,0 +1,105 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	gen "github.com/kolaente/meet-mesh/api/gen"
+)
+
+func TestSignupSheet(t *testing.T) {
+	h := newTestHandler(t)
+	ctx := context.Background()
+
+	poll := Poll{UserID: 1, Slug: "signup", Name: "Sign-up", Status: LinkStatusActive, Mode: PollModeSignup, MaxChoices: 1}
+	h.db.Create(&poll)
+	start := time.Now().Add(24 * time.Hour).UTC()
+	options := []PollOption{
+		{PollID: poll.ID, Type: SlotTypeTime, StartTime: start, EndTime: start.Add(time.Hour), Capacity: 1},
+		{PollID: poll.ID, Type: SlotTypeTime, StartTime: start.Add(time.Hour), EndTime: start.Add(2 * time.Hour)},
+	}
+	h.db.Create(&options)
+	first, second := fmt.Sprint(options[0].ID), fmt.Sprint(options[1].ID)
+
+	submit := func(responses gen.SubmitVoteReqResponses) gen.SubmitVoteRes {
+		t.Helper()
+		res, err := h.SubmitVote(ctx, &gen.SubmitVoteReq{Responses: responses}, gen.SubmitVoteParams{Slug: poll.Slug})
+		if err != nil {
+			t.Fatalf("SubmitVote failed: %v", err)
+		}
+		return res
+	}
+
+	if res := submit(gen.SubmitVoteReqResponses{first: gen.VoteResponse(VoteResponseYes), second: gen.VoteResponse(VoteResponseYes)}); !isError(res) {
+		t.Errorf("expected too many choices to be rejected, got %#v", res)
+	}
+	if res := submit(gen.SubmitVoteReqResponses{first: gen.VoteResponse(VoteResponseMaybe)}); !isError(res) {
+		t.Errorf("expected maybe to be rejected, got %#v", res)
+	}
+
+	res := submit(gen.SubmitVoteReqResponses{first: gen.VoteResponse(VoteResponseYes)})
+	vote, ok := res.(*gen.Vote)
+	if !ok {
+		t.Fatalf("expected sign-up to succeed, got %#v", res)
+	}
+	if res := submit(gen.SubmitVoteReqResponses{first: gen.VoteResponse(VoteResponseYes)}); !isError(res) {
+		t.Errorf("expected full option to be rejected, got %#v", res)
+	}
+
+	public, err := h.GetPublicPoll(ctx, gen.GetPublicPollParams{Slug: poll.Slug})
+	if err != nil {
+		t.Fatalf("GetPublicPoll failed: %v", err)
+	}
+	opts := public.(*gen.GetPublicPollOK).Options
+	if !opts[0].Full.Value || opts[0].Signups.Value != 1 || opts[1].Full.Value {
+		t.Errorf("unexpected option status: %+v", opts)
+	}
+
+	// Keeping your own spot when editing the vote is fine
+	updated, err := h.UpdateOwnVote(ctx, &gen.UpdateOwnVoteReq{
+		Responses: gen.UpdateOwnVoteReqResponses{first: gen.VoteResponse(VoteResponseYes)},
+	}, gen.UpdateOwnVoteParams{Slug: poll.Slug, Token: vote.EditToken.Value})
+	if err != nil {
+		t.Fatalf("UpdateOwnVote failed: %v", err)
+	}
+	if _, ok := updated.(*gen.Vote); !ok {
+		t.Errorf("expected update to succeed, got %#v", updated)
+	}
+}
+
+func TestSignupSheetConcurrentVotes(t *testing.T) {
+	h := newTestHandler(t)
+	ctx := context.Background()
+
+	poll := Poll{UserID: 1, Slug: "rush", Name: "Rush", Status: LinkStatusActive, Mode: PollModeSignup}
+	h.db.Create(&poll)
+	start := time.Now().Add(24 * time.Hour).UTC()
+	option := PollOption{PollID: poll.ID, Type: SlotTypeTime, StartTime: start, EndTime: start.Add(time.Hour), Capacity: 3}
+	h.db.Create(&option)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, _ = h.SubmitVote(ctx, &gen.SubmitVoteReq{
+				Responses: gen.SubmitVoteReqResponses{fmt.Sprint(option.ID): gen.VoteResponse(VoteResponseYes)},
+			}, gen.SubmitVoteParams{Slug: poll.Slug})
+		}()
+	}
+	wg.Wait()
+
+	var count int64
+	h.db.Model(&Vote{}).Where("poll_id = ?", poll.ID).Count(&count)
+	if count != 3 {
+		t.Errorf("expected 3 sign-ups, got %d", count)
+	}
+}
+
+func isError(res any) bool {
+	_, ok := res.(*gen.Error)
+	return ok
+}
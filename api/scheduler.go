@@ -0,0 +1,50 @@
+// api/scheduler.go
+package api
+
+import (
+	"context"
+	"log"
+	"time"
+)
+
+// Scheduler runs background jobs periodically until its context is canceled.
+type Scheduler struct {
+	jobs []scheduledJob
+}
+
+type scheduledJob struct {
+	name     string
+	interval time.Duration
+	run      func(ctx context.Context) error
+}
+
+func NewScheduler() *Scheduler {
+	return &Scheduler{}
+}
+
+// Every registers run to be called every interval. Jobs must be registered
+// before Start is called.
+func (s *Scheduler) Every(name string, interval time.Duration, run func(ctx context.Context) error) {
+	s.jobs = append(s.jobs, scheduledJob{name: name, interval: interval, run: run})
+}
+
+// Start runs every job once right away and then on its interval, each in its
+// own goroutine. Errors are logged and don't stop the job.
+func (s *Scheduler) Start(ctx context.Context) {
+	for _, job := range s.jobs {
+		go func(job scheduledJob) {
+			ticker := time.NewTicker(job.interval)
+			defer ticker.Stop()
+			for {
+				if err := job.run(ctx); err != nil {
+					log.Printf("[WARN] Job %s failed: %v", job.name, err)
+				}
+				select {
+				case <-ctx.Done():
+					return
+				case <-ticker.C:
+				}
+			}
+		}(job)
+	}
+}
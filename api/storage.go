@@ -0,0 +1,227 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+)
+
+const (
+	StorageBackendLocal = "local"
+	StorageBackendS3    = "s3"
+)
+
+// ErrFileNotFound is returned by FileStorage.Get for unknown keys.
+var ErrFileNotFound = errors.New("file not found")
+
+// FileStorage stores uploaded files such as avatars.
+// Keys are plain file names without path separators.
+type FileStorage interface {
+	Put(ctx context.Context, key string, data []byte, contentType string) error
+	Get(ctx context.Context, key string) (*StoredFile, error)
+	Delete(ctx context.Context, key string) error
+}
+
+// StoredFile is a file read from a FileStorage. Callers must close Body.
+type StoredFile struct {
+	Body        io.ReadSeekCloser
+	Size        int64
+	ContentType string
+	ModTime     time.Time
+	ETag        string
+}
+
+// NewFileStorage returns the storage backend configured in cfg. localPath is
+// the directory used by the local backend, prefix the key prefix used by the
+// S3 backend, so different kinds of files can share one bucket.
+func NewFileStorage(cfg *StorageConfig, localPath, prefix string) (FileStorage, error) {
+	switch cfg.Backend {
+	case "", StorageBackendLocal:
+		return NewLocalFileStorage(localPath)
+	case StorageBackendS3:
+		return NewS3FileStorage(&cfg.S3, prefix)
+	default:
+		return nil, fmt.Errorf("unknown storage backend %q", cfg.Backend)
+	}
+}
+
+// validStorageKey reports whether key is a plain file name.
+func validStorageKey(key string) bool {
+	return key != "" && key != "." && key != ".." && !strings.ContainsAny(key, `/\`)
+}
+
+// LocalFileStorage stores files in a directory on the local disk.
+type LocalFileStorage struct {
+	dir string
+}
+
+func NewLocalFileStorage(dir string) (*LocalFileStorage, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+	return &LocalFileStorage{dir: dir}, nil
+}
+
+func (s *LocalFileStorage) Put(ctx context.Context, key string, data []byte, contentType string) error {
+	if !validStorageKey(key) {
+		return fmt.Errorf("invalid storage key %q", key)
+	}
+
+	// Write to a temporary file first so readers never see partial files
+	tmp, err := os.CreateTemp(s.dir, ".upload-*")
+	if err != nil {
+		return err
+	}
+	if _, err := tmp.Write(data); err != nil {
+		_ = tmp.Close()
+		_ = os.Remove(tmp.Name())
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		_ = os.Remove(tmp.Name())
+		return err
+	}
+	if err := os.Chmod(tmp.Name(), 0644); err != nil {
+		_ = os.Remove(tmp.Name())
+		return err
+	}
+	return os.Rename(tmp.Name(), filepath.Join(s.dir, key))
+}
+
+func (s *LocalFileStorage) Get(ctx context.Context, key string) (*StoredFile, error) {
+	if !validStorageKey(key) {
+		return nil, ErrFileNotFound
+	}
+
+	f, err := os.Open(filepath.Join(s.dir, key))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, ErrFileNotFound
+		}
+		return nil, err
+	}
+	info, err := f.Stat()
+	if err != nil {
+		_ = f.Close()
+		return nil, err
+	}
+
+	return &StoredFile{
+		Body:    f,
+		Size:    info.Size(),
+		ModTime: info.ModTime(),
+		ETag:    fmt.Sprintf(`"%x-%x"`, info.ModTime().UnixNano(), info.Size()),
+	}, nil
+}
+
+func (s *LocalFileStorage) Delete(ctx context.Context, key string) error {
+	if !validStorageKey(key) {
+		return nil
+	}
+	err := os.Remove(filepath.Join(s.dir, key))
+	if os.IsNotExist(err) {
+		return nil
+	}
+	return err
+}
+
+// S3FileStorage stores files in an S3-compatible bucket (AWS S3, MinIO, ...).
+type S3FileStorage struct {
+	client *minio.Client
+	bucket string
+	prefix string
+}
+
+func NewS3FileStorage(cfg *S3Config, prefix string) (*S3FileStorage, error) {
+	if cfg.Endpoint == "" || cfg.Bucket == "" {
+		return nil, errors.New("s3 storage requires endpoint and bucket")
+	}
+
+	client, err := minio.New(cfg.Endpoint, &minio.Options{
+		Creds:        credentials.NewStaticV4(cfg.AccessKeyID, cfg.SecretAccessKey, ""),
+		Secure:       cfg.UseSSL,
+		Region:       cfg.Region,
+		BucketLookup: s3BucketLookup(cfg.PathStyle),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if cfg.Prefix != "" {
+		prefix = strings.Trim(cfg.Prefix, "/") + "/" + prefix
+	}
+
+	return &S3FileStorage{
+		client: client,
+		bucket: cfg.Bucket,
+		prefix: prefix,
+	}, nil
+}
+
+func s3BucketLookup(pathStyle bool) minio.BucketLookupType {
+	if pathStyle {
+		return minio.BucketLookupPath
+	}
+	return minio.BucketLookupAuto
+}
+
+func (s *S3FileStorage) objectName(key string) string {
+	if s.prefix == "" {
+		return key
+	}
+	return s.prefix + "/" + key
+}
+
+func (s *S3FileStorage) Put(ctx context.Context, key string, data []byte, contentType string) error {
+	if !validStorageKey(key) {
+		return fmt.Errorf("invalid storage key %q", key)
+	}
+	_, err := s.client.PutObject(ctx, s.bucket, s.objectName(key), bytes.NewReader(data), int64(len(data)), minio.PutObjectOptions{
+		ContentType:          contentType,
+		DisableContentSha256: true,
+	})
+	return err
+}
+
+func (s *S3FileStorage) Get(ctx context.Context, key string) (*StoredFile, error) {
+	if !validStorageKey(key) {
+		return nil, ErrFileNotFound
+	}
+
+	obj, err := s.client.GetObject(ctx, s.bucket, s.objectName(key), minio.GetObjectOptions{})
+	if err != nil {
+		return nil, err
+	}
+	info, err := obj.Stat()
+	if err != nil {
+		_ = obj.Close()
+		if minio.ToErrorResponse(err).Code == "NoSuchKey" {
+			return nil, ErrFileNotFound
+		}
+		return nil, err
+	}
+
+	return &StoredFile{
+		Body:        obj,
+		Size:        info.Size,
+		ContentType: info.ContentType,
+		ModTime:     info.LastModified,
+		ETag:        `"` + strings.Trim(info.ETag, `"`) + `"`,
+	}, nil
+}
+
+func (s *S3FileStorage) Delete(ctx context.Context, key string) error {
+	if !validStorageKey(key) {
+		return nil
+	}
+	return s.client.RemoveObject(ctx, s.bucket, s.objectName(key), minio.RemoveObjectOptions{})
+}
@@ -0,0 +1,175 @@
+package api
+
+import (
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// fakeS3 is a minimal in-memory stand-in for an S3-compatible server
+// supporting path-style PUT, GET, HEAD and DELETE of objects.
+type fakeS3 struct {
+	mu      sync.Mutex
+	objects map[string][]byte
+	types   map[string]string
+}
+
+func newFakeS3() *fakeS3 {
+	return &fakeS3{objects: map[string][]byte{}, types: map[string]string{}}
+}
+
+func (f *fakeS3) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	key := r.URL.Path
+	switch r.Method {
+	case http.MethodPut:
+		data, _ := io.ReadAll(r.Body)
+		f.objects[key] = data
+		f.types[key] = r.Header.Get("Content-Type")
+		sum := md5.Sum(data)
+		w.Header().Set("ETag", `"`+hex.EncodeToString(sum[:])+`"`)
+	case http.MethodGet, http.MethodHead:
+		data, ok := f.objects[key]
+		if !ok {
+			w.Header().Set("Content-Type", "application/xml")
+			w.WriteHeader(http.StatusNotFound)
+			if r.Method == http.MethodGet {
+				_, _ = io.WriteString(w, `<Error><Code>NoSuchKey</Code><Message>not found</Message></Error>`)
+			}
+			return
+		}
+		sum := md5.Sum(data)
+		w.Header().Set("ETag", `"`+hex.EncodeToString(sum[:])+`"`)
+		w.Header().Set("Content-Type", f.types[key])
+		w.Header().Set("Content-Length", strconv.Itoa(len(data)))
+		w.Header().Set("Last-Modified", time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC).Format(http.TimeFormat))
+		if r.Method == http.MethodGet {
+			_, _ = w.Write(data)
+		}
+	case http.MethodDelete:
+		delete(f.objects, key)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+func testFileStorage(t *testing.T, storage FileStorage) {
+	ctx := context.Background()
+
+	if _, err := storage.Get(ctx, "missing.jpg"); !errors.Is(err, ErrFileNotFound) {
+		t.Fatalf("expected ErrFileNotFound, got %v", err)
+	}
+
+	if err := storage.Put(ctx, "avatar.jpg", []byte("jpeg data"), "image/jpeg"); err != nil {
+		t.Fatalf("Put failed: %v", err)
+	}
+
+	file, err := storage.Get(ctx, "avatar.jpg")
+	if err != nil {
+		t.Fatalf("Get failed: %v", err)
+	}
+	data, _ := io.ReadAll(file.Body)
+	_ = file.Body.Close()
+	if string(data) != "jpeg data" {
+		t.Errorf("got %q, want %q", data, "jpeg data")
+	}
+	if file.ETag == "" {
+		t.Error("expected an ETag")
+	}
+
+	if err := storage.Delete(ctx, "avatar.jpg"); err != nil {
+		t.Fatalf("Delete failed: %v", err)
+	}
+	if _, err := storage.Get(ctx, "avatar.jpg"); !errors.Is(err, ErrFileNotFound) {
+		t.Errorf("expected ErrFileNotFound after delete, got %v", err)
+	}
+
+	if err := storage.Put(ctx, "../escape.jpg", []byte("x"), "image/jpeg"); err == nil {
+		t.Error("expected error for key with path separator")
+	}
+}
+
+func TestLocalFileStorage(t *testing.T) {
+	storage, err := NewFileStorage(&StorageConfig{}, t.TempDir(), "avatars")
+	if err != nil {
+		t.Fatalf("NewFileStorage failed: %v", err)
+	}
+	testFileStorage(t, storage)
+}
+
+func TestS3FileStorage(t *testing.T) {
+	fake := newFakeS3()
+	server := httptest.NewServer(fake)
+	defer server.Close()
+
+	storage, err := NewFileStorage(&StorageConfig{
+		Backend: StorageBackendS3,
+		S3: S3Config{
+			Endpoint:        strings.TrimPrefix(server.URL, "http://"),
+			Bucket:          "meet-mesh",
+			Region:          "us-east-1",
+			AccessKeyID:     "access",
+			SecretAccessKey: "secret",
+			PathStyle:       true,
+		},
+	}, "", "avatars")
+	if err != nil {
+		t.Fatalf("NewFileStorage failed: %v", err)
+	}
+
+	testFileStorage(t, storage)
+
+	// Objects are stored below the prefix
+	if err := storage.Put(context.Background(), "a.jpg", []byte("x"), "image/jpeg"); err != nil {
+		t.Fatalf("Put failed: %v", err)
+	}
+	if _, ok := fake.objects["/meet-mesh/avatars/a.jpg"]; !ok {
+		t.Errorf("expected object at /meet-mesh/avatars/a.jpg, have %v", fake.objects)
+	}
+}
+
+func TestServeStoredFile(t *testing.T) {
+	storage, err := NewLocalFileStorage(t.TempDir())
+	if err != nil {
+		t.Fatalf("NewLocalFileStorage failed: %v", err)
+	}
+	if err := storage.Put(context.Background(), "a.jpg", []byte("jpeg data"), "image/jpeg"); err != nil {
+		t.Fatalf("Put failed: %v", err)
+	}
+
+	rec := httptest.NewRecorder()
+	serveStoredFile(rec, httptest.NewRequest(http.MethodGet, "/api/avatars/a.jpg", nil), storage, "a.jpg", "image/jpeg")
+	if rec.Code != http.StatusOK || rec.Body.String() != "jpeg data" {
+		t.Fatalf("unexpected response %d %q", rec.Code, rec.Body.String())
+	}
+	etag := rec.Header().Get("ETag")
+	if etag == "" || rec.Header().Get("Cache-Control") == "" {
+		t.Fatalf("missing cache headers: %v", rec.Header())
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/api/avatars/a.jpg", nil)
+	req.Header.Set("If-None-Match", etag)
+	rec = httptest.NewRecorder()
+	serveStoredFile(rec, req, storage, "a.jpg", "image/jpeg")
+	if rec.Code != http.StatusNotModified {
+		t.Errorf("expected 304 for matching ETag, got %d", rec.Code)
+	}
+
+	rec = httptest.NewRecorder()
+	serveStoredFile(rec, httptest.NewRequest(http.MethodGet, "/api/avatars/b.jpg", nil), storage, "b.jpg", "image/jpeg")
+	if rec.Code != http.StatusNotFound {
+		t.Errorf("expected 404 for missing file, got %d", rec.Code)
+	}
+}
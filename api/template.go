@@ -0,0 +1,256 @@
+package api
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"text/template"
+	"text/template/parse"
+	"time"
+)
+
+const maxTemplateOutput = 16 << 10 // 16KB
+
+// TemplateData holds the values exposed to event title and description templates.
+type TemplateData struct {
+	GuestName      string
+	GuestEmail     string
+	MeetingLink    string
+	LinkName       string
+	OrganizerName  string
+	OrganizerEmail string
+	Start          time.Time
+	End            time.Time
+	CustomFields   map[string]string
+}
+
+// newBookingTemplateData collects the template values for a booking.
+// booking.BookingLink is used for the link name and meeting link if it is loaded.
+func newBookingTemplateData(booking *Booking, slot *Slot, organizer *User) *TemplateData {
+	data := &TemplateData{}
+	if booking != nil {
+		data.GuestName = booking.GuestName
+		data.GuestEmail = booking.GuestEmail
+		data.CustomFields = booking.CustomFields
+		data.LinkName = booking.BookingLink.Name
+		data.MeetingLink = bookingMeetingLink(booking, &booking.BookingLink)
+	}
+	if slot != nil {
+		data.Start = slot.StartTime
+		data.End = slot.EndTime
+	}
+	if organizer != nil {
+		data.OrganizerName = organizer.Name
+		data.OrganizerEmail = organizer.Email
+	}
+	return data
+}
+
+// newPollTemplateData collects the template values for the winning option of a poll.
+func newPollTemplateData(poll *Poll, option *PollOption, organizer *User) *TemplateData {
+	data := &TemplateData{
+		LinkName: poll.Name,
+		Start:    option.StartTime,
+		End:      option.EndTime,
+	}
+	if organizer != nil {
+		data.OrganizerName = organizer.Name
+		data.OrganizerEmail = organizer.Email
+	}
+	return data
+}
+
+// bookingMeetingLink returns the meeting link generated for booking, or the
+// static meeting link of link for bookings made before links were generated.
+func bookingMeetingLink(booking *Booking, link *BookingLink) string {
+	if booking.MeetingLink != "" {
+		return booking.MeetingLink
+	}
+	return link.MeetingLink
+}
+
+// organizerMeetingLink returns the link the organizer joins the meeting of
+// booking with.
+func organizerMeetingLink(booking *Booking) string {
+	if booking.HostLink != "" {
+		return booking.HostLink
+	}
+	return booking.MeetingLink
+}
+
+// templateFuncs returns the functions available inside templates.
+// Every variable is exposed as a niladic function so both {{guest_name}}
+// and pipelines like {{format start_at "2006-01-02"}} work.
+func templateFuncs(data *TemplateData) template.FuncMap {
+	if data == nil {
+		data = &TemplateData{}
+	}
+	str := func(s string) func() string { return func() string { return s } }
+	tm := func(t time.Time) func() time.Time { return func() time.Time { return t } }
+
+	return template.FuncMap{
+		"guest_name":      str(data.GuestName),
+		"guest_email":     str(data.GuestEmail),
+		"meeting_link":    str(data.MeetingLink),
+		"link_name":       str(data.LinkName),
+		"organizer_name":  str(data.OrganizerName),
+		"organizer_email": str(data.OrganizerEmail),
+		"start_at":        tm(data.Start),
+		"end_at":          tm(data.End),
+		"start_date":      str(data.Start.Format("Monday, January 2, 2006")),
+		"start_time":      str(data.Start.Format("3:04 PM")),
+		"start_datetime":  str(data.Start.Format("Monday, January 2 at 3:04 PM")),
+		"start_iso":       str(data.Start.Format(time.RFC3339)),
+		"end_date":        str(data.End.Format("Monday, January 2, 2006")),
+		"end_time":        str(data.End.Format("3:04 PM")),
+		"end_datetime":    str(data.End.Format("Monday, January 2 at 3:04 PM")),
+		"end_iso":         str(data.End.Format(time.RFC3339)),
+		"duration_minutes": func() int {
+			return int(data.End.Sub(data.Start).Minutes())
+		},
+		"field": func(name string) string {
+			return data.CustomFields[name]
+		},
+		"format": func(t time.Time, layout string) string {
+			return t.Format(layout)
+		},
+		"upper": strings.ToUpper,
+		"lower": strings.ToLower,
+		"default": func(fallback, value string) string {
+			if value == "" {
+				return fallback
+			}
+			return value
+		},
+	}
+}
+
+// parseTemplate parses src and rejects constructs that are not allowed in
+// user-supplied templates (loops, sub-templates).
+func parseTemplate(src string, funcs template.FuncMap) (*template.Template, error) {
+	tmpl, err := template.New("event").Option("missingkey=zero").Funcs(funcs).Parse(src)
+	if err != nil {
+		return nil, err
+	}
+	for _, t := range tmpl.Templates() {
+		if t.Name() != "event" {
+			return nil, errors.New("defining templates is not allowed")
+		}
+		if t.Tree != nil {
+			if err := checkTemplateNode(t.Tree.Root); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return tmpl, nil
+}
+
+func checkTemplateNode(node parse.Node) error {
+	switch n := node.(type) {
+	case nil:
+		return nil
+	case *parse.ListNode:
+		if n == nil {
+			return nil
+		}
+		for _, child := range n.Nodes {
+			if err := checkTemplateNode(child); err != nil {
+				return err
+			}
+		}
+	case *parse.IfNode:
+		if err := checkTemplateNode(n.List); err != nil {
+			return err
+		}
+		return checkTemplateNode(n.ElseList)
+	case *parse.WithNode:
+		if err := checkTemplateNode(n.List); err != nil {
+			return err
+		}
+		return checkTemplateNode(n.ElseList)
+	case *parse.TextNode, *parse.ActionNode, *parse.CommentNode:
+		return nil
+	case *parse.RangeNode:
+		return errors.New("range is not allowed in templates")
+	case *parse.TemplateNode:
+		return errors.New("including templates is not allowed")
+	default:
+		return fmt.Errorf("unsupported template construct: %s", node)
+	}
+	return nil
+}
+
+// ValidateTemplate checks that src is a valid event template.
+func ValidateTemplate(src string) error {
+	_, err := parseTemplate(src, templateFuncs(nil))
+	return err
+}
+
+// ValidateEventTemplate checks the title and description templates of tmpl.
+func ValidateEventTemplate(tmpl *EventTemplate) error {
+	if tmpl == nil {
+		return nil
+	}
+	if err := ValidateTemplate(tmpl.TitleTemplate); err != nil {
+		return fmt.Errorf("invalid title template: %w", err)
+	}
+	if err := ValidateTemplate(tmpl.DescriptionTemplate); err != nil {
+		return fmt.Errorf("invalid description template: %w", err)
+	}
+	return nil
+}
+
+// limitedWriter fails once more than limit bytes have been written.
+type limitedWriter struct {
+	buf   bytes.Buffer
+	limit int
+}
+
+func (w *limitedWriter) Write(p []byte) (int, error) {
+	if w.buf.Len()+len(p) > w.limit {
+		return 0, errors.New("template output too large")
+	}
+	return w.buf.Write(p)
+}
+
+// RenderTemplate expands src with data.
+func RenderTemplate(src string, data *TemplateData) (string, error) {
+	tmpl, err := parseTemplate(src, templateFuncs(data))
+	if err != nil {
+		return "", err
+	}
+	w := &limitedWriter{limit: maxTemplateOutput}
+	if err := tmpl.Execute(w, nil); err != nil {
+		return "", err
+	}
+	return w.buf.String(), nil
+}
+
+// renderTemplateOrRaw expands src and falls back to the unexpanded source
+// if the template can't be rendered.
+func renderTemplateOrRaw(src string, data *TemplateData) string {
+	out, err := RenderTemplate(src, data)
+	if err != nil {
+		log.Printf("[WARN] Failed to render template: %v", err)
+		return src
+	}
+	return out
+}
+
+// renderEventTemplate returns the event title and description for tmpl.
+// The title defaults to "Meeting" if no title template is set.
+func renderEventTemplate(tmpl *EventTemplate, data *TemplateData) (title, description string) {
+	title = "Meeting"
+	if tmpl == nil {
+		return title, ""
+	}
+	if tmpl.TitleTemplate != "" {
+		title = renderTemplateOrRaw(tmpl.TitleTemplate, data)
+	}
+	if tmpl.DescriptionTemplate != "" {
+		description = renderTemplateOrRaw(tmpl.DescriptionTemplate, data)
+	}
+	return title, description
+}
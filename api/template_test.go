@@ -0,0 +1,70 @@
+package api
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRenderTemplate(t *testing.T) {
+	data := &TemplateData{
+		GuestName:     "John Doe",
+		GuestEmail:    "guest@example.com",
+		MeetingLink:   "https://meet.example.com/abc",
+		LinkName:      "Intro Call",
+		OrganizerName: "Jane",
+		Start:         time.Date(2026, 2, 15, 14, 0, 0, 0, time.UTC),
+		End:           time.Date(2026, 2, 15, 14, 30, 0, 0, time.UTC),
+		CustomFields:  map[string]string{"company": "Acme"},
+	}
+
+	tests := []struct {
+		template string
+		want     string
+	}{
+		{"Meeting with {{guest_name}}", "Meeting with John Doe"},
+		{"{{guest_email}} / {{meeting_link}}", "guest@example.com / https://meet.example.com/abc"},
+		{"{{link_name}} with {{organizer_name}}", "Intro Call with Jane"},
+		{"{{field \"company\"}}", "Acme"},
+		{"{{field \"missing\" | default \"n/a\"}}", "n/a"},
+		{"{{format start_at \"2006-01-02 15:04\"}}", "2026-02-15 14:00"},
+		{"{{start_time}}-{{end_time}} ({{duration_minutes}} min)", "2:00 PM-2:30 PM (30 min)"},
+		{"{{if meeting_link}}Join: {{meeting_link}}{{end}}", "Join: https://meet.example.com/abc"},
+		{"{{upper guest_name}}", "JOHN DOE"},
+	}
+
+	for _, tt := range tests {
+		got, err := RenderTemplate(tt.template, data)
+		if err != nil {
+			t.Errorf("RenderTemplate(%q) failed: %v", tt.template, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("RenderTemplate(%q) = %q, want %q", tt.template, got, tt.want)
+		}
+	}
+}
+
+func TestValidateTemplate(t *testing.T) {
+	valid := []string{
+		"",
+		"Meeting with {{guest_name}}",
+		"{{if field \"company\"}}{{field \"company\"}}{{else}}none{{end}}",
+	}
+	for _, tmpl := range valid {
+		if err := ValidateTemplate(tmpl); err != nil {
+			t.Errorf("ValidateTemplate(%q) returned error: %v", tmpl, err)
+		}
+	}
+
+	invalid := []string{
+		"{{guest_name",
+		"{{unknown_variable}}",
+		"{{range 1000000000}}x{{end}}",
+		"{{define \"x\"}}y{{end}}",
+	}
+	for _, tmpl := range invalid {
+		if err := ValidateTemplate(tmpl); err == nil {
+			t.Errorf("ValidateTemplate(%q) expected error", tmpl)
+		}
+	}
+}
@@ -142,6 +142,23 @@ export interface paths {
         patch?: never;
         trace?: never;
     };
+    "/meeting-providers": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        /** List enabled meeting link providers */
+        get: operations["listMeetingProviders"];
+        put?: never;
+        post?: never;
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
     "/booking-links": {
         parameters: {
             query?: never;
@@ -251,6 +268,46 @@ export interface paths {
         patch?: never;
         trace?: never;
     };
+    "/polls/{id}/options/suggest": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        get?: never;
+        put?: never;
+        /**
+         * Suggest poll options from the organizer's free time
+         * @description Runs the booking availability logic for the given range: slots within the availability rules that don't overlap busy times in the connected calendars. Times the poll already offers are skipped.
+         */
+        post: operations["suggestPollOptions"];
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/polls/{id}/options/generate": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        get?: never;
+        put?: never;
+        /**
+         * Generate poll options from a recurrence pattern
+         * @description Creates one option per matching day between start_date and end_date, for each of the given times. Full-day options cover the whole day, multi-day options span span_days days. Times are wall-clock times in time_zone, so they stay the same across daylight saving changes. Options the poll already offers are skipped. Without add, the options are only previewed.
+         */
+        post: operations["generatePollOptions"];
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
     "/polls/{id}/options/{optionId}": {
         parameters: {
             query?: never;
@@ -285,6 +342,161 @@ export interface paths {
         patch?: never;
         trace?: never;
     };
+    "/polls/{id}/invites": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        /** List invitees of a poll and whether they voted */
+        get: operations["listPollInvites"];
+        put?: never;
+        /** Invite participants and email them a personal link */
+        post: operations["addPollInvites"];
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/polls/{id}/invites/remind": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        get?: never;
+        put?: never;
+        /** Send a reminder to all invitees who haven't voted yet */
+        post: operations["remindPollInvites"];
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/polls/{id}/invites/{inviteId}": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        get?: never;
+        put?: never;
+        post?: never;
+        /** Remove an invitee from a poll */
+        delete: operations["deletePollInvite"];
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/polls/{id}/results": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        /** Get ranked results of a poll */
+        get: operations["getPollRanking"];
+        put?: never;
+        post?: never;
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/polls/{id}/comments": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        /** List all comments of a poll, including hidden ones */
+        get: operations["listPollComments"];
+        put?: never;
+        post?: never;
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/polls/{id}/comments/{commentId}": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        get?: never;
+        /** Hide or show a comment */
+        put: operations["moderatePollComment"];
+        post?: never;
+        /** Delete a comment */
+        delete: operations["deletePollComment"];
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/polls/{id}/proposals": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        /** List the option proposals waiting for approval */
+        get: operations["listPollProposals"];
+        put?: never;
+        post?: never;
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/polls/{id}/proposals/{proposalId}": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        get?: never;
+        put?: never;
+        post?: never;
+        /** Decline an option proposal */
+        delete: operations["declinePollProposal"];
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/polls/{id}/proposals/{proposalId}/approve": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        get?: never;
+        put?: never;
+        /** Add a proposal to the options of the poll */
+        post: operations["approvePollProposal"];
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
     "/polls/{id}/pick-winner": {
         parameters: {
             query?: never;
@@ -421,6 +633,83 @@ export interface paths {
         patch?: never;
         trace?: never;
     };
+    "/p/poll/{slug}/autofill": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        get?: never;
+        put?: never;
+        /**
+         * Suggest answers from the voter's own calendar
+         * @description Reads the busy times from a private ICS or CalDAV free-busy URL, or from the contents of an uploaded .ics file, and suggests an answer per option: no if the voter is busy, yes otherwise. Full-day and multi-day options the voter is partly busy on get maybe. The calendar is only used to compute the answers and is not stored.
+         */
+        post: operations["autofillVote"];
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/p/poll/{slug}/vote/{token}": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        /** Get own vote by edit token */
+        get: operations["getOwnVote"];
+        /** Update own vote by edit token */
+        put: operations["updateOwnVote"];
+        post?: never;
+        /** Delete own vote by edit token */
+        delete: operations["deleteOwnVote"];
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/p/poll/{slug}/comments": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        /** List the visible comments of a poll */
+        get: operations["getPublicPollComments"];
+        put?: never;
+        /** Comment on a poll or one of its options */
+        post: operations["addPollComment"];
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/p/poll/{slug}/proposals": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        get?: never;
+        put?: never;
+        /**
+         * Propose an option for a poll
+         * @description Only accepted if the poll allows proposals. Depending on the poll, the option is added right away or waits for the organizer's approval.
+         */
+        post: operations["proposePollOption"];
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
     "/p/poll/{slug}/results": {
         parameters: {
             query?: never;
@@ -428,7 +717,10 @@ export interface paths {
             path?: never;
             cookie?: never;
         };
-        /** Get poll results */
+        /**
+         * Get poll results
+         * @description Results are only returned if the poll shows results and its timing allows it. Voter details are reduced to what the poll's visibility permits.
+         */
         get: operations["getPollResults"];
         put?: never;
         post?: never;
@@ -455,6 +747,26 @@ export interface paths {
         patch?: never;
         trace?: never;
     };
+    "/actions/verify-email": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        /**
+         * Confirm a guest's email address via email link
+         * @description Confirms a pending booking that matched an email based auto-confirm rule once the guest proved they own the address.
+         */
+        get: operations["verifyBookingEmail"];
+        put?: never;
+        post?: never;
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
     "/actions/decline": {
         parameters: {
             query?: never;
@@ -478,6 +790,13 @@ export interface components {
     schemas: {
         Error: {
             message: string;
+            /** @description Problems with individual custom field answers. */
+            field_errors?: components["schemas"]["FieldError"][];
+        };
+        FieldError: {
+            /** @description Name of the custom field. */
+            field: string;
+            message: string;
         };
         /**
          * @description 1=time, 2=full_day, 3=multi_day
@@ -490,43 +809,102 @@ export interface components {
          */
         LinkStatus: 1 | 2;
         /**
-         * @description 1=pending, 2=confirmed, 3=declined
+         * @description 1=vote, 2=signup
          * @enum {integer}
          */
-        BookingStatus: 1 | 2 | 3;
+        PollMode: 1 | 2;
         /**
-         * @description 1=text, 2=email, 3=phone, 4=select, 5=textarea
+         * @description 1=aggregate (counts only), 2=names (voter names without emails), 3=full
          * @enum {integer}
          */
-        CustomFieldType: 1 | 2 | 3 | 4 | 5;
+        ResultsVisibility: 1 | 2 | 3;
         /**
-         * @description 1=yes, 2=no, 3=maybe
+         * @description 1=always, 2=after_vote (only to participants who voted), 3=after_close
          * @enum {integer}
          */
-        VoteResponse: 1 | 2 | 3;
-        AvailabilityRule: {
-            days_of_week: number[];
-            start_time: string;
-            end_time: string;
-        };
+        ResultsTiming: 1 | 2 | 3;
+        /**
+         * @description 1=off, 2=approval (the organizer approves proposals), 3=auto (proposals are added right away)
+         * @enum {integer}
+         */
+        ProposalMode: 1 | 2 | 3;
+        /**
+         * @description 1=decline, 2=confirm
+         * @enum {integer}
+         */
+        ApprovalTimeoutAction: 1 | 2;
+        /**
+         * @description 1=pending, 2=confirmed, 3=declined
+         * @enum {integer}
+         */
+        BookingStatus: 1 | 2 | 3;
+        /**
+         * @description 1=text, 2=email, 3=phone, 4=select, 5=textarea, 6=checkbox, 7=number, 8=date, 9=multi_select, 10=file. Checkbox answers are "true" or "false", dates are YYYY-MM-DD and multi-select answers are the chosen options separated by commas. File fields are only available on booking links: the guest uploads the file to POST /api/attachments?booking_link={slug}&field={name} first and answers with the returned token.
+         * @enum {integer}
+         */
+        CustomFieldType: 1 | 2 | 3 | 4 | 5 | 6 | 7 | 8 | 9 | 10;
+        /**
+         * @description 1=yes, 2=no, 3=maybe
+         * @enum {integer}
+         */
+        VoteResponse: 1 | 2 | 3;
+        AvailabilityRule: {
+            days_of_week: number[];
+            start_time: string;
+            end_time: string;
+        };
+        /** @description Bookings matching any rule are confirmed, all others wait for approval. Only used when auto_confirm is off. Guests type in their email address, so bookings matching known_guests or email_domains wait for approval until the guest confirms the address through a link sent to it. */
+        AutoConfirmRules: {
+            /** @description Confirm guests who already have a confirmed booking with the organizer, once they confirmed their email address */
+            known_guests?: boolean;
+            /**
+             * @description Confirm guests whose email address belongs to one of these domains, once they confirmed their email address
+             * @example [
+             *       "example.com"
+             *     ]
+             */
+            email_domains?: string[];
+            /** @description Confirm bookings whose slot lies within these hours right away */
+            hours?: components["schemas"]["AvailabilityRule"][];
+        };
         CustomField: {
             name: string;
             label: string;
             type: components["schemas"]["CustomFieldType"];
             required: boolean;
             options?: string[];
-        };
+            /** @description Regular expression text and textarea answers must match completely. */
+            pattern?: string;
+            /** @description Largest upload accepted by a file field, 10MB if not set. */
+            max_file_size_mb?: number;
+            /** @description File types accepted by a file field as extensions like ".pdf" or MIME types like "image/*". All types are accepted if empty. */
+            file_types?: string[];
+        };
+        /** @description Templates use Go text/template syntax. Available values: {{guest_name}}, {{guest_email}}, {{meeting_link}}, {{link_name}}, {{organizer_name}}, {{organizer_email}}, {{start_date}}, {{start_time}}, {{start_datetime}}, {{start_iso}}, {{end_date}}, {{end_time}}, {{end_datetime}}, {{end_iso}}, {{duration_minutes}}, {{field "name"}} for custom field answers and {{format start_at "2006-01-02 15:04"}} for custom date formats. For polls the guest values and custom fields are empty and {{link_name}} is the poll name. */
         EventTemplate: {
             title_template?: string;
             description_template?: string;
             location?: string;
         };
+        /** @description Points an option earns per response, defaults to yes=2 and maybe=1 */
+        PollScoring: {
+            yes_points: number;
+            maybe_points: number;
+        };
+        /** @description Theme colors and images for public pages and emails. Logo and cover images are uploaded via /api/branding; their URLs are ignored in update requests. */
+        Branding: {
+            primary_color?: string;
+            accent_color?: string;
+            logo_url?: string;
+            cover_url?: string;
+        };
         User: {
             id: number;
             email: string;
             name?: string;
             /** @description URL to the user's avatar image, or empty if no avatar is set */
             avatar_url?: string;
+            branding?: components["schemas"]["Branding"];
         };
         CalendarConnection: {
             id: number;
@@ -567,6 +945,10 @@ export interface components {
             description?: string;
             status: components["schemas"]["LinkStatus"];
             auto_confirm?: boolean;
+            auto_confirm_rules?: components["schemas"]["AutoConfirmRules"];
+            /** @description Hours after which bookings that still wait for approval are resolved with approval_timeout_action. 0 waits until the organizer reacts. */
+            approval_timeout_hours?: number;
+            approval_timeout_action?: components["schemas"]["ApprovalTimeoutAction"];
             /**
              * @description Duration of each bookable slot in minutes (deprecated, use slot_durations_minutes)
              * @default 30
@@ -589,9 +971,12 @@ export interface components {
             require_email?: boolean;
             /** @description Video meeting link (Zoom, Google Meet, etc.) to include in calendar events */
             meeting_link?: string;
+            /** @description Provider generating a meeting link per booking (e.g. jitsi, bigbluebutton). Empty or "static" uses meeting_link for every booking. */
+            meeting_provider?: string;
             availability_rules?: components["schemas"]["AvailabilityRule"][];
             custom_fields?: components["schemas"]["CustomField"][];
             event_template?: components["schemas"]["EventTemplate"];
+            branding?: components["schemas"]["Branding"];
             /** Format: date-time */
             created_at?: string;
         };
@@ -601,9 +986,33 @@ export interface components {
             name: string;
             description?: string;
             status: components["schemas"]["LinkStatus"];
+            mode?: components["schemas"]["PollMode"];
+            /** @description Sign-up sheets only, how many options a participant may choose (0 = unlimited) */
+            max_choices?: number;
             show_results?: boolean;
+            results_visibility?: components["schemas"]["ResultsVisibility"];
+            results_timing?: components["schemas"]["ResultsTiming"];
             require_email?: boolean;
             custom_fields?: components["schemas"]["CustomField"][];
+            branding?: components["schemas"]["Branding"];
+            /**
+             * Format: date-time
+             * @description Voting closes automatically at this time
+             */
+            deadline?: string;
+            event_template?: components["schemas"]["EventTemplate"];
+            /** @description Option picked as winner, set once the poll is decided */
+            winner_option_id?: number;
+            /** @description Hours before the deadline to remind invitees who haven't voted yet, 0 disables reminders */
+            reminder_hours?: number;
+            scoring?: components["schemas"]["PollScoring"];
+            /** @description Pick the recommended option as winner when the poll closes */
+            auto_pick_on_close?: boolean;
+            /** @description Pick the winner as soon as every invitee has voted and all voters said yes to an option */
+            auto_pick_when_all_yes?: boolean;
+            /** @description Reserve the options as tentative events in the organizer's calendar until a winner is picked or the poll closes. Held times can't be booked through booking links. Options participants add without approval are not held. */
+            hold_times?: boolean;
+            option_proposals?: components["schemas"]["ProposalMode"];
             /** Format: date-time */
             created_at?: string;
         };
@@ -614,6 +1023,21 @@ export interface components {
             start_time: string;
             /** Format: date-time */
             end_time: string;
+            /** @description Sign-up sheets only, maximum number of participants (0 = unlimited) */
+            capacity?: number;
+            /** @description Sign-up sheets only, number of participants who chose this option */
+            signups?: number;
+            /** @description Sign-up sheets only, the option has reached its capacity */
+            full?: boolean;
+            /** @description Name of the participant who proposed the option */
+            proposed_by?: string;
+        };
+        PollOptionCandidate: {
+            type: components["schemas"]["SlotType"];
+            /** Format: date-time */
+            start_time: string;
+            /** Format: date-time */
+            end_time: string;
         };
         Slot: {
             id: number;
@@ -629,12 +1053,27 @@ export interface components {
             guest_email: string;
             guest_name?: string;
             status: components["schemas"]["BookingStatus"];
+            /** @description Meeting link generated for this booking */
+            meeting_link?: string;
             custom_fields?: {
                 [key: string]: string;
             };
+            /** @description Files the guest uploaded for file fields. */
+            attachments?: components["schemas"]["BookingAttachment"][];
             /** Format: date-time */
             created_at?: string;
         };
+        BookingAttachment: {
+            id: number;
+            /** @description Name of the custom field. */
+            field: string;
+            filename: string;
+            content_type?: string;
+            /** Format: int64 */
+            size: number;
+            /** @description Download URL, requires the organizer's session. */
+            url: string;
+        };
         Vote: {
             id: number;
             guest_name?: string;
@@ -645,6 +1084,53 @@ export interface components {
             custom_fields?: {
                 [key: string]: string;
             };
+            /** @description Token to edit or delete the vote, only returned to the voter */
+            edit_token?: string;
+            /** Format: date-time */
+            created_at?: string;
+        };
+        PollInvite: {
+            id: number;
+            email: string;
+            name?: string;
+            voted: boolean;
+            /** Format: date-time */
+            voted_at?: string;
+            /** @description Options a required participant can't attend are ranked last */
+            required?: boolean;
+            /** Format: date-time */
+            invited_at?: string;
+            /** Format: date-time */
+            reminded_at?: string;
+        };
+        Invitee: {
+            /** Format: email */
+            email: string;
+            name?: string;
+            required?: boolean;
+        };
+        PollProposal: {
+            id: number;
+            type: components["schemas"]["SlotType"];
+            /** Format: date-time */
+            start_time: string;
+            /** Format: date-time */
+            end_time: string;
+            name: string;
+            email?: string;
+            /** Format: date-time */
+            created_at?: string;
+        };
+        PollComment: {
+            id: number;
+            /** @description Option the comment refers to, omitted for comments on the poll */
+            option_id?: number;
+            author_name: string;
+            /** @description Only visible to the organizer */
+            author_email?: string;
+            body: string;
+            /** @description Hidden by the organizer, only visible to the organizer */
+            hidden?: boolean;
             /** Format: date-time */
             created_at?: string;
         };
@@ -653,6 +1139,15 @@ export interface components {
             yes_count: number;
             no_count: number;
             maybe_count: number;
+            score?: number;
+            /** @description Number of required participants who can't attend */
+            required_no_count?: number;
+        };
+        PollResults: {
+            /** @description Options ranked from best to worst */
+            tally: components["schemas"]["VoteTally"][];
+            /** @description Best ranked option, omitted while nobody is available for any option */
+            recommended_option_id?: number;
         };
     };
     responses: never;
@@ -778,6 +1273,7 @@ export interface operations {
                 "application/json": {
                     /** @description Display name for the organizer */
                     name?: string;
+                    branding?: components["schemas"]["Branding"];
                 };
             };
         };
@@ -931,6 +1427,26 @@ export interface operations {
             };
         };
     };
+    listMeetingProviders: {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        requestBody?: never;
+        responses: {
+            /** @description Provider names */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": string[];
+                };
+            };
+        };
+    };
     listBookingLinks: {
         parameters: {
             query?: never;
@@ -964,6 +1480,9 @@ export interface operations {
                     name: string;
                     description?: string;
                     auto_confirm?: boolean;
+                    auto_confirm_rules?: components["schemas"]["AutoConfirmRules"];
+                    approval_timeout_hours?: number;
+                    approval_timeout_action?: components["schemas"]["ApprovalTimeoutAction"];
                     /** @default 30 */
                     slot_duration_minutes?: number;
                     /** @description Available slot durations in minutes */
@@ -973,9 +1492,12 @@ export interface operations {
                     require_email?: boolean;
                     /** @description Video meeting link (Zoom, Google Meet, etc.) */
                     meeting_link?: string;
+                    /** @description Provider generating a meeting link per booking */
+                    meeting_provider?: string;
                     availability_rules?: components["schemas"]["AvailabilityRule"][];
                     custom_fields?: components["schemas"]["CustomField"][];
                     event_template?: components["schemas"]["EventTemplate"];
+                    branding?: components["schemas"]["Branding"];
                 };
             };
         };
@@ -989,6 +1511,15 @@ export interface operations {
                     "application/json": components["schemas"]["BookingLink"];
                 };
             };
+            /** @description Invalid booking link */
+            400: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Error"];
+                };
+            };
         };
     };
     getBookingLink: {
@@ -1029,15 +1560,21 @@ export interface operations {
                     description?: string;
                     status?: components["schemas"]["LinkStatus"];
                     auto_confirm?: boolean;
+                    auto_confirm_rules?: components["schemas"]["AutoConfirmRules"];
+                    approval_timeout_hours?: number;
+                    approval_timeout_action?: components["schemas"]["ApprovalTimeoutAction"];
                     slot_duration_minutes?: number;
                     slot_durations_minutes?: number[];
                     buffer_minutes?: number;
                     require_email?: boolean;
                     /** @description Video meeting link (Zoom, Google Meet, etc.) */
                     meeting_link?: string;
+                    /** @description Provider generating a meeting link per booking */
+                    meeting_provider?: string;
                     availability_rules?: components["schemas"]["AvailabilityRule"][];
                     custom_fields?: components["schemas"]["CustomField"][];
                     event_template?: components["schemas"]["EventTemplate"];
+                    branding?: components["schemas"]["Branding"];
                 };
             };
         };
@@ -1051,6 +1588,15 @@ export interface operations {
                     "application/json": components["schemas"]["BookingLink"];
                 };
             };
+            /** @description Invalid booking link */
+            400: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Error"];
+                };
+            };
         };
     };
     deleteBookingLink: {
@@ -1128,8 +1674,23 @@ export interface operations {
                     name: string;
                     description?: string;
                     show_results?: boolean;
+                    results_visibility?: components["schemas"]["ResultsVisibility"];
+                    results_timing?: components["schemas"]["ResultsTiming"];
                     require_email?: boolean;
+                    mode?: components["schemas"]["PollMode"];
+                    max_choices?: number;
                     custom_fields?: components["schemas"]["CustomField"][];
+                    branding?: components["schemas"]["Branding"];
+                    /** Format: date-time */
+                    deadline?: string;
+                    event_template?: components["schemas"]["EventTemplate"];
+                    /** @description Defaults to 24 */
+                    reminder_hours?: number;
+                    scoring?: components["schemas"]["PollScoring"];
+                    auto_pick_on_close?: boolean;
+                    auto_pick_when_all_yes?: boolean;
+                    hold_times?: boolean;
+                    option_proposals?: components["schemas"]["ProposalMode"];
                 };
             };
         };
@@ -1143,6 +1704,15 @@ export interface operations {
                     "application/json": components["schemas"]["Poll"];
                 };
             };
+            /** @description Invalid request */
+            400: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Error"];
+                };
+            };
         };
     };
     getPoll: {
@@ -1183,8 +1753,25 @@ export interface operations {
                     description?: string;
                     status?: components["schemas"]["LinkStatus"];
                     show_results?: boolean;
+                    results_visibility?: components["schemas"]["ResultsVisibility"];
+                    results_timing?: components["schemas"]["ResultsTiming"];
                     require_email?: boolean;
+                    mode?: components["schemas"]["PollMode"];
+                    max_choices?: number;
                     custom_fields?: components["schemas"]["CustomField"][];
+                    branding?: components["schemas"]["Branding"];
+                    /**
+                     * Format: date-time
+                     * @description Set to null to remove the deadline
+                     */
+                    deadline?: string | null;
+                    event_template?: components["schemas"]["EventTemplate"];
+                    reminder_hours?: number;
+                    scoring?: components["schemas"]["PollScoring"];
+                    auto_pick_on_close?: boolean;
+                    auto_pick_when_all_yes?: boolean;
+                    hold_times?: boolean;
+                    option_proposals?: components["schemas"]["ProposalMode"];
                 };
             };
         };
@@ -1198,6 +1785,15 @@ export interface operations {
                     "application/json": components["schemas"]["Poll"];
                 };
             };
+            /** @description Invalid request */
+            400: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Error"];
+                };
+            };
         };
     };
     deletePoll: {
@@ -1259,6 +1855,7 @@ export interface operations {
                     start_time: string;
                     /** Format: date-time */
                     end_time: string;
+                    capacity?: number;
                 };
             };
         };
@@ -1274,28 +1871,61 @@ export interface operations {
             };
         };
     };
-    deletePollOption: {
+    suggestPollOptions: {
         parameters: {
             query?: never;
             header?: never;
             path: {
                 id: number;
-                optionId: number;
             };
             cookie?: never;
         };
-        requestBody?: never;
+        requestBody: {
+            content: {
+                "application/json": {
+                    /** Format: date-time */
+                    start: string;
+                    /** Format: date-time */
+                    end: string;
+                    duration_minutes: number;
+                    buffer_minutes?: number;
+                    /** @description Rules to use, defaults to the rules of booking_link_id or of all active booking links of the organizer */
+                    availability_rules?: components["schemas"]["AvailabilityRule"][];
+                    /** @description Use the availability rules of this booking link */
+                    booking_link_id?: number;
+                    /** @description Maximum number of suggestions, defaults to 50 */
+                    limit?: number;
+                    /** @description Add the suggestions to the poll right away */
+                    add?: boolean;
+                };
+            };
+        };
         responses: {
-            /** @description Option deleted */
-            204: {
+            /** @description Suggested options */
+            200: {
                 headers: {
                     [name: string]: unknown;
                 };
-                content?: never;
+                content: {
+                    "application/json": {
+                        candidates: components["schemas"]["PollOptionCandidate"][];
+                        /** @description Options created when add is set */
+                        added?: components["schemas"]["PollOption"][];
+                    };
+                };
+            };
+            /** @description Invalid request */
+            400: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Error"];
+                };
             };
         };
     };
-    getPollVotes: {
+    generatePollOptions: {
         parameters: {
             query?: never;
             header?: never;
@@ -1304,38 +1934,73 @@ export interface operations {
             };
             cookie?: never;
         };
-        requestBody?: never;
+        requestBody: {
+            content: {
+                "application/json": {
+                    type: components["schemas"]["SlotType"];
+                    /** Format: date */
+                    start_date: string;
+                    /**
+                     * Format: date
+                     * @description Last day an option may start on
+                     */
+                    end_date: string;
+                    /** @description 0=Sunday ... 6=Saturday, defaults to every day */
+                    days_of_week?: number[];
+                    /** @description Start times of time options */
+                    times?: string[];
+                    /** @description Length of time options */
+                    duration_minutes?: number;
+                    /** @description Number of days of multi-day options */
+                    span_days?: number;
+                    /** @description IANA time zone of the times, e.g. Europe/Berlin. Defaults to UTC. */
+                    time_zone?: string;
+                    /** @description Add the generated options to the poll */
+                    add?: boolean;
+                };
+            };
+        };
         responses: {
-            /** @description Votes */
+            /** @description Generated options */
             200: {
                 headers: {
                     [name: string]: unknown;
                 };
                 content: {
-                    "application/json": components["schemas"]["Vote"][];
+                    "application/json": {
+                        candidates: components["schemas"]["PollOptionCandidate"][];
+                        /** @description Number of options the poll already offers */
+                        skipped: number;
+                        /** @description Options created when add is set */
+                        added?: components["schemas"]["PollOption"][];
+                    };
+                };
+            };
+            /** @description Invalid request */
+            400: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Error"];
                 };
             };
         };
     };
-    pickPollWinner: {
+    deletePollOption: {
         parameters: {
             query?: never;
             header?: never;
             path: {
                 id: number;
+                optionId: number;
             };
             cookie?: never;
         };
-        requestBody: {
-            content: {
-                "application/json": {
-                    option_id: number;
-                };
-            };
-        };
+        requestBody?: never;
         responses: {
-            /** @description Winner picked */
-            200: {
+            /** @description Option deleted */
+            204: {
                 headers: {
                     [name: string]: unknown;
                 };
@@ -1343,7 +2008,7 @@ export interface operations {
             };
         };
     };
-    approveBooking: {
+    getPollVotes: {
         parameters: {
             query?: never;
             header?: never;
@@ -1354,18 +2019,18 @@ export interface operations {
         };
         requestBody?: never;
         responses: {
-            /** @description Booking approved */
+            /** @description Votes */
             200: {
                 headers: {
                     [name: string]: unknown;
                 };
                 content: {
-                    "application/json": components["schemas"]["Booking"];
+                    "application/json": components["schemas"]["Vote"][];
                 };
             };
         };
     };
-    declineBooking: {
+    listPollInvites: {
         parameters: {
             query?: never;
             header?: never;
@@ -1376,50 +2041,45 @@ export interface operations {
         };
         requestBody?: never;
         responses: {
-            /** @description Booking declined */
+            /** @description Invitees */
             200: {
                 headers: {
                     [name: string]: unknown;
                 };
                 content: {
-                    "application/json": components["schemas"]["Booking"];
+                    "application/json": components["schemas"]["PollInvite"][];
                 };
             };
         };
     };
-    getPublicBookingLink: {
+    addPollInvites: {
         parameters: {
             query?: never;
             header?: never;
             path: {
-                slug: string;
+                id: number;
             };
             cookie?: never;
         };
-        requestBody?: never;
+        requestBody: {
+            content: {
+                "application/json": {
+                    invitees: components["schemas"]["Invitee"][];
+                };
+            };
+        };
         responses: {
-            /** @description Booking link info */
-            200: {
+            /** @description Newly invited participants, addresses that were already invited are skipped */
+            201: {
                 headers: {
                     [name: string]: unknown;
                 };
                 content: {
-                    "application/json": {
-                        name: string;
-                        description?: string;
-                        custom_fields?: components["schemas"]["CustomField"][];
-                        require_email?: boolean;
-                        /** @description Available slot durations in minutes */
-                        slot_durations_minutes?: number[];
-                        /** @description Display name of the organizer */
-                        organizer_name?: string;
-                        /** @description URL to the organizer's avatar image */
-                        organizer_avatar_url?: string;
-                    };
+                    "application/json": components["schemas"]["PollInvite"][];
                 };
             };
-            /** @description Not found */
-            404: {
+            /** @description Invalid request */
+            400: {
                 headers: {
                     [name: string]: unknown;
                 };
@@ -1429,57 +2089,393 @@ export interface operations {
             };
         };
     };
-    getBookingAvailability: {
+    remindPollInvites: {
         parameters: {
-            query: {
-                start: string;
-                end: string;
-                /** @description Filter availability by slot duration in minutes */
-                duration?: number;
-            };
+            query?: never;
             header?: never;
             path: {
-                slug: string;
+                id: number;
             };
             cookie?: never;
         };
         requestBody?: never;
         responses: {
-            /** @description Available slots */
+            /** @description Reminders sent */
             200: {
                 headers: {
                     [name: string]: unknown;
                 };
                 content: {
                     "application/json": {
-                        slots: components["schemas"]["Slot"][];
+                        sent: number;
                     };
                 };
             };
         };
     };
-    createBooking: {
+    deletePollInvite: {
         parameters: {
             query?: never;
             header?: never;
             path: {
-                slug: string;
+                id: number;
+                inviteId: number;
             };
             cookie?: never;
         };
-        requestBody: {
-            content: {
-                "application/json": {
-                    /** Format: email */
-                    guest_email: string;
-                    guest_name?: string;
-                    /**
-                     * Format: date-time
-                     * @description Start time of the requested slot
-                     */
-                    start_time: string;
-                    /**
-                     * Format: date-time
+        requestBody?: never;
+        responses: {
+            /** @description Invite removed */
+            204: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content?: never;
+            };
+        };
+    };
+    getPollRanking: {
+        parameters: {
+            query?: never;
+            header?: never;
+            path: {
+                id: number;
+            };
+            cookie?: never;
+        };
+        requestBody?: never;
+        responses: {
+            /** @description Ranked results */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["PollResults"];
+                };
+            };
+        };
+    };
+    listPollComments: {
+        parameters: {
+            query?: never;
+            header?: never;
+            path: {
+                id: number;
+            };
+            cookie?: never;
+        };
+        requestBody?: never;
+        responses: {
+            /** @description Comments */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["PollComment"][];
+                };
+            };
+        };
+    };
+    moderatePollComment: {
+        parameters: {
+            query?: never;
+            header?: never;
+            path: {
+                id: number;
+                commentId: number;
+            };
+            cookie?: never;
+        };
+        requestBody: {
+            content: {
+                "application/json": {
+                    hidden: boolean;
+                };
+            };
+        };
+        responses: {
+            /** @description Comment updated */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["PollComment"];
+                };
+            };
+        };
+    };
+    deletePollComment: {
+        parameters: {
+            query?: never;
+            header?: never;
+            path: {
+                id: number;
+                commentId: number;
+            };
+            cookie?: never;
+        };
+        requestBody?: never;
+        responses: {
+            /** @description Comment deleted */
+            204: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content?: never;
+            };
+        };
+    };
+    listPollProposals: {
+        parameters: {
+            query?: never;
+            header?: never;
+            path: {
+                id: number;
+            };
+            cookie?: never;
+        };
+        requestBody?: never;
+        responses: {
+            /** @description Proposals, oldest first */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["PollProposal"][];
+                };
+            };
+        };
+    };
+    declinePollProposal: {
+        parameters: {
+            query?: never;
+            header?: never;
+            path: {
+                id: number;
+                proposalId: number;
+            };
+            cookie?: never;
+        };
+        requestBody?: never;
+        responses: {
+            /** @description Proposal declined */
+            204: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content?: never;
+            };
+        };
+    };
+    approvePollProposal: {
+        parameters: {
+            query?: never;
+            header?: never;
+            path: {
+                id: number;
+                proposalId: number;
+            };
+            cookie?: never;
+        };
+        requestBody?: never;
+        responses: {
+            /** @description Option created */
+            201: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["PollOption"];
+                };
+            };
+            /** @description The poll already offers this time */
+            400: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Error"];
+                };
+            };
+        };
+    };
+    pickPollWinner: {
+        parameters: {
+            query?: never;
+            header?: never;
+            path: {
+                id: number;
+            };
+            cookie?: never;
+        };
+        requestBody: {
+            content: {
+                "application/json": {
+                    option_id: number;
+                };
+            };
+        };
+        responses: {
+            /** @description Winner picked */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content?: never;
+            };
+            /** @description A winner was already picked */
+            400: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Error"];
+                };
+            };
+        };
+    };
+    approveBooking: {
+        parameters: {
+            query?: never;
+            header?: never;
+            path: {
+                id: number;
+            };
+            cookie?: never;
+        };
+        requestBody?: never;
+        responses: {
+            /** @description Booking approved */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Booking"];
+                };
+            };
+        };
+    };
+    declineBooking: {
+        parameters: {
+            query?: never;
+            header?: never;
+            path: {
+                id: number;
+            };
+            cookie?: never;
+        };
+        requestBody?: never;
+        responses: {
+            /** @description Booking declined */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Booking"];
+                };
+            };
+        };
+    };
+    getPublicBookingLink: {
+        parameters: {
+            query?: never;
+            header?: never;
+            path: {
+                slug: string;
+            };
+            cookie?: never;
+        };
+        requestBody?: never;
+        responses: {
+            /** @description Booking link info */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": {
+                        name: string;
+                        description?: string;
+                        custom_fields?: components["schemas"]["CustomField"][];
+                        require_email?: boolean;
+                        /** @description Available slot durations in minutes */
+                        slot_durations_minutes?: number[];
+                        /** @description Display name of the organizer */
+                        organizer_name?: string;
+                        /** @description URL to the organizer's avatar image */
+                        organizer_avatar_url?: string;
+                        branding?: components["schemas"]["Branding"];
+                    };
+                };
+            };
+            /** @description Not found */
+            404: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Error"];
+                };
+            };
+        };
+    };
+    getBookingAvailability: {
+        parameters: {
+            query: {
+                start: string;
+                end: string;
+                /** @description Filter availability by slot duration in minutes */
+                duration?: number;
+            };
+            header?: never;
+            path: {
+                slug: string;
+            };
+            cookie?: never;
+        };
+        requestBody?: never;
+        responses: {
+            /** @description Available slots */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": {
+                        slots: components["schemas"]["Slot"][];
+                    };
+                };
+            };
+        };
+    };
+    createBooking: {
+        parameters: {
+            query?: never;
+            header?: never;
+            path: {
+                slug: string;
+            };
+            cookie?: never;
+        };
+        requestBody: {
+            content: {
+                "application/json": {
+                    /** Format: email */
+                    guest_email: string;
+                    guest_name?: string;
+                    /**
+                     * Format: date-time
+                     * @description Start time of the requested slot
+                     */
+                    start_time: string;
+                    /**
+                     * Format: date-time
                      * @description End time of the requested slot
                      */
                     end_time: string;
@@ -1490,20 +2486,181 @@ export interface operations {
             };
         };
         responses: {
-            /** @description Booking created */
+            /** @description Booking created */
+            201: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": {
+                        status: components["schemas"]["BookingStatus"];
+                        message?: string;
+                        /** @description The booking is confirmed once the guest confirms their email address through the link sent to it */
+                        verify_email?: boolean;
+                    };
+                };
+            };
+            /** @description Slot unavailable */
+            409: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Error"];
+                };
+            };
+        };
+    };
+    getPublicPoll: {
+        parameters: {
+            query?: {
+                /** @description Token of a personal invite link */
+                invite?: string;
+            };
+            header?: never;
+            path: {
+                slug: string;
+            };
+            cookie?: never;
+        };
+        requestBody?: never;
+        responses: {
+            /** @description Poll info */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": {
+                        name: string;
+                        description?: string;
+                        custom_fields?: components["schemas"]["CustomField"][];
+                        options: components["schemas"]["PollOption"][];
+                        show_results?: boolean;
+                        results_visibility?: components["schemas"]["ResultsVisibility"];
+                        results_timing?: components["schemas"]["ResultsTiming"];
+                        require_email?: boolean;
+                        mode?: components["schemas"]["PollMode"];
+                        max_choices?: number;
+                        option_proposals?: components["schemas"]["ProposalMode"];
+                        /** @description Display name of the organizer */
+                        organizer_name?: string;
+                        /** @description URL to the organizer's avatar image */
+                        organizer_avatar_url?: string;
+                        branding?: components["schemas"]["Branding"];
+                        /**
+                         * Format: date-time
+                         * @description Voting closes at this time
+                         */
+                        deadline?: string;
+                        /** @description Voting has closed, at the deadline or by the organizer. The poll can still be viewed but no longer takes votes, comments or proposals. */
+                        closed?: boolean;
+                        /** @description Option picked as winner, set once the poll is decided */
+                        winner_option_id?: number;
+                        /** @description The invited participant, when opened through a personal link */
+                        invitee?: {
+                            email: string;
+                            name?: string;
+                            /** @description Edit token of the invitee's vote, if they already voted */
+                            edit_token?: string;
+                        };
+                    };
+                };
+            };
+            /** @description Not found */
+            404: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Error"];
+                };
+            };
+        };
+    };
+    submitVote: {
+        parameters: {
+            query?: never;
+            header?: never;
+            path: {
+                slug: string;
+            };
+            cookie?: never;
+        };
+        requestBody: {
+            content: {
+                "application/json": {
+                    guest_name?: string;
+                    /** Format: email */
+                    guest_email?: string;
+                    responses: {
+                        [key: string]: components["schemas"]["VoteResponse"];
+                    };
+                    custom_fields?: {
+                        [key: string]: string;
+                    };
+                    /** @description Token of the personal invite link the vote was cast through */
+                    invite_token?: string;
+                };
+            };
+        };
+        responses: {
+            /** @description Vote submitted */
             201: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Vote"];
+                };
+            };
+            /** @description Invalid vote or voting closed */
+            400: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Error"];
+                };
+            };
+        };
+    };
+    autofillVote: {
+        parameters: {
+            query?: never;
+            header?: never;
+            path: {
+                slug: string;
+            };
+            cookie?: never;
+        };
+        requestBody: {
+            content: {
+                "application/json": {
+                    /** @description http(s) or webcal URL of the calendar */
+                    url?: string;
+                    /** @description Contents of an .ics file */
+                    ics?: string;
+                };
+            };
+        };
+        responses: {
+            /** @description Suggested answers */
+            200: {
                 headers: {
                     [name: string]: unknown;
                 };
                 content: {
                     "application/json": {
-                        status: components["schemas"]["BookingStatus"];
-                        message?: string;
+                        /** @description Suggested answer per option ID */
+                        responses: {
+                            [key: string]: components["schemas"]["VoteResponse"];
+                        };
                     };
                 };
             };
-            /** @description Slot unavailable */
-            409: {
+            /** @description Calendar couldn't be read or voting closed */
+            400: {
                 headers: {
                     [name: string]: unknown;
                 };
@@ -1513,38 +2670,28 @@ export interface operations {
             };
         };
     };
-    getPublicPoll: {
+    getOwnVote: {
         parameters: {
             query?: never;
             header?: never;
             path: {
                 slug: string;
+                token: string;
             };
             cookie?: never;
         };
         requestBody?: never;
         responses: {
-            /** @description Poll info */
+            /** @description Vote */
             200: {
                 headers: {
                     [name: string]: unknown;
                 };
                 content: {
-                    "application/json": {
-                        name: string;
-                        description?: string;
-                        custom_fields?: components["schemas"]["CustomField"][];
-                        options: components["schemas"]["PollOption"][];
-                        show_results?: boolean;
-                        require_email?: boolean;
-                        /** @description Display name of the organizer */
-                        organizer_name?: string;
-                        /** @description URL to the organizer's avatar image */
-                        organizer_avatar_url?: string;
-                    };
+                    "application/json": components["schemas"]["Vote"];
                 };
             };
-            /** @description Not found */
+            /** @description Vote not found */
             404: {
                 headers: {
                     [name: string]: unknown;
@@ -1555,12 +2702,13 @@ export interface operations {
             };
         };
     };
-    submitVote: {
+    updateOwnVote: {
         parameters: {
             query?: never;
             header?: never;
             path: {
                 slug: string;
+                token: string;
             };
             cookie?: never;
         };
@@ -1568,8 +2716,6 @@ export interface operations {
             content: {
                 "application/json": {
                     guest_name?: string;
-                    /** Format: email */
-                    guest_email?: string;
                     responses: {
                         [key: string]: components["schemas"]["VoteResponse"];
                     };
@@ -1580,8 +2726,8 @@ export interface operations {
             };
         };
         responses: {
-            /** @description Vote submitted */
-            201: {
+            /** @description Vote updated */
+            200: {
                 headers: {
                     [name: string]: unknown;
                 };
@@ -1589,9 +2735,120 @@ export interface operations {
                     "application/json": components["schemas"]["Vote"];
                 };
             };
+            /** @description Vote not found or voting closed */
+            400: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Error"];
+                };
+            };
         };
     };
-    getPollResults: {
+    deleteOwnVote: {
+        parameters: {
+            query?: never;
+            header?: never;
+            path: {
+                slug: string;
+                token: string;
+            };
+            cookie?: never;
+        };
+        requestBody?: never;
+        responses: {
+            /** @description Vote deleted */
+            204: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content?: never;
+            };
+            /** @description Vote not found or voting closed */
+            400: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Error"];
+                };
+            };
+        };
+    };
+    getPublicPollComments: {
+        parameters: {
+            query?: never;
+            header?: never;
+            path: {
+                slug: string;
+            };
+            cookie?: never;
+        };
+        requestBody?: never;
+        responses: {
+            /** @description Comments, oldest first */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["PollComment"][];
+                };
+            };
+            /** @description Poll not found */
+            404: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Error"];
+                };
+            };
+        };
+    };
+    addPollComment: {
+        parameters: {
+            query?: never;
+            header?: never;
+            path: {
+                slug: string;
+            };
+            cookie?: never;
+        };
+        requestBody: {
+            content: {
+                "application/json": {
+                    author_name: string;
+                    /** Format: email */
+                    author_email?: string;
+                    body: string;
+                    option_id?: number;
+                };
+            };
+        };
+        responses: {
+            /** @description Comment added */
+            201: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["PollComment"];
+                };
+            };
+            /** @description Invalid comment or poll closed */
+            400: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Error"];
+                };
+            };
+        };
+    };
+    proposePollOption: {
         parameters: {
             query?: never;
             header?: never;
@@ -1600,6 +2857,57 @@ export interface operations {
             };
             cookie?: never;
         };
+        requestBody: {
+            content: {
+                "application/json": {
+                    type: components["schemas"]["SlotType"];
+                    /** Format: date-time */
+                    start_time: string;
+                    /** Format: date-time */
+                    end_time: string;
+                    name: string;
+                    /** Format: email */
+                    email?: string;
+                };
+            };
+        };
+        responses: {
+            /** @description Proposal received */
+            201: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": {
+                        /** @description The proposal waits for the organizer's approval */
+                        pending: boolean;
+                        option?: components["schemas"]["PollOption"];
+                    };
+                };
+            };
+            /** @description Invalid proposal or poll closed */
+            400: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Error"];
+                };
+            };
+        };
+    };
+    getPollResults: {
+        parameters: {
+            query?: {
+                /** @description Edit token of the visitor's vote, required for results shown after voting */
+                token?: string;
+            };
+            header?: never;
+            path: {
+                slug: string;
+            };
+            cookie?: never;
+        };
         requestBody?: never;
         responses: {
             /** @description Poll results */
@@ -1609,7 +2917,10 @@ export interface operations {
                 };
                 content: {
                     "application/json": {
+                        /** @description Options ranked from best to worst */
                         tally: components["schemas"]["VoteTally"][];
+                        recommended_option_id?: number;
+                        /** @description Omitted when only aggregate results are shown */
                         votes?: components["schemas"]["Vote"][];
                     };
                 };
@@ -1656,6 +2967,39 @@ export interface operations {
             };
         };
     };
+    verifyBookingEmail: {
+        parameters: {
+            query: {
+                token: string;
+            };
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        requestBody?: never;
+        responses: {
+            /** @description Email address confirmed */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": {
+                        message?: string;
+                    };
+                };
+            };
+            /** @description Invalid or expired token */
+            400: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Error"];
+                };
+            };
+        };
+    };
     declineViaEmail: {
         parameters: {
             query?: never;